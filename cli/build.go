@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/pkg/build"
+	"github.com/spf13/pflag"
+)
+
+// buildProject builds release binaries of the project for the targets in
+// its lace.yml.
+func buildProject(log logger.Logger, args []string) {
+	fs := pflag.NewFlagSet("build", pflag.ExitOnError)
+	version := fs.String("version", "", "version to stamp into the binaries, defaults to lace.yml's or git's")
+	targets := fs.StringArray("target", nil, "build only the named target, may be repeated")
+	archive := fs.Bool("archive", false, "pack each binary into a tar.gz and write checksums")
+	jobs := fs.Int("jobs", 0, "number of targets to build at once, defaults to the number of CPUs")
+	offline := fs.Bool("offline", false, "use only dependencies already in the cache")
+	clean := fs.Bool("clean", false, "remove existing artifacts first")
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("error parsing arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	dir := findProject()
+	if fs.NArg() > 0 {
+		dir = isProject(fs.Arg(0))
+	}
+
+	if dir == "" {
+		log.Error("no lace.yml found")
+		os.Exit(1)
+	}
+
+	b, err := build.LoadBuilder(log, dir)
+	if err != nil {
+		log.Error("error loading project builder", "error", err)
+		os.Exit(1)
+	}
+
+	b.SetOffline(*offline)
+
+	if *clean {
+		log.Info("cleaning build artifacts")
+		b.Clean()
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	arts, err := b.Build(ctx, build.BuildOptions{
+		Version: *version,
+		Targets: *targets,
+		Archive: *archive,
+		Jobs:    *jobs,
+	})
+	if err != nil {
+		log.Error("error running build", "error", err)
+		os.Exit(1)
+	}
+
+	for _, art := range arts {
+		fmt.Println(art.Path)
+	}
+}
@@ -3,19 +3,25 @@ package cli
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"strings"
+	"syscall"
 
 	"github.com/lab47/lablog/logger"
 	"github.com/lab47/lace/core"
 	"github.com/lab47/lace/pkg/build"
+	"github.com/lab47/lace/pkg/rpc"
 	_ "github.com/lab47/lace/std-ng/all"
+	"github.com/lab47/lace/std-ng/namespace"
 	"github.com/spf13/pflag"
-	"golang.org/x/sys/unix"
 )
 
 type (
@@ -81,7 +87,29 @@ var runningProfile interface {
 	Stop()
 }
 
+// buildInfo identifies a program built from a lace project, as stamped into
+// it by the builder.
+var buildInfo struct {
+	program string
+	version string
+	id      string
+}
+
+// SetBuildInfo records the name, version and build id of the program, which
+// --version then reports.
+func SetBuildInfo(program, version, id string) {
+	buildInfo.program = program
+	buildInfo.version = version
+	buildInfo.id = id
+}
+
 func MainIn(nsName string) {
+	MainInFS(nsName, nil)
+}
+
+// MainInFS is MainIn for a binary that carries its lace sources with it,
+// loading namespaces from sources before looking for them on disk.
+func MainInFS(nsName string, sources fs.FS) {
 	env, err := core.NewEnv()
 	if err != nil {
 		fmt.Printf("unable to initialize environment: %s", err)
@@ -96,6 +124,8 @@ func MainIn(nsName string) {
 	cpuProfileRate := fs.Int("cpuprofile-rate", 100, "Specify the sampling rate of the cpu profiler")
 	memProfile := fs.String("memprofile", "", "Write Memory profile info to the specified path")
 	debugBytecode := fs.Bool("debug-bytecode", false, "Display bytecode for functions are it is generated")
+	startupReport := fs.Bool("startup-report", false, "Report the time spent loading reflected Go packages on exit")
+	watchPaths := fs.String("watch-paths", "", "load the sources from these directories, separated by "+string(filepath.ListSeparator)+", and reload them as they change")
 
 	if err := fs.Parse(os.Args); err != nil {
 		fmt.Printf("error parsing arguments: %s\n", err)
@@ -104,10 +134,31 @@ func MainIn(nsName string) {
 
 	env.SetEnvArgs(fs.Args()[1:])
 
-	env.SetClassPath(".")
+	// Under lace run --watch, the sources are loaded from the project
+	// rather than the binary, so that changes to them can be reloaded.
+	if *watchPaths != "" {
+		env.SetClassPath(*watchPaths)
+
+		w, err := namespace.Watch(env, namespace.WatchOptions{})
+		if err != nil {
+			fmt.Fprintf(core.Stderr, "unable to watch sources: %s\n", err)
+			os.Exit(1)
+		}
+		defer w.Close()
+	} else {
+		env.SetClassPath(".")
+
+		if sources != nil {
+			env.AddClassPathFS("embed", sources)
+		}
+	}
 
 	if *version {
-		println(core.VERSION)
+		if buildInfo.program != "" {
+			fmt.Printf("%s %s (build %s, lace %s)\n", buildInfo.program, buildInfo.version, buildInfo.id, core.VERSION)
+		} else {
+			println(core.VERSION)
+		}
 		return
 	}
 
@@ -127,6 +178,15 @@ func MainIn(nsName string) {
 		os.Exit(code)
 	})
 
+	if *startupReport {
+		report := func() {
+			env.WritePkgReflectReport(core.Stderr)
+		}
+
+		teardown = append(teardown, report)
+		defer report()
+	}
+
 	env.DebugBytecode = *debugBytecode
 
 	if cpuProfileName != "" {
@@ -199,6 +259,20 @@ func MainIn(nsName string) {
 	fmt.Println("here")
 }
 
+// commands are the names of lace's own commands, which take precedence over
+// a project's tasks of the same name.
+var commands = map[string]bool{
+	"run":   true,
+	"repl":  true,
+	"lint":  true,
+	"node":  true,
+	"deps":  true,
+	"build": true,
+	"new":   true,
+	"task":  true,
+	"tasks": true,
+}
+
 func Main() {
 	env, err := core.NewEnv()
 	if err != nil {
@@ -212,8 +286,24 @@ func Main() {
 	args := os.Args[1:]
 	switch {
 	case len(args) >= 1:
-		if _, err := os.Stat(args[0]); err == nil {
+		var (
+			task bool
+			terr error
+		)
+		if !commands[args[0]] {
+			task, terr = isTask(args[0])
+		}
+
+		if task {
+			args = append([]string{"task"}, args...)
+		} else if _, err := os.Stat(args[0]); err == nil {
 			args = append([]string{"run"}, args...)
+		} else if terr != nil {
+			// The name may well be a task, such as one in a cycle, so the
+			// reason lace.yml couldn't be loaded is shown rather than the
+			// name being taken as an unknown command.
+			log.Error("error loading lace.yml", "error", terr)
+			os.Exit(1)
 		}
 	case len(args) == 0:
 		args = []string{"repl"}
@@ -224,6 +314,12 @@ func Main() {
 
 	switch cmd {
 	case "run":
+		// A built program runs what it's given, rather than building the
+		// project it's in.
+		if buildInfo.program != "" {
+			run(log, env, args)
+			return
+		}
 		if len(args) >= 1 {
 			if dir := isProject(args[0]); dir != "" {
 				err = os.Chdir(dir)
@@ -234,9 +330,13 @@ func Main() {
 				runInProject(log, dir, env, args[1:])
 				return
 			}
+			if isScript(env, args[0]) {
+				run(log, env, args)
+				return
+			}
 		}
 		if dir := findProject(); dir == "" {
-			run(env, args)
+			run(log, env, args)
 			return
 		} else {
 			runInProject(log, dir, env, args)
@@ -244,12 +344,111 @@ func Main() {
 		}
 	case "repl":
 		_ = env.REPL(os.Stdin, os.Stdout)
+	case "lint":
+		lint(env, args)
+	case "node":
+		node(log, env, args)
+	case "deps":
+		deps(log, args)
+	case "build":
+		buildProject(log, args)
+	case "new":
+		newProject(log, args)
+	case "task":
+		runTask(log, env, args)
+	case "tasks":
+		listTasks(log, args)
 	default:
 		fmt.Printf("Unknown command: %s\n", cmd)
 		os.Exit(1)
 	}
 }
 
+// lint parses the given files in linter mode, reporting problems such as
+// unresolved symbols and calls into Go functions that can't succeed.
+func lint(env *core.Env, args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(core.Stderr, "usage: lace lint <file>...")
+		os.Exit(1)
+	}
+
+	env.InitEnv(core.Stdin, core.Stdout, core.Stderr, nil)
+
+	for _, filename := range args {
+		reader, err := core.NewReaderFromFile(filename)
+		if err != nil {
+			os.Exit(1)
+		}
+
+		if err := core.LintReader(env, reader, filename); err != nil {
+			os.Exit(1)
+		}
+	}
+
+	if core.PROBLEM_COUNT > 0 {
+		os.Exit(1)
+	}
+}
+
+// node serves the process as a node on the bus, running the code that
+// other processes send it with rpc/eval-on and rpc/spawn-on.
+func node(log logger.Logger, env *core.Env, args []string) {
+	fs := pflag.NewFlagSet("node", pflag.ExitOnError)
+	bus := fs.String("bus", "", "url of the bus to connect to, defaults to LACE_RPC_URL")
+	name := fs.String("name", "", "name of the node, defaults to the hostname")
+	allow := fs.StringArray("allow", nil, "key of a caller allowed to send code, may be repeated")
+	insecure := fs.Bool("insecure", false, "let any caller on the bus send code")
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("error parsing arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	// The code a node receives runs with its full access, so the callers
+	// allowed to send it must be named.
+	if len(*allow) == 0 {
+		if !*insecure {
+			fmt.Fprintf(core.Stderr, "Error: lace node requires --allow with the key of each caller, or --insecure\n")
+			os.Exit(1)
+		}
+
+		*allow = nil
+	}
+
+	if *name == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			log.Error("unable to determine node name", "error", err)
+			os.Exit(1)
+		}
+
+		*name = host
+	}
+
+	env.InitEnv(core.Stdin, core.Stdout, core.Stderr, fs.Args())
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := env.SetContext(ctx); err != nil {
+		log.Error("unable to set context", "error", err)
+		os.Exit(1)
+	}
+
+	conn, err := rpc.ConnectBus(env, *bus)
+	if err != nil {
+		log.Error("unable to connect to bus", "error", err)
+		os.Exit(1)
+	}
+
+	log.Info("serving node", "name", *name, "allow", *allow)
+
+	if err := conn.ServeNode(ctx, *name, *allow); err != nil {
+		log.Error("error serving node", "error", err)
+		os.Exit(1)
+	}
+}
+
 func finish(memProfileName string) {
 	if runningProfile != nil {
 		runningProfile.Stop()
@@ -311,9 +510,23 @@ func runInProject(log logger.Logger, dir string, env *core.Env, args []string) {
 		os.Exit(1)
 	}
 
-	if args[0] == "--clean" {
-		log.Info("cleaning build artifacts")
-		b.Clean()
+	var watch, offline bool
+
+flags:
+	for len(args) > 0 {
+		switch args[0] {
+		case "--clean":
+			log.Info("cleaning build artifacts")
+			b.Clean()
+		case "--offline":
+			offline = true
+			b.SetOffline(true)
+		case "--watch":
+			watch = true
+		default:
+			break flags
+		}
+
 		args = args[1:]
 	}
 
@@ -324,9 +537,32 @@ func runInProject(log logger.Logger, dir string, env *core.Env, args []string) {
 		os.Exit(1)
 	}
 
+	if watch {
+		cfg, err := build.LoadConfig(dir)
+		if err != nil {
+			log.Error("error loading lace.yml", "error", err)
+			os.Exit(1)
+		}
+
+		cp, err := build.ClassPath(ctx, log, dir, cfg, offline)
+		if err != nil {
+			log.Error("error resolving class path", "error", err)
+			os.Exit(1)
+		}
+
+		// The paths are passed to the program rather than put in its
+		// environment, so that the processes it starts don't watch too.
+		flags := []string{"--watch-paths", strings.Join(cp, string(filepath.ListSeparator))}
+		if cfg.Main == "" {
+			flags = append([]string{"run"}, flags...)
+		}
+
+		args = append(flags, args...)
+	}
+
 	argv := append([]string{exe}, args...)
 
-	err = unix.Exec(exe, argv, os.Environ())
+	err = execProgram(exe, argv)
 
 	log.Error("error executing exe", "error", err)
 
@@ -344,13 +580,70 @@ func runInProject(log logger.Logger, dir string, env *core.Env, args []string) {
 	os.Exit(1)
 }
 
-func run(env *core.Env, args []string) {
+// isScript reports whether filename is a script that declares go-imports
+// or deps, which runs the same wherever it's run from.
+func isScript(env *core.Env, filename string) bool {
+	cfg, err := build.ScriptConfig(env, filename)
+	return err == nil && cfg != nil
+}
+
+// runScript runs a script that declares go-imports or deps by building a
+// program with them, or finding it in the cache, and running the script
+// with that program instead.
+func runScript(log logger.Logger, cfg *build.Config, filename, classPath string, offline, watch bool, args []string) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	exe, deps, err := build.BuildScript(ctx, log, cfg, offline)
+	if err != nil {
+		log.Error("error building script", "error", err)
+		os.Exit(1)
+	}
+
+	cp := strings.Join(append([]string{classPath}, deps...), string(filepath.ListSeparator))
+	argv := []string{exe, "run", "--classpath", cp}
+	if watch {
+		argv = append(argv, "--watch")
+	}
+
+	argv = append(append(argv, filename), args...)
+
+	// execProgram only returns if the program can't take over the process,
+	// as on windows, in which case it's run as a child.
+	err = execProgram(exe, argv)
+	log.Debug("unable to exec script program", "error", err)
+
+	cmd := exec.Command(exe, argv[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			os.Exit(ee.ExitCode())
+		}
+
+		log.Error("error running script", "error", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func run(log logger.Logger, env *core.Env, args []string) {
 	fs := pflag.NewFlagSet("lace", pflag.ExitOnError)
 	version := fs.BoolP("version", "v", false, "report the version number")
 	cpuProfile := fs.String("cpuprofile", "", "Write CPU profile info to the specified path")
 	cpuProfileRate := fs.Int("cpuprofile-rate", 100, "Specify the sampling rate of the cpu profiler")
 	memProfile := fs.String("memprofile", "", "Write Memory profile info to the specified path")
 	debugBytecode := fs.Bool("debug-bytecode", false, "Display bytecode for functions are it is generated")
+	startupReport := fs.Bool("startup-report", false, "Report the time spent loading reflected Go packages on exit")
+	classPath := fs.String("classpath", os.Getenv("LACE_CLASSPATH"), "directories and .zip or .jar archives to load libs from, separated by "+string(filepath.ListSeparator))
+	watch := fs.Bool("watch", false, "reload the namespaces loaded from files as the files change")
+	watchPaths := fs.String("watch-paths", "", "directories to watch with --watch, separated by "+string(filepath.ListSeparator))
+	offline := fs.Bool("offline", false, "use only dependencies of scripts already in the cache")
 
 	if err := fs.Parse(args); err != nil {
 		fmt.Printf("error parsing arguments: %s\n", err)
@@ -364,9 +657,24 @@ func run(env *core.Env, args []string) {
 		args = fs.Args()[1:]
 	}
 
+	// A script declaring go-imports or deps is run by a program built with
+	// them, which a built program already is.
+	if filename != "" && filename != "-" && buildInfo.program == "" {
+		cfg, err := build.ScriptConfig(env, filename)
+		if err != nil {
+			log.Error("error reading script", "error", err)
+			os.Exit(1)
+		}
+
+		if cfg != nil {
+			runScript(log, cfg, filename, *classPath, *offline, *watch, args)
+			return
+		}
+	}
+
 	env.InitEnv(core.Stdin, core.Stdout, core.Stderr, args)
 
-	//env.SetClassPath(classPath)
+	env.SetClassPath(*classPath)
 
 	if *version {
 		println(core.VERSION)
@@ -389,6 +697,15 @@ func run(env *core.Env, args []string) {
 		os.Exit(code)
 	})
 
+	if *startupReport {
+		report := func() {
+			env.WritePkgReflectReport(core.Stderr)
+		}
+
+		teardown = append(teardown, report)
+		defer report()
+	}
+
 	env.DebugBytecode = *debugBytecode
 
 	if cpuProfileName != "" {
@@ -415,6 +732,24 @@ func run(env *core.Env, args []string) {
 		defer finish(memProfileName)
 	}
 
+	if *watch || *watchPaths != "" {
+		opts := namespace.WatchOptions{}
+
+		switch {
+		case *watchPaths != "":
+			opts.Paths = filepath.SplitList(*watchPaths)
+		case len(env.ClassPathDirs()) == 0 && filename != "" && filename != "-":
+			opts.Paths = []string{filepath.Dir(filename)}
+		}
+
+		w, err := namespace.Watch(env, opts)
+		if err != nil {
+			fmt.Fprintf(core.Stderr, "unable to watch sources: %s\n", err)
+			core.Exit(1)
+		}
+		defer w.Close()
+	}
+
 	if filename != "" {
 		if err := processFile(env, filename); err != nil {
 			if ee, ok := err.(*core.ExitError); ok {
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/build"
+	"github.com/spf13/pflag"
+)
+
+const depsUsage = `usage: lace deps [--offline] <command>
+
+Commands:
+  fetch    fetch the dependencies in lace.yml and write lace.lock
+  verify   check the cached dependencies against lace.lock
+  tree     show the dependencies and theirs
+  graph    write the project's namespace dependency graph in DOT format
+`
+
+// deps manages the lace libraries the project depends on.
+func deps(log logger.Logger, args []string) {
+	fs := pflag.NewFlagSet("deps", pflag.ExitOnError)
+	offline := fs.Bool("offline", false, "use only dependencies already in the cache")
+	fs.Usage = func() {
+		fmt.Fprint(core.Stderr, depsUsage)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("error parsing arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	dir := findProject()
+	if dir == "" {
+		log.Error("no lace.yml found")
+		os.Exit(1)
+	}
+
+	cfg, err := build.LoadConfig(dir)
+	if err != nil {
+		log.Error("error loading lace.yml", "error", err)
+		os.Exit(1)
+	}
+
+	r := build.NewResolver(log, dir, cfg)
+	r.Offline = *offline
+
+	switch fs.Arg(0) {
+	case "fetch":
+		lock, err := r.Fetch(context.Background())
+		if err != nil {
+			log.Error("error fetching dependencies", "error", err)
+			os.Exit(1)
+		}
+
+		log.Info("dependencies locked", "count", len(lock.Dependencies))
+	case "verify":
+		lock, err := r.Verify()
+		if err != nil {
+			log.Error("dependencies failed verification", "error", err)
+			os.Exit(1)
+		}
+
+		log.Info("dependencies verified", "count", len(lock.Dependencies))
+	case "tree":
+		lock, err := build.ReadLock(dir)
+		if err != nil {
+			log.Error("error reading lock", "error", err)
+			os.Exit(1)
+		}
+
+		if err := r.Tree(lock, os.Stdout); err != nil {
+			log.Error("error showing dependencies", "error", err, "hint", "run lace deps fetch")
+			os.Exit(1)
+		}
+	case "graph":
+		env, err := core.NewEnv()
+		if err != nil {
+			log.Error("error creating environment", "error", err)
+			os.Exit(1)
+		}
+
+		g, err := build.Graph(env, dir, cfg)
+		if err != nil {
+			log.Error("error reading namespaces", "error", err)
+			os.Exit(1)
+		}
+
+		if err := g.WriteDot(os.Stdout); err != nil {
+			log.Error("error writing graph", "error", err)
+			os.Exit(1)
+		}
+	default:
+		fs.Usage()
+		os.Exit(1)
+	}
+}
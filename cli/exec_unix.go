@@ -0,0 +1,14 @@
+//go:build !windows
+
+package cli
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// execProgram replaces the process with the program at exe.
+func execProgram(exe string, argv []string) error {
+	return unix.Exec(exe, argv, os.Environ())
+}
@@ -0,0 +1,9 @@
+package cli
+
+import "errors"
+
+// execProgram can't replace the process on Windows, so the program is run
+// as a child instead.
+func execProgram(exe string, argv []string) error {
+	return errors.New("exec isn't supported on windows")
+}
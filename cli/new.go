@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/build"
+	"github.com/spf13/pflag"
+)
+
+// newProject creates a project from a template.
+func newProject(log logger.Logger, args []string) {
+	fs := pflag.NewFlagSet("new", pflag.ExitOnError)
+	tmpl := fs.StringP("template", "t", "app", "template to create the project from: one of "+
+		strings.Join(build.TemplateNames(), ", ")+", a template in "+build.UserTemplateDir()+" or a directory")
+	module := fs.String("module", "", "Go module path of the project, defaults to its name")
+	fs.Usage = func() {
+		fmt.Fprintf(core.Stderr, "usage: lace new [options] <name> [dir]\n\n%s", fs.FlagUsages())
+	}
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("error parsing arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	name := fs.Arg(0)
+
+	dir := name
+	if fs.NArg() == 2 {
+		dir = fs.Arg(1)
+	}
+
+	p := build.NewProject(filepath.Base(name))
+	if *module != "" {
+		p.Module = *module
+	}
+
+	if p.Namespace == "" {
+		log.Error("project name doesn't make a namespace", "name", name)
+		os.Exit(1)
+	}
+
+	layers, err := build.FindTemplate(*tmpl)
+	if err != nil {
+		log.Error("error finding template", "error", err)
+		os.Exit(1)
+	}
+
+	err = build.Generate(dir, layers, p)
+	if err != nil {
+		log.Error("error creating project", "error", err)
+		os.Exit(1)
+	}
+
+	log.Info("created project", "name", p.Name, "template", *tmpl, "dir", dir)
+}
@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/build"
+	"github.com/spf13/pflag"
+)
+
+// isTask reports whether name is a task of the project in the current
+// directory. It returns an error if the project's lace.yml can't be loaded.
+func isTask(name string) (bool, error) {
+	dir := findProject()
+	if dir == "" {
+		return false, nil
+	}
+
+	cfg, err := build.LoadConfig(dir)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := cfg.Tasks[name]
+	return ok, nil
+}
+
+// loadTasks returns the directory and config of the project in the current
+// directory, exiting if there isn't one.
+func loadTasks(log logger.Logger) (string, *build.Config) {
+	dir := findProject()
+	if dir == "" {
+		log.Error("no lace.yml found")
+		os.Exit(1)
+	}
+
+	cfg, err := build.LoadConfig(dir)
+	if err != nil {
+		log.Error("error loading lace.yml", "error", err)
+		os.Exit(1)
+	}
+
+	return dir, cfg
+}
+
+// listTasks shows the tasks declared in lace.yml.
+func listTasks(log logger.Logger, args []string) {
+	if len(args) != 0 {
+		fmt.Fprintln(core.Stderr, "usage: lace tasks")
+		os.Exit(1)
+	}
+
+	_, cfg := loadTasks(log)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	for _, name := range cfg.TaskNames() {
+		t := cfg.Tasks[name]
+
+		doc, _, _ := strings.Cut(strings.TrimSpace(t.Doc), "\n")
+
+		if len(t.Depends) > 0 {
+			doc = strings.TrimSpace(doc + " (depends on " + strings.Join(t.Depends, ", ") + ")")
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\n", name, doc)
+	}
+
+	tw.Flush()
+}
+
+// runTask runs a task from lace.yml in process, after the tasks it depends
+// on. Independent dependencies run in parallel. The arguments following the
+// task's name are its *command-line-args*.
+func runTask(log logger.Logger, env *core.Env, args []string) {
+	fs := pflag.NewFlagSet("task", pflag.ExitOnError)
+	jobs := fs.Int("jobs", 0, "number of tasks to run at once, defaults to the number of CPUs")
+	offline := fs.Bool("offline", false, "use only dependencies already in the cache")
+	fs.SetInterspersed(false)
+	fs.Usage = func() {
+		fmt.Fprintf(core.Stderr, "usage: lace task [options] <name> [args]\n\n%s", fs.FlagUsages())
+	}
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("error parsing arguments: %s\n", err)
+		os.Exit(1)
+	}
+
+	if fs.NArg() < 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	dir, cfg := loadTasks(log)
+
+	name := fs.Arg(0)
+
+	order, err := cfg.TaskOrder(name)
+	if err != nil {
+		log.Error("error ordering tasks", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cp, err := build.ClassPath(ctx, log, dir, cfg, *offline)
+	if err != nil {
+		log.Error("error resolving class path", "error", err)
+		os.Exit(1)
+	}
+
+	env.InitEnv(core.Stdin, core.Stdout, core.Stderr, fs.Args()[1:])
+	env.SetClassPath(strings.Join(cp, string(filepath.ListSeparator)))
+
+	r := &taskRunner{
+		log:  log,
+		env:  env,
+		cfg:  cfg,
+		name: name,
+		args: fs.Args()[1:],
+	}
+
+	err = r.run(ctx, order, *jobs)
+	if err != nil {
+		var ee *core.ExitError
+		if errors.As(err, &ee) {
+			os.Exit(ee.Code)
+		}
+
+		var te *taskError
+		if errors.As(err, &te) {
+			log.Error("task failed", "task", te.task)
+		} else {
+			log.Error("error running tasks", "error", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+// taskError is the failure of a single task.
+type taskError struct {
+	task string
+	err  error
+}
+
+func (e *taskError) Error() string {
+	return fmt.Sprintf("task %s failed: %s", e.task, e.err)
+}
+
+func (e *taskError) Unwrap() error {
+	return e.err
+}
+
+var errDependencyFailed = errors.New("a dependency failed")
+
+type taskRunner struct {
+	log logger.Logger
+	env *core.Env
+	cfg *build.Config
+
+	// name is the task that was asked for, which is given args. The tasks
+	// it depends on are given none.
+	name string
+	args []string
+}
+
+// run runs the tasks in order, each once its dependencies are done and at
+// most jobs at a time. A task whose dependency failed doesn't run.
+func (r *taskRunner) run(ctx context.Context, order []string, jobs int) error {
+	err := r.load(order)
+	if err != nil {
+		return err
+	}
+
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sem  = make(chan struct{}, jobs)
+		done = map[string]chan struct{}{}
+		errs = map[string]error{}
+	)
+
+	for _, name := range order {
+		done[name] = make(chan struct{})
+	}
+
+	for _, name := range order {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+			defer close(done[name])
+
+			t := r.cfg.Tasks[name]
+
+			for _, dep := range t.Depends {
+				<-done[dep]
+			}
+
+			mu.Lock()
+			for _, dep := range t.Depends {
+				if errs[dep] != nil {
+					errs[name] = errDependencyFailed
+				}
+			}
+			failed := errs[name] != nil
+			mu.Unlock()
+
+			if failed {
+				return
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := r.runTask(ctx, name, &t)
+
+			mu.Lock()
+			errs[name] = err
+			mu.Unlock()
+		}(name)
+	}
+
+	wg.Wait()
+
+	for _, name := range order {
+		if err := errs[name]; err != nil && err != errDependencyFailed {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// load requires the namespaces of the tasks' functions up front, so that
+// tasks running in parallel don't load them at the same time.
+func (r *taskRunner) load(order []string) error {
+	loaded := map[string]bool{}
+
+	for _, name := range order {
+		t := r.cfg.Tasks[name]
+		if t.Fn == "" {
+			continue
+		}
+
+		for _, ns := range []string{"lace.tools.cli", t.Namespace()} {
+			if loaded[ns] {
+				continue
+			}
+
+			_, err := core.CallVar(r.env, "lace.core/require", core.MakeSymbol(ns))
+			if err != nil {
+				core.DisplayError(r.env, err)
+				return &taskError{task: name, err: err}
+			}
+
+			loaded[ns] = true
+		}
+	}
+
+	return nil
+}
+
+func (r *taskRunner) runTask(ctx context.Context, name string, t *build.Task) error {
+	env := r.env.Child()
+
+	err := env.SetContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("running task", "task", name)
+
+	switch {
+	case t.Run != "":
+		err = evalForms(env, name, t.Run)
+	case t.Fn != "":
+		err = r.callTask(env, name, t)
+	}
+
+	if err != nil {
+		var ee *core.ExitError
+		if errors.As(err, &ee) {
+			if ee.Code == 0 {
+				return nil
+			}
+		} else {
+			core.DisplayError(env, err)
+		}
+
+		return &taskError{task: name, err: err}
+	}
+
+	return nil
+}
+
+// evalForms evaluates the forms of a task's run one after the other, so
+// that a namespace required by one can be used by those that follow.
+func evalForms(env *core.Env, name, text string) error {
+	reader := core.NewReader(strings.NewReader(text), "<task "+name+">")
+
+	for {
+		form, err := core.TryRead(env, reader)
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = core.CallVar(env, "lace.core/eval", form)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// callTask calls the task's function with its arguments as parsed by
+// lace.tools.cli/parse-opts against its options.
+func (r *taskRunner) callTask(env *core.Env, name string, t *build.Task) error {
+	var args []string
+	if name == r.name {
+		args = r.args
+	}
+
+	argv := core.EmptyVector()
+	for _, arg := range args {
+		argv, _ = argv.Conjoin(core.MakeString(arg))
+	}
+
+	var specs any = core.EmptyVector()
+
+	if t.Options != "" {
+		var err error
+
+		specs, err = env.Eval(t.Options)
+		if err != nil {
+			return fmt.Errorf("error reading options of task %s: %w", name, err)
+		}
+	}
+
+	opts, err := core.CallVar(env, "lace.tools.cli/parse-opts", argv, specs)
+	if err != nil {
+		return err
+	}
+
+	if m, ok := opts.(core.Gettable); ok {
+		_, errs, _ := m.Get(env, core.MakeKeyword("errors"))
+		_, summary, _ := m.Get(env, core.MakeKeyword("summary"))
+
+		if seq, ok := errs.(core.Seqable); ok && errs != core.NIL {
+			var msgs []string
+
+			for s := seq.Seq(); ; {
+				empty, err := s.IsEmpty(env)
+				if err != nil || empty {
+					break
+				}
+
+				msg, _ := s.First(env)
+				msgs = append(msgs, core.SimpleToString(msg))
+
+				s, _ = s.Rest(env)
+			}
+
+			if len(msgs) > 0 {
+				return fmt.Errorf("%s\n\nusage: lace %s [options]\n%s", strings.Join(msgs, "\n"), name, core.SimpleToString(summary))
+			}
+		}
+	}
+
+	_, err = core.CallVar(env, t.Fn, opts)
+	return err
+}
@@ -1,6 +1,9 @@
 package core
 
-import "io"
+import (
+	"fmt"
+	"io"
+)
 
 type (
 	// A Map implementation that uses a simple array. Very efficient for small maps.
@@ -213,15 +216,29 @@ func (m *ArrayMap) Clone() *ArrayMap {
 	return &result
 }
 
-func NewArrayMap(key Equ, value any) (Associative, error) {
+// NewArrayMap returns a map of key to value, along with any further key
+// value pairs in more. Later values replace earlier ones for the same key.
+func NewArrayMap(key Equ, value any, more ...any) (Associative, error) {
+	if len(more)%2 != 0 {
+		return nil, fmt.Errorf("NewArrayMap: no value supplied for key %v", more[len(more)-1])
+	}
+
 	m := EmptyArrayMap()
+	m.arr = append(m.arr, key, value)
 
-	i := m.indexOfEqu(key)
-	if i != -1 {
-		m.arr[i+1] = value
-		return m, nil
+	for i := 0; i < len(more); i += 2 {
+		k, ok := more[i].(Equ)
+		if !ok {
+			return nil, fmt.Errorf("NewArrayMap: unsupported key type %s", TypeName(more[i]))
+		}
+
+		if j := m.indexOfEqu(k); j != -1 {
+			m.arr[j+1] = more[i+1]
+		} else {
+			m.arr = append(m.arr, k, more[i+1])
+		}
 	}
-	m.arr = append(m.arr, key, value)
+
 	return m, nil
 }
 
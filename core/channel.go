@@ -1,5 +1,7 @@
 package core
 
+import "sync"
+
 type (
 	FutureResult struct {
 		value any
@@ -9,6 +11,9 @@ type (
 		ch       chan FutureResult
 		isClosed bool
 		hash     uint32
+
+		mu      sync.Mutex
+		onClose func()
 	}
 )
 
@@ -49,8 +54,25 @@ func ExtractChannel(env *Env, args []any, index int) (*Channel, error) {
 }
 
 func (ch *Channel) Close() {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
 	if !ch.isClosed {
+		if ch.onClose != nil {
+			ch.onClose()
+		}
+
 		close(ch.ch)
 		ch.isClosed = true
 	}
 }
+
+// OnClose sets f to be called when the channel is closed, just before it
+// is. A goroutine sending to the channel from Go uses it to stop sending,
+// so that close! doesn't close the channel under it.
+func (ch *Channel) OnClose(f func()) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.onClose = f
+}
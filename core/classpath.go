@@ -0,0 +1,211 @@
+package core
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ClassPathFS is a *classpath* element that serves libs out of an fs.FS
+// rather than a directory, such as the sources embedded in a built binary.
+// Files loaded from it are named by joining Name and their path in FS.
+type ClassPathFS struct {
+	Name string
+	FS   fs.FS
+}
+
+func (c *ClassPathFS) ToString(env *Env, escape bool) (string, error) {
+	return "#classpath-fs[" + c.Name + "]", nil
+}
+
+// AddClassPathFS puts fsys at the front of *classpath*, so libs found in it
+// are loaded in preference to those on disk.
+func (env *Env) AddClassPathFS(name string, fsys fs.FS) {
+	cpVec := NewVectorFrom(&ClassPathFS{Name: name, FS: fsys})
+
+	if cur, ok := env.classPath.GetStatic().(*Vector); ok {
+		for i := 0; i < cur.Count(); i++ {
+			cpVec, _ = cpVec.Conjoin(cur.at(i))
+		}
+	}
+
+	env.classPath.SetStatic(cpVec)
+}
+
+// AppendClassPathFS puts fsys at the end of *classpath*, so libs are only
+// loaded from it if no other element has them.
+func (env *Env) AppendClassPathFS(name string, fsys fs.FS) {
+	cpVec := EmptyVector()
+
+	if cur, ok := env.classPath.GetStatic().(*Vector); ok {
+		cpVec = cur
+	}
+
+	cpVec, _ = cpVec.Conjoin(&ClassPathFS{Name: name, FS: fsys})
+
+	env.classPath.SetStatic(cpVec)
+}
+
+// ClassPathDirs returns the directories on *classpath*, leaving out the
+// archives, filesystems and the empty element that stands for the path
+// relative to the file being loaded.
+func (env *Env) ClassPathDirs() []string {
+	cur, ok := env.classPath.GetStatic().(*Vector)
+	if !ok {
+		return nil
+	}
+
+	var dirs []string
+
+	for i := 0; i < cur.Count(); i++ {
+		s, ok := cur.at(i).(String)
+		if !ok || s.S() == "" || IsArchive(s.S()) {
+			continue
+		}
+
+		dirs = append(dirs, s.S())
+	}
+
+	return dirs
+}
+
+// IsArchive reports whether a *classpath* element names a zip archive of
+// libs, such as a .zip or .jar file, rather than a directory.
+func IsArchive(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".zip", ".jar":
+		return true
+	default:
+		return false
+	}
+}
+
+var archives = struct {
+	sync.Mutex
+	open map[string]*zip.ReadCloser
+}{open: map[string]*zip.ReadCloser{}}
+
+// openArchive returns the archive at name as an fs.FS. Archives are kept
+// open once opened, as libs are loaded from them throughout the life of
+// the program.
+func openArchive(name string) (fs.FS, error) {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	archives.Lock()
+	defer archives.Unlock()
+
+	if zr, ok := archives.open[abs]; ok {
+		return zr, nil
+	}
+
+	zr, err := zip.OpenReader(abs)
+	if err != nil {
+		return nil, err
+	}
+
+	archives.open[abs] = zr
+	return zr, nil
+}
+
+// OverlayFS is an fs.FS made of layers, each of which shadows the files of
+// the layers after it. It's meant for putting in-memory libs, such as an
+// fstest.MapFS in a test, over the libs of a directory or archive.
+type OverlayFS []fs.FS
+
+// Open opens name from the first layer that has it.
+func (o OverlayFS) Open(name string) (fs.File, error) {
+	for _, layer := range o {
+		f, err := layer.Open(name)
+		if err == nil {
+			return f, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// openLib finds libname on *classpath*, returning the opened file and its
+// name. An empty classpath entry means pathname, and the failure to open
+// it, or to open an archive entry, is returned if no later entry has the
+// lib. If no entry has the lib, the returned reader is nil.
+func openLib(env *Env, libname, pathname string) (io.ReadCloser, string, error) {
+	cp := env.classPath.GetStatic()
+	cpvec, err := AssertVector(env, cp, "*classpath* must be a Vector, not a "+TypeName(cp))
+	if err != nil {
+		return nil, "", err
+	}
+
+	libfile := path.Join(strings.Split(libname, ".")...) + ".clj"
+
+	var canonicalErr, archiveErr error
+	count := cpvec.Count()
+	for i := 0; i < count; i++ {
+		elem := cpvec.at(i)
+
+		if cpfs, ok := elem.(*ClassPathFS); ok {
+			f, err := cpfs.FS.Open(libfile)
+			if err == nil {
+				return f, path.Join(cpfs.Name, libfile), nil
+			}
+			continue
+		}
+
+		cpelem, err := AssertString(env, elem, "*classpath* must contain only Strings, not a "+TypeName(elem)+" (at element "+strconv.Itoa(i)+")")
+		if err != nil {
+			return nil, "", err
+		}
+
+		var filename string
+		s := cpelem.S()
+
+		if IsArchive(s) {
+			fsys, err := openArchive(s)
+			if err != nil {
+				if archiveErr == nil {
+					archiveErr = err
+				}
+				continue
+			}
+
+			f, err := fsys.Open(libfile)
+			if err == nil {
+				return f, filepath.Join(s, filepath.FromSlash(libfile)), nil
+			}
+			continue
+		}
+
+		if s == "" {
+			filename = pathname
+		} else {
+			filename = filepath.Join(s, filepath.FromSlash(libfile))
+		}
+
+		f, err := os.Open(filename)
+		if err == nil {
+			return f, filename, nil
+		}
+		if s == "" {
+			canonicalErr = err
+		}
+	}
+
+	if canonicalErr != nil {
+		return nil, "", canonicalErr
+	}
+
+	return nil, "", archiveErr
+}
@@ -1,7 +1,11 @@
 package core
 
 import (
+	"archive/zip"
+	"os"
+	"path/filepath"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -18,4 +22,160 @@ func TestEnv(t *testing.T) {
 
 		r.True(Equals(e, obj, MakeInt(7)))
 	})
+
+	t.Run("compares built strings by value", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		for _, code := range []string{
+			`(= (str "a" "b") "ab")`,
+			`(= "ab" (str "a" "b"))`,
+			`(= (str "a" "b") (str "ab"))`,
+			`(contains? #{"-h"} (str \- \h))`,
+		} {
+			obj, err := e.Eval(code)
+			r.NoError(err)
+
+			r.True(Equals(e, obj, Boolean(true)), code)
+		}
+	})
+
+	t.Run("restores the namespace after a nested load", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		e.SetClassPath(t.TempDir())
+		e.AddClassPathFS("test", fstest.MapFS{
+			"app/util.clj": {Data: []byte("(ns app.util)\n")},
+			"app/core.clj": {Data: []byte("(ns app.core (:require app.util))\n(def answer 42)\n")},
+		})
+
+		_, err = Load(e, "app.core")
+		r.NoError(err)
+
+		obj, err := e.Eval("app.core/answer")
+		r.NoError(err)
+
+		r.True(Equals(e, obj, MakeInt(42)))
+	})
+}
+
+func TestClassPathFS(t *testing.T) {
+	t.Run("loads namespaces from an fs ahead of disk", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		dir := t.TempDir()
+		r.NoError(os.MkdirAll(filepath.Join(dir, "app"), 0755))
+		r.NoError(os.WriteFile(filepath.Join(dir, "app", "util.clj"), []byte("(ns app.util)\n(defn twice [x] x)\n"), 0644))
+
+		e.SetClassPath(dir)
+		e.AddClassPathFS("embed", fstest.MapFS{
+			"app/util.clj": {Data: []byte("(ns app.util)\n(defn twice [x] (* 2 x))\n")},
+			"app/core.clj": {Data: []byte("(ns app.core (:require app.util))\n")},
+		})
+
+		_, err = Load(e, "app.core")
+		r.NoError(err)
+
+		obj, err := e.Eval("(app.util/twice 21)")
+		r.NoError(err)
+
+		r.True(Equals(e, obj, MakeInt(42)))
+	})
+
+	t.Run("reports libs that aren't on the classpath", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		e.SetClassPath(t.TempDir())
+		e.AddClassPathFS("embed", fstest.MapFS{})
+
+		_, err = Load(e, "app.missing")
+		r.Error(err)
+	})
+
+	t.Run("loads namespaces from zip archives", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		archive := filepath.Join(t.TempDir(), "lib.jar")
+
+		f, err := os.Create(archive)
+		r.NoError(err)
+
+		zw := zip.NewWriter(f)
+		w, err := zw.Create("lib/math.clj")
+		r.NoError(err)
+
+		_, err = w.Write([]byte("(ns lib.math)\n(defn square [x] (* x x))\n"))
+		r.NoError(err)
+
+		r.NoError(zw.Close())
+		r.NoError(f.Close())
+
+		e.SetClassPath(t.TempDir() + string(filepath.ListSeparator) + archive)
+
+		_, err = Load(e, "lib.math")
+		r.NoError(err)
+
+		obj, err := e.Eval("(lib.math/square 7)")
+		r.NoError(err)
+
+		r.True(Equals(e, obj, MakeInt(49)))
+	})
+
+	t.Run("reports archives that can't be opened", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		archive := filepath.Join(t.TempDir(), "broken.zip")
+		r.NoError(os.WriteFile(archive, []byte("not a zip"), 0644))
+
+		e.SetClassPath(t.TempDir() + string(filepath.ListSeparator) + archive)
+
+		_, err = Load(e, "lib.math")
+		r.ErrorIs(err, zip.ErrFormat)
+	})
+
+	t.Run("overlays in-memory libs on others", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		e.SetClassPath(t.TempDir())
+		e.AppendClassPathFS("test", OverlayFS{
+			fstest.MapFS{
+				"app/conf.clj": {Data: []byte("(ns app.conf)\n(def port 8080)\n")},
+			},
+			fstest.MapFS{
+				"app/conf.clj": {Data: []byte("(ns app.conf)\n(def port 80)\n")},
+				"app/db.clj":   {Data: []byte("(ns app.db)\n(def host \"db\")\n")},
+			},
+		})
+
+		_, err = Load(e, "app.conf")
+		r.NoError(err)
+
+		_, err = Load(e, "app.db")
+		r.NoError(err)
+
+		obj, err := e.Eval("app.conf/port")
+		r.NoError(err)
+
+		r.True(Equals(e, obj, MakeInt(8080)))
+	})
 }
@@ -39,6 +39,9 @@ type (
 		version       *Var
 		ctx           *Var
 		Features      Set
+
+		pkgReflect *pkgReflectState
+		nsGraph    *NSGraph
 	}
 
 	Env struct {
@@ -366,10 +369,16 @@ func (env *Env) LookupNamespace(s Symbol) (*Namespace, bool) {
 			// the setup.
 			ns = env.EnsureNamespace(s)
 		} else {
-			_, err := PopulateNativeNamespaceToEnv(env, s.Name())
+			ok, err := PopulateNativeNamespaceToEnv(env, s.Name())
 			if err != nil {
 				panic(WrapError(env, err))
 			}
+
+			if ok {
+				env.mu.Lock()
+				ns = env.Namespaces[s.Name()]
+				env.mu.Unlock()
+			}
 		}
 	}
 
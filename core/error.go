@@ -202,15 +202,33 @@ func WrapError(env *Env, err error) *EvalError {
 	}
 
 	if !errors.Is(err, &EvalError{}) {
-		err = &EvalError{
-			err:  err,
-			hash: hashStringU32(err.Error()),
-		}
+		err = newEvalError(err)
 	}
 
 	return env.populateStackTrace(err)
 }
 
+// newEvalError wraps a Go error, adopting the category and data of errors
+// that provide them, such as errors reported by another process.
+func newEvalError(err error) *EvalError {
+	ee := &EvalError{
+		err:  err,
+		hash: hashStringU32(err.Error()),
+	}
+
+	var hc HasCategory
+	if errors.As(err, &hc) {
+		ee.cat = hc.Category()
+	}
+
+	var ed ErrorData
+	if errors.As(err, &ed) {
+		ee.Map = ed.ErrorData()
+	}
+
+	return ee
+}
+
 func AddContext(env *Env, err error, str string, args ...any) error {
 	e := WrapError(env, err)
 
@@ -255,6 +273,85 @@ func (e *EvalError) AddData(env *Env, obj any) {
 	}
 }
 
+var errorType = reflect.TypeFor[error]()
+
+// AsGoError returns the Go error held by obj. Besides plain error values,
+// this unpacks reflected Go variables such as os/ErrNotExist.
+func AsGoError(obj any) (error, bool) {
+	switch sv := obj.(type) {
+	case error:
+		return sv, true
+	case reflect.Value:
+		for sv.IsValid() && sv.CanInterface() {
+			if err, ok := sv.Interface().(error); ok {
+				return err, true
+			}
+
+			switch sv.Kind() {
+			case reflect.Pointer, reflect.Interface:
+				if sv.IsNil() {
+					return nil, false
+				}
+				sv = sv.Elem()
+			default:
+				return nil, false
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// ErrorAs searches the chain of err for an error of type t, the same as
+// errors.As. When t is a struct type that only implements error via its
+// pointer, the pointer type is searched for instead.
+func ErrorAs(err error, t Type) (any, bool) {
+	rt := t.rType
+
+	if rt.Kind() != reflect.Interface && !rt.Implements(errorType) {
+		rt = reflect.PointerTo(rt)
+		if !rt.Implements(errorType) {
+			return nil, false
+		}
+	}
+
+	target := reflect.New(rt)
+	if !errors.As(err, target.Interface()) {
+		return nil, false
+	}
+
+	return target.Elem().Interface(), true
+}
+
+// catchMatches reports whether obj, a thrown value, is handled by a catch
+// clause for target, which is either a Type or a var holding a Go error.
+// Types are matched against obj and then against the Go error chain. The
+// value to bind is returned, which is the matching error from the chain
+// when it was found there.
+func catchMatches(env *Env, target any, obj any) (any, bool) {
+	switch t := target.(type) {
+	case Type:
+		if IsInstance(env, t, obj) {
+			return obj, true
+		}
+
+		if err, ok := obj.(error); ok {
+			return ErrorAs(err, t)
+		}
+	case *Var:
+		sentinel, ok := AsGoError(t.Resolve(env))
+		if !ok {
+			return nil, false
+		}
+
+		if err, ok := obj.(error); ok && errors.Is(err, sentinel) {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
 func DisplayError(env *Env, err error) {
 	var ee *EvalError
 
@@ -326,10 +423,7 @@ func (v *VMStacktrace) Is(other error) bool {
 func (env *Env) populateStackTrace(err error) *EvalError {
 	var ee *EvalError
 	if !errors.As(err, &ee) {
-		ee = &EvalError{
-			err:  err,
-			hash: hashStringU32(err.Error()),
-		}
+		ee = newEvalError(err)
 	}
 
 	if ee.stackTrace != nil {
@@ -342,8 +342,13 @@ func (expr *TryExpr) Eval(genv *Env, env *LocalEnv) (obj any, err error) {
 	obj, err = evalBody(genv, expr.body, env)
 	if r, ok := err.(Error); ok {
 		for _, catchExpr := range expr.catches {
-			if IsInstance(genv, catchExpr.excType, r) {
-				obj, err = evalBody(genv, catchExpr.body, env.addFrame([]any{r}))
+			var target any = catchExpr.excType
+			if catchExpr.excSentinel != nil {
+				target = catchExpr.excSentinel
+			}
+
+			if bound, ok := catchMatches(genv, target, r); ok {
+				obj, err = evalBody(genv, catchExpr.body, env.addFrame([]any{bound}))
 				break
 			}
 		}
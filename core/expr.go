@@ -211,7 +211,11 @@ func (expr *ThrowExpr) Dump(pos bool) Map {
 
 func (expr *CatchExpr) Dump(pos bool) Map {
 	res := exprArrayMap(expr, "catch", pos)
-	res.AddEqu(MakeKeyword("error-type"), expr.excType)
+	if expr.excSentinel != nil {
+		res.AddEqu(MakeKeyword("error-value"), expr.excSentinel)
+	} else {
+		res.AddEqu(MakeKeyword("error-type"), expr.excType)
+	}
 	res.AddEqu(MakeKeyword("error-symbol"), expr.excSymbol)
 	addVector(res, expr.body, "body", pos)
 	return res
@@ -2,10 +2,9 @@ package core
 
 import (
 	"bufio"
+	"bytes"
+	"io"
 	"os"
-	"path/filepath"
-	"strconv"
-	"strings"
 )
 
 //go:generate go run .././pkg/pkgreflect/cmd/pkgreflect -lace-name lace.lang -honor-directive -in-core -specialized github.com/lab47/lace/core binding.go
@@ -202,52 +201,55 @@ func LoadLibFromPath(env *Env, libnamev Symbol, pathnamev String) (any, error) {
 	libname := libnamev.Name()
 	pathname := pathnamev.S()
 
-	cp := env.classPath.GetStatic()
-	cpvec, err := AssertVector(env, cp, "*classpath* must be a Vector, not a "+TypeName(cp))
+	f, filename, err := openLib(env, libname, pathname)
 	if err != nil {
 		return nil, err
 	}
-
-	count := cpvec.Count()
-	var f *os.File
-	var canonicalErr error
-	var filename string
-	for i := 0; i < count; i++ {
-		elem := cpvec.at(i)
-		cpelem, err := AssertString(env, elem, "*classpath* must contain only Strings, not a "+TypeName(elem)+" (at element "+strconv.Itoa(i)+")")
-		if err != nil {
-			return nil, err
-		}
-		s := cpelem.S()
-		if s == "" {
-			filename = pathname
-		} else {
-			filename = filepath.Join(s, filepath.Join(strings.Split(libname, ".")...)) + ".clj" // could cache inner join....
-		}
-
-		f, err = os.Open(filename)
-		if err == nil {
-			canonicalErr = nil
-			break
-		}
-		if s == "" {
-			canonicalErr = err
-		}
-	}
-	if canonicalErr != nil {
-		return nil, canonicalErr
-	}
-	if f == nil || filename == "" {
+	if f == nil {
 		return nil, SError(env, "LoadError", "unable to find path for library", "library", libname)
 	}
-	reader := NewReader(bufio.NewReader(f), filename)
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := NewReader(bufio.NewReader(bytes.NewReader(data)), filename)
 	err = ProcessReaderFromEval(env, reader, filename)
 	if err != nil {
 		return nil, SError(env, "LoadError", "error loading file", "path", filename, "error", err.Error())
 	}
+
+	recordLib(env, libname, filename, f, data)
+
 	return NIL, nil
 }
 
+// recordLib adds a lib loaded from f to the ns graph, with the namespaces
+// its ns form depends on. Only files on disk are given a ModTime, as only
+// they can change while the program runs.
+func recordLib(env *Env, libname, filename string, f io.Reader, data []byte) {
+	_, deps, err := ReadNSDecl(env, bytes.NewReader(data), filename)
+	if err != nil {
+		return
+	}
+
+	node := NSNode{
+		Name: libname,
+		File: filename,
+		Deps: deps,
+	}
+
+	if osf, ok := f.(*os.File); ok {
+		if fi, err := osf.Stat(); err == nil {
+			node.ModTime = fi.ModTime()
+		}
+	}
+
+	env.NSGraph().Add(node)
+}
+
 // StartGoRoutine runs the given callable in a new goroutine, returning a channel
 // that can be used to retrieve the return value.
 //
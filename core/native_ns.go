@@ -379,7 +379,7 @@ func (b *NSBuilder) DefVar(i *DefVarInfo) *NSBuilder {
 
 func (n *NSBuilder) Defn(b *DefnInfo) *NSBuilder {
 	if b.Fn != nil {
-		procFn, _, err := n.buildProc(b.Fn)
+		procFn, cs, err := n.buildProc(b.Fn)
 		if err != nil {
 			panic(fmt.Sprintf("unable to define %s: %s", b.Name, err))
 		}
@@ -405,6 +405,7 @@ func (n *NSBuilder) Defn(b *DefnInfo) *NSBuilder {
 			Package: n.pkg,
 			File:    file,
 			Line:    line,
+			sig:     cs,
 		}
 
 		meta := n.makeMeta(b)
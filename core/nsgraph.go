@@ -0,0 +1,477 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NSNode is a namespace loaded from a file, as recorded in the ns graph.
+// Deps are the namespaces its ns form requires or uses. ModTime is zero
+// for files that aren't on disk, such as those embedded in a binary.
+type NSNode struct {
+	Name    string
+	File    string
+	ModTime time.Time
+	Deps    []string
+}
+
+// NSGraph records the namespaces loaded from files along with the
+// namespaces their ns forms depend on, so the namespaces depending on one
+// that changed can be found and reloaded after it.
+type NSGraph struct {
+	mu    sync.Mutex
+	nodes map[string]*NSNode
+
+	// pending are namespaces that were unloaded to be reloaded but failed
+	// to load again, which are reloaded on the next refresh.
+	pending map[string]*NSNode
+}
+
+// NewNSGraph returns an empty graph, to be filled in with Add.
+func NewNSGraph() *NSGraph {
+	return &NSGraph{
+		nodes:   map[string]*NSNode{},
+		pending: map[string]*NSNode{},
+	}
+}
+
+// NSGraph returns the graph of the namespaces loaded into env.
+func (env *Env) NSGraph() *NSGraph {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	if env.nsGraph == nil {
+		env.nsGraph = NewNSGraph()
+	}
+
+	return env.nsGraph
+}
+
+// Add records the namespace n, replacing any of the same name.
+func (g *NSGraph) Add(n NSNode) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.nodes[n.Name] = &n
+	delete(g.pending, n.Name)
+}
+
+// Nodes returns the recorded namespaces, sorted by name.
+func (g *NSGraph) Nodes() []NSNode {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	nodes := make([]NSNode, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, *n)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes
+}
+
+// Forget removes name from the graph, as when it's been unloaded. If
+// pending is true, the next refresh tries to load it again.
+func (g *NSGraph) Forget(name string, pending bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	n, ok := g.nodes[name]
+	delete(g.nodes, name)
+
+	switch {
+	case !pending:
+		delete(g.pending, name)
+	case ok:
+		g.pending[name] = n
+	}
+}
+
+// WriteDot writes the graph to w in Graphviz's DOT format, with an edge
+// from each namespace to those it depends on. Namespaces that are depended
+// on but aren't in the graph, such as those built into lace, are dashed.
+func (g *NSGraph) WriteDot(w io.Writer) error {
+	nodes := g.Nodes()
+
+	known := map[string]bool{}
+	for _, n := range nodes {
+		known[n.Name] = true
+	}
+
+	var b strings.Builder
+
+	b.WriteString("digraph namespaces {\n")
+
+	external := map[string]bool{}
+
+	for _, n := range nodes {
+		if len(n.Deps) == 0 {
+			fmt.Fprintf(&b, "  %q;\n", n.Name)
+		}
+
+		for _, dep := range n.Deps {
+			fmt.Fprintf(&b, "  %q -> %q;\n", n.Name, dep)
+
+			if !known[dep] {
+				external[dep] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range external {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %q [style=dashed];\n", name)
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Changed returns the namespaces whose files have changed since they were
+// loaded, along with those left to be reloaded by a failed refresh, and
+// the namespaces whose files have been removed.
+func (g *NSGraph) Changed() (changed, removed []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for name, n := range g.nodes {
+		if n.ModTime.IsZero() {
+			continue
+		}
+
+		fi, err := os.Stat(n.File)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			removed = append(removed, name)
+		case err != nil:
+			continue
+		case !fi.ModTime().Equal(n.ModTime):
+			changed = append(changed, name)
+		}
+	}
+
+	for name := range g.pending {
+		changed = append(changed, name)
+	}
+
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	return changed, removed
+}
+
+// Dependents returns names along with every namespace that depends on one
+// of them, directly or not, ordered so that each namespace comes after
+// those it depends on.
+func (g *NSGraph) Dependents(names []string) []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	users := map[string][]string{}
+	for _, nodes := range []map[string]*NSNode{g.nodes, g.pending} {
+		for _, n := range nodes {
+			for _, dep := range n.Deps {
+				users[dep] = append(users[dep], n.Name)
+			}
+		}
+	}
+
+	set := map[string]bool{}
+
+	var add func(name string)
+	add = func(name string) {
+		if set[name] {
+			return
+		}
+
+		set[name] = true
+
+		for _, user := range users[name] {
+			add(user)
+		}
+	}
+
+	for _, name := range names {
+		add(name)
+	}
+
+	return g.order(set)
+}
+
+// order sorts the namespaces in set so that each comes after those in set
+// it depends on. Namespaces that depend on each other are ordered by name.
+func (g *NSGraph) order(set map[string]bool) []string {
+	var names []string
+	for name := range set {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var (
+		order []string
+		seen  = map[string]bool{}
+		visit func(name string)
+	)
+
+	visit = func(name string) {
+		if seen[name] {
+			return
+		}
+
+		seen[name] = true
+
+		n, ok := g.nodes[name]
+		if !ok {
+			n, ok = g.pending[name]
+		}
+
+		if ok {
+			for _, dep := range n.Deps {
+				if set[dep] {
+					visit(dep)
+				}
+			}
+		}
+
+		order = append(order, name)
+	}
+
+	for _, name := range names {
+		visit(name)
+	}
+
+	return order
+}
+
+// Refresh unloads the namespaces whose files changed since they were
+// loaded, along with those that depend on them, and loads them again in
+// dependency order. Namespaces whose files were removed are only unloaded.
+// It returns the namespaces that were loaded again. If one fails to load,
+// it and those after it are put back as they were before the refresh, so
+// that running code keeps working, and are tried again by the next
+// refresh.
+func (env *Env) Refresh() ([]string, error) {
+	changed, removed := env.NSGraph().Changed()
+
+	gone := map[string]bool{}
+	for _, name := range removed {
+		gone[name] = true
+	}
+
+	return env.reload(append(changed, removed...), gone)
+}
+
+// RefreshAll unloads and loads again every namespace that was loaded from
+// a file.
+func (env *Env) RefreshAll() ([]string, error) {
+	var names []string
+
+	for _, n := range env.NSGraph().Nodes() {
+		names = append(names, n.Name)
+	}
+
+	changed, removed := env.NSGraph().Changed()
+
+	gone := map[string]bool{}
+	for _, name := range removed {
+		gone[name] = true
+	}
+
+	return env.reload(append(append(names, changed...), removed...), gone)
+}
+
+func (env *Env) reload(names []string, gone map[string]bool) ([]string, error) {
+	g := env.NSGraph()
+
+	order := g.Dependents(names)
+	if len(order) == 0 {
+		return nil, nil
+	}
+
+	cur := env.CurrentNamespace()
+	defer env.SetCurrentNamespace(cur)
+
+	old := map[string]*Namespace{}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		name := order[i]
+
+		old[name] = env.RemoveNamespace(MakeSymbol(name))
+		g.Forget(name, !gone[name])
+	}
+
+	var loaded []string
+
+	for i, name := range order {
+		if gone[name] {
+			continue
+		}
+
+		_, err := CallVar(env, "lace.core/require", MakeSymbol(name), MakeKeyword("reload"))
+		if err != nil {
+			for _, name := range order[i:] {
+				env.RemoveNamespace(MakeSymbol(name))
+
+				if ns := old[name]; ns != nil && !gone[name] {
+					env.restoreNamespace(ns)
+				}
+			}
+
+			return loaded, err
+		}
+
+		loaded = append(loaded, name)
+	}
+
+	return loaded, nil
+}
+
+// restoreNamespace puts back a namespace that was removed, so that code
+// using it keeps running when loading it again fails.
+func (env *Env) restoreNamespace(ns *Namespace) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	env.Namespaces[ns.Name.Name()] = ns
+}
+
+// ReadNSDecl reads the ns form at the start of r, returning the name of
+// the namespace it declares and the namespaces it requires or uses. If r
+// doesn't start with an ns form, the name is empty.
+func ReadNSDecl(env *Env, r io.Reader, filename string) (string, []string, error) {
+	reader := NewReader(bufio.NewReader(r), filename)
+
+	form, err := TryRead(env, reader)
+	if err == io.EOF {
+		return "", nil, nil
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	list, ok := form.(*List)
+	if !ok {
+		return "", nil, nil
+	}
+
+	elems, err := ToSlice(env, list.Seq())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(elems) < 2 || !isSymbolNamed(elems[0], "ns") {
+		return "", nil, nil
+	}
+
+	name, ok := elems[1].(Symbol)
+	if !ok {
+		return "", nil, nil
+	}
+
+	var deps []string
+
+	for _, elem := range elems[2:] {
+		clause, ok := elem.(*List)
+		if !ok {
+			continue
+		}
+
+		parts, err := ToSlice(env, clause.Seq())
+		if err != nil {
+			return "", nil, err
+		}
+
+		if len(parts) == 0 {
+			continue
+		}
+
+		kw, ok := parts[0].(Keyword)
+		if !ok || (kw.Name() != "require" && kw.Name() != "use") {
+			continue
+		}
+
+		for _, spec := range parts[1:] {
+			libs, err := libspecNames(env, spec)
+			if err != nil {
+				return "", nil, err
+			}
+
+			deps = append(deps, libs...)
+		}
+	}
+
+	return name.Name(), deps, nil
+}
+
+// libspecNames returns the namespaces named by a libspec of a :require or
+// :use clause, which is a symbol, a vector starting with one, or a prefix
+// list of a prefix followed by libspecs.
+func libspecNames(env *Env, spec any) ([]string, error) {
+	switch spec := spec.(type) {
+	case Symbol:
+		return []string{spec.Name()}, nil
+	case *Vector:
+		if spec.Count() > 0 {
+			if sym, ok := spec.at(0).(Symbol); ok {
+				return []string{sym.Name()}, nil
+			}
+		}
+	case *List:
+		parts, err := ToSlice(env, spec.Seq())
+		if err != nil {
+			return nil, err
+		}
+
+		if len(parts) == 0 {
+			return nil, nil
+		}
+
+		prefix, ok := parts[0].(Symbol)
+		if !ok {
+			return nil, nil
+		}
+
+		var names []string
+
+		for _, part := range parts[1:] {
+			libs, err := libspecNames(env, part)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, lib := range libs {
+				names = append(names, prefix.Name()+"."+lib)
+			}
+		}
+
+		return names, nil
+	}
+
+	return nil, nil
+}
+
+func isSymbolNamed(obj any, name string) bool {
+	sym, ok := obj.(Symbol)
+	return ok && sym.Namespace() == "" && sym.Name() == name
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNSGraph(t *testing.T) {
+	t.Run("reads the dependencies of ns forms", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		name, deps, err := ReadNSDecl(e, strings.NewReader(`
+(ns app.core
+  "The app."
+  (:require [app.db :as db]
+            app.util
+            (app.http client [server :refer [serve]]))
+  (:use app.log)
+  (:import (java.io File)))
+
+(defn main [])
+`), "core.clj")
+		r.NoError(err)
+
+		r.Equal("app.core", name)
+		r.Equal([]string{"app.db", "app.util", "app.http.client", "app.http.server", "app.log"}, deps)
+
+		name, _, err = ReadNSDecl(e, strings.NewReader(`(println "hi")`), "script.clj")
+		r.NoError(err)
+		r.Equal("", name)
+	})
+
+	t.Run("refreshes changed namespaces and their dependents", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := NewEnv()
+		r.NoError(err)
+
+		dir := t.TempDir()
+		r.NoError(os.MkdirAll(filepath.Join(dir, "app"), 0755))
+
+		write := func(name, code string, age time.Duration) {
+			path := filepath.Join(dir, "app", name)
+			r.NoError(os.WriteFile(path, []byte(code), 0644))
+
+			mt := time.Now().Add(age)
+			r.NoError(os.Chtimes(path, mt, mt))
+		}
+
+		write("util.clj", "(ns app.util)\n(def answer 41)\n", -time.Hour)
+		write("api.clj", "(ns app.api (:require app.util))\n", -time.Hour)
+		write("other.clj", "(ns app.other)\n", -time.Hour)
+
+		e.SetClassPath(dir)
+
+		for _, lib := range []string{"app.api", "app.other"} {
+			_, err = Load(e, lib)
+			r.NoError(err)
+		}
+
+		var names []string
+		for _, n := range e.NSGraph().Nodes() {
+			names = append(names, n.Name)
+		}
+
+		r.Equal([]string{"app.api", "app.other", "app.util"}, names)
+		r.Equal([]string{"app.util", "app.api"}, e.NSGraph().Dependents([]string{"app.util"}))
+
+		loaded, err := e.Refresh()
+		r.NoError(err)
+		r.Empty(loaded)
+
+		write("util.clj", "(ns app.util)\n(def answer 42)\n", 0)
+
+		loaded, err = e.Refresh()
+		r.NoError(err)
+		r.Equal([]string{"app.util", "app.api"}, loaded)
+
+		obj, err := e.Eval("app.util/answer")
+		r.NoError(err)
+		r.True(Equals(e, obj, MakeInt(42)))
+
+		write("util.clj", "(ns app.util)\n(def answer (undefined-fn))\n", time.Hour)
+
+		_, err = e.Refresh()
+		r.Error(err)
+
+		obj, err = e.Eval("app.util/answer")
+		r.NoError(err)
+		r.True(Equals(e, obj, MakeInt(42)))
+
+		write("util.clj", "(ns app.util)\n(def answer 43)\n", 2*time.Hour)
+
+		loaded, err = e.Refresh()
+		r.NoError(err)
+		r.Equal([]string{"app.util", "app.api"}, loaded)
+	})
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -108,9 +109,10 @@ type (
 	}
 	CatchExpr struct {
 		Position
-		excType   Type
-		excSymbol Symbol
-		body      []Expr
+		excType     Type
+		excSentinel *Var
+		excSymbol   Symbol
+		body        []Expr
 	}
 	TryExpr struct {
 		Position
@@ -1154,24 +1156,95 @@ func isFinally(env *Env, obj any) bool {
 	return criticalSymbols.finally.Is(v)
 }
 
-func resolveType(obj any, ctx *ParseContext) (Type, error) {
-	excType, err := Parse(obj, ctx)
+// goCatchSymbol rewrites the Go style names allowed in catch clauses, ie
+// os.ErrNotExist or *fs.PathError, into the var they refer to. The returned
+// bool indicates the name was prefixed with * to request the pointer type.
+func goCatchSymbol(env *Env, sym Symbol) (Symbol, bool) {
+	ns, name := sym.Namespace(), sym.Name()
+
+	var ptr bool
+
+	if ns == "" {
+		if strings.HasPrefix(name, "*") {
+			ptr = true
+			name = name[1:]
+		}
+
+		dot := strings.LastIndexByte(name, '.')
+		if dot <= 0 || dot == len(name)-1 {
+			return sym, false
+		}
+
+		ns, name = name[:dot], name[dot+1:]
+	} else if strings.HasPrefix(ns, "*") {
+		ptr = true
+		ns = ns[1:]
+	}
+
+	goSym := AssembleSymbol(ns, name)
+	if env.NamespaceFor(env.CurrentNamespace(), goSym) != nil {
+		return goSym, ptr
+	}
+
+	// Packages are also known by the name Go code uses for them, such as
+	// fs for io/fs.
+	if env.pkgReflect != nil {
+		if pns := env.pkgReflect.packageNamespace(ns); pns != nil {
+			return AssembleSymbol(pns.Name.Name(), name), ptr
+		}
+	}
+
+	return sym, false
+}
+
+// resolveCatchTarget resolves what a catch clause matches against. That is
+// either a type, which also matches Go errors in the chain via errors.As,
+// or a var holding a Go error value, which is matched via errors.Is.
+func resolveCatchTarget(obj any, ctx *ParseContext) (Type, *Var, error) {
+	var ptr bool
+
+	if sym, ok := obj.(Symbol); ok {
+		if _, found := ctx.Env.Resolve(sym); !found {
+			var goSym Symbol
+			goSym, ptr = goCatchSymbol(ctx.Env, sym)
+			obj = DeriveReadObject(obj, goSym)
+		}
+	}
+
+	expr, err := Parse(obj, ctx)
 	if err != nil {
-		return Type{}, err
+		return Type{}, nil, err
 	}
 
-	switch excType := excType.(type) {
+	var val any
+
+	switch expr := expr.(type) {
 	case *LiteralExpr:
-		switch t := excType.obj.(type) {
-		case Type:
-			return t, nil
+		val = expr.obj
+	case *VarRefExpr:
+		val = expr.vr.GetStatic()
+
+		if _, ok := val.(Type); !ok {
+			if _, ok := AsGoError(val); ok {
+				return Type{}, expr.vr, nil
+			}
+		}
+	}
+
+	if t, ok := val.(Type); ok {
+		if ptr {
+			t = Type{rType: reflect.PointerTo(t.rType)}
 		}
+
+		return t, nil, nil
 	}
+
 	s, err := ToString(ctx.Env, obj)
 	if err != nil {
-		return Type{}, err
+		return Type{}, nil, err
 	}
-	return Type{}, &ParseError{obj: obj, msg: "Unable to resolve type: " + s}
+
+	return Type{}, nil, &ParseError{obj: obj, msg: "Unable to resolve type or error value: " + s}
 }
 
 func parseCatch(obj any, ctx *ParseContext) (*CatchExpr, error) {
@@ -1216,7 +1289,7 @@ func parseCatch(obj any, ctx *ParseContext) (*CatchExpr, error) {
 		return nil, err
 	}
 
-	excType, err := resolveType(v, ctx)
+	excType, excSentinel, err := resolveCatchTarget(v, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1245,10 +1318,11 @@ func parseCatch(obj any, ctx *ParseContext) (*CatchExpr, error) {
 		return nil, err
 	}
 	return &CatchExpr{
-		Position:  GetPosition(obj),
-		excType:   excType,
-		excSymbol: excSymbol.(Symbol),
-		body:      bodye,
+		Position:    GetPosition(obj),
+		excType:     excType,
+		excSentinel: excSentinel,
+		excSymbol:   excSymbol.(Symbol),
+		body:        bodye,
 	}, nil
 }
 
@@ -2053,6 +2127,10 @@ func parseList(env *Env, obj any, ctx *ParseContext) (Expr, error) {
 		Position: pos,
 	}
 
+	if err := checkProcCall(ctx, obj, res); err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
 
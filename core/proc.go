@@ -13,6 +13,10 @@ type Proc struct {
 	Package string // "" for core (this package), else e.g. "std/string"
 	File    string
 	Line    int
+
+	// sig describes the arguments of the Go function backing Fn, when
+	// known. It's used to check calls when they're parsed.
+	sig *conversionSet
 }
 
 var _ Callable = Proc{}
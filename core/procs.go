@@ -12,7 +12,6 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -541,8 +540,6 @@ var procExInfo = func(env *Env, args []any) (any, error) {
 		return nil, err
 	}
 
-	res := WrapError(env, errors.New(s.S()))
-
 	var data ArrayMap
 
 	m, err := EnsureMap(env, args, 1)
@@ -552,18 +549,44 @@ var procExInfo = func(env *Env, args []any) (any, error) {
 
 	data.AddEqu(criticalKeywords.message, s)
 	data.AddEqu(criticalKeywords.data, m)
+
+	var cause error
+
 	if len(args) == 3 {
-		e, err := EnsureError(env, args, 2)
-		if err != nil {
-			return nil, err
+		e, ok := AsGoError(args[2])
+		if !ok {
+			return nil, env.NewArgTypeError(2, args[2], "Error")
 		}
-		data.AddEqu(criticalKeywords.cause, e)
+		cause = e
+		data.AddEqu(criticalKeywords.cause, args[2])
 	}
 
+	// Built directly rather than via WrapError so that a cause which is
+	// itself an EvalError isn't mistaken for this error.
+	res := env.populateStackTrace(&EvalError{
+		err:  &causedError{msg: s.S(), cause: cause},
+		hash: hashStringU32(s.S()),
+	})
+
 	res.Map = &data
 	return res, nil
 }
 
+// causedError carries the message of an ex-info and puts its cause into
+// the error chain so Go code can inspect it with errors.Is and errors.As.
+type causedError struct {
+	msg   string
+	cause error
+}
+
+func (e *causedError) Error() string {
+	return e.msg
+}
+
+func (e *causedError) Unwrap() error {
+	return e.cause
+}
+
 var procExData = func(env *Env, args []any) (any, error) {
 	if err := CheckArity(env, args, 1, 1); err != nil {
 		return nil, err
@@ -2653,52 +2676,28 @@ var procLoadLibFromPath = func(env *Env, args []any) (any, error) {
 	}
 	pathname := pathnamev.S()
 
-	cp := env.classPath.GetStatic()
-	cpvec, err := AssertVector(env, cp, "*classpath* must be a Vector, not a "+TypeName(cp))
+	f, filename, err := openLib(env, libname, pathname)
 	if err != nil {
 		return nil, err
 	}
-
-	count := cpvec.Count()
-	var f *os.File
-	var canonicalErr error
-	var filename string
-	for i := 0; i < count; i++ {
-		elem := cpvec.at(i)
-		cpelem, err := AssertString(env, elem, "*classpath* must contain only Strings, not a "+TypeName(elem)+" (at element "+strconv.Itoa(i)+")")
-		if err != nil {
-			return nil, err
-		}
-		s := cpelem.S()
-		if s == "" {
-			filename = pathname
-		} else {
-			filename = filepath.Join(s, filepath.Join(strings.Split(libname, ".")...)) + ".clj" // could cache inner join....
-		}
-
-		f, err = os.Open(filename)
-		if err == nil {
-			canonicalErr = nil
-			break
-		}
-		if s == "" {
-			canonicalErr = err
-		}
-	}
-	if canonicalErr != nil {
-		return nil, canonicalErr
+	if f == nil {
+		return nil, Errorf(env, "unable to find path for library: %s", libname)
 	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error attempting to open: %s", filename)
-	}
-	if filename == "" {
-		return nil, Errorf(env, "unable to find path for library: %s", libname)
+		return nil, err
 	}
-	reader := NewReader(bufio.NewReader(f), filename)
+
+	reader := NewReader(bufio.NewReader(bytes.NewReader(data)), filename)
 	err = ProcessReaderFromEval(env, reader, filename)
 	if err != nil {
 		return nil, err
 	}
+
+	recordLib(env, libname, filename, f, data)
+
 	return NIL, nil
 }
 
@@ -2877,13 +2876,7 @@ var procParse = func(env *Env, args []any) (any, error) {
 		return nil, err
 	}
 
-	lm, _ := env.Resolve(MakeSymbol("lace.core/*linter-mode*"))
-	lm.SetStatic(Boolean(true))
-	LINTER_MODE = true
-	defer func() {
-		LINTER_MODE = false
-		lm.SetStatic(Boolean(false))
-	}()
+	defer enterLinterMode(env)()
 	parseContext := &ParseContext{Env: env}
 	res, err := Parse(args[0], parseContext)
 	if err != nil {
@@ -3015,18 +3008,11 @@ var procExit = func(env *Env, args []any) (any, error) {
 }
 
 func ReadIntoBytecode(env *Env, reader *Reader, filename string) ([]byte, error) {
-	parseContext := &ParseContext{Env: env}
-	if filename != "" {
-		currentFilename := parseContext.Env.file.GetStatic()
-		defer func() {
-			parseContext.Env.SetFilename(currentFilename)
-		}()
-		s, err := filepath.Abs(filename)
-		if err != nil {
-			return nil, err
-		}
-		parseContext.Env.SetFilename(MakeString(s))
+	parseContext, restore, err := fileParseContext(env, filename)
+	if err != nil {
+		return nil, err
 	}
+	defer restore()
 
 	e := NewEngine()
 	env.Engine = e
@@ -3073,20 +3059,47 @@ var procIncProblemCount = func(env *Env, args []any) (any, error) {
 	return NIL, nil
 }
 
-func ProcessReader(env *Env, reader *Reader, filename string) (any, error) {
+// enterLinterMode turns on linter mode, returning the function that turns
+// it off again.
+func enterLinterMode(env *Env) func() {
+	lm, _ := env.Resolve(MakeSymbol("lace.core/*linter-mode*"))
+	lm.SetStatic(Boolean(true))
+	LINTER_MODE = true
+	return func() {
+		LINTER_MODE = false
+		lm.SetStatic(Boolean(false))
+	}
+}
+
+// fileParseContext returns the context to parse the forms read from
+// filename in. The file is the env's current one until the returned
+// function is called.
+func fileParseContext(env *Env, filename string) (*ParseContext, func(), error) {
 	parseContext := &ParseContext{Env: env}
-	if filename != "" {
-		currentFilename := parseContext.Env.file.GetStatic()
-		defer func() {
-			parseContext.Env.SetFilename(currentFilename)
-		}()
-		s, err := filepath.Abs(filename)
-		if err != nil {
-			return nil, err
-		}
-		parseContext.Env.SetFilename(MakeString(s))
+	if filename == "" {
+		return parseContext, func() {}, nil
 	}
 
+	s, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentFilename := env.file.GetStatic()
+	env.SetFilename(MakeString(s))
+
+	return parseContext, func() {
+		env.SetFilename(currentFilename)
+	}, nil
+}
+
+func ProcessReader(env *Env, reader *Reader, filename string) (any, error) {
+	parseContext, restore, err := fileParseContext(env, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
 	var exprs []Expr
 
 	for {
@@ -3139,7 +3152,7 @@ func ProcessReader(env *Env, reader *Reader, filename string) (any, error) {
 		},
 	}
 
-	_, err := compileFn(env, fn, nil)
+	_, err = compileFn(env, fn, nil)
 	if err != nil {
 		fmt.Printf("error compiling: %s\n", err)
 		return nil, err
@@ -3198,18 +3211,13 @@ func ProcessReader(env *Env, reader *Reader, filename string) (any, error) {
 }
 
 func ProcessReaderFromEval(env *Env, reader *Reader, filename string) error {
-	parseContext := &ParseContext{Env: env}
-	if filename != "" {
-		currentFilename := parseContext.Env.file.GetStatic()
-		defer func() {
-			parseContext.Env.SetFilename(currentFilename)
-		}()
-		s, err := filepath.Abs(filename)
-		if err != nil {
-			return err
-		}
-		parseContext.Env.SetFilename(MakeString(s))
+	currentNs := env.CurrentNamespace()
+	defer env.SetCurrentNamespace(currentNs)
+	parseContext, restore, err := fileParseContext(env, filename)
+	if err != nil {
+		return err
 	}
+	defer restore()
 	for {
 		obj, err := TryRead(env, reader)
 		if err == io.EOF {
@@ -3229,6 +3237,72 @@ func ProcessReaderFromEval(env *Env, reader *Reader, filename string) error {
 	}
 }
 
+// LintReader parses every form read from reader in linter mode, printing
+// the problems found to Stderr instead of stopping at the first one. Only
+// ns, in-ns and require forms are evaluated, so that the namespaces they
+// refer to can be resolved.
+func LintReader(env *Env, reader *Reader, filename string) error {
+	defer enterLinterMode(env)()
+
+	cur := env.CurrentNamespace()
+	defer env.SetCurrentNamespace(cur)
+
+	parseContext, restore, err := fileParseContext(env, filename)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	for {
+		obj, err := TryRead(env, reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(Stderr, err)
+			return err
+		}
+		expr, err := TryParse(obj, parseContext)
+		if err != nil {
+			fmt.Fprintln(Stderr, err)
+			continue
+		}
+
+		if !isNamespaceForm(env, obj) {
+			continue
+		}
+
+		if _, err := TryEval(env, expr); err != nil {
+			PROBLEM_COUNT++
+			fmt.Fprintln(Stderr, err)
+		}
+	}
+}
+
+func isNamespaceForm(env *Env, obj any) bool {
+	seq, ok := obj.(Seq)
+	if !ok {
+		return false
+	}
+
+	first, err := seq.First(env)
+	if err != nil {
+		return false
+	}
+
+	sym, ok := first.(Symbol)
+	if !ok {
+		return false
+	}
+
+	switch sym.Name() {
+	case "ns", "in-ns", "require":
+		return sym.Namespace() == "" || sym.Namespace() == "lace.core"
+	default:
+		return false
+	}
+}
+
 func processInEnvInNS(env *Env, ns *Namespace, data []byte) error {
 	cur := env.CurrentNamespace()
 	env.SetCurrentNamespace(ns)
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	_ "embed"
 
@@ -112,64 +113,7 @@ func structList(env *Env, val reflect.Value) (any, error) {
 }
 
 func structFromMap(env *Env, rt *Type, m Map) (any, error) {
-	t := rt.ReflectType()
-	if t.Kind() != reflect.Struct {
-		return nil, env.NewError("type is not a struct")
-	}
-
-	ret := reflect.New(t)
-
-	rv := ret.Elem()
-
-	iter := m.Iter()
-
-	for iter.HasNext() {
-		p := iter.Next()
-
-		var key string
-
-		if err := CoerceString(env, p.Key, &key); err != nil {
-			return nil, err
-		}
-
-		key = convertMethodName(key)
-
-		field := rv.FieldByName(key)
-		if !field.IsValid() {
-			return nil, env.NewError("Unknown field: %s", key)
-		}
-
-		fval := p.Value
-
-		var (
-			frv reflect.Value
-			err error
-		)
-
-		if field.Type().Kind() == reflect.Func {
-			call, ok := fval.(Callable)
-			if !ok {
-				return nil, env.TypeError(TCContext{Context: "struct value"}, fval, "Callable")
-			}
-			frv = convReg.makeFuncConvertIn(env, call, field.Type())
-		} else {
-			cv, _ := convReg.convArg(field.Type())
-
-			frv, err = cv(env, -1, fval)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if !frv.Type().AssignableTo(field.Type()) {
-			return nil, env.NewError(
-				fmt.Sprintf("needed type %s, had %T", field.Type(), fval))
-		}
-
-		field.Set(frv)
-	}
-
-	return ret.Interface(), nil
+	return dataToStruct(env, rt.ReflectType(), m)
 }
 
 func structAsMap(env *Env, val reflect.Value) (any, error) {
@@ -190,37 +134,7 @@ func structToMap(env *Env, val reflect.Value) (any, error) {
 		return nil, env.NewError(fmt.Sprintf("value must be a struct, is a %s (%T)", val.Kind(), val.Interface()))
 	}
 
-	var ret Associative = NIL
-
-	t := val.Type()
-
-	for i := 0; i < t.NumField(); i++ {
-		tf := t.Field(i)
-		if !tf.IsExported() {
-			continue
-		}
-
-		field := val.Field(i)
-		rt := field.Type()
-
-		cv, err := convReg.convRet(rt)(field)
-		if err != nil {
-			if ce, ok := err.(OutConvError); ok {
-				return nil, env.NewError(string(ce))
-			}
-			return nil, err
-		}
-
-		ret, err = ret.Assoc(env,
-			MakeKeyword(tf.Name),
-			cv,
-		)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return ret, nil
+	return valueToData(env, nil, val)
 }
 
 type reifiedFunc struct {
@@ -234,7 +148,7 @@ type reifiedType struct {
 	MethodVec *Vector
 }
 
-func listMethods(env *Env, obj any, reg map[reflect.Type]reifiedType) Seq {
+func listMethods(env *Env, obj any, state *pkgReflectState) Seq {
 	t := reflect.TypeOf(obj)
 
 	for t.Kind() == reflect.Pointer {
@@ -242,7 +156,7 @@ func listMethods(env *Env, obj any, reg map[reflect.Type]reifiedType) Seq {
 	}
 
 	var objs []any
-	if meths, ok := reg[t]; ok {
+	if meths, ok := state.methodsFor(env, t); ok {
 		return meths.MethodVec.Seq()
 	} else {
 		for i := 0; i < t.NumMethod(); i++ {
@@ -449,10 +363,13 @@ var nsSubs = strings.NewReplacer(
 func SetupPkgReflect(env *Env) ([]*Namespace, error) {
 	var ret []*Namespace
 
-	typedMethods := map[reflect.Type]reifiedType{}
+	state := newPkgReflectState()
+	env.pkgReflect = state
 
 	var pkgs []any
 
+	start := time.Now()
+
 	for name, pkg := range pkgreflect.Registry() {
 		nsName := nsSubs.Replace(name)
 		m := EmptyArrayMap()
@@ -463,91 +380,48 @@ func SetupPkgReflect(env *Env) ([]*Namespace, error) {
 
 		b.NSMeta(pkg.Doc, "1.0")
 
-		for name, typ := range pkg.Types {
-			b.DefType(&DefTypeInfo{
-				Name:  name,
-				Doc:   typ.Doc,
-				Added: "1.0",
-				Type:  typ.Value,
-			})
-
-			var keys []string
-			for n := range typ.Methods {
-				keys = append(keys, n)
-			}
+		stat := &PkgLoadStat{
+			Package:   name,
+			Namespace: nsName,
+		}
 
-			sort.Strings(keys)
-
-			var objs []any
-			methods := map[string]reifiedFunc{}
-			for _, n := range keys {
-				m := typ.Methods[n]
-				var name Symbol
-				if m.Tag != "" {
-					tag := MakeSymbol(m.Tag)
-					name = MakeTaggedSymbol(nsName+"/"+n, tag)
-				} else {
-					name = MakeSymbol(nsName + "/" + n)
-				}
-
-				methods[n] = reifiedFunc{
-					Func: m,
-					Name: name,
-				}
-
-				objs = append(objs, name)
-			}
+		state.stats[name] = stat
+		state.byPath[name] = b.ns
+		state.all = append(state.all, b.ns)
 
-			typedMethods[typ.Value] = reifiedType{
-				Namespace: nsName,
-				Methods:   methods,
-				MethodVec: NewVectorFrom(objs...),
+		// The registry name isn't always the Go package path, so also track
+		// the path of the package's own types.
+		for _, typ := range pkg.Types {
+			if typ.Methods != nil {
+				state.byPath[typ.Value.PkgPath()] = b.ns
 			}
 		}
 
-		for name, val := range pkg.Functions {
-			var args []string
-			tags := map[string]string{}
+		// The namespace is only populated once it's referenced, so that
+		// linking in many reflected packages doesn't slow down startup.
+		b.ns.Lazy = func(env *Env, ns *Namespace) {
+			t := time.Now()
+			vars := populatePkgNamespace(env, nsName, pkg, state)
 
-			for _, a := range val.Args {
-				args = append(args, a.Name)
-				if a.Tag != "" {
-					tags[a.Name] = a.Tag
-				}
-			}
+			// The stats are read under the lock, but it isn't held while
+			// populating, which registers the package's methods.
+			state.mu.Lock()
+			defer state.mu.Unlock()
 
-			b.Defn(&DefnInfo{
-				Name:    name,
-				Doc:     val.Doc,
-				Added:   "1.0",
-				Tag:     val.Tag,
-				Args:    args,
-				ArgTags: tags,
-				Fn:      val.Value,
-			})
+			stat.Vars = vars
+			stat.Duration = time.Since(t)
+			stat.Loaded = true
 		}
 
-		for name, val := range pkg.Consts {
-			b.DefVar(&DefVarInfo{
-				Name:  name,
-				Doc:   val.Doc,
-				Added: "1.0",
-				Value: val.Value,
-			})
-		}
+		ret = append(ret, b.ns)
 
-		for name, val := range pkg.Variables {
-			b.DefVar(&DefVarInfo{
-				Name:  name,
-				Doc:   val.Doc,
-				Added: "1.0",
-				Value: val.Value,
-			})
+		if pkg.Wrapper != "" {
+			setupPkgWrapper(env, name, pkg, state)
 		}
-
-		ret = append(ret, b.ns)
 	}
 
+	state.setup = time.Since(start)
+
 	b := NewNSBuilder(env, "lace.reflect")
 	b.Defn(&DefnInfo{
 		Name:  "methods",
@@ -555,7 +429,23 @@ func SetupPkgReflect(env *Env) ([]*Namespace, error) {
 		Added: "1.0",
 		Tag:   "Seq",
 		Fn: func(env *Env, obj any) Seq {
-			return listMethods(env, obj, typedMethods)
+			return listMethods(env, obj, state)
+		},
+	})
+
+	b.Defn(&DefnInfo{
+		Name:  "load-report",
+		Doc:   "Returns the cost of loading each reflected Go package, most expensive first. Packages are loaded the first time their namespace is referenced.",
+		Added: "1.0",
+		Fn:    pkgLoadReport,
+	})
+
+	b.Defn(&DefnInfo{
+		Name:  "load-all!",
+		Doc:   "Loads the namespaces of all reflected Go packages.",
+		Added: "1.0",
+		Fn: func(env *Env) {
+			state.loadAll(env)
 		},
 	})
 
@@ -619,14 +509,14 @@ func SetupPkgReflect(env *Env) ([]*Namespace, error) {
 
 	b.Defn(&DefnInfo{
 		Name:  "to-map",
-		Doc:   "Convert a struct value to a map.",
+		Doc:   "Convert a struct value to a map, as go/->data does.",
 		Added: "1.0",
 		Fn:    structToMap,
 	})
 
 	b.Defn(&DefnInfo{
 		Name:  "from-map",
-		Doc:   "Create a struct value, populating it from the values in the map.",
+		Doc:   "Create a struct value, populating it from the values in the map, as go/->struct does.",
 		Added: "1.0",
 		Fn:    structFromMap,
 	})
@@ -839,5 +729,97 @@ func SetupPkgReflect(env *Env) ([]*Namespace, error) {
 
 	ret = append(ret, b.ns)
 
+	ret = append(ret, setupGoDataNS(env))
+
 	return ret, nil
 }
+
+// populatePkgNamespace defines the types, functions, consts, and variables
+// of pkg in the namespace nsName. Returns the number of vars defined.
+func populatePkgNamespace(env *Env, nsName string, pkg *pkgreflect.Package, state *pkgReflectState) int {
+	b := NewNSBuilder(env, nsName)
+
+	for name, typ := range pkg.Types {
+		b.DefType(&DefTypeInfo{
+			Name:  name,
+			Doc:   typ.Doc,
+			Added: "1.0",
+			Type:  typ.Value,
+		})
+
+		var keys []string
+		for n := range typ.Methods {
+			keys = append(keys, n)
+		}
+
+		sort.Strings(keys)
+
+		var objs []any
+		methods := map[string]reifiedFunc{}
+		for _, n := range keys {
+			m := typ.Methods[n]
+			var name Symbol
+			if m.Tag != "" {
+				tag := MakeSymbol(m.Tag)
+				name = MakeTaggedSymbol(nsName+"/"+n, tag)
+			} else {
+				name = MakeSymbol(nsName + "/" + n)
+			}
+
+			methods[n] = reifiedFunc{
+				Func: m,
+				Name: name,
+			}
+
+			objs = append(objs, name)
+		}
+
+		state.addMethods(typ.Value, reifiedType{
+			Namespace: nsName,
+			Methods:   methods,
+			MethodVec: NewVectorFrom(objs...),
+		})
+	}
+
+	for name, val := range pkg.Functions {
+		var args []string
+		tags := map[string]string{}
+
+		for _, a := range val.Args {
+			args = append(args, a.Name)
+			if a.Tag != "" {
+				tags[a.Name] = a.Tag
+			}
+		}
+
+		b.Defn(&DefnInfo{
+			Name:    name,
+			Doc:     val.Doc,
+			Added:   "1.0",
+			Tag:     val.Tag,
+			Args:    args,
+			ArgTags: tags,
+			Fn:      val.Value,
+		})
+	}
+
+	for name, val := range pkg.Consts {
+		b.DefVar(&DefVarInfo{
+			Name:  name,
+			Doc:   val.Doc,
+			Added: "1.0",
+			Value: val.Value,
+		})
+	}
+
+	for name, val := range pkg.Variables {
+		b.DefVar(&DefVarInfo{
+			Name:  name,
+			Doc:   val.Doc,
+			Added: "1.0",
+			Value: val.Value,
+		})
+	}
+
+	return len(pkg.Types) + len(pkg.Functions) + len(pkg.Consts) + len(pkg.Variables)
+}
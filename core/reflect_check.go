@@ -0,0 +1,109 @@
+package core
+
+import (
+	"fmt"
+)
+
+// checkProcCall validates a call to a proc backed by a Go function against
+// the signature of that function. Calls with the wrong number of arguments,
+// or with literal arguments that can't be converted to the parameter type,
+// are certain to fail when run. They're reported as parse errors, or as
+// warnings when linting so that every problem in a file is listed.
+func checkProcCall(ctx *ParseContext, obj any, call *CallExpr) error {
+	ref, ok := call.callable.(*VarRefExpr)
+	if !ok || ref.vr.isMacro || ref.vr.isDynamic {
+		return nil
+	}
+
+	proc, ok := ref.vr.GetStatic().(Proc)
+	if !ok || proc.sig == nil {
+		return nil
+	}
+
+	cs := proc.sig
+
+	report := func(msg string) error {
+		if LINTER_MODE {
+			printParseWarning(call.Pos(), msg)
+			return nil
+		}
+
+		return &ParseError{obj: obj, msg: msg}
+	}
+
+	variadic := cs.ft.IsVariadic()
+
+	switch {
+	case variadic && len(call.args) < cs.arity-1:
+		return report(fmt.Sprintf("Wrong number of args (%d) passed to %s; expects at least %d",
+			len(call.args), ref.vr.Name(), cs.arity-1))
+	case !variadic && len(call.args) != cs.arity:
+		return report(fmt.Sprintf("Wrong number of args (%d) passed to %s; expects %d",
+			len(call.args), ref.vr.Name(), cs.arity))
+	}
+
+	literal := func(inIdx int) (any, bool) {
+		lit, ok := call.args[inIdx].(*LiteralExpr)
+		if !ok || lit.isSurrogate {
+			return nil, false
+		}
+
+		return lit.obj, true
+	}
+
+	// The variadic arguments are either spread or passed as a slice, which
+	// is only known for literals.
+	spread := -1
+
+	if variadic {
+		spread = cs.arity - 1
+
+		if len(call.args) == cs.arity {
+			if obj, ok := literal(spread); !ok || cs.passesSlice(obj) {
+				spread = -1
+			}
+		}
+	}
+
+	for destIdx, inIdx := range cs.arityMap {
+		if inIdx < 0 || inIdx == spread {
+			continue
+		}
+
+		if inIdx >= len(call.args) {
+			continue
+		}
+
+		obj, ok := literal(inIdx)
+		if !ok {
+			continue
+		}
+
+		if _, err := cs.argIn[destIdx](ctx.Env, inIdx, obj); err != nil {
+			if err := report(fmt.Sprintf("Arg[%d] of %s must have type %s, got %s",
+				inIdx, ref.vr.Name(), cs.ft.In(destIdx), TypeName(obj))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if spread < 0 {
+		return nil
+	}
+
+	for inIdx := spread; inIdx < len(call.args); inIdx++ {
+		obj, ok := literal(inIdx)
+		if !ok {
+			continue
+		}
+
+		if _, err := cs.varIn(ctx.Env, inIdx, obj); err != nil {
+			if err := report(fmt.Sprintf("Arg[%d] of %s must have type %s, got %s",
+				inIdx, ref.vr.Name(), cs.ft.In(cs.ft.NumIn()-1).Elem(), TypeName(obj))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -133,6 +133,9 @@ type conversionSet struct {
 	arityMap []int
 	arity    int
 
+	// varIn converts each of the variadic arguments when they're spread.
+	varIn inConv
+
 	rets     []outConv
 	values   int
 	errorPos int
@@ -143,6 +146,15 @@ func (c *ConvRegistry) wrapFunc(fnVal reflect.Value, cs *conversionSet) reflect.
 	// input to .Call
 	if cs.ft.NumIn() <= 10 {
 		return reflect.ValueOf(ProcFn(func(env *Env, objArgs []any) (any, error) {
+			if cs.spreads(objArgs) {
+				ret, err := cs.callSpread(env, fnVal, objArgs)
+				if err != nil {
+					return nil, err
+				}
+
+				return cs.convertRets(env, ret)
+			}
+
 			if len(objArgs) != cs.arity {
 				return nil, ErrorArityMinMax(env, len(objArgs), cs.arity, cs.arity)
 			}
@@ -167,44 +179,26 @@ func (c *ConvRegistry) wrapFunc(fnVal reflect.Value, cs *conversionSet) reflect.
 				dest[destIdx] = sub
 			}
 
-			ret = fnVal.Call(dest[:cs.ft.NumIn()])
-
-			if cs.errorPos >= 0 && !ret[cs.errorPos].IsNil() {
-				return nil, WrapError(env, ret[cs.errorPos].Interface().(error))
+			// The last argument is a slice or seq of the variadic arguments.
+			if cs.ft.IsVariadic() {
+				ret = fnVal.CallSlice(dest[:cs.ft.NumIn()])
+			} else {
+				ret = fnVal.Call(dest[:cs.ft.NumIn()])
 			}
 
-			switch cs.values {
-			case 0:
-				return NIL, nil
-			case 1:
-				obj, err := cs.rets[0](ret[0])
+			return cs.convertRets(env, ret)
+		}))
+	} else {
+		return reflect.ValueOf(ProcFn(func(env *Env, objArgs []any) (any, error) {
+			if cs.spreads(objArgs) {
+				ret, err := cs.callSpread(env, fnVal, objArgs)
 				if err != nil {
-					if ce, ok := err.(OutConvError); ok {
-						err = env.NewError(string(ce))
-					}
-
-					return nil, WrapError(env, err)
-				}
-				return obj, nil
-			default:
-				var objects []any
-
-				for i, rv := range ret {
-					if i == cs.errorPos {
-						continue
-					}
-
-					v, err := cs.rets[i](rv)
-					if err == nil {
-						objects = append(objects, v)
-					}
+					return nil, err
 				}
 
-				return NewListFrom(objects...), nil
+				return cs.convertRets(env, ret)
 			}
-		}))
-	} else {
-		return reflect.ValueOf(ProcFn(func(env *Env, objArgs []any) (any, error) {
+
 			if len(objArgs) != cs.arity {
 				return nil, ErrorArityMinMax(env, len(objArgs), cs.arity, cs.arity)
 			}
@@ -229,43 +223,119 @@ func (c *ConvRegistry) wrapFunc(fnVal reflect.Value, cs *conversionSet) reflect.
 				dest[destIdx] = sub
 			}
 
-			ret = fnVal.Call(dest[:cs.ft.NumIn()])
+			// The last argument is a slice or seq of the variadic arguments.
+			if cs.ft.IsVariadic() {
+				ret = fnVal.CallSlice(dest[:cs.ft.NumIn()])
+			} else {
+				ret = fnVal.Call(dest[:cs.ft.NumIn()])
+			}
+
+			return cs.convertRets(env, ret)
+		}))
+
+	}
+}
+
+// spreads reports whether objArgs pass the variadic arguments of the
+// function one by one, rather than as a slice or seq in the last argument.
+func (cs *conversionSet) spreads(objArgs []any) bool {
+	if !cs.ft.IsVariadic() || len(objArgs) < cs.arity-1 {
+		return false
+	}
+
+	if len(objArgs) != cs.arity {
+		return true
+	}
+
+	return !cs.passesSlice(objArgs[len(objArgs)-1])
+}
+
+// passesSlice reports whether o, given as the last argument, is passed as
+// the whole slice of variadic arguments. When the variadic arguments are
+// interfaces, o is passed as a single argument instead; use apply to
+// spread it.
+func (cs *conversionSet) passesSlice(o any) bool {
+	st := cs.ft.In(cs.ft.NumIn() - 1)
+	if st.Elem().Kind() == reflect.Interface {
+		return false
+	}
+
+	if _, ok := o.(Sequential); ok {
+		return true
+	}
+
+	return o != nil && reflect.TypeOf(o).AssignableTo(st)
+}
+
+// callSpread calls fnVal with the variadic arguments in objArgs converted
+// one by one.
+func (cs *conversionSet) callSpread(env *Env, fnVal reflect.Value, objArgs []any) ([]reflect.Value, error) {
+	fixed := cs.ft.NumIn() - 1
+
+	dest := make([]reflect.Value, fixed, fixed+len(objArgs)-cs.arity+1)
 
-			if cs.errorPos >= 0 && !ret[cs.errorPos].IsNil() {
-				return nil, WrapError(env, ret[cs.errorPos].Interface().(error))
+	for destIdx, inIdx := range cs.arityMap[:fixed] {
+		var a any
+
+		if inIdx >= 0 {
+			a = objArgs[inIdx]
+		}
+
+		sub, err := cs.argIn[destIdx](env, inIdx, a)
+		if err != nil {
+			return nil, WrapError(env, err)
+		}
+
+		dest[destIdx] = sub
+	}
+
+	for i := cs.arity - 1; i < len(objArgs); i++ {
+		sub, err := cs.varIn(env, i, objArgs[i])
+		if err != nil {
+			return nil, WrapError(env, err)
+		}
+
+		dest = append(dest, sub)
+	}
+
+	return fnVal.Call(dest), nil
+}
+
+// convertRets converts the results of calling the function, returning its
+// error if it has one.
+func (cs *conversionSet) convertRets(env *Env, ret []reflect.Value) (any, error) {
+	if cs.errorPos >= 0 && !ret[cs.errorPos].IsNil() {
+		return nil, WrapError(env, ret[cs.errorPos].Interface().(error))
+	}
+
+	switch cs.values {
+	case 0:
+		return NIL, nil
+	case 1:
+		obj, err := cs.rets[0](ret[0])
+		if err != nil {
+			if ce, ok := err.(OutConvError); ok {
+				err = env.NewError(string(ce))
 			}
 
-			switch cs.values {
-			case 0:
-				return NIL, nil
-			case 1:
-				obj, err := cs.rets[0](ret[0])
-				if err != nil {
-					if ce, ok := err.(OutConvError); ok {
-						err = env.NewError(string(ce))
-					}
+			return nil, WrapError(env, err)
+		}
+		return obj, nil
+	default:
+		var objects []any
 
-					return nil, WrapError(env, err)
-				}
-				return obj, nil
-			default:
-				var objects []any
-
-				for i, rv := range ret {
-					if i == cs.errorPos {
-						continue
-					}
-
-					v, err := cs.rets[i](rv)
-					if err == nil {
-						objects = append(objects, v)
-					}
-				}
+		for i, rv := range ret {
+			if i == cs.errorPos {
+				continue
+			}
 
-				return NewListFrom(objects...), nil
+			v, err := cs.rets[i](rv)
+			if err == nil {
+				objects = append(objects, v)
 			}
-		}))
+		}
 
+		return NewListFrom(objects...), nil
 	}
 }
 
@@ -322,6 +392,10 @@ func (c *ConvRegistry) buildCS(t reflect.Type) *conversionSet {
 		values:   valueReturns,
 	}
 
+	if t.IsVariadic() {
+		cs.varIn, _ = c.convArg(t.In(t.NumIn() - 1).Elem())
+	}
+
 	c.cacheCS[t] = cs
 
 	return cs
@@ -658,6 +732,18 @@ func convertReflectValueIn(env *Env, index int, o any, at reflect.Type) (reflect
 		}
 	}
 
+	// Allow sequences to be passed for slices, such as variadic arguments.
+	if at.Kind() == reflect.Slice {
+		if _, ok := o.(Seqable); ok {
+			rv := reflect.New(at).Elem()
+			if err := dataToValue(env, nil, rv, o); err != nil {
+				return reflect.Value{}, err
+			}
+
+			return rv, nil
+		}
+	}
+
 	return reflect.Value{}, env.NewArgTypeError(index, o, at.Name())
 }
 
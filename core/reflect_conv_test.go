@@ -0,0 +1,53 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariadicCalls(t *testing.T) {
+	e, err := NewEnv()
+	require.NoError(t, err)
+
+	count, _, err := convReg.ConverterForFunc(reflect.ValueOf(func(args ...any) int {
+		return len(args)
+	}))
+	require.NoError(t, err)
+
+	join, _, err := convReg.ConverterForFunc(reflect.ValueOf(func(parts ...string) int {
+		return len(parts)
+	}))
+	require.NoError(t, err)
+
+	vec := NewVectorFrom(MakeString("a"), MakeString("b"))
+
+	t.Run("passes a lone seq as one argument to interface variadics", func(t *testing.T) {
+		r := require.New(t)
+
+		res, err := count(e, []any{vec})
+		r.NoError(err)
+		r.Equal(MakeInt(1), res)
+
+		res, err = count(e, []any{vec, vec})
+		r.NoError(err)
+		r.Equal(MakeInt(2), res)
+	})
+
+	t.Run("passes a lone seq as the whole slice to other variadics", func(t *testing.T) {
+		r := require.New(t)
+
+		res, err := join(e, []any{vec})
+		r.NoError(err)
+		r.Equal(MakeInt(2), res)
+
+		res, err = join(e, []any{[]string{"a", "b", "c"}})
+		r.NoError(err)
+		r.Equal(MakeInt(3), res)
+
+		res, err = join(e, []any{MakeString("a")})
+		r.NoError(err)
+		r.Equal(MakeInt(1), res)
+	})
+}
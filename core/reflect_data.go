@@ -0,0 +1,618 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// dataField describes how a single struct field maps to a key in a lace map.
+type dataField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// dataFields returns the fields of the struct type t, named according to
+// their lace, json, or yaml tags. Untagged fields use the kebab-case form
+// of their Go name. Untagged embedded structs have their fields promoted.
+func dataFields(t reflect.Type) []dataField {
+	var fields []dataField
+
+	for i := 0; i < t.NumField(); i++ {
+		tf := t.Field(i)
+
+		var (
+			name string
+			opts []string
+		)
+
+		for _, key := range []string{"lace", "json", "yaml"} {
+			tag, ok := tf.Tag.Lookup(key)
+			if !ok {
+				continue
+			}
+
+			parts := strings.Split(tag, ",")
+			name, opts = parts[0], parts[1:]
+			break
+		}
+
+		if name == "-" && len(opts) == 0 {
+			continue
+		}
+
+		if tf.Anonymous && name == "" {
+			ft := tf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				for _, sub := range dataFields(ft) {
+					sub.index = append([]int{i}, sub.index...)
+					fields = append(fields, sub)
+				}
+				continue
+			}
+		}
+
+		if !tf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = pkgreflect.KebabName(tf.Name)
+		}
+
+		df := dataField{
+			name:  name,
+			index: []int{i},
+		}
+
+		for _, o := range opts {
+			if o == "omitempty" || o == "omitzero" {
+				df.omitEmpty = true
+			}
+		}
+
+		fields = append(fields, df)
+	}
+
+	return fields
+}
+
+func dataPathError(env *Env, path []any, msg string, args ...any) error {
+	return SError(env, "ConversionError",
+		fmt.Sprintf(msg, args...),
+		"path", NewVectorFrom(path...),
+	)
+}
+
+func withPath(path []any, key any) []any {
+	return append(path[:len(path):len(path)], key)
+}
+
+func dataKeyName(key any) (string, bool) {
+	switch sv := key.(type) {
+	case Keyword:
+		return sv.Name(), true
+	case String:
+		return sv.S(), true
+	case Symbol:
+		return sv.Name(), true
+	default:
+		return "", false
+	}
+}
+
+// dataVisit identifies a pointer, map, or slice that valueToData is
+// currently descending into.
+type dataVisit struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+	len int
+}
+
+// valueToData recursively converts a Go value into lace data. Structs become
+// maps with keyword keys, slices and arrays become vectors. Values that
+// refer back to themselves are reported as an error.
+func valueToData(env *Env, path []any, rv reflect.Value) (any, error) {
+	return valueToDataSeen(env, map[dataVisit]bool{}, path, rv)
+}
+
+func valueToDataSeen(env *Env, seen map[dataVisit]bool, path []any, rv reflect.Value) (any, error) {
+	if !rv.IsValid() {
+		return NIL, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			break
+		}
+
+		v := dataVisit{ptr: rv.UnsafePointer(), typ: rv.Type()}
+		if rv.Kind() == reflect.Slice {
+			v.len = rv.Len()
+		}
+
+		if seen[v] {
+			return nil, dataPathError(env, path, "cycle through %s", rv.Type())
+		}
+
+		seen[v] = true
+		defer delete(seen, v)
+	}
+
+	switch rv.Type() {
+	case timeType:
+		return MakeTime(rv.Interface().(time.Time)), nil
+	case durationType:
+		return MakeString(rv.Interface().(time.Duration).String()), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return NIL, nil
+		}
+		return valueToDataSeen(env, seen, path, rv.Elem())
+	case reflect.Bool:
+		return MakeBoolean(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return MakeInt(int(rv.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt {
+			return MakeBigIntFrom(new(big.Int).SetUint64(u)), nil
+		}
+		return MakeInt(int(u)), nil
+	case reflect.Float32, reflect.Float64:
+		return MakeDouble(rv.Float()), nil
+	case reflect.String:
+		return MakeString(rv.String()), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice {
+			if rv.IsNil() {
+				return NIL, nil
+			}
+
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return MakeString(string(rv.Bytes())), nil
+			}
+		}
+
+		objs := make([]any, 0, rv.Len())
+
+		for i := 0; i < rv.Len(); i++ {
+			o, err := valueToDataSeen(env, seen, withPath(path, MakeInt(i)), rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			objs = append(objs, o)
+		}
+
+		return NewVectorFrom(objs...), nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return NIL, nil
+		}
+
+		m := EmptyArrayMap()
+
+		iter := rv.MapRange()
+		for iter.Next() {
+			k, err := valueToDataSeen(env, seen, path, iter.Key())
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := valueToDataSeen(env, seen, withPath(path, k), iter.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			m.Set(env, k, v)
+		}
+
+		return m, nil
+	case reflect.Struct:
+		m := EmptyArrayMap()
+
+		for _, f := range dataFields(rv.Type()) {
+			fv, err := rv.FieldByIndexErr(f.index)
+			if err != nil {
+				// A nil embedded pointer, nothing to report.
+				continue
+			}
+
+			if f.omitEmpty && fv.IsZero() {
+				continue
+			}
+
+			key := MakeKeyword(f.name)
+
+			v, err := valueToDataSeen(env, seen, withPath(path, key), fv)
+			if err != nil {
+				return nil, err
+			}
+
+			m.Set(env, key, v)
+		}
+
+		return m, nil
+	default:
+		return rv.Interface(), nil
+	}
+}
+
+// dataToValue recursively populates rv, which must be settable, from the
+// lace data obj. Errors report the path within obj that failed to convert.
+func dataToValue(env *Env, path []any, rv reflect.Value, obj any) error {
+	if obj == nil || obj == NIL {
+		rv.SetZero()
+		return nil
+	}
+
+	if ov := reflect.ValueOf(obj); ov.Type().AssignableTo(rv.Type()) && rv.Kind() != reflect.Interface {
+		rv.Set(ov)
+		return nil
+	}
+
+	switch rv.Type() {
+	case timeType:
+		switch sv := obj.(type) {
+		case Time:
+			rv.Set(reflect.ValueOf(sv.T))
+			return nil
+		case String:
+			t, err := time.Parse(time.RFC3339Nano, sv.S())
+			if err != nil {
+				return dataPathError(env, path, "invalid time %q: %s", sv.S(), err)
+			}
+			rv.Set(reflect.ValueOf(t))
+			return nil
+		default:
+			return dataPathError(env, path, "expected Time or String, got %s", TypeName(obj))
+		}
+	case durationType:
+		switch sv := obj.(type) {
+		case String:
+			d, err := time.ParseDuration(sv.S())
+			if err != nil {
+				return dataPathError(env, path, "invalid duration %q: %s", sv.S(), err)
+			}
+			rv.SetInt(int64(d))
+			return nil
+		case Number:
+			rv.SetInt(int64(sv.Int().I()))
+			return nil
+		default:
+			return dataPathError(env, path, "expected String or Number, got %s", TypeName(obj))
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		ptr := reflect.New(rv.Type().Elem())
+		if err := dataToValue(env, path, ptr.Elem(), obj); err != nil {
+			return err
+		}
+		rv.Set(ptr)
+		return nil
+	case reflect.Interface:
+		v, err := toAny(env, obj)
+		if err != nil {
+			return err
+		}
+
+		vv := reflect.ValueOf(v)
+		if !vv.Type().AssignableTo(rv.Type()) {
+			return dataPathError(env, path, "%s does not implement %s", TypeName(obj), rv.Type())
+		}
+
+		rv.Set(vv)
+		return nil
+	case reflect.Bool:
+		b, ok := obj.(Boolean)
+		if !ok {
+			return dataPathError(env, path, "expected Boolean, got %s", TypeName(obj))
+		}
+		rv.SetBool(bool(b))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := obj.(Number)
+		if !ok {
+			return dataPathError(env, path, "expected Number, got %s", TypeName(obj))
+		}
+		i := int64(n.Int().I())
+		if rv.OverflowInt(i) {
+			return dataPathError(env, path, "value %d overflows %s", i, rv.Type())
+		}
+		rv.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, ok := obj.(Number)
+		if !ok {
+			return dataPathError(env, path, "expected Number, got %s", TypeName(obj))
+		}
+		i := n.BigInt()
+		if !i.IsUint64() || rv.OverflowUint(i.Uint64()) {
+			return dataPathError(env, path, "value %s overflows %s", i, rv.Type())
+		}
+		rv.SetUint(i.Uint64())
+		return nil
+	case reflect.Float32, reflect.Float64:
+		n, ok := obj.(Number)
+		if !ok {
+			return dataPathError(env, path, "expected Number, got %s", TypeName(obj))
+		}
+		rv.SetFloat(n.Double().D)
+		return nil
+	case reflect.String:
+		s, ok := dataKeyName(obj)
+		if !ok {
+			return dataPathError(env, path, "expected String, got %s", TypeName(obj))
+		}
+		rv.SetString(s)
+		return nil
+	case reflect.Func:
+		call, ok := obj.(Callable)
+		if !ok {
+			return dataPathError(env, path, "expected Callable, got %s", TypeName(obj))
+		}
+		rv.Set(convReg.makeFuncConvertIn(env, call, rv.Type()))
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			if s, ok := obj.(String); ok {
+				rv.SetBytes([]byte(s.S()))
+				return nil
+			}
+		}
+
+		return dataToSeq(env, path, rv, obj)
+	case reflect.Array:
+		return dataToSeq(env, path, rv, obj)
+	case reflect.Map:
+		m, ok := obj.(Map)
+		if !ok {
+			return dataPathError(env, path, "expected Map, got %s", TypeName(obj))
+		}
+
+		out := reflect.MakeMapWithSize(rv.Type(), m.Count())
+
+		iter := m.Iter()
+		for iter.HasNext() {
+			p := iter.Next()
+
+			kv := reflect.New(rv.Type().Key()).Elem()
+			if err := dataToValue(env, path, kv, p.Key); err != nil {
+				return err
+			}
+
+			vv := reflect.New(rv.Type().Elem()).Elem()
+			if err := dataToValue(env, withPath(path, p.Key), vv, p.Value); err != nil {
+				return err
+			}
+
+			out.SetMapIndex(kv, vv)
+		}
+
+		rv.Set(out)
+		return nil
+	case reflect.Struct:
+		m, ok := obj.(Map)
+		if !ok {
+			return dataPathError(env, path, "expected Map, got %s", TypeName(obj))
+		}
+
+		fields := dataFields(rv.Type())
+
+		iter := m.Iter()
+		for iter.HasNext() {
+			p := iter.Next()
+
+			name, ok := dataKeyName(p.Key)
+			if !ok {
+				return dataPathError(env, withPath(path, p.Key), "invalid key type %s", TypeName(p.Key))
+			}
+
+			var field *dataField
+
+			for i := range fields {
+				if fields[i].name == name {
+					field = &fields[i]
+					break
+				}
+			}
+
+			if field == nil {
+				sf, ok := rv.Type().FieldByName(name)
+				if !ok || !sf.IsExported() {
+					return dataPathError(env, withPath(path, p.Key), "unknown field %s in %s", name, rv.Type())
+				}
+
+				field = &dataField{name: name, index: sf.Index}
+			}
+
+			fv, err := rv.FieldByIndexErr(field.index)
+			if err != nil {
+				fv = rv
+				for _, i := range field.index {
+					if fv.Kind() == reflect.Pointer {
+						if fv.IsNil() {
+							fv.Set(reflect.New(fv.Type().Elem()))
+						}
+						fv = fv.Elem()
+					}
+					fv = fv.Field(i)
+				}
+			}
+
+			if err := dataToValue(env, withPath(path, p.Key), fv, p.Value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return dataPathError(env, path, "unable to convert %s to %s", TypeName(obj), rv.Type())
+	}
+}
+
+func dataToSeq(env *Env, path []any, rv reflect.Value, obj any) error {
+	sq, ok := obj.(Seqable)
+	if !ok {
+		return dataPathError(env, path, "expected a sequence, got %s", TypeName(obj))
+	}
+
+	var elems []any
+
+	i := iter(sq.Seq())
+	for i.HasNext(env) {
+		o, err := i.Next(env)
+		if err != nil {
+			return err
+		}
+
+		elems = append(elems, o)
+	}
+
+	out := rv
+
+	if rv.Kind() == reflect.Slice {
+		out = reflect.MakeSlice(rv.Type(), len(elems), len(elems))
+	} else if len(elems) > rv.Len() {
+		return dataPathError(env, path, "%d elements do not fit in %s", len(elems), rv.Type())
+	}
+
+	for idx, elem := range elems {
+		if err := dataToValue(env, withPath(path, MakeInt(idx)), out.Index(idx), elem); err != nil {
+			return err
+		}
+	}
+
+	if rv.Kind() == reflect.Slice {
+		rv.Set(out)
+	}
+
+	return nil
+}
+
+// dataToStruct creates a new value of type rt populated from m, descending
+// into nested structs, pointers, slices and maps. Returns a pointer to the
+// new value.
+func dataToStruct(env *Env, t reflect.Type, m Map) (any, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, env.NewError("type is not a struct")
+	}
+
+	ret := reflect.New(t)
+
+	if err := dataToValue(env, nil, ret.Elem(), m); err != nil {
+		return nil, err
+	}
+
+	return ret.Interface(), nil
+}
+
+// dataFromValue converts a Go value into plain lace data.
+func dataFromValue(env *Env, obj any) (any, error) {
+	rv, ok := obj.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(obj)
+	}
+
+	return valueToData(env, nil, rv)
+}
+
+// DataFromValue converts a Go value into plain lace data, as go/->data
+// does.
+func DataFromValue(env *Env, obj any) (any, error) {
+	return dataFromValue(env, obj)
+}
+
+// DataToStruct returns a pointer to a new value of the struct type t
+// populated from m, as go/->struct does, except that keys which don't name
+// a field of t are skipped. Data written for another version of t, with
+// fields added or removed, still converts.
+func DataToStruct(env *Env, t reflect.Type, m Map) (any, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, env.NewError("type is not a struct")
+	}
+
+	fields := dataFields(t)
+
+	known := func(name string) bool {
+		for _, f := range fields {
+			if f.name == name {
+				return true
+			}
+		}
+
+		sf, ok := t.FieldByName(name)
+		return ok && sf.IsExported()
+	}
+
+	iter := m.Iter()
+	for iter.HasNext() {
+		p := iter.Next()
+
+		if name, ok := dataKeyName(p.Key); ok && known(name) {
+			continue
+		}
+
+		var err error
+
+		m, err = m.Without(env, p.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dataToStruct(env, t, m)
+}
+
+func setupGoDataNS(env *Env) *Namespace {
+	b := NewNSBuilder(env, "go")
+
+	b.NSMeta("Conversion between Go values and lace data.", "1.0")
+
+	b.Defn(&DefnInfo{
+		Name:  "->struct",
+		Doc:   "Create a value of the Go struct type, populated recursively from the map. Keys are matched using the lace, json, and yaml struct tags, or the kebab-case form of the field name.",
+		Added: "1.0",
+		Fn:    dataToStruct,
+	})
+
+	b.Defn(&DefnInfo{
+		Name:  "->data",
+		Doc:   "Convert a Go value recursively to lace data. Structs become maps with keyword keys named by their struct tags.",
+		Added: "1.0",
+		Fn:    dataFromValue,
+	})
+
+	return b.ns
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testContainer struct {
+	Name    string        `json:"name"`
+	Port    int           `yaml:"port"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+	Env     map[string]string
+}
+
+type testSpec struct {
+	HTTPServer string `lace:"http-server"`
+	Containers []*testContainer
+	Created    time.Time
+	Skip       string `json:"-"`
+}
+
+type testDeployment struct {
+	Spec testSpec `json:"spec"`
+}
+
+type testNode struct {
+	Name string
+	Next *testNode
+}
+
+func TestDataConversion(t *testing.T) {
+	e, err := NewEnv()
+	require.NoError(t, err)
+
+	typ := reflect.TypeFor[testDeployment]()
+
+	t.Run("round trips nested values", func(t *testing.T) {
+		r := require.New(t)
+
+		m, err := e.Eval(`{:spec {:http-server "web"
+		                          :created "2024-05-01T10:00:00Z"
+		                          :containers [{:name "a" :port 80 :timeout "1m30s" :env {"X" "1"}}]}}`)
+		r.NoError(err)
+
+		v, err := dataToStruct(e, typ, m.(Map))
+		r.NoError(err)
+
+		d := v.(*testDeployment)
+		r.Equal("web", d.Spec.HTTPServer)
+		r.Len(d.Spec.Containers, 1)
+		r.Equal(80, d.Spec.Containers[0].Port)
+		r.Equal(90*time.Second, d.Spec.Containers[0].Timeout)
+		r.Equal("1", d.Spec.Containers[0].Env["X"])
+		r.Equal(2024, d.Spec.Created.Year())
+
+		back, err := dataFromValue(e, d)
+		r.NoError(err)
+
+		port, err := e.Eval(`(fn [m] (get-in m [:spec :containers 0 :port]))`)
+		r.NoError(err)
+
+		res, err := port.(Callable).Call(e, []any{back})
+		r.NoError(err)
+		r.True(Equals(e, MakeInt(80), res))
+	})
+
+	t.Run("omits empty and skipped fields", func(t *testing.T) {
+		r := require.New(t)
+
+		v, err := dataFromValue(e, &testSpec{HTTPServer: "a", Skip: "x"})
+		r.NoError(err)
+
+		m := v.(Map)
+		ok, _ := m.GetEqu(MakeKeyword("skip"))
+		r.False(ok)
+		ok, _ = m.GetEqu(MakeKeyword("http-server"))
+		r.True(ok)
+
+		v, err = dataFromValue(e, &testContainer{Name: "a"})
+		r.NoError(err)
+
+		ok, _ = v.(Map).GetEqu(MakeKeyword("timeout"))
+		r.False(ok)
+	})
+
+	t.Run("reports the path of a failure", func(t *testing.T) {
+		r := require.New(t)
+
+		m, err := e.Eval(`{:spec {:containers [{:name "a" :port "eighty"}]}}`)
+		r.NoError(err)
+
+		_, err = dataToStruct(e, typ, m.(Map))
+		r.Error(err)
+
+		ee, ok := err.(*EvalError)
+		r.True(ok)
+
+		ok, path, err := ee.Map.Get(e, MakeString("path"))
+		r.NoError(err)
+		r.True(ok)
+
+		expected, err := e.Eval(`[:spec :containers 0 :port]`)
+		r.NoError(err)
+
+		r.True(Equals(e, expected, path))
+	})
+
+	t.Run("reports values that refer to themselves", func(t *testing.T) {
+		r := require.New(t)
+
+		n := &testNode{Name: "a"}
+		n.Next = n
+
+		_, err := dataFromValue(e, n)
+		r.ErrorContains(err, "cycle")
+
+		s := []any{1, nil}
+		s[1] = s
+
+		_, err = dataFromValue(e, s)
+		r.ErrorContains(err, "cycle")
+
+		m := map[string]any{}
+		m["self"] = m
+
+		_, err = dataFromValue(e, m)
+		r.ErrorContains(err, "cycle")
+
+		shared := &testNode{Name: "b"}
+
+		_, err = dataFromValue(e, []*testNode{shared, shared})
+		r.NoError(err)
+	})
+
+	t.Run("keeps large unsigned values intact", func(t *testing.T) {
+		r := require.New(t)
+
+		var u uint64 = math.MaxUint64
+
+		v, err := dataFromValue(e, u)
+		r.NoError(err)
+
+		bi, ok := v.(*BigInt)
+		r.True(ok)
+		r.Equal("18446744073709551615", bi.BigInt().String())
+
+		var out uint64
+		r.NoError(dataToValue(e, nil, reflect.ValueOf(&out).Elem(), v))
+		r.Equal(u, out)
+	})
+
+	t.Run("converts nested values through go/to-map and go/from-map", func(t *testing.T) {
+		r := require.New(t)
+
+		m, err := e.Eval(`{:spec {:http-server "web" :containers [{:name "a" :port 80}]}}`)
+		r.NoError(err)
+
+		v, err := structFromMap(e, &Type{rType: typ}, m.(Map))
+		r.NoError(err)
+
+		d := v.(*testDeployment)
+		r.Equal("web", d.Spec.HTTPServer)
+		r.Equal(80, d.Spec.Containers[0].Port)
+
+		back, err := structToMap(e, reflect.ValueOf(d))
+		r.NoError(err)
+
+		port, err := e.Eval(`(fn [m] (get-in m [:spec :containers 0 :port]))`)
+		r.NoError(err)
+
+		res, err := port.(Callable).Call(e, []any{back})
+		r.NoError(err)
+		r.True(Equals(e, MakeInt(80), res))
+	})
+}
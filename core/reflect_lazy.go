@@ -0,0 +1,223 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+)
+
+// PkgLoadStat records the cost of populating the namespace for a single
+// reflected Go package.
+type PkgLoadStat struct {
+	Package   string        `lace:"package"`
+	Namespace string        `lace:"namespace"`
+	Loaded    bool          `lace:"loaded?"`
+	Vars      int           `lace:"vars"`
+	Duration  time.Duration `lace:"duration"`
+}
+
+// pkgReflectState tracks the reflected packages registered in an Env, which
+// of them have been populated, and the methods known for their types.
+type pkgReflectState struct {
+	mu      sync.Mutex
+	setup   time.Duration
+	stats   map[string]*PkgLoadStat
+	byPath  map[string]*Namespace
+	all     []*Namespace
+	methods map[reflect.Type]reifiedType
+}
+
+func newPkgReflectState() *pkgReflectState {
+	return &pkgReflectState{
+		stats:   map[string]*PkgLoadStat{},
+		byPath:  map[string]*Namespace{},
+		methods: map[reflect.Type]reifiedType{},
+	}
+}
+
+func (s *pkgReflectState) addMethods(t reflect.Type, rt reifiedType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.methods[t] = rt
+}
+
+// methodsFor returns the reified methods of t, populating the namespace of
+// the package that defines t if it hasn't been yet.
+func (s *pkgReflectState) methodsFor(env *Env, t reflect.Type) (reifiedType, bool) {
+	s.mu.Lock()
+	rt, ok := s.methods[t]
+	ns := s.byPath[t.PkgPath()]
+	s.mu.Unlock()
+
+	if ok || ns == nil || ns.Lazy == nil {
+		return rt, ok
+	}
+
+	ns.MaybeLazy(env, "methods")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, ok = s.methods[t]
+	return rt, ok
+}
+
+// packageNamespace returns the namespace of the reflected Go package known
+// by name, either its import path with dots for slashes or, as Go code
+// refers to it, the last element of the path. It returns nil if no package
+// or more than one has that name.
+func (s *pkgReflectState) packageNamespace(name string) *Namespace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pkgPath := strings.ReplaceAll(name, ".", "/")
+
+	var found *Namespace
+
+	for p, ns := range s.byPath {
+		if p != pkgPath && path.Base(p) != name {
+			continue
+		}
+
+		if found != nil && found != ns {
+			return nil
+		}
+
+		found = ns
+	}
+
+	return found
+}
+
+// loadAll populates every registered package namespace.
+func (s *pkgReflectState) loadAll(env *Env) {
+	s.mu.Lock()
+	all := slices.Clone(s.all)
+	s.mu.Unlock()
+
+	for _, ns := range all {
+		ns.MaybeLazy(env, "load-all")
+	}
+}
+
+// PkgReflectReport returns the time spent registering reflected packages
+// and the cost of each package, most expensive first.
+func (env *Env) PkgReflectReport() (time.Duration, []PkgLoadStat) {
+	s := env.pkgReflect
+	if s == nil {
+		return 0, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stats []PkgLoadStat
+	for _, st := range s.stats {
+		stats = append(stats, *st)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Duration != stats[j].Duration {
+			return stats[i].Duration > stats[j].Duration
+		}
+
+		return stats[i].Package < stats[j].Package
+	})
+
+	return s.setup, stats
+}
+
+// WritePkgReflectReport writes a human readable version of PkgReflectReport.
+func (env *Env) WritePkgReflectReport(w io.Writer) {
+	setup, stats := env.PkgReflectReport()
+
+	var (
+		total  time.Duration
+		loaded int
+	)
+
+	for _, st := range stats {
+		total += st.Duration
+		if st.Loaded {
+			loaded++
+		}
+	}
+
+	fmt.Fprintf(w, "reflected packages: %d registered in %s, %d loaded in %s\n",
+		len(stats), setup, loaded, total)
+
+	for _, st := range stats {
+		if !st.Loaded {
+			continue
+		}
+
+		fmt.Fprintf(w, "  %-30s %5d vars %12s\n", st.Namespace, st.Vars, st.Duration)
+	}
+}
+
+func pkgLoadReport(env *Env) (any, error) {
+	_, stats := env.PkgReflectReport()
+
+	return valueToData(env, nil, reflect.ValueOf(stats))
+}
+
+// setupPkgWrapper registers the idiomatic lace namespace generated for a
+// package. Its source is only evaluated once the namespace is referenced.
+// Unlike the binding namespaces it's not returned to be set up by the Env,
+// since that would refer all of lace.core into it.
+func setupPkgWrapper(env *Env, name string, pkg *pkgreflect.Package, state *pkgReflectState) {
+	ns, err := env.ProtoNamespace(MakeSymbol(pkg.WrapperNS))
+	if err != nil {
+		panic(err)
+	}
+
+	stat := &PkgLoadStat{
+		Package:   name,
+		Namespace: pkg.WrapperNS,
+	}
+
+	state.stats[pkg.WrapperNS] = stat
+	state.all = append(state.all, ns)
+
+	ns.Lazy = func(env *Env, ns *Namespace) {
+		t := time.Now()
+
+		cur := env.CurrentNamespace()
+		defer env.SetCurrentNamespace(cur)
+
+		// The source starts with its own ns form, which refers lace.core
+		// minus the names it redefines.
+		env.SetCurrentNamespace(env.CoreNamespace)
+
+		reader := NewReader(bufio.NewReader(strings.NewReader(pkg.Wrapper)), pkg.WrapperNS+".clj")
+
+		err := ProcessReaderFromEval(env, reader, "")
+		if err != nil {
+			panic(WrapError(env, err))
+		}
+
+		vars := 0
+		for _, v := range ns.Mappings() {
+			if v.ns == ns {
+				vars++
+			}
+		}
+
+		state.mu.Lock()
+		defer state.mu.Unlock()
+
+		stat.Vars = vars
+		stat.Duration = time.Since(t)
+		stat.Loaded = true
+	}
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	pkgreflect.AddPackage("lace/lazytest", &pkgreflect.Package{
+		Name: "lazytest",
+		Doc:  "Package used to test lazy loading.",
+		Functions: map[string]pkgreflect.FuncValue{
+			"Upper": {
+				Doc:   "Upper cases a string.",
+				Args:  []pkgreflect.Arg{{Name: "s"}},
+				Value: reflect.ValueOf(strings.ToUpper),
+			},
+		},
+	})
+}
+
+func TestPkgReflectLazy(t *testing.T) {
+	r := require.New(t)
+
+	e, err := NewEnv()
+	r.NoError(err)
+
+	loaded := func() bool {
+		_, stats := e.PkgReflectReport()
+		for _, st := range stats {
+			if st.Package == "lace/lazytest" {
+				return st.Loaded
+			}
+		}
+
+		t.Fatal("package not registered")
+		return false
+	}
+
+	r.False(loaded())
+
+	obj, err := e.Eval(`(lace.lazytest/Upper "abc")`)
+	r.NoError(err)
+
+	r.True(Equals(e, MakeString("ABC"), obj))
+	r.True(loaded())
+}
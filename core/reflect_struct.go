@@ -12,6 +12,11 @@ type StructMap struct {
 
 var _ Map = StructMap{}
 
+// Value returns the struct the map reads and writes.
+func (r StructMap) Value() reflect.Value {
+	return r.val
+}
+
 func (r StructMap) Clone() StructMap {
 	val := reflect.Indirect(r.val)
 	t := val.Type()
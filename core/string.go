@@ -48,7 +48,7 @@ func MakeString(s string) String {
 func (s GoString) Equals(env *Env, other interface{}) bool {
 	switch other := other.(type) {
 	case String:
-		return s == other
+		return s.S() == other.S()
 	default:
 		return false
 	}
@@ -157,7 +157,7 @@ func (s *Rope) ToString(env *Env, escape bool) (string, error) {
 func (s *Rope) Equals(env *Env, other interface{}) bool {
 	switch other := other.(type) {
 	case String:
-		return s == other
+		return s.S() == other.S()
 	default:
 		return false
 	}
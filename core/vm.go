@@ -81,6 +81,10 @@ func (v *FnData) String() string {
 
 type CheckTypeData struct {
 	Type Type
+
+	// When set, the check matches Go errors held by this var using
+	// errors.Is rather than checking Type.
+	Sentinel *Var
 }
 
 func (v *CheckTypeData) insData() {}
@@ -744,7 +748,8 @@ func (c *Compiler) Process(env *Env, expr Expr) error {
 			c.insn(Instruction{
 				Op: CheckType,
 				Data: &CheckTypeData{
-					Type: ce.excType,
+					Type:     ce.excType,
+					Sentinel: ce.excSentinel,
 				},
 			})
 
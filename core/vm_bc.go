@@ -92,7 +92,11 @@ func (e *BytecodeEncoder) Encode(i *Instruction) error {
 			a = e.addDefVar(d.vr)
 		case CheckType:
 			d := i.Data.(*CheckTypeData)
-			a = e.addLiteral(d.Type)
+			if d.Sentinel != nil {
+				a = e.addLiteral(d.Sentinel)
+			} else {
+				a = e.addLiteral(d.Type)
+			}
 		case MakeFn:
 			d := i.Data.(*FnData)
 			a = e.addCode(d.Code)
@@ -1005,9 +1009,17 @@ loop:
 		case CheckType:
 			obj := frame.stackPop()
 
-			if typ, ok := c.literals[a].(Type); ok {
-				frame.stackPush(MakeBoolean(IsInstance(env, typ, obj)))
-			} else {
+			switch target := c.literals[a].(type) {
+			case Type, *Var:
+				bound, ok := catchMatches(env, target, obj)
+				if ok {
+					// The match may have been found further down the error
+					// chain, in which case that error is what the catch binds.
+					frame.stackPop()
+					frame.stackPush(bound)
+				}
+				frame.stackPush(MakeBoolean(ok))
+			default:
 				panic("nope")
 			}
 
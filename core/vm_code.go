@@ -367,12 +367,12 @@ func (lit *CodeLiteral) AsValue(env *Env) (any, error) {
 	case lit.Var != nil:
 		ns := env.FindNamespace(MakeSymbol(lit.Var.Name.Namespace))
 		if ns == nil {
-			return nil, fmt.Errorf("unknown ns")
+			return nil, fmt.Errorf("unable to resolve var %s: no namespace %s", lit.Var.Name.Symbol().String(), lit.Var.Name.Namespace)
 		}
 
 		vr := ns.Resolve(lit.Var.Name.Name)
 		if vr == nil {
-			return nil, fmt.Errorf("missing var 2: %s", lit.Var.Name.Symbol().String())
+			return nil, fmt.Errorf("unable to resolve var: %s", lit.Var.Name.Symbol().String())
 		}
 
 		return vr, nil
@@ -539,6 +539,17 @@ func (c *Code) AsData(env *Env) (*CodeAsData, error) {
 }
 
 func (cad *CodeAsData) AsCode(env *Env) (*Code, error) {
+	return cad.asCode(env, false)
+}
+
+// asCode rebuilds the code. Shipped code comes from another process, so
+// rather than creating the namespaces and vars it names, it may only
+// reference vars that already exist and can't define any.
+func (cad *CodeAsData) asCode(env *Env, shipped bool) (*Code, error) {
+	if shipped && len(cad.DefVarNames) > 0 {
+		return nil, fmt.Errorf("shipped code can't define vars: %s", cad.DefVarNames[0])
+	}
+
 	c := &Code{
 		fnId:         nextFnId.Add(1),
 		numBindings:  cad.NumBindings,
@@ -567,17 +578,24 @@ func (cad *CodeAsData) AsCode(env *Env) (*Code, error) {
 	}
 
 	for _, csym := range cad.VarNames {
-		ns := env.EnsureNamespace(MakeSymbol(csym.Namespace))
-		if ns == nil {
-			panic("bad ns: " + csym.Namespace)
+		var ns *Namespace
+		if shipped {
+			ns = env.FindNamespace(MakeSymbol(csym.Namespace))
+			if ns == nil {
+				return nil, fmt.Errorf("unable to resolve var %s: no namespace %s", csym.Symbol().String(), csym.Namespace)
+			}
+		} else {
+			ns = env.EnsureNamespace(MakeSymbol(csym.Namespace))
 		}
 
+		ns.MaybeLazy(env, "code")
+
 		sym := csym.Symbol()
 		c.data.varNames = append(c.data.varNames, sym)
 
 		vr := ns.Resolve(csym.Name)
 		if vr == nil {
-			return nil, fmt.Errorf("missing var 1: %s", sym.String())
+			return nil, fmt.Errorf("unable to resolve var: %s", sym.String())
 		}
 
 		c.data.vars = append(c.data.vars, vr)
@@ -593,7 +611,7 @@ func (cad *CodeAsData) AsCode(env *Env) (*Code, error) {
 	}
 
 	for _, sub := range cad.Codes {
-		subC, err := sub.AsCode(env)
+		subC, err := sub.asCode(env, shipped)
 		if err != nil {
 			return nil, err
 		}
@@ -637,3 +655,53 @@ func UnmarshalCode(env *Env, data []byte) (*Fn, error) {
 
 	return fn, nil
 }
+
+// FnAsData returns the code of fn as data, along with the values of the
+// upvals it captured, so that it can be rebuilt elsewhere with FnFromData.
+func FnAsData(env *Env, fn *Fn) (*CodeAsData, []any, error) {
+	if fn.code == nil {
+		return nil, nil, fmt.Errorf("function has not been compiled: %s", fn.String())
+	}
+
+	cad, err := fn.code.AsData(env)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upvals := make([]any, len(fn.importedUpvals))
+
+	for i, uv := range fn.importedUpvals {
+		if uv == nil || uv.Value == nil {
+			upvals[i] = NIL
+		} else {
+			upvals[i] = uv.Value
+		}
+	}
+
+	return cad, upvals, nil
+}
+
+// FnFromData rebuilds a function from the data returned by FnAsData. The
+// vars the code references are resolved by name in env, and must already
+// exist; code that defines vars is refused.
+func FnFromData(env *Env, cad *CodeAsData, upvals []any) (*Fn, error) {
+	if len(upvals) != cad.ImportUpvals {
+		return nil, fmt.Errorf("function captures %d upvals, got %d", cad.ImportUpvals, len(upvals))
+	}
+
+	code, err := cad.asCode(env, true)
+	if err != nil {
+		return nil, err
+	}
+
+	fn := &Fn{
+		code:           code,
+		importedUpvals: make([]*NamedPair, len(upvals)),
+	}
+
+	for i, v := range upvals {
+		fn.importedUpvals[i] = &NamedPair{Value: v}
+	}
+
+	return fn, nil
+}
@@ -0,0 +1,33 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.archive.tar
+  "Package tar implements access to tar archives."
+  (:refer-clojure :exclude [file-info-header new-reader new-writer]))
+
+(defn file-info-header
+  "FileInfoHeader creates a partially-populated [Header] from fi.
+If fi describes a symlink, FileInfoHeader records link as the link target.
+If fi describes a directory, a slash is appended to the name.
+
+Since fs.FileInfo's Name method only returns the base name of
+the file it describes, it may be necessary to modify Header.Name
+to provide the full path name of the file.
+
+If fi implements [FileInfoNames]
+Header.Gname and Header.Uname
+are provided by the methods of the interface."
+  {:arglists '([fi link]) :go "archive/tar.FileInfoHeader"}
+  [fi link]
+  (lace.go.archive.tar/FileInfoHeader fi link))
+
+(defn new-reader
+  "NewReader creates a new [Reader] reading from r."
+  {:arglists '([r]) :go "archive/tar.NewReader"}
+  [r]
+  (lace.go.archive.tar/NewReader r))
+
+(defn new-writer
+  "NewWriter creates a new Writer writing to w."
+  {:arglists '([w]) :go "archive/tar.NewWriter"}
+  [w]
+  (lace.go.archive.tar/NewWriter w))
@@ -0,0 +1,78 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	tar "archive/tar"
+)
+
+//go:embed archive_tar.clj
+var wrapper_archive_tar string
+
+func init() {
+	Header_methods := map[string]pkgreflect.Func{}
+	Format_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Writer_methods := map[string]pkgreflect.Func{}
+	Header_methods["FileInfo"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "fs.FileInfo", Doc: "FileInfo returns an fs.FileInfo for the Header."}
+	Format_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Reader_methods["Next"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Next advances to the next entry in the tar archive.\nThe Header.Size determines how many bytes can be read for the next file.\nAny remaining data in the current file is automatically discarded.\nAt the end of the archive, Next returns the error io.EOF.\n\nIf Next encounters a non-local file name (as defined by [filepath.IsLocal])\nand the GODEBUG environment variable contains `tarinsecurepath=0`,\nOnly file names are validated, not link targets.\nNext returns the header with an [ErrInsecurePath] error.\nA future version of Go may introduce this behavior by default.\nPrograms that want to accept non-local names can ignore\nthe [ErrInsecurePath] error and use the returned header."}
+	Reader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "any", Doc: "Read reads from the current file in the tar archive.\nIt returns (0, io.EOF) when it reaches the end of that file,\nuntil [Next] is called to advance to the next file.\n\nIf the current file is sparse, then the regions marked as a hole\nare read back as NUL-bytes.\n\nCalling Read on special types like [TypeLink], [TypeSymlink], [TypeChar],\n[TypeBlock], [TypeDir], and [TypeFifo] returns (0, [io.EOF]) regardless of what\nthe [Header.Size] claims."}
+	Writer_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Flush finishes writing the current file's block padding.\nThe current file must be fully written before Flush can be called.\n\nThis is unnecessary as the next call to [Writer.WriteHeader] or [Writer.Close]\nwill implicitly flush out the file's padding."}
+	Writer_methods["WriteHeader"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "hdr", Tag: "Header"}}, Tag: "error", Doc: "WriteHeader writes hdr and prepares to accept the file's contents.\nThe Header.Size determines how many bytes can be written for the next file.\nIf the current file is not fully written, then this returns an error.\nThis implicitly flushes any padding necessary before writing the header."}
+	Writer_methods["AddFS"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "fsys", Tag: "fs.FS"}}, Tag: "error", Doc: "AddFS adds the files from fs.FS to the archive.\nIt walks the directory tree starting at the root of the filesystem\nadding each file to the tar archive while maintaining the directory structure."}
+	Writer_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "any", Doc: "Write writes to the current file in the tar archive.\nWrite returns the error [ErrWriteTooLong] if more than\nHeader.Size bytes are written after [Writer.WriteHeader].\n\nCalling Write on special types like [TypeLink], [TypeSymlink], [TypeChar],\n[TypeBlock], [TypeDir], and [TypeFifo] returns (0, [ErrWriteTooLong]) regardless\nof what the [Header.Size] claims."}
+	Writer_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close closes the tar archive by flushing the padding, and writing the footer.\nIf the current file (from a prior call to [Writer.WriteHeader]) is not fully written,\nthen this returns an error."}
+	pkgreflect.AddPackage("lace.go.archive.tar", &pkgreflect.Package{
+		Doc:       "Package tar implements access to tar archives.",
+		WrapperNS: "go.archive.tar",
+		Wrapper:   wrapper_archive_tar,
+		Types: map[string]pkgreflect.Type{
+			"Header": {Doc: "A Header represents a single header in a tar archive.\nSome fields may not be populated.\n\nFor forward compatibility, users that retrieve a Header from Reader.Next,\nmutate it in some ways, and then pass it back to Writer.WriteHeader\nshould do so by creating a new Header and copying the fields\nthat they are interested in preserving.", Value: reflect.TypeOf((*tar.Header)(nil)).Elem(), Methods: Header_methods},
+			"Format": {Doc: "Format represents the tar archive format.\n\nThe original tar format was introduced in Unix V7.\nSince then, there have been multiple competing formats attempting to\nstandardize or extend the V7 format to overcome its limitations.\nThe most common formats are the USTAR, PAX, and GNU formats,\neach with their own advantages and limitations.\n\nThe following table captures the capabilities of each format:\n\n\t                  |  USTAR |       PAX |       GNU\n\t------------------+--------+-----------+----------\n\tName              |   256B | unlimited | unlimited\n\tLinkname          |   100B | unlimited | unlimited\n\tSize              | uint33 | unlimited |    uint89\n\tMode              | uint21 |    uint21 |    uint57\n\tUid/Gid           | uint21 | unlimited |    uint57\n\tUname/Gname       |    32B | unlimited |       32B\n\tModTime           | uint33 | unlimited |     int89\n\tAccessTime        |    n/a | unlimited |     int89\n\tChangeTime        |    n/a | unlimited |     int89\n\tDevmajor/Devminor | uint21 |    uint21 |    uint57\n\t------------------+--------+-----------+----------\n\tstring encoding   |  ASCII |     UTF-8 |    binary\n\tsub-second times  |     no |       yes |        no\n\tsparse files      |     no |       yes |       yes\n\nThe table's upper portion shows the [Header] fields, where each format reports\nthe maximum number of bytes allowed for each string field and\nthe integer type used to store each numeric field\n(where timestamps are stored as the number of seconds since the Unix epoch).\n\nThe table's lower portion shows specialized features of each format,\nsuch as supported string encodings, support for sub-second timestamps,\nor support for sparse files.\n\nThe Writer currently provides no support for sparse files.", Value: reflect.TypeOf((*tar.Format)(nil)).Elem(), Methods: Format_methods},
+			"Reader": {Doc: "Reader provides sequential access to the contents of a tar archive.\nReader.Next advances to the next file in the archive (including the first),\nand then Reader can be treated as an io.Reader to access the file's data.", Value: reflect.TypeOf((*tar.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Writer": {Doc: "Writer provides sequential writing of a tar archive.\n[Writer.WriteHeader] begins a new file with the provided [Header],\nand then Writer can be treated as an io.Writer to supply that file's data.", Value: reflect.TypeOf((*tar.Writer)(nil)).Elem(), Methods: Writer_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"FileInfoHeader": {Doc: "FileInfoHeader creates a partially-populated [Header] from fi.\nIf fi describes a symlink, FileInfoHeader records link as the link target.\nIf fi describes a directory, a slash is appended to the name.\n\nSince fs.FileInfo's Name method only returns the base name of\nthe file it describes, it may be necessary to modify Header.Name\nto provide the full path name of the file.\n\nIf fi implements [FileInfoNames]\nHeader.Gname and Header.Uname\nare provided by the methods of the interface.", Args: []pkgreflect.Arg{{Name: "fi", Tag: "fs.FileInfo"}, {Name: "link", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(tar.FileInfoHeader)},
+
+			"NewReader": {Doc: "NewReader creates a new [Reader] reading from r.", Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "Reader", Value: reflect.ValueOf(tar.NewReader)},
+
+			"NewWriter": {Doc: "NewWriter creates a new Writer writing to w.", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "Writer", Value: reflect.ValueOf(tar.NewWriter)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrFieldTooLong":    {Doc: "", Value: reflect.ValueOf(&tar.ErrFieldTooLong)},
+			"ErrHeader":          {Doc: "", Value: reflect.ValueOf(&tar.ErrHeader)},
+			"ErrInsecurePath":    {Doc: "", Value: reflect.ValueOf(&tar.ErrInsecurePath)},
+			"ErrWriteAfterClose": {Doc: "", Value: reflect.ValueOf(&tar.ErrWriteAfterClose)},
+			"ErrWriteTooLong":    {Doc: "", Value: reflect.ValueOf(&tar.ErrWriteTooLong)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"FormatGNU":         {Doc: "FormatGNU represents the GNU header format.\n\nThe GNU header format is older than the USTAR and PAX standards and\nis not compatible with them. The GNU format supports\narbitrary file sizes, filenames of arbitrary encoding and length,\nsparse files, and other features.\n\nIt is recommended that PAX be chosen over GNU unless the target\napplication can only parse GNU formatted archives.\n\nReference:\n\thttps://www.gnu.org/software/tar/manual/html_node/Standard.html", Value: reflect.ValueOf(tar.FormatGNU)},
+			"FormatPAX":         {Doc: "FormatPAX represents the PAX header format defined in POSIX.1-2001.\n\nPAX extends USTAR by writing a special file with Typeflag TypeXHeader\npreceding the original header. This file contains a set of key-value\nrecords, which are used to overcome USTAR's shortcomings, in addition to\nproviding the ability to have sub-second resolution for timestamps.\n\nSome newer formats add their own extensions to PAX by defining their\nown keys and assigning certain semantic meaning to the associated values.\nFor example, sparse file support in PAX is implemented using keys\ndefined by the GNU manual (e.g., \"GNU.sparse.map\").\n\nReference:\n\thttp://pubs.opengroup.org/onlinepubs/009695399/utilities/pax.html", Value: reflect.ValueOf(tar.FormatPAX)},
+			"FormatUSTAR":       {Doc: "FormatUSTAR represents the USTAR header format defined in POSIX.1-1988.\n\nWhile this format is compatible with most tar readers,\nthe format has several limitations making it unsuitable for some usages.\nMost notably, it cannot support sparse files, files larger than 8GiB,\nfilenames larger than 256 characters, and non-ASCII filenames.\n\nReference:\n\thttp://pubs.opengroup.org/onlinepubs/9699919799/utilities/pax.html#tag_20_92_13_06", Value: reflect.ValueOf(tar.FormatUSTAR)},
+			"FormatUnknown":     {Doc: "FormatUnknown indicates that the format is unknown.", Value: reflect.ValueOf(tar.FormatUnknown)},
+			"TypeBlock":         {Doc: "", Value: reflect.ValueOf(tar.TypeBlock)},
+			"TypeChar":          {Doc: "", Value: reflect.ValueOf(tar.TypeChar)},
+			"TypeCont":          {Doc: "Type '7' is reserved.", Value: reflect.ValueOf(tar.TypeCont)},
+			"TypeDir":           {Doc: "", Value: reflect.ValueOf(tar.TypeDir)},
+			"TypeFifo":          {Doc: "", Value: reflect.ValueOf(tar.TypeFifo)},
+			"TypeGNULongLink":   {Doc: "", Value: reflect.ValueOf(tar.TypeGNULongLink)},
+			"TypeGNULongName":   {Doc: "Types 'L' and 'K' are used by the GNU format for a meta file\nused to store the path or link name for the next file.\nThis package transparently handles these types.", Value: reflect.ValueOf(tar.TypeGNULongName)},
+			"TypeGNUSparse":     {Doc: "Type 'S' indicates a sparse file in the GNU format.", Value: reflect.ValueOf(tar.TypeGNUSparse)},
+			"TypeLink":          {Doc: "Type '1' to '6' are header-only flags and may not have a data body.", Value: reflect.ValueOf(tar.TypeLink)},
+			"TypeReg":           {Doc: "Type '0' indicates a regular file.", Value: reflect.ValueOf(tar.TypeReg)},
+			"TypeRegA":          {Doc: "Deprecated: Use TypeReg instead.", Value: reflect.ValueOf(tar.TypeRegA)},
+			"TypeSymlink":       {Doc: "", Value: reflect.ValueOf(tar.TypeSymlink)},
+			"TypeXGlobalHeader": {Doc: "Type 'g' is used by the PAX format to store key-value records that\nare relevant to all subsequent files.\nThis package only supports parsing and composing such headers,\nbut does not currently support persisting the global state across files.", Value: reflect.ValueOf(tar.TypeXGlobalHeader)},
+			"TypeXHeader":       {Doc: "Type 'x' is used by the PAX format to store key-value records that\nare only relevant to the next file.\nThis package transparently handles these types.", Value: reflect.ValueOf(tar.TypeXHeader)},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.archive.zip
+  "Package zip provides support for reading and writing ZIP archives."
+  (:refer-clojure :exclude [file-info-header new-reader new-writer open-reader register-compressor register-decompressor]))
+
+(defn file-info-header
+  "FileInfoHeader creates a partially-populated [FileHeader] from an
+fs.FileInfo.
+Because fs.FileInfo's Name method returns only the base name of
+the file it describes, it may be necessary to modify the Name field
+of the returned header to provide the full path name of the file.
+If compression is desired, callers should set the FileHeader.Method
+field; it is unset by default."
+  {:arglists '([fi]) :go "archive/zip.FileInfoHeader"}
+  [fi]
+  (lace.go.archive.zip/FileInfoHeader fi))
+
+(defn new-reader
+  "NewReader returns a new [Reader] reading from r, which is assumed to
+have the given size in bytes.
+
+If any file inside the archive uses a non-local name
+(as defined by [filepath.IsLocal]) or a name containing backslashes
+and the GODEBUG environment variable contains `zipinsecurepath=0`,
+NewReader returns the reader with an [ErrInsecurePath] error.
+A future version of Go may introduce this behavior by default.
+Programs that want to accept non-local names can ignore
+the [ErrInsecurePath] error and use the returned reader."
+  {:arglists '([r size]) :go "archive/zip.NewReader"}
+  [r size]
+  (lace.go.archive.zip/NewReader r size))
+
+(defn new-writer
+  "NewWriter returns a new [Writer] writing a zip file to w.
+
+Note that the exact bytes written to w are not covered by the Go 1
+compatibility promise. Callers, including tests, should not depend on the
+exact written bytes."
+  {:arglists '([w]) :go "archive/zip.NewWriter"}
+  [w]
+  (lace.go.archive.zip/NewWriter w))
+
+(defn open-reader
+  "OpenReader will open the Zip file specified by name and return a ReadCloser.
+
+If any file inside the archive uses a non-local name
+(as defined by [filepath.IsLocal]) or a name containing backslashes
+and the GODEBUG environment variable contains `zipinsecurepath=0`,
+OpenReader returns the reader with an ErrInsecurePath error.
+A future version of Go may introduce this behavior by default.
+Programs that want to accept non-local names can ignore
+the ErrInsecurePath error and use the returned reader."
+  {:arglists '([name]) :go "archive/zip.OpenReader"}
+  [name]
+  (lace.go.archive.zip/OpenReader name))
+
+(defn register-compressor
+  "RegisterCompressor registers custom compressors for a specified method ID.
+The common methods [Store] and [Deflate] are built in."
+  {:arglists '([method comp]) :go "archive/zip.RegisterCompressor"}
+  [method comp]
+  (lace.go.archive.zip/RegisterCompressor method comp))
+
+(defn register-decompressor
+  "RegisterDecompressor allows custom decompressors for a specified method ID.
+The common methods [Store] and [Deflate] are built in."
+  {:arglists '([method dcomp]) :go "archive/zip.RegisterDecompressor"}
+  [method dcomp]
+  (lace.go.archive.zip/RegisterDecompressor method dcomp))
@@ -0,0 +1,85 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	zip "archive/zip"
+)
+
+//go:embed archive_zip.clj
+var wrapper_archive_zip string
+
+func init() {
+	File_methods := map[string]pkgreflect.Func{}
+	ReadCloser_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Compressor_methods := map[string]pkgreflect.Func{}
+	Decompressor_methods := map[string]pkgreflect.Func{}
+	FileHeader_methods := map[string]pkgreflect.Func{}
+	Writer_methods := map[string]pkgreflect.Func{}
+	Reader_methods["RegisterDecompressor"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "method", Tag: "uint16"}, {Name: "dcomp", Tag: "Decompressor"}}, Tag: "any", Doc: "RegisterDecompressor registers or overrides a custom decompressor for a\nspecific method ID. If a decompressor for a given method is not found,\n[Reader] will default to looking up the decompressor at the package level."}
+	ReadCloser_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close closes the Zip file, rendering it unusable for I/O."}
+	File_methods["DataOffset"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "DataOffset returns the offset of the file's possibly-compressed\ndata, relative to the beginning of the zip file.\n\nMost callers should instead use [File.Open], which transparently\ndecompresses data and verifies checksums."}
+	File_methods["Open"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Open returns a [ReadCloser] that provides access to the [File]'s contents.\nMultiple files may be read concurrently."}
+	File_methods["OpenRaw"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "OpenRaw returns a [Reader] that provides access to the [File]'s contents without\ndecompression."}
+	Reader_methods["Open"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "any", Doc: "Open opens the named file in the ZIP archive,\nusing the semantics of fs.FS.Open:\npaths are always slash separated, with no\nleading / or ../ elements."}
+	FileHeader_methods["FileInfo"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "fs.FileInfo", Doc: "FileInfo returns an fs.FileInfo for the [FileHeader]."}
+	FileHeader_methods["ModTime"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "time.Time", Doc: "ModTime returns the modification time in UTC using the legacy\n[ModifiedDate] and [ModifiedTime] fields.\n\nDeprecated: Use [Modified] instead."}
+	FileHeader_methods["SetModTime"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "t", Tag: "time.Time"}}, Tag: "any", Doc: "SetModTime sets the [Modified], [ModifiedTime], and [ModifiedDate] fields\nto the given time in UTC.\n\nDeprecated: Use [Modified] instead."}
+	FileHeader_methods["Mode"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "fs.FileMode", Doc: "Mode returns the permission and mode bits for the [FileHeader]."}
+	FileHeader_methods["SetMode"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "mode", Tag: "fs.FileMode"}}, Tag: "any", Doc: "SetMode changes the permission and mode bits for the [FileHeader]."}
+	Writer_methods["SetOffset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int64"}}, Tag: "any", Doc: "SetOffset sets the offset of the beginning of the zip data within the\nunderlying writer. It should be used when the zip data is appended to an\nexisting file, such as a binary executable.\nIt must be called before any data is written."}
+	Writer_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Flush flushes any buffered data to the underlying writer.\nCalling Flush is not normally necessary; calling Close is sufficient."}
+	Writer_methods["SetComment"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "comment", Tag: "string"}}, Tag: "error", Doc: "SetComment sets the end-of-central-directory comment field.\nIt can only be called before [Writer.Close]."}
+	Writer_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close finishes writing the zip file by writing the central directory.\nIt does not close the underlying writer."}
+	Writer_methods["Create"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "any", Doc: "Create adds a file to the zip file using the provided name.\nIt returns a [Writer] to which the file contents should be written.\nThe file contents will be compressed using the [Deflate] method.\nThe name must be a relative path: it must not start with a drive\nletter (e.g. C:) or leading slash, and only forward slashes are\nallowed. To create a directory instead of a file, add a trailing\nslash to the name. Duplicate names will not overwrite previous entries\nand are appended to the zip file.\nThe file's contents must be written to the [io.Writer] before the next\ncall to [Writer.Create], [Writer.CreateHeader], or [Writer.Close]."}
+	Writer_methods["CreateHeader"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "fh", Tag: "FileHeader"}}, Tag: "any", Doc: "CreateHeader adds a file to the zip archive using the provided [FileHeader]\nfor the file metadata. [Writer] takes ownership of fh and may mutate\nits fields. The caller must not modify fh after calling [Writer.CreateHeader].\n\nThis returns a [Writer] to which the file contents should be written.\nThe file's contents must be written to the io.Writer before the next\ncall to [Writer.Create], [Writer.CreateHeader], [Writer.CreateRaw], or [Writer.Close]."}
+	Writer_methods["CreateRaw"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "fh", Tag: "FileHeader"}}, Tag: "any", Doc: "CreateRaw adds a file to the zip archive using the provided [FileHeader] and\nreturns a [Writer] to which the file contents should be written. The file's\ncontents must be written to the io.Writer before the next call to [Writer.Create],\n[Writer.CreateHeader], [Writer.CreateRaw], or [Writer.Close].\n\nIn contrast to [Writer.CreateHeader], the bytes passed to Writer are not compressed.\n\nCreateRaw's argument is stored in w. If the argument is a pointer to the embedded\n[FileHeader] in a [File] obtained from a [Reader] created from in-memory data,\nthen w will refer to all of that memory."}
+	Writer_methods["Copy"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "f", Tag: "File"}}, Tag: "error", Doc: "Copy copies the file f (obtained from a [Reader]) into w. It copies the raw\nform directly bypassing decompression, compression, and validation."}
+	Writer_methods["RegisterCompressor"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "method", Tag: "uint16"}, {Name: "comp", Tag: "Compressor"}}, Tag: "any", Doc: "RegisterCompressor registers or overrides a custom compressor for a specific\nmethod ID. If a compressor for a given method is not found, [Writer] will\ndefault to looking up the compressor at the package level."}
+	Writer_methods["AddFS"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "fsys", Tag: "fs.FS"}}, Tag: "error", Doc: "AddFS adds the files from fs.FS to the archive.\nIt walks the directory tree starting at the root of the filesystem\nadding each file to the zip using deflate while maintaining the directory structure."}
+	pkgreflect.AddPackage("lace.go.archive.zip", &pkgreflect.Package{
+		Doc:       "Package zip provides support for reading and writing ZIP archives.",
+		WrapperNS: "go.archive.zip",
+		Wrapper:   wrapper_archive_zip,
+		Types: map[string]pkgreflect.Type{
+			"File":         {Doc: "A File is a single file in a ZIP archive.\nThe file information is in the embedded [FileHeader].\nThe file content can be accessed by calling [File.Open].", Value: reflect.TypeOf((*zip.File)(nil)).Elem(), Methods: File_methods},
+			"ReadCloser":   {Doc: "A ReadCloser is a [Reader] that must be closed when no longer needed.", Value: reflect.TypeOf((*zip.ReadCloser)(nil)).Elem(), Methods: ReadCloser_methods},
+			"Reader":       {Doc: "A Reader serves content from a ZIP archive.", Value: reflect.TypeOf((*zip.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Compressor":   {Doc: "A Compressor returns a new compressing writer, writing to w.\nThe WriteCloser's Close method must be used to flush pending data to w.\nThe Compressor itself must be safe to invoke from multiple goroutines\nsimultaneously, but each returned writer will be used only by\none goroutine at a time.", Value: reflect.TypeOf((*zip.Compressor)(nil)).Elem(), Methods: Compressor_methods},
+			"Decompressor": {Doc: "A Decompressor returns a new decompressing reader, reading from r.\nThe [io.ReadCloser]'s Close method must be used to release associated resources.\nThe Decompressor itself must be safe to invoke from multiple goroutines\nsimultaneously, but each returned reader will be used only by\none goroutine at a time.", Value: reflect.TypeOf((*zip.Decompressor)(nil)).Elem(), Methods: Decompressor_methods},
+			"FileHeader":   {Doc: "FileHeader describes a file within a ZIP file.\nSee the [ZIP specification] for details.\n\n[ZIP specification]: https://support.pkware.com/pkzip/appnote", Value: reflect.TypeOf((*zip.FileHeader)(nil)).Elem(), Methods: FileHeader_methods},
+			"Writer":       {Doc: "Writer implements a zip file writer.", Value: reflect.TypeOf((*zip.Writer)(nil)).Elem(), Methods: Writer_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"FileInfoHeader": {Doc: "FileInfoHeader creates a partially-populated [FileHeader] from an\nfs.FileInfo.\nBecause fs.FileInfo's Name method returns only the base name of\nthe file it describes, it may be necessary to modify the Name field\nof the returned header to provide the full path name of the file.\nIf compression is desired, callers should set the FileHeader.Method\nfield; it is unset by default.", Args: []pkgreflect.Arg{{Name: "fi", Tag: "fs.FileInfo"}}, Tag: "any", Value: reflect.ValueOf(zip.FileInfoHeader)},
+
+			"NewReader": {Doc: "NewReader returns a new [Reader] reading from r, which is assumed to\nhave the given size in bytes.\n\nIf any file inside the archive uses a non-local name\n(as defined by [filepath.IsLocal]) or a name containing backslashes\nand the GODEBUG environment variable contains `zipinsecurepath=0`,\nNewReader returns the reader with an [ErrInsecurePath] error.\nA future version of Go may introduce this behavior by default.\nPrograms that want to accept non-local names can ignore\nthe [ErrInsecurePath] error and use the returned reader.", Args: []pkgreflect.Arg{{Name: "r", Tag: "io.ReaderAt"}, {Name: "size", Tag: "int64"}}, Tag: "any", Value: reflect.ValueOf(zip.NewReader)},
+
+			"NewWriter": {Doc: "NewWriter returns a new [Writer] writing a zip file to w.\n\nNote that the exact bytes written to w are not covered by the Go 1\ncompatibility promise. Callers, including tests, should not depend on the\nexact written bytes.", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "Writer", Value: reflect.ValueOf(zip.NewWriter)},
+
+			"OpenReader": {Doc: "OpenReader will open the Zip file specified by name and return a ReadCloser.\n\nIf any file inside the archive uses a non-local name\n(as defined by [filepath.IsLocal]) or a name containing backslashes\nand the GODEBUG environment variable contains `zipinsecurepath=0`,\nOpenReader returns the reader with an ErrInsecurePath error.\nA future version of Go may introduce this behavior by default.\nPrograms that want to accept non-local names can ignore\nthe ErrInsecurePath error and use the returned reader.", Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(zip.OpenReader)},
+
+			"RegisterCompressor": {Doc: "RegisterCompressor registers custom compressors for a specified method ID.\nThe common methods [Store] and [Deflate] are built in.", Args: []pkgreflect.Arg{{Name: "method", Tag: "uint16"}, {Name: "comp", Tag: "Compressor"}}, Tag: "any", Value: reflect.ValueOf(zip.RegisterCompressor)},
+
+			"RegisterDecompressor": {Doc: "RegisterDecompressor allows custom decompressors for a specified method ID.\nThe common methods [Store] and [Deflate] are built in.", Args: []pkgreflect.Arg{{Name: "method", Tag: "uint16"}, {Name: "dcomp", Tag: "Decompressor"}}, Tag: "any", Value: reflect.ValueOf(zip.RegisterDecompressor)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrAlgorithm":    {Doc: "", Value: reflect.ValueOf(&zip.ErrAlgorithm)},
+			"ErrChecksum":     {Doc: "", Value: reflect.ValueOf(&zip.ErrChecksum)},
+			"ErrFormat":       {Doc: "", Value: reflect.ValueOf(&zip.ErrFormat)},
+			"ErrInsecurePath": {Doc: "", Value: reflect.ValueOf(&zip.ErrInsecurePath)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"Deflate": {Doc: "", Value: reflect.ValueOf(zip.Deflate)},
+			"Store":   {Doc: "", Value: reflect.ValueOf(zip.Store)},
+		},
+	})
+}
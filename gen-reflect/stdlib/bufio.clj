@@ -0,0 +1,85 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.bufio
+  "Package bufio implements buffered I/O. It wraps an io.Reader or io.Writer object, creating another object (Reader or Writer) that also implements the interface but provides buffering and some help for textual I/O."
+  (:refer-clojure :exclude [new-read-writer new-reader new-reader-size new-scanner new-writer new-writer-size scan-bytes scan-lines scan-runes scan-words]))
+
+(defn new-read-writer
+  "NewReadWriter allocates a new [ReadWriter] that dispatches to r and w."
+  {:arglists '([r w]) :go "bufio.NewReadWriter"}
+  [r w]
+  (lace.go.bufio/NewReadWriter r w))
+
+(defn new-reader
+  "NewReader returns a new [Reader] whose buffer has the default size."
+  {:arglists '([rd]) :go "bufio.NewReader"}
+  [rd]
+  (lace.go.bufio/NewReader rd))
+
+(defn new-reader-size
+  "NewReaderSize returns a new [Reader] whose buffer has at least the specified
+size. If the argument io.Reader is already a [Reader] with large enough
+size, it returns the underlying [Reader]."
+  {:arglists '([rd size]) :go "bufio.NewReaderSize"}
+  [rd size]
+  (lace.go.bufio/NewReaderSize rd size))
+
+(defn new-scanner
+  "NewScanner returns a new [Scanner] to read from r.
+The split function defaults to [ScanLines]."
+  {:arglists '([r]) :go "bufio.NewScanner"}
+  [r]
+  (lace.go.bufio/NewScanner r))
+
+(defn new-writer
+  "NewWriter returns a new [Writer] whose buffer has the default size.
+If the argument io.Writer is already a [Writer] with large enough buffer size,
+it returns the underlying [Writer]."
+  {:arglists '([w]) :go "bufio.NewWriter"}
+  [w]
+  (lace.go.bufio/NewWriter w))
+
+(defn new-writer-size
+  "NewWriterSize returns a new [Writer] whose buffer has at least the specified
+size. If the argument io.Writer is already a [Writer] with large enough
+size, it returns the underlying [Writer]."
+  {:arglists '([w size]) :go "bufio.NewWriterSize"}
+  [w size]
+  (lace.go.bufio/NewWriterSize w size))
+
+(defn scan-bytes
+  "ScanBytes is a split function for a [Scanner] that returns each byte as a token."
+  {:arglists '([data at-eof]) :go "bufio.ScanBytes"}
+  [data at-eof]
+  (lace.go.bufio/ScanBytes data at-eof))
+
+(defn scan-lines
+  "ScanLines is a split function for a [Scanner] that returns each line of
+text, stripped of any trailing end-of-line marker. The returned line may
+be empty. The end-of-line marker is one optional carriage return followed
+by one mandatory newline. In regular expression notation, it is `\\r?\\n`.
+The last non-empty line of input will be returned even if it has no
+newline."
+  {:arglists '([data at-eof]) :go "bufio.ScanLines"}
+  [data at-eof]
+  (lace.go.bufio/ScanLines data at-eof))
+
+(defn scan-runes
+  "ScanRunes is a split function for a [Scanner] that returns each
+UTF-8-encoded rune as a token. The sequence of runes returned is
+equivalent to that from a range loop over the input as a string, which
+means that erroneous UTF-8 encodings translate to U+FFFD = \"\\xef\\xbf\\xbd\".
+Because of the Scan interface, this makes it impossible for the client to
+distinguish correctly encoded replacement runes from encoding errors."
+  {:arglists '([data at-eof]) :go "bufio.ScanRunes"}
+  [data at-eof]
+  (lace.go.bufio/ScanRunes data at-eof))
+
+(defn scan-words
+  "ScanWords is a split function for a [Scanner] that returns each
+space-separated word of text, with surrounding spaces deleted. It will
+never return an empty string. The definition of space is set by
+unicode.IsSpace."
+  {:arglists '([data at-eof]) :go "bufio.ScanWords"}
+  [data at-eof]
+  (lace.go.bufio/ScanWords data at-eof))
@@ -0,0 +1,104 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	bufio "bufio"
+)
+
+//go:embed bufio.clj
+var wrapper_bufio string
+
+func init() {
+	ReadWriter_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Writer_methods := map[string]pkgreflect.Func{}
+	Scanner_methods := map[string]pkgreflect.Func{}
+	SplitFunc_methods := map[string]pkgreflect.Func{}
+	Reader_methods["Size"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Size returns the size of the underlying buffer in bytes."}
+	Reader_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "any", Doc: "Reset discards any buffered data, resets all state, and switches\nthe buffered reader to read from r.\nCalling Reset on the zero value of [Reader] initializes the internal buffer\nto the default size.\nCalling b.Reset(b) (that is, resetting a [Reader] to itself) does nothing."}
+	Reader_methods["Peek"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "any", Doc: "Peek returns the next n bytes without advancing the reader. The bytes stop\nbeing valid at the next read call. If necessary, Peek will read more bytes\ninto the buffer in order to make n bytes available. If Peek returns fewer\nthan n bytes, it also returns an error explaining why the read is short.\nThe error is [ErrBufferFull] if n is larger than b's buffer size.\n\nCalling Peek prevents a [Reader.UnreadByte] or [Reader.UnreadRune] call from succeeding\nuntil the next read operation."}
+	Reader_methods["Discard"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "any", Doc: "Discard skips the next n bytes, returning the number of bytes discarded.\n\nIf Discard skips fewer than n bytes, it also returns an error.\nIf 0 <= n <= b.Buffered(), Discard is guaranteed to succeed without\nreading from the underlying io.Reader."}
+	Reader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "p", Tag: "[]byte"}}, Tag: "any", Doc: "Read reads data into p.\nIt returns the number of bytes read into p.\nThe bytes are taken from at most one Read on the underlying [Reader],\nhence n may be less than len(p).\nTo read exactly len(p) bytes, use io.ReadFull(b, p).\nIf the underlying [Reader] can return a non-zero count with io.EOF,\nthen this Read method can do so as well; see the [io.Reader] docs."}
+	Reader_methods["ReadByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadByte reads and returns a single byte.\nIf no byte is available, returns an error."}
+	Reader_methods["UnreadByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "UnreadByte unreads the last byte. Only the most recently read byte can be unread.\n\nUnreadByte returns an error if the most recent method called on the\n[Reader] was not a read operation. Notably, [Reader.Peek], [Reader.Discard], and [Reader.WriteTo] are not\nconsidered read operations."}
+	Reader_methods["ReadRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadRune reads a single UTF-8 encoded Unicode character and returns the\nrune and its size in bytes. If the encoded rune is invalid, it consumes one byte\nand returns unicode.ReplacementChar (U+FFFD) with a size of 1."}
+	Reader_methods["UnreadRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "UnreadRune unreads the last rune. If the most recent method called on\nthe [Reader] was not a [Reader.ReadRune], [Reader.UnreadRune] returns an error. (In this\nregard it is stricter than [Reader.UnreadByte], which will unread the last byte\nfrom any read operation.)"}
+	Reader_methods["Buffered"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Buffered returns the number of bytes that can be read from the current buffer."}
+	Reader_methods["ReadSlice"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "delim", Tag: "byte"}}, Tag: "any", Doc: "ReadSlice reads until the first occurrence of delim in the input,\nreturning a slice pointing at the bytes in the buffer.\nThe bytes stop being valid at the next read.\nIf ReadSlice encounters an error before finding a delimiter,\nit returns all the data in the buffer and the error itself (often io.EOF).\nReadSlice fails with error [ErrBufferFull] if the buffer fills without a delim.\nBecause the data returned from ReadSlice will be overwritten\nby the next I/O operation, most clients should use\n[Reader.ReadBytes] or ReadString instead.\nReadSlice returns err != nil if and only if line does not end in delim."}
+	Reader_methods["ReadLine"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadLine is a low-level line-reading primitive. Most callers should use\n[Reader.ReadBytes]('\\n') or [Reader.ReadString]('\\n') instead or use a [Scanner].\n\nReadLine tries to return a single line, not including the end-of-line bytes.\nIf the line was too long for the buffer then isPrefix is set and the\nbeginning of the line is returned. The rest of the line will be returned\nfrom future calls. isPrefix will be false when returning the last fragment\nof the line. The returned buffer is only valid until the next call to\nReadLine. ReadLine either returns a non-nil line or it returns an error,\nnever both.\n\nThe text returned from ReadLine does not include the line end (\"\\r\\n\" or \"\\n\").\nNo indication or error is given if the input ends without a final line end.\nCalling [Reader.UnreadByte] after ReadLine will always unread the last byte read\n(possibly a character belonging to the line end) even if that byte is not\npart of the line returned by ReadLine."}
+	Reader_methods["ReadBytes"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "delim", Tag: "byte"}}, Tag: "any", Doc: "ReadBytes reads until the first occurrence of delim in the input,\nreturning a slice containing the data up to and including the delimiter.\nIf ReadBytes encounters an error before finding a delimiter,\nit returns the data read before the error and the error itself (often io.EOF).\nReadBytes returns err != nil if and only if the returned data does not end in\ndelim.\nFor simple uses, a Scanner may be more convenient."}
+	Reader_methods["ReadString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "delim", Tag: "byte"}}, Tag: "any", Doc: "ReadString reads until the first occurrence of delim in the input,\nreturning a string containing the data up to and including the delimiter.\nIf ReadString encounters an error before finding a delimiter,\nit returns the data read before the error and the error itself (often io.EOF).\nReadString returns err != nil if and only if the returned data does not end in\ndelim.\nFor simple uses, a Scanner may be more convenient."}
+	Reader_methods["WriteTo"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "any", Doc: "WriteTo implements io.WriterTo.\nThis may make multiple calls to the [Reader.Read] method of the underlying [Reader].\nIf the underlying reader supports the [Reader.WriteTo] method,\nthis calls the underlying [Reader.WriteTo] without buffering."}
+	Writer_methods["Size"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Size returns the size of the underlying buffer in bytes."}
+	Writer_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "any", Doc: "Reset discards any unflushed buffered data, clears any error, and\nresets b to write its output to w.\nCalling Reset on the zero value of [Writer] initializes the internal buffer\nto the default size.\nCalling w.Reset(w) (that is, resetting a [Writer] to itself) does nothing."}
+	Writer_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Flush writes any buffered data to the underlying [io.Writer]."}
+	Writer_methods["Available"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Available returns how many bytes are unused in the buffer."}
+	Writer_methods["AvailableBuffer"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]byte", Doc: "AvailableBuffer returns an empty buffer with b.Available() capacity.\nThis buffer is intended to be appended to and\npassed to an immediately succeeding [Writer.Write] call.\nThe buffer is only valid until the next write operation on b."}
+	Writer_methods["Buffered"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Buffered returns the number of bytes that have been written into the current buffer."}
+	Writer_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "p", Tag: "[]byte"}}, Tag: "any", Doc: "Write writes the contents of p into the buffer.\nIt returns the number of bytes written.\nIf nn < len(p), it also returns an error explaining\nwhy the write is short."}
+	Writer_methods["WriteByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "c", Tag: "byte"}}, Tag: "error", Doc: "WriteByte writes a single byte."}
+	Writer_methods["WriteRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "any", Doc: "WriteRune writes a single Unicode code point, returning\nthe number of bytes written and any error."}
+	Writer_methods["WriteString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Doc: "WriteString writes a string.\nIt returns the number of bytes written.\nIf the count is less than len(s), it also returns an error explaining\nwhy the write is short."}
+	Writer_methods["ReadFrom"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "any", Doc: "ReadFrom implements [io.ReaderFrom]. If the underlying writer\nsupports the ReadFrom method, this calls the underlying ReadFrom.\nIf there is buffered data and an underlying ReadFrom, this fills\nthe buffer and writes it before calling ReadFrom."}
+	Scanner_methods["Err"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Err returns the first non-EOF error that was encountered by the [Scanner]."}
+	Scanner_methods["Bytes"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]byte", Doc: "Bytes returns the most recent token generated by a call to [Scanner.Scan].\nThe underlying array may point to data that will be overwritten\nby a subsequent call to Scan. It does no allocation."}
+	Scanner_methods["Text"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Text returns the most recent token generated by a call to [Scanner.Scan]\nas a newly allocated string holding its bytes."}
+	Scanner_methods["Scan"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "Scan advances the [Scanner] to the next token, which will then be\navailable through the [Scanner.Bytes] or [Scanner.Text] method. It returns false when\nthere are no more tokens, either by reaching the end of the input or an error.\nAfter Scan returns false, the [Scanner.Err] method will return any error that\noccurred during scanning, except that if it was [io.EOF], [Scanner.Err]\nwill return nil.\nScan panics if the split function returns too many empty\ntokens without advancing the input. This is a common error mode for\nscanners."}
+	Scanner_methods["Buffer"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "buf", Tag: "[]byte"}, {Name: "max", Tag: "int"}}, Tag: "any", Doc: "Buffer controls memory allocation by the Scanner.\nIt sets the initial buffer to use when scanning\nand the maximum size of buffer that may be allocated during scanning.\nThe contents of the buffer are ignored.\n\nThe maximum token size must be less than the larger of max and cap(buf).\nIf max <= cap(buf), [Scanner.Scan] will use this buffer only and do no allocation.\n\nBy default, [Scanner.Scan] uses an internal buffer and sets the\nmaximum token size to [MaxScanTokenSize].\n\nBuffer panics if it is called after scanning has started."}
+	Scanner_methods["Split"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "split", Tag: "SplitFunc"}}, Tag: "any", Doc: "Split sets the split function for the [Scanner].\nThe default split function is [ScanLines].\n\nSplit panics if it is called after scanning has started."}
+	pkgreflect.AddPackage("lace.go.bufio", &pkgreflect.Package{
+		Doc:       "Package bufio implements buffered I/O. It wraps an io.Reader or io.Writer object, creating another object (Reader or Writer) that also implements the interface but provides buffering and some help for textual I/O.",
+		WrapperNS: "go.bufio",
+		Wrapper:   wrapper_bufio,
+		Types: map[string]pkgreflect.Type{
+			"ReadWriter": {Doc: "ReadWriter stores pointers to a [Reader] and a [Writer].\nIt implements [io.ReadWriter].", Value: reflect.TypeOf((*bufio.ReadWriter)(nil)).Elem(), Methods: ReadWriter_methods},
+			"Reader":     {Doc: "Reader implements buffering for an io.Reader object.\nA new Reader is created by calling [NewReader] or [NewReaderSize];\nalternatively the zero value of a Reader may be used after calling [Reader.Reset]\non it.", Value: reflect.TypeOf((*bufio.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Writer":     {Doc: "Writer implements buffering for an [io.Writer] object.\nIf an error occurs writing to a [Writer], no more data will be\naccepted and all subsequent writes, and [Writer.Flush], will return the error.\nAfter all data has been written, the client should call the\n[Writer.Flush] method to guarantee all data has been forwarded to\nthe underlying [io.Writer].", Value: reflect.TypeOf((*bufio.Writer)(nil)).Elem(), Methods: Writer_methods},
+			"Scanner":    {Doc: "Scanner provides a convenient interface for reading data such as\na file of newline-delimited lines of text. Successive calls to\nthe [Scanner.Scan] method will step through the 'tokens' of a file, skipping\nthe bytes between the tokens. The specification of a token is\ndefined by a split function of type [SplitFunc]; the default split\nfunction breaks the input into lines with line termination stripped. [Scanner.Split]\nfunctions are defined in this package for scanning a file into\nlines, bytes, UTF-8-encoded runes, and space-delimited words. The\nclient may instead provide a custom split function.\n\nScanning stops unrecoverably at EOF, the first I/O error, or a token too\nlarge to fit in the [Scanner.Buffer]. When a scan stops, the reader may have\nadvanced arbitrarily far past the last token. Programs that need more\ncontrol over error handling or large tokens, or must run sequential scans\non a reader, should use [bufio.Reader] instead.", Value: reflect.TypeOf((*bufio.Scanner)(nil)).Elem(), Methods: Scanner_methods},
+			"SplitFunc":  {Doc: "SplitFunc is the signature of the split function used to tokenize the\ninput. The arguments are an initial substring of the remaining unprocessed\ndata and a flag, atEOF, that reports whether the [Reader] has no more data\nto give. The return values are the number of bytes to advance the input\nand the next token to return to the user, if any, plus an error, if any.\n\nScanning stops if the function returns an error, in which case some of\nthe input may be discarded. If that error is [ErrFinalToken], scanning\nstops with no error. A non-nil token delivered with [ErrFinalToken]\nwill be the last token, and a nil token with [ErrFinalToken]\nimmediately stops the scanning.\n\nOtherwise, the [Scanner] advances the input. If the token is not nil,\nthe [Scanner] returns it to the user. If the token is nil, the\nScanner reads more data and continues scanning; if there is no more\ndata--if atEOF was true--the [Scanner] returns. If the data does not\nyet hold a complete token, for instance if it has no newline while\nscanning lines, a [SplitFunc] can return (0, nil, nil) to signal the\n[Scanner] to read more data into the slice and try again with a\nlonger slice starting at the same point in the input.\n\nThe function is never called with an empty data slice unless atEOF\nis true. If atEOF is true, however, data may be non-empty and,\nas always, holds unprocessed text.", Value: reflect.TypeOf((*bufio.SplitFunc)(nil)).Elem(), Methods: SplitFunc_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewReadWriter": {Doc: "NewReadWriter allocates a new [ReadWriter] that dispatches to r and w.", Args: []pkgreflect.Arg{{Name: "r", Tag: "Reader"}, {Name: "w", Tag: "Writer"}}, Tag: "ReadWriter", Value: reflect.ValueOf(bufio.NewReadWriter)},
+
+			"NewReader": {Doc: "NewReader returns a new [Reader] whose buffer has the default size.", Args: []pkgreflect.Arg{{Name: "rd", Tag: "io.Reader"}}, Tag: "Reader", Value: reflect.ValueOf(bufio.NewReader)},
+
+			"NewReaderSize": {Doc: "NewReaderSize returns a new [Reader] whose buffer has at least the specified\nsize. If the argument io.Reader is already a [Reader] with large enough\nsize, it returns the underlying [Reader].", Args: []pkgreflect.Arg{{Name: "rd", Tag: "io.Reader"}, {Name: "size", Tag: "int"}}, Tag: "Reader", Value: reflect.ValueOf(bufio.NewReaderSize)},
+
+			"NewScanner": {Doc: "NewScanner returns a new [Scanner] to read from r.\nThe split function defaults to [ScanLines].", Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "Scanner", Value: reflect.ValueOf(bufio.NewScanner)},
+
+			"NewWriter": {Doc: "NewWriter returns a new [Writer] whose buffer has the default size.\nIf the argument io.Writer is already a [Writer] with large enough buffer size,\nit returns the underlying [Writer].", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "Writer", Value: reflect.ValueOf(bufio.NewWriter)},
+
+			"NewWriterSize": {Doc: "NewWriterSize returns a new [Writer] whose buffer has at least the specified\nsize. If the argument io.Writer is already a [Writer] with large enough\nsize, it returns the underlying [Writer].", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}, {Name: "size", Tag: "int"}}, Tag: "Writer", Value: reflect.ValueOf(bufio.NewWriterSize)},
+
+			"ScanBytes": {Doc: "ScanBytes is a split function for a [Scanner] that returns each byte as a token.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}, {Name: "atEOF", Tag: "bool"}}, Tag: "any", Value: reflect.ValueOf(bufio.ScanBytes)},
+
+			"ScanLines": {Doc: "ScanLines is a split function for a [Scanner] that returns each line of\ntext, stripped of any trailing end-of-line marker. The returned line may\nbe empty. The end-of-line marker is one optional carriage return followed\nby one mandatory newline. In regular expression notation, it is `\\r?\\n`.\nThe last non-empty line of input will be returned even if it has no\nnewline.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}, {Name: "atEOF", Tag: "bool"}}, Tag: "any", Value: reflect.ValueOf(bufio.ScanLines)},
+
+			"ScanRunes": {Doc: "ScanRunes is a split function for a [Scanner] that returns each\nUTF-8-encoded rune as a token. The sequence of runes returned is\nequivalent to that from a range loop over the input as a string, which\nmeans that erroneous UTF-8 encodings translate to U+FFFD = \"\\xef\\xbf\\xbd\".\nBecause of the Scan interface, this makes it impossible for the client to\ndistinguish correctly encoded replacement runes from encoding errors.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}, {Name: "atEOF", Tag: "bool"}}, Tag: "any", Value: reflect.ValueOf(bufio.ScanRunes)},
+
+			"ScanWords": {Doc: "ScanWords is a split function for a [Scanner] that returns each\nspace-separated word of text, with surrounding spaces deleted. It will\nnever return an empty string. The definition of space is set by\nunicode.IsSpace.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}, {Name: "atEOF", Tag: "bool"}}, Tag: "any", Value: reflect.ValueOf(bufio.ScanWords)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrAdvanceTooFar":     {Doc: "", Value: reflect.ValueOf(&bufio.ErrAdvanceTooFar)},
+			"ErrBadReadCount":      {Doc: "", Value: reflect.ValueOf(&bufio.ErrBadReadCount)},
+			"ErrBufferFull":        {Doc: "", Value: reflect.ValueOf(&bufio.ErrBufferFull)},
+			"ErrFinalToken":        {Doc: "", Value: reflect.ValueOf(&bufio.ErrFinalToken)},
+			"ErrInvalidUnreadByte": {Doc: "", Value: reflect.ValueOf(&bufio.ErrInvalidUnreadByte)},
+			"ErrInvalidUnreadRune": {Doc: "", Value: reflect.ValueOf(&bufio.ErrInvalidUnreadRune)},
+			"ErrNegativeAdvance":   {Doc: "", Value: reflect.ValueOf(&bufio.ErrNegativeAdvance)},
+			"ErrNegativeCount":     {Doc: "", Value: reflect.ValueOf(&bufio.ErrNegativeCount)},
+			"ErrTooLong":           {Doc: "", Value: reflect.ValueOf(&bufio.ErrTooLong)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"MaxScanTokenSize": {Doc: "MaxScanTokenSize is the maximum size used to buffer a token\nunless the user provides an explicit buffer with [Scanner.Buffer].\nThe actual maximum token size may be smaller as the buffer\nmay need to include, for instance, a newline.", Value: reflect.ValueOf(bufio.MaxScanTokenSize)},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.compress.gzip
+  "Package gzip implements reading and writing of gzip format compressed files, as specified in RFC 1952."
+  (:refer-clojure :exclude [new-reader new-writer new-writer-level]))
+
+(defn new-reader
+  "NewReader creates a new [Reader] reading the given reader.
+If r does not also implement [io.ByteReader],
+the decompressor may read more data than necessary from r.
+
+It is the caller's responsibility to call [Reader.Close] when done.
+
+The Reader.[Header] fields will be valid in the [Reader] returned."
+  {:arglists '([r]) :go "compress/gzip.NewReader"}
+  [r]
+  (lace.go.compress.gzip/NewReader r))
+
+(defn new-writer
+  "NewWriter returns a new [Writer].
+Writes to the returned writer are compressed and written to w.
+
+It is the caller's responsibility to call Close on the [Writer] when done.
+Writes may be buffered and not flushed until Close.
+
+Callers that wish to set the fields in Writer.[Header] must do so before
+the first call to Write, Flush, or Close.
+
+Note that the exact bytes written to w are not covered by the Go 1
+compatibility promise. Callers, including tests, should not depend on the
+exact written bytes."
+  {:arglists '([w]) :go "compress/gzip.NewWriter"}
+  [w]
+  (lace.go.compress.gzip/NewWriter w))
+
+(defn new-writer-level
+  "NewWriterLevel is like [NewWriter] but specifies the compression level instead
+of assuming [DefaultCompression].
+
+The compression level can be [DefaultCompression], [NoCompression], [HuffmanOnly]
+or any integer value between [BestSpeed] and [BestCompression] inclusive.
+The error returned will be nil if the level is valid.
+
+Note that the exact bytes written to w are not covered by the Go 1
+compatibility promise. Callers, including tests, should not depend on the
+exact written bytes."
+  {:arglists '([w level]) :go "compress/gzip.NewWriterLevel"}
+  [w level]
+  (lace.go.compress.gzip/NewWriterLevel w level))
@@ -0,0 +1,59 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	gzip "compress/gzip"
+)
+
+//go:embed compress_gzip.clj
+var wrapper_compress_gzip string
+
+func init() {
+	Header_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Writer_methods := map[string]pkgreflect.Func{}
+	Reader_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "error", Doc: "Reset discards the [Reader] z's state and makes it equivalent to the\nresult of its original state from [NewReader], but reading from r instead.\nThis permits reusing a [Reader] rather than allocating a new one."}
+	Reader_methods["Multistream"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ok", Tag: "bool"}}, Tag: "any", Doc: "Multistream controls whether the reader supports multistream files.\n\nIf enabled (the default), the [Reader] expects the input to be a sequence\nof individually gzipped data streams, each with its own header and\ntrailer, ending at EOF. The effect is that the concatenation of a sequence\nof gzipped files is treated as equivalent to the gzip of the concatenation\nof the sequence. This is standard behavior for gzip readers.\n\nCalling Multistream(false) disables this behavior; disabling the behavior\ncan be useful when reading file formats that distinguish individual gzip\ndata streams or mix gzip data streams with other data streams.\nIn this mode, when the [Reader] reaches the end of the data stream,\n[Reader.Read] returns [io.EOF]. The underlying reader must implement [io.ByteReader]\nin order to be left positioned just after the gzip stream.\nTo start the next stream, call z.Reset(r) followed by z.Multistream(false).\nIf there is no next stream, z.Reset(r) will return [io.EOF]."}
+	Reader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "p", Tag: "[]byte"}}, Tag: "any", Doc: "Read implements [io.Reader], reading uncompressed bytes from its underlying reader."}
+	Reader_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close closes the [Reader]. It does not close the underlying reader.\nIn order for the GZIP checksum to be verified, the reader must be\nfully consumed until the [io.EOF]."}
+	Writer_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "any", Doc: "Reset discards the [Writer] z's state and makes it equivalent to the\nresult of its original state from [NewWriter] or [NewWriterLevel], but\nwriting to w instead. This permits reusing a [Writer] rather than\nallocating a new one."}
+	Writer_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "p", Tag: "[]byte"}}, Tag: "any", Doc: "Write writes a compressed form of p to the underlying [io.Writer]. The\ncompressed bytes are not necessarily flushed until the [Writer] is closed."}
+	Writer_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Flush flushes any pending compressed data to the underlying writer.\n\nIt is useful mainly in compressed network protocols, to ensure that\na remote reader has enough data to reconstruct a packet. Flush does\nnot return until the data has been written. If the underlying\nwriter returns an error, Flush returns that error.\n\nIn the terminology of the zlib library, Flush is equivalent to Z_SYNC_FLUSH."}
+	Writer_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close closes the [Writer] by flushing any unwritten data to the underlying\n[io.Writer] and writing the GZIP footer.\nIt does not close the underlying [io.Writer]."}
+	pkgreflect.AddPackage("lace.go.compress.gzip", &pkgreflect.Package{
+		Doc:       "Package gzip implements reading and writing of gzip format compressed files, as specified in RFC 1952.",
+		WrapperNS: "go.compress.gzip",
+		Wrapper:   wrapper_compress_gzip,
+		Types: map[string]pkgreflect.Type{
+			"Header": {Doc: "The gzip file stores a header giving metadata about the compressed file.\nThat header is exposed as the fields of the [Writer] and [Reader] structs.\n\nStrings must be UTF-8 encoded and may only contain Unicode code points\nU+0001 through U+00FF, due to limitations of the GZIP file format.", Value: reflect.TypeOf((*gzip.Header)(nil)).Elem(), Methods: Header_methods},
+			"Reader": {Doc: "A Reader is an [io.Reader] that can be read to retrieve\nuncompressed data from a gzip-format compressed file.\n\nIn general, a gzip file can be a concatenation of gzip files,\neach with its own header. Reads from the Reader\nreturn the concatenation of the uncompressed data of each.\nOnly the first header is recorded in the Reader fields.\n\nGzip files store a length and checksum of the uncompressed data.\nThe Reader will return an [ErrChecksum] when [Reader.Read]\nreaches the end of the uncompressed data if it does not\nhave the expected length or checksum. Clients should treat data\nreturned by [Reader.Read] as tentative until they receive the [io.EOF]\nmarking the end of the data.", Value: reflect.TypeOf((*gzip.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Writer": {Doc: "A Writer is an [io.WriteCloser].\nWrites to a Writer are compressed and written to w.", Value: reflect.TypeOf((*gzip.Writer)(nil)).Elem(), Methods: Writer_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewReader": {Doc: "NewReader creates a new [Reader] reading the given reader.\nIf r does not also implement [io.ByteReader],\nthe decompressor may read more data than necessary from r.\n\nIt is the caller's responsibility to call [Reader.Close] when done.\n\nThe Reader.[Header] fields will be valid in the [Reader] returned.", Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "any", Value: reflect.ValueOf(gzip.NewReader)},
+
+			"NewWriter": {Doc: "NewWriter returns a new [Writer].\nWrites to the returned writer are compressed and written to w.\n\nIt is the caller's responsibility to call Close on the [Writer] when done.\nWrites may be buffered and not flushed until Close.\n\nCallers that wish to set the fields in Writer.[Header] must do so before\nthe first call to Write, Flush, or Close.\n\nNote that the exact bytes written to w are not covered by the Go 1\ncompatibility promise. Callers, including tests, should not depend on the\nexact written bytes.", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "Writer", Value: reflect.ValueOf(gzip.NewWriter)},
+
+			"NewWriterLevel": {Doc: "NewWriterLevel is like [NewWriter] but specifies the compression level instead\nof assuming [DefaultCompression].\n\nThe compression level can be [DefaultCompression], [NoCompression], [HuffmanOnly]\nor any integer value between [BestSpeed] and [BestCompression] inclusive.\nThe error returned will be nil if the level is valid.\n\nNote that the exact bytes written to w are not covered by the Go 1\ncompatibility promise. Callers, including tests, should not depend on the\nexact written bytes.", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}, {Name: "level", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(gzip.NewWriterLevel)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrChecksum": {Doc: "ErrChecksum is returned when reading GZIP data that has an invalid checksum.", Value: reflect.ValueOf(&gzip.ErrChecksum)},
+			"ErrHeader":   {Doc: "ErrHeader is returned when reading GZIP data that has an invalid header.", Value: reflect.ValueOf(&gzip.ErrHeader)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"BestCompression":    {Doc: "", Value: reflect.ValueOf(gzip.BestCompression)},
+			"BestSpeed":          {Doc: "", Value: reflect.ValueOf(gzip.BestSpeed)},
+			"DefaultCompression": {Doc: "", Value: reflect.ValueOf(gzip.DefaultCompression)},
+			"HuffmanOnly":        {Doc: "", Value: reflect.ValueOf(gzip.HuffmanOnly)},
+			"NoCompression":      {Doc: "", Value: reflect.ValueOf(gzip.NoCompression)},
+		},
+	})
+}
@@ -0,0 +1,13 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto
+  "Package crypto collects common cryptographic constants."
+  (:refer-clojure :exclude [register-hash]))
+
+(defn register-hash
+  "RegisterHash registers a function that returns a new instance of the given
+hash function. This is intended to be called from the init function in
+packages that implement hash functions."
+  {:arglists '([h f]) :go "crypto.RegisterHash"}
+  [h f]
+  (lace.go.crypto/RegisterHash h f))
@@ -0,0 +1,107 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"io"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	crypto "crypto"
+)
+
+type DecrypterImpl struct {
+	DecryptFn func(io.Reader, []byte, crypto.DecrypterOpts) ([]byte, error)
+	PublicFn  func() crypto.PublicKey
+}
+
+func (s *DecrypterImpl) Decrypt(a0 io.Reader, a1 []byte, a2 crypto.DecrypterOpts) ([]byte, error) {
+	return s.DecryptFn(a0, a1, a2)
+}
+func (s *DecrypterImpl) Public() crypto.PublicKey {
+	return s.PublicFn()
+}
+
+type SignerImpl struct {
+	PublicFn func() crypto.PublicKey
+	SignFn   func(io.Reader, []byte, crypto.SignerOpts) ([]byte, error)
+}
+
+func (s *SignerImpl) Public() crypto.PublicKey {
+	return s.PublicFn()
+}
+func (s *SignerImpl) Sign(a0 io.Reader, a1 []byte, a2 crypto.SignerOpts) ([]byte, error) {
+	return s.SignFn(a0, a1, a2)
+}
+
+type SignerOptsImpl struct {
+	HashFuncFn func() crypto.Hash
+}
+
+func (s *SignerOptsImpl) HashFunc() crypto.Hash {
+	return s.HashFuncFn()
+}
+
+//go:embed crypto.clj
+var wrapper_crypto string
+
+func init() {
+	Decrypter_methods := map[string]pkgreflect.Func{}
+	DecrypterOpts_methods := map[string]pkgreflect.Func{}
+	Hash_methods := map[string]pkgreflect.Func{}
+	PrivateKey_methods := map[string]pkgreflect.Func{}
+	PublicKey_methods := map[string]pkgreflect.Func{}
+	Signer_methods := map[string]pkgreflect.Func{}
+	SignerOpts_methods := map[string]pkgreflect.Func{}
+	Hash_methods["HashFunc"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Hash", Doc: "HashFunc simply returns the value of h so that [Hash] implements [SignerOpts]."}
+	Hash_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Hash_methods["Size"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Size returns the length, in bytes, of a digest resulting from the given hash\nfunction. It doesn't require that the hash function in question be linked\ninto the program."}
+	Hash_methods["New"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "hash.Hash", Doc: "New returns a new hash.Hash calculating the given hash function. New panics\nif the hash function is not linked into the binary."}
+	Hash_methods["Available"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "Available reports whether the given hash function is linked into the binary."}
+	pkgreflect.AddPackage("lace.go.crypto", &pkgreflect.Package{
+		Doc:       "Package crypto collects common cryptographic constants.",
+		WrapperNS: "go.crypto",
+		Wrapper:   wrapper_crypto,
+		Types: map[string]pkgreflect.Type{
+			"Decrypter":      {Doc: "Decrypter is an interface for an opaque private key that can be used for\nasymmetric decryption operations. An example would be an RSA key\nkept in a hardware module.", Value: reflect.TypeOf((*crypto.Decrypter)(nil)).Elem(), Methods: Decrypter_methods},
+			"DecrypterOpts":  {Doc: "", Value: reflect.TypeOf((*crypto.DecrypterOpts)(nil)).Elem(), Methods: DecrypterOpts_methods},
+			"Hash":           {Doc: "Hash identifies a cryptographic hash function that is implemented in another\npackage.", Value: reflect.TypeOf((*crypto.Hash)(nil)).Elem(), Methods: Hash_methods},
+			"PrivateKey":     {Doc: "PrivateKey represents a private key using an unspecified algorithm.\n\nAlthough this type is an empty interface for backwards compatibility reasons,\nall private key types in the standard library implement the following interface\n\n\tinterface{\n\t    Public() crypto.PublicKey\n\t    Equal(x crypto.PrivateKey) bool\n\t}\n\nas well as purpose-specific interfaces such as [Signer] and [Decrypter], which\ncan be used for increased type safety within applications.", Value: reflect.TypeOf((*crypto.PrivateKey)(nil)).Elem(), Methods: PrivateKey_methods},
+			"PublicKey":      {Doc: "PublicKey represents a public key using an unspecified algorithm.\n\nAlthough this type is an empty interface for backwards compatibility reasons,\nall public key types in the standard library implement the following interface\n\n\tinterface{\n\t    Equal(x crypto.PublicKey) bool\n\t}\n\nwhich can be used for increased type safety within applications.", Value: reflect.TypeOf((*crypto.PublicKey)(nil)).Elem(), Methods: PublicKey_methods},
+			"Signer":         {Doc: "Signer is an interface for an opaque private key that can be used for\nsigning operations. For example, an RSA key kept in a hardware module.", Value: reflect.TypeOf((*crypto.Signer)(nil)).Elem(), Methods: Signer_methods},
+			"SignerOpts":     {Doc: "SignerOpts contains options for signing with a [Signer].", Value: reflect.TypeOf((*crypto.SignerOpts)(nil)).Elem(), Methods: SignerOpts_methods},
+			"DecrypterImpl":  {Doc: `Struct version of interface Decrypter for implementation`, Value: reflect.TypeFor[DecrypterImpl]()},
+			"SignerImpl":     {Doc: `Struct version of interface Signer for implementation`, Value: reflect.TypeFor[SignerImpl]()},
+			"SignerOptsImpl": {Doc: `Struct version of interface SignerOpts for implementation`, Value: reflect.TypeFor[SignerOptsImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"RegisterHash": {Doc: "RegisterHash registers a function that returns a new instance of the given\nhash function. This is intended to be called from the init function in\npackages that implement hash functions.", Args: []pkgreflect.Arg{{Name: "h", Tag: "Hash"}, {Name: "f", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(crypto.RegisterHash)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{
+			"BLAKE2b_256": {Doc: "", Value: reflect.ValueOf(crypto.BLAKE2b_256)},
+			"BLAKE2b_384": {Doc: "", Value: reflect.ValueOf(crypto.BLAKE2b_384)},
+			"BLAKE2b_512": {Doc: "", Value: reflect.ValueOf(crypto.BLAKE2b_512)},
+			"BLAKE2s_256": {Doc: "", Value: reflect.ValueOf(crypto.BLAKE2s_256)},
+			"MD4":         {Doc: "", Value: reflect.ValueOf(crypto.MD4)},
+			"MD5":         {Doc: "", Value: reflect.ValueOf(crypto.MD5)},
+			"MD5SHA1":     {Doc: "", Value: reflect.ValueOf(crypto.MD5SHA1)},
+			"RIPEMD160":   {Doc: "", Value: reflect.ValueOf(crypto.RIPEMD160)},
+			"SHA1":        {Doc: "", Value: reflect.ValueOf(crypto.SHA1)},
+			"SHA224":      {Doc: "", Value: reflect.ValueOf(crypto.SHA224)},
+			"SHA256":      {Doc: "", Value: reflect.ValueOf(crypto.SHA256)},
+			"SHA384":      {Doc: "", Value: reflect.ValueOf(crypto.SHA384)},
+			"SHA3_224":    {Doc: "", Value: reflect.ValueOf(crypto.SHA3_224)},
+			"SHA3_256":    {Doc: "", Value: reflect.ValueOf(crypto.SHA3_256)},
+			"SHA3_384":    {Doc: "", Value: reflect.ValueOf(crypto.SHA3_384)},
+			"SHA3_512":    {Doc: "", Value: reflect.ValueOf(crypto.SHA3_512)},
+			"SHA512":      {Doc: "", Value: reflect.ValueOf(crypto.SHA512)},
+			"SHA512_224":  {Doc: "", Value: reflect.ValueOf(crypto.SHA512_224)},
+			"SHA512_256":  {Doc: "", Value: reflect.ValueOf(crypto.SHA512_256)},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.aes
+  "Package aes implements AES encryption (formerly Rijndael), as defined in U.S. Federal Information Processing Standards Publication 197."
+  (:refer-clojure :exclude [new-cipher]))
+
+(defn new-cipher
+  "NewCipher creates and returns a new [cipher.Block].
+The key argument must be the AES key,
+either 16, 24, or 32 bytes to select
+AES-128, AES-192, or AES-256."
+  {:arglists '([key]) :go "crypto/aes.NewCipher"}
+  [key]
+  (lace.go.crypto.aes/NewCipher key))
@@ -0,0 +1,60 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.cipher
+  "Package cipher implements standard block cipher modes that can be wrapped around low-level block cipher implementations."
+  (:refer-clojure :exclude [new-cbc-decrypter new-cbc-encrypter new-ctr new-gcm new-gcm-with-nonce-size new-gcm-with-tag-size]))
+
+(defn new-cbc-decrypter
+  "NewCBCDecrypter returns a BlockMode which decrypts in cipher block chaining
+mode, using the given Block. The length of iv must be the same as the
+Block's block size and must match the iv used to encrypt the data."
+  {:arglists '([b iv]) :go "crypto/cipher.NewCBCDecrypter"}
+  [b iv]
+  (lace.go.crypto.cipher/NewCBCDecrypter b iv))
+
+(defn new-cbc-encrypter
+  "NewCBCEncrypter returns a BlockMode which encrypts in cipher block chaining
+mode, using the given Block. The length of iv must be the same as the
+Block's block size."
+  {:arglists '([b iv]) :go "crypto/cipher.NewCBCEncrypter"}
+  [b iv]
+  (lace.go.crypto.cipher/NewCBCEncrypter b iv))
+
+(defn new-ctr
+  "NewCTR returns a [Stream] which encrypts/decrypts using the given [Block] in
+counter mode. The length of iv must be the same as the [Block]'s block size."
+  {:arglists '([block iv]) :go "crypto/cipher.NewCTR"}
+  [block iv]
+  (lace.go.crypto.cipher/NewCTR block iv))
+
+(defn new-gcm
+  "NewGCM returns the given 128-bit, block cipher wrapped in Galois Counter Mode
+with the standard nonce length."
+  {:arglists '([cipher]) :go "crypto/cipher.NewGCM"}
+  [cipher]
+  (lace.go.crypto.cipher/NewGCM cipher))
+
+(defn new-gcm-with-nonce-size
+  "NewGCMWithNonceSize returns the given 128-bit, block cipher wrapped in Galois
+Counter Mode, which accepts nonces of the given length. The length must not
+be zero.
+
+Only use this function if you require compatibility with an existing
+cryptosystem that uses non-standard nonce lengths. All other users should use
+[NewGCM], which is faster and more resistant to misuse."
+  {:arglists '([cipher size]) :go "crypto/cipher.NewGCMWithNonceSize"}
+  [cipher size]
+  (lace.go.crypto.cipher/NewGCMWithNonceSize cipher size))
+
+(defn new-gcm-with-tag-size
+  "NewGCMWithTagSize returns the given 128-bit, block cipher wrapped in Galois
+Counter Mode, which generates tags with the given length.
+
+Tag sizes between 12 and 16 bytes are allowed.
+
+Only use this function if you require compatibility with an existing
+cryptosystem that uses non-standard tag lengths. All other users should use
+[NewGCM], which is more resistant to misuse."
+  {:arglists '([cipher tag-size]) :go "crypto/cipher.NewGCMWithTagSize"}
+  [cipher tag-size]
+  (lace.go.crypto.cipher/NewGCMWithTagSize cipher tag-size))
@@ -0,0 +1,117 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	cipher "crypto/cipher"
+)
+
+type AEADImpl struct {
+	NonceSizeFn func() int
+	OpenFn      func([]byte, []byte, []byte, []byte) ([]byte, error)
+	OverheadFn  func() int
+	SealFn      func([]byte, []byte, []byte, []byte) []byte
+}
+
+func (s *AEADImpl) NonceSize() int {
+	return s.NonceSizeFn()
+}
+func (s *AEADImpl) Open(a0 []byte, a1 []byte, a2 []byte, a3 []byte) ([]byte, error) {
+	return s.OpenFn(a0, a1, a2, a3)
+}
+func (s *AEADImpl) Overhead() int {
+	return s.OverheadFn()
+}
+func (s *AEADImpl) Seal(a0 []byte, a1 []byte, a2 []byte, a3 []byte) []byte {
+	return s.SealFn(a0, a1, a2, a3)
+}
+
+type BlockImpl struct {
+	BlockSizeFn func() int
+	DecryptFn   func([]byte, []byte)
+	EncryptFn   func([]byte, []byte)
+}
+
+func (s *BlockImpl) BlockSize() int {
+	return s.BlockSizeFn()
+}
+func (s *BlockImpl) Decrypt(a0 []byte, a1 []byte) {
+	s.DecryptFn(a0, a1)
+}
+func (s *BlockImpl) Encrypt(a0 []byte, a1 []byte) {
+	s.EncryptFn(a0, a1)
+}
+
+type BlockModeImpl struct {
+	BlockSizeFn   func() int
+	CryptBlocksFn func([]byte, []byte)
+}
+
+func (s *BlockModeImpl) BlockSize() int {
+	return s.BlockSizeFn()
+}
+func (s *BlockModeImpl) CryptBlocks(a0 []byte, a1 []byte) {
+	s.CryptBlocksFn(a0, a1)
+}
+
+type StreamImpl struct {
+	XORKeyStreamFn func([]byte, []byte)
+}
+
+func (s *StreamImpl) XORKeyStream(a0 []byte, a1 []byte) {
+	s.XORKeyStreamFn(a0, a1)
+}
+
+//go:embed crypto_cipher.clj
+var wrapper_crypto_cipher string
+
+func init() {
+	AEAD_methods := map[string]pkgreflect.Func{}
+	Block_methods := map[string]pkgreflect.Func{}
+	BlockMode_methods := map[string]pkgreflect.Func{}
+	Stream_methods := map[string]pkgreflect.Func{}
+	StreamReader_methods := map[string]pkgreflect.Func{}
+	StreamWriter_methods := map[string]pkgreflect.Func{}
+	StreamReader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}}, Tag: "any", Doc: ""}
+	StreamWriter_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "[]byte"}}, Tag: "any", Doc: ""}
+	StreamWriter_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close closes the underlying Writer and returns its Close return value, if the Writer\nis also an io.Closer. Otherwise it returns nil."}
+	pkgreflect.AddPackage("lace.go.crypto.cipher", &pkgreflect.Package{
+		Doc:       "Package cipher implements standard block cipher modes that can be wrapped around low-level block cipher implementations.",
+		WrapperNS: "go.crypto.cipher",
+		Wrapper:   wrapper_crypto_cipher,
+		Types: map[string]pkgreflect.Type{
+			"AEAD":          {Doc: "AEAD is a cipher mode providing authenticated encryption with associated\ndata. For a description of the methodology, see\nhttps://en.wikipedia.org/wiki/Authenticated_encryption.", Value: reflect.TypeOf((*cipher.AEAD)(nil)).Elem(), Methods: AEAD_methods},
+			"Block":         {Doc: "A Block represents an implementation of block cipher\nusing a given key. It provides the capability to encrypt\nor decrypt individual blocks. The mode implementations\nextend that capability to streams of blocks.", Value: reflect.TypeOf((*cipher.Block)(nil)).Elem(), Methods: Block_methods},
+			"BlockMode":     {Doc: "A BlockMode represents a block cipher running in a block-based mode (CBC,\nECB etc).", Value: reflect.TypeOf((*cipher.BlockMode)(nil)).Elem(), Methods: BlockMode_methods},
+			"Stream":        {Doc: "A Stream represents a stream cipher.", Value: reflect.TypeOf((*cipher.Stream)(nil)).Elem(), Methods: Stream_methods},
+			"StreamReader":  {Doc: "StreamReader wraps a [Stream] into an [io.Reader]. It calls XORKeyStream\nto process each slice of data which passes through.", Value: reflect.TypeOf((*cipher.StreamReader)(nil)).Elem(), Methods: StreamReader_methods},
+			"StreamWriter":  {Doc: "StreamWriter wraps a [Stream] into an io.Writer. It calls XORKeyStream\nto process each slice of data which passes through. If any [StreamWriter.Write]\ncall returns short then the StreamWriter is out of sync and must be discarded.\nA StreamWriter has no internal buffering; [StreamWriter.Close] does not need\nto be called to flush write data.", Value: reflect.TypeOf((*cipher.StreamWriter)(nil)).Elem(), Methods: StreamWriter_methods},
+			"AEADImpl":      {Doc: `Struct version of interface AEAD for implementation`, Value: reflect.TypeFor[AEADImpl]()},
+			"BlockImpl":     {Doc: `Struct version of interface Block for implementation`, Value: reflect.TypeFor[BlockImpl]()},
+			"BlockModeImpl": {Doc: `Struct version of interface BlockMode for implementation`, Value: reflect.TypeFor[BlockModeImpl]()},
+			"StreamImpl":    {Doc: `Struct version of interface Stream for implementation`, Value: reflect.TypeFor[StreamImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewCBCDecrypter": {Doc: "NewCBCDecrypter returns a BlockMode which decrypts in cipher block chaining\nmode, using the given Block. The length of iv must be the same as the\nBlock's block size and must match the iv used to encrypt the data.", Args: []pkgreflect.Arg{{Name: "b", Tag: "Block"}, {Name: "iv", Tag: "[]byte"}}, Tag: "BlockMode", Value: reflect.ValueOf(cipher.NewCBCDecrypter)},
+
+			"NewCBCEncrypter": {Doc: "NewCBCEncrypter returns a BlockMode which encrypts in cipher block chaining\nmode, using the given Block. The length of iv must be the same as the\nBlock's block size.", Args: []pkgreflect.Arg{{Name: "b", Tag: "Block"}, {Name: "iv", Tag: "[]byte"}}, Tag: "BlockMode", Value: reflect.ValueOf(cipher.NewCBCEncrypter)},
+
+			"NewCTR": {Doc: "NewCTR returns a [Stream] which encrypts/decrypts using the given [Block] in\ncounter mode. The length of iv must be the same as the [Block]'s block size.", Args: []pkgreflect.Arg{{Name: "block", Tag: "Block"}, {Name: "iv", Tag: "[]byte"}}, Tag: "Stream", Value: reflect.ValueOf(cipher.NewCTR)},
+
+			"NewGCM": {Doc: "NewGCM returns the given 128-bit, block cipher wrapped in Galois Counter Mode\nwith the standard nonce length.", Args: []pkgreflect.Arg{{Name: "cipher", Tag: "Block"}}, Tag: "any", Value: reflect.ValueOf(cipher.NewGCM)},
+
+			"NewGCMWithNonceSize": {Doc: "NewGCMWithNonceSize returns the given 128-bit, block cipher wrapped in Galois\nCounter Mode, which accepts nonces of the given length. The length must not\nbe zero.\n\nOnly use this function if you require compatibility with an existing\ncryptosystem that uses non-standard nonce lengths. All other users should use\n[NewGCM], which is faster and more resistant to misuse.", Args: []pkgreflect.Arg{{Name: "cipher", Tag: "Block"}, {Name: "size", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(cipher.NewGCMWithNonceSize)},
+
+			"NewGCMWithTagSize": {Doc: "NewGCMWithTagSize returns the given 128-bit, block cipher wrapped in Galois\nCounter Mode, which generates tags with the given length.\n\nTag sizes between 12 and 16 bytes are allowed.\n\nOnly use this function if you require compatibility with an existing\ncryptosystem that uses non-standard tag lengths. All other users should use\n[NewGCM], which is more resistant to misuse.", Args: []pkgreflect.Arg{{Name: "cipher", Tag: "Block"}, {Name: "tagSize", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(cipher.NewGCMWithTagSize)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
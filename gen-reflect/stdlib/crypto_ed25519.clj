@@ -0,0 +1,62 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.ed25519
+  "Package ed25519 implements the Ed25519 signature algorithm."
+  (:refer-clojure :exclude [generate-key new-key-from-seed sign verify verify-with-options]))
+
+(defn generate-key
+  "GenerateKey generates a public/private key pair using entropy from random.
+
+If random is nil, a secure random source is used. (Before Go 1.26, a custom
+[crypto/rand.Reader] was used if set by the application. That behavior can be
+restored with GODEBUG=cryptocustomrand=1. This setting will be removed in a
+future Go release. Instead, use [testing/cryptotest.SetGlobalRandom].)
+
+The output of this function is deterministic, and equivalent to reading
+[SeedSize] bytes from random, and passing them to [NewKeyFromSeed]."
+  {:arglists '([random]) :go "crypto/ed25519.GenerateKey"}
+  [random]
+  (lace.go.crypto.ed25519/GenerateKey random))
+
+(defn new-key-from-seed
+  "NewKeyFromSeed calculates a private key from a seed. It will panic if
+len(seed) is not [SeedSize]. This function is provided for interoperability
+with RFC 8032. RFC 8032's private keys correspond to seeds in this
+package."
+  {:arglists '([seed]) :go "crypto/ed25519.NewKeyFromSeed"}
+  [seed]
+  (lace.go.crypto.ed25519/NewKeyFromSeed seed))
+
+(defn sign
+  "Sign signs the message with privateKey and returns a signature. It will
+panic if len(privateKey) is not [PrivateKeySize]."
+  {:arglists '([private-key message]) :go "crypto/ed25519.Sign"}
+  [private-key message]
+  (lace.go.crypto.ed25519/Sign private-key message))
+
+(defn verify
+  "Verify reports whether sig is a valid signature of message by publicKey. It
+will panic if len(publicKey) is not [PublicKeySize].
+
+The inputs are not considered confidential, and may leak through timing side
+channels, or if an attacker has control of part of the inputs."
+  {:arglists '([public-key message sig]) :go "crypto/ed25519.Verify"}
+  [public-key message sig]
+  (lace.go.crypto.ed25519/Verify public-key message sig))
+
+(defn verify-with-options
+  "VerifyWithOptions reports whether sig is a valid signature of message by
+publicKey. A valid signature is indicated by returning a nil error. It will
+panic if len(publicKey) is not [PublicKeySize].
+
+If opts.Hash is [crypto.SHA512], the pre-hashed variant Ed25519ph is used and
+message is expected to be a SHA-512 hash, otherwise opts.Hash must be
+[crypto.Hash](0) and the message must not be hashed, as Ed25519 performs two
+passes over messages to be signed.
+
+The inputs are not considered confidential, and may leak through timing side
+channels, or if an attacker has control of part of the inputs."
+  {:arglists '([public-key message sig opts]) :go "crypto/ed25519.VerifyWithOptions"}
+  [public-key message sig opts]
+  (lace.core/let [opts (if (lace.core/map? opts) (go/->struct lace.go.crypto.ed25519/Options opts) opts)]
+    (lace.go.crypto.ed25519/VerifyWithOptions public-key message sig opts)))
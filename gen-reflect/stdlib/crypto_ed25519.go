@@ -0,0 +1,57 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	ed25519 "crypto/ed25519"
+)
+
+//go:embed crypto_ed25519.clj
+var wrapper_crypto_ed25519 string
+
+func init() {
+	Options_methods := map[string]pkgreflect.Func{}
+	PrivateKey_methods := map[string]pkgreflect.Func{}
+	PublicKey_methods := map[string]pkgreflect.Func{}
+	PublicKey_methods["Equal"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "crypto.PublicKey"}}, Tag: "bool", Doc: "Equal reports whether pub and x have the same value."}
+	PrivateKey_methods["Public"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "crypto.PublicKey", Doc: "Public returns the [PublicKey] corresponding to priv."}
+	PrivateKey_methods["Equal"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "crypto.PrivateKey"}}, Tag: "bool", Doc: "Equal reports whether priv and x have the same value."}
+	PrivateKey_methods["Seed"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]byte", Doc: "Seed returns the private key seed corresponding to priv. It is provided for\ninteroperability with RFC 8032. RFC 8032's private keys correspond to seeds\nin this package."}
+	PrivateKey_methods["Sign"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "message", Tag: "[]byte"}, {Name: "opts", Tag: "crypto.SignerOpts"}}, Tag: "any", Doc: "Sign signs the given message with priv. rand is ignored and can be nil.\n\nIf opts.HashFunc() is [crypto.SHA512], the pre-hashed variant Ed25519ph is used\nand message is expected to be a SHA-512 hash, otherwise opts.HashFunc() must\nbe [crypto.Hash](0) and the message must not be hashed, as Ed25519 performs two\npasses over messages to be signed.\n\nA value of type [Options] can be used as opts, or crypto.Hash(0) or\ncrypto.SHA512 directly to select plain Ed25519 or Ed25519ph, respectively."}
+	Options_methods["HashFunc"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "crypto.Hash", Doc: "HashFunc returns o.Hash."}
+	pkgreflect.AddPackage("lace.go.crypto.ed25519", &pkgreflect.Package{
+		Doc:       "Package ed25519 implements the Ed25519 signature algorithm.",
+		WrapperNS: "go.crypto.ed25519",
+		Wrapper:   wrapper_crypto_ed25519,
+		Types: map[string]pkgreflect.Type{
+			"Options":    {Doc: "Options can be used with [PrivateKey.Sign] or [VerifyWithOptions]\nto select Ed25519 variants.", Value: reflect.TypeOf((*ed25519.Options)(nil)).Elem(), Methods: Options_methods},
+			"PrivateKey": {Doc: "PrivateKey is the type of Ed25519 private keys. It implements [crypto.Signer].", Value: reflect.TypeOf((*ed25519.PrivateKey)(nil)).Elem(), Methods: PrivateKey_methods},
+			"PublicKey":  {Doc: "PublicKey is the type of Ed25519 public keys.", Value: reflect.TypeOf((*ed25519.PublicKey)(nil)).Elem(), Methods: PublicKey_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"GenerateKey": {Doc: "GenerateKey generates a public/private key pair using entropy from random.\n\nIf random is nil, a secure random source is used. (Before Go 1.26, a custom\n[crypto/rand.Reader] was used if set by the application. That behavior can be\nrestored with GODEBUG=cryptocustomrand=1. This setting will be removed in a\nfuture Go release. Instead, use [testing/cryptotest.SetGlobalRandom].)\n\nThe output of this function is deterministic, and equivalent to reading\n[SeedSize] bytes from random, and passing them to [NewKeyFromSeed].", Args: []pkgreflect.Arg{{Name: "random", Tag: "io.Reader"}}, Tag: "any", Value: reflect.ValueOf(ed25519.GenerateKey)},
+
+			"NewKeyFromSeed": {Doc: "NewKeyFromSeed calculates a private key from a seed. It will panic if\nlen(seed) is not [SeedSize]. This function is provided for interoperability\nwith RFC 8032. RFC 8032's private keys correspond to seeds in this\npackage.", Args: []pkgreflect.Arg{{Name: "seed", Tag: "[]byte"}}, Tag: "PrivateKey", Value: reflect.ValueOf(ed25519.NewKeyFromSeed)},
+
+			"Sign": {Doc: "Sign signs the message with privateKey and returns a signature. It will\npanic if len(privateKey) is not [PrivateKeySize].", Args: []pkgreflect.Arg{{Name: "privateKey", Tag: "PrivateKey"}, {Name: "message", Tag: "[]byte"}}, Tag: "[]byte", Value: reflect.ValueOf(ed25519.Sign)},
+
+			"Verify": {Doc: "Verify reports whether sig is a valid signature of message by publicKey. It\nwill panic if len(publicKey) is not [PublicKeySize].\n\nThe inputs are not considered confidential, and may leak through timing side\nchannels, or if an attacker has control of part of the inputs.", Args: []pkgreflect.Arg{{Name: "publicKey", Tag: "PublicKey"}, {Name: "message", Tag: "[]byte"}, {Name: "sig", Tag: "[]byte"}}, Tag: "bool", Value: reflect.ValueOf(ed25519.Verify)},
+
+			"VerifyWithOptions": {Doc: "VerifyWithOptions reports whether sig is a valid signature of message by\npublicKey. A valid signature is indicated by returning a nil error. It will\npanic if len(publicKey) is not [PublicKeySize].\n\nIf opts.Hash is [crypto.SHA512], the pre-hashed variant Ed25519ph is used and\nmessage is expected to be a SHA-512 hash, otherwise opts.Hash must be\n[crypto.Hash](0) and the message must not be hashed, as Ed25519 performs two\npasses over messages to be signed.\n\nThe inputs are not considered confidential, and may leak through timing side\nchannels, or if an attacker has control of part of the inputs.", Args: []pkgreflect.Arg{{Name: "publicKey", Tag: "PublicKey"}, {Name: "message", Tag: "[]byte"}, {Name: "sig", Tag: "[]byte"}, {Name: "opts", Tag: "Options"}}, Tag: "error", Value: reflect.ValueOf(ed25519.VerifyWithOptions)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{
+			"PrivateKeySize": {Doc: "PrivateKeySize is the size, in bytes, of private keys as used in this package.", Value: reflect.ValueOf(ed25519.PrivateKeySize)},
+			"PublicKeySize":  {Doc: "PublicKeySize is the size, in bytes, of public keys as used in this package.", Value: reflect.ValueOf(ed25519.PublicKeySize)},
+			"SeedSize":       {Doc: "SeedSize is the size, in bytes, of private key seeds. These are the private key representations used by RFC 8032.", Value: reflect.ValueOf(ed25519.SeedSize)},
+			"SignatureSize":  {Doc: "SignatureSize is the size, in bytes, of signatures generated and verified by this package.", Value: reflect.ValueOf(ed25519.SignatureSize)},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.hmac
+  "Package hmac implements the Keyed-Hash Message Authentication Code (HMAC) as defined in U.S. Federal Information Processing Standards Publication 198."
+  (:refer-clojure :exclude [equal new]))
+
+(defn equal
+  "Equal compares two MACs for equality without leaking timing information."
+  {:arglists '([mac1 mac2]) :go "crypto/hmac.Equal"}
+  [mac1 mac2]
+  (lace.go.crypto.hmac/Equal mac1 mac2))
+
+(defn new
+  "New returns a new HMAC hash using the given [hash.Hash] type and key.
+New functions like [crypto/sha256.New] can be used as h.
+h must return a new Hash every time it is called.
+Note that unlike other hash implementations in the standard library,
+the returned Hash does not implement [encoding.BinaryMarshaler]
+or [encoding.BinaryUnmarshaler]."
+  {:arglists '([h key]) :go "crypto/hmac.New"}
+  [h key]
+  (lace.go.crypto.hmac/New h key))
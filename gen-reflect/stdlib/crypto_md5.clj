@@ -0,0 +1,20 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.md5
+  "Package md5 implements the MD5 hash algorithm as defined in RFC 1321."
+  (:refer-clojure :exclude [new sum]))
+
+(defn new
+  "New returns a new [hash.Hash] computing the MD5 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/md5.New"}
+  []
+  (lace.go.crypto.md5/New))
+
+(defn sum
+  "Sum returns the MD5 checksum of the data."
+  {:arglists '([data]) :go "crypto/md5.Sum"}
+  [data]
+  (lace.go.crypto.md5/Sum data))
@@ -0,0 +1,34 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.rand
+  "Package rand implements a cryptographically secure random number generator."
+  (:refer-clojure :exclude [int prime read]))
+
+(defn int
+  "Int returns a uniform random value in [0, max). It panics if max <= 0, and
+returns an error if rand.Read returns one."
+  {:arglists '([rand max]) :go "crypto/rand.Int"}
+  [rand max]
+  (lace.go.crypto.rand/Int rand max))
+
+(defn prime
+  "Prime returns a number of the given bit length that is prime with high probability.
+Prime will return error for any error returned by rand.Read or if bits < 2.
+
+Since Go 1.26, a secure source of random bytes is always used, and the Reader is
+ignored unless GODEBUG=cryptocustomrand=1 is set. This setting will be removed
+in a future Go release. Instead, use [testing/cryptotest.SetGlobalRandom]."
+  {:arglists '([r bits]) :go "crypto/rand.Prime"}
+  [r bits]
+  (lace.go.crypto.rand/Prime r bits))
+
+(defn read
+  "Read fills b with cryptographically secure random bytes. It never returns an
+error, and always fills b entirely.
+
+Read calls [io.ReadFull] on [Reader] and crashes the program irrecoverably if
+an error is returned. The default Reader uses operating system APIs that are
+documented to never return an error on all but legacy Linux systems."
+  {:arglists '([b]) :go "crypto/rand.Read"}
+  [b]
+  (lace.go.crypto.rand/Read b))
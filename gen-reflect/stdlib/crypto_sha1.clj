@@ -0,0 +1,20 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.sha1
+  "Package sha1 implements the SHA-1 hash algorithm as defined in RFC 3174."
+  (:refer-clojure :exclude [new sum]))
+
+(defn new
+  "New returns a new [hash.Hash] computing the SHA1 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha1.New"}
+  []
+  (lace.go.crypto.sha1/New))
+
+(defn sum
+  "Sum returns the SHA-1 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha1.Sum"}
+  [data]
+  (lace.go.crypto.sha1/Sum data))
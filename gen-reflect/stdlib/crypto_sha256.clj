@@ -0,0 +1,35 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.sha256
+  "Package sha256 implements the SHA224 and SHA256 hash algorithms as defined in FIPS 180-4."
+  (:refer-clojure :exclude [new new224 sum224 sum256]))
+
+(defn new
+  "New returns a new [hash.Hash] computing the SHA256 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha256.New"}
+  []
+  (lace.go.crypto.sha256/New))
+
+(defn new224
+  "New224 returns a new [hash.Hash] computing the SHA224 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha256.New224"}
+  []
+  (lace.go.crypto.sha256/New224))
+
+(defn sum224
+  "Sum224 returns the SHA224 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha256.Sum224"}
+  [data]
+  (lace.go.crypto.sha256/Sum224 data))
+
+(defn sum256
+  "Sum256 returns the SHA256 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha256.Sum256"}
+  [data]
+  (lace.go.crypto.sha256/Sum256 data))
@@ -0,0 +1,65 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.sha512
+  "Package sha512 implements the SHA-384, SHA-512, SHA-512/224, and SHA-512/256 hash algorithms as defined in FIPS 180-4."
+  (:refer-clojure :exclude [new new384 new512_224 new512_256 sum384 sum512 sum512_224 sum512_256]))
+
+(defn new
+  "New returns a new [hash.Hash] computing the SHA-512 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha512.New"}
+  []
+  (lace.go.crypto.sha512/New))
+
+(defn new384
+  "New384 returns a new [hash.Hash] computing the SHA-384 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha512.New384"}
+  []
+  (lace.go.crypto.sha512/New384))
+
+(defn new512_224
+  "New512_224 returns a new [hash.Hash] computing the SHA-512/224 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha512.New512_224"}
+  []
+  (lace.go.crypto.sha512/New512_224))
+
+(defn new512_256
+  "New512_256 returns a new [hash.Hash] computing the SHA-512/256 checksum. The Hash
+also implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and
+[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal
+state of the hash."
+  {:arglists '([]) :go "crypto/sha512.New512_256"}
+  []
+  (lace.go.crypto.sha512/New512_256))
+
+(defn sum384
+  "Sum384 returns the SHA384 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha512.Sum384"}
+  [data]
+  (lace.go.crypto.sha512/Sum384 data))
+
+(defn sum512
+  "Sum512 returns the SHA512 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha512.Sum512"}
+  [data]
+  (lace.go.crypto.sha512/Sum512 data))
+
+(defn sum512_224
+  "Sum512_224 returns the Sum512/224 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha512.Sum512_224"}
+  [data]
+  (lace.go.crypto.sha512/Sum512_224 data))
+
+(defn sum512_256
+  "Sum512_256 returns the Sum512/256 checksum of the data."
+  {:arglists '([data]) :go "crypto/sha512.Sum512_256"}
+  [data]
+  (lace.go.crypto.sha512/Sum512_256 data))
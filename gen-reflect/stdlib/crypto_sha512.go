@@ -0,0 +1,51 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	sha512 "crypto/sha512"
+)
+
+//go:embed crypto_sha512.clj
+var wrapper_crypto_sha512 string
+
+func init() {
+	pkgreflect.AddPackage("lace.go.crypto.sha512", &pkgreflect.Package{
+		Doc:       "Package sha512 implements the SHA-384, SHA-512, SHA-512/224, and SHA-512/256 hash algorithms as defined in FIPS 180-4.",
+		WrapperNS: "go.crypto.sha512",
+		Wrapper:   wrapper_crypto_sha512,
+		Types:     map[string]pkgreflect.Type{},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"New": {Doc: "New returns a new [hash.Hash] computing the SHA-512 checksum. The Hash\nalso implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and\n[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal\nstate of the hash.", Args: []pkgreflect.Arg{}, Tag: "hash.Hash", Value: reflect.ValueOf(sha512.New)},
+
+			"New384": {Doc: "New384 returns a new [hash.Hash] computing the SHA-384 checksum. The Hash\nalso implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and\n[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal\nstate of the hash.", Args: []pkgreflect.Arg{}, Tag: "hash.Hash", Value: reflect.ValueOf(sha512.New384)},
+
+			"New512_224": {Doc: "New512_224 returns a new [hash.Hash] computing the SHA-512/224 checksum. The Hash\nalso implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and\n[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal\nstate of the hash.", Args: []pkgreflect.Arg{}, Tag: "hash.Hash", Value: reflect.ValueOf(sha512.New512_224)},
+
+			"New512_256": {Doc: "New512_256 returns a new [hash.Hash] computing the SHA-512/256 checksum. The Hash\nalso implements [encoding.BinaryMarshaler], [encoding.BinaryAppender] and\n[encoding.BinaryUnmarshaler] to marshal and unmarshal the internal\nstate of the hash.", Args: []pkgreflect.Arg{}, Tag: "hash.Hash", Value: reflect.ValueOf(sha512.New512_256)},
+
+			"Sum384": {Doc: "Sum384 returns the SHA384 checksum of the data.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}}, Tag: "[]byte", Value: reflect.ValueOf(sha512.Sum384)},
+
+			"Sum512": {Doc: "Sum512 returns the SHA512 checksum of the data.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}}, Tag: "[]byte", Value: reflect.ValueOf(sha512.Sum512)},
+
+			"Sum512_224": {Doc: "Sum512_224 returns the Sum512/224 checksum of the data.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}}, Tag: "[]byte", Value: reflect.ValueOf(sha512.Sum512_224)},
+
+			"Sum512_256": {Doc: "Sum512_256 returns the Sum512/256 checksum of the data.", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}}, Tag: "[]byte", Value: reflect.ValueOf(sha512.Sum512_256)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{
+			"BlockSize": {Doc: "BlockSize is the block size, in bytes, of the SHA-512/224,\nSHA-512/256, SHA-384 and SHA-512 hash functions.", Value: reflect.ValueOf(sha512.BlockSize)},
+			"Size":      {Doc: "Size is the size, in bytes, of a SHA-512 checksum.", Value: reflect.ValueOf(sha512.Size)},
+			"Size224":   {Doc: "Size224 is the size, in bytes, of a SHA-512/224 checksum.", Value: reflect.ValueOf(sha512.Size224)},
+			"Size256":   {Doc: "Size256 is the size, in bytes, of a SHA-512/256 checksum.", Value: reflect.ValueOf(sha512.Size256)},
+			"Size384":   {Doc: "Size384 is the size, in bytes, of a SHA-384 checksum.", Value: reflect.ValueOf(sha512.Size384)},
+		},
+	})
+}
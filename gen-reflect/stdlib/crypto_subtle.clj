@@ -0,0 +1,61 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.subtle
+  "Package subtle implements functions that are often useful in cryptographic code but require careful thought to use correctly."
+  (:refer-clojure :exclude [constant-time-byte-eq constant-time-compare constant-time-copy constant-time-eq constant-time-less-or-eq constant-time-select xor-bytes]))
+
+(defn constant-time-byte-eq
+  "ConstantTimeByteEq returns 1 if x == y and 0 otherwise."
+  {:arglists '([x y]) :go "crypto/subtle.ConstantTimeByteEq"}
+  [x y]
+  (lace.go.crypto.subtle/ConstantTimeByteEq x y))
+
+(defn constant-time-compare
+  "ConstantTimeCompare returns 1 if the two slices, x and y, have equal contents
+and 0 otherwise. The time taken is a function of the length of the slices and
+is independent of the contents. If the lengths of x and y do not match it
+returns 0 immediately."
+  {:arglists '([x y]) :go "crypto/subtle.ConstantTimeCompare"}
+  [x y]
+  (lace.go.crypto.subtle/ConstantTimeCompare x y))
+
+(defn constant-time-copy
+  "ConstantTimeCopy copies the contents of y into x (a slice of equal length)
+if v == 1. If v == 0, x is left unchanged. Its behavior is undefined if v
+takes any other value."
+  {:arglists '([v x y]) :go "crypto/subtle.ConstantTimeCopy"}
+  [v x y]
+  (lace.go.crypto.subtle/ConstantTimeCopy v x y))
+
+(defn constant-time-eq
+  "ConstantTimeEq returns 1 if x == y and 0 otherwise."
+  {:arglists '([x y]) :go "crypto/subtle.ConstantTimeEq"}
+  [x y]
+  (lace.go.crypto.subtle/ConstantTimeEq x y))
+
+(defn constant-time-less-or-eq
+  "ConstantTimeLessOrEq returns 1 if x <= y and 0 otherwise.
+Its behavior is undefined if x or y are negative or > 2**31 - 1."
+  {:arglists '([x y]) :go "crypto/subtle.ConstantTimeLessOrEq"}
+  [x y]
+  (lace.go.crypto.subtle/ConstantTimeLessOrEq x y))
+
+(defn constant-time-select
+  "ConstantTimeSelect returns x if v == 1 and y if v == 0.
+Its behavior is undefined if v takes any other value."
+  {:arglists '([v x y]) :go "crypto/subtle.ConstantTimeSelect"}
+  [v x y]
+  (lace.go.crypto.subtle/ConstantTimeSelect v x y))
+
+(defn xor-bytes
+  "XORBytes sets dst[i] = x[i] ^ y[i] for all i < n = min(len(x), len(y)),
+returning n, the number of bytes written to dst.
+
+If dst does not have length at least n,
+XORBytes panics without writing anything to dst.
+
+dst and x or y may overlap exactly or not at all,
+otherwise XORBytes may panic."
+  {:arglists '([dst x y]) :go "crypto/subtle.XORBytes"}
+  [dst x y]
+  (lace.go.crypto.subtle/XORBytes dst x y))
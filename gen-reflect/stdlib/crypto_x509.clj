@@ -0,0 +1,380 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.crypto.x509
+  "Package x509 implements a subset of the X.509 standard."
+  (:refer-clojure :exclude [create-certificate create-certificate-request create-revocation-list decrypt-pem-block encrypt-pem-block encrypted-pem-block? marshal-ec-private-key marshal-pkcs1-private-key marshal-pkcs1-public-key marshal-pkcs8-private-key marshal-pkix-public-key new-cert-pool oid-from-ints parse-certificate parse-certificate-request parse-certificates parse-crl parse-dercrl parse-ec-private-key parse-pkcs1-private-key parse-pkcs1-public-key parse-pkcs8-private-key parse-pkix-public-key parse-revocation-list set-fallback-roots system-cert-pool]))
+
+(defn create-certificate
+  "CreateCertificate creates a new X.509 v3 certificate based on a template.
+The following members of template are currently used:
+
+  - AuthorityKeyId
+  - BasicConstraintsValid
+  - CRLDistributionPoints
+  - DNSNames
+  - EmailAddresses
+  - ExcludedDNSDomains
+  - ExcludedEmailAddresses
+  - ExcludedIPRanges
+  - ExcludedURIDomains
+  - ExtKeyUsage
+  - ExtraExtensions
+  - IPAddresses
+  - IsCA
+  - IssuingCertificateURL
+  - KeyUsage
+  - MaxPathLen
+  - MaxPathLenZero
+  - NotAfter
+  - NotBefore
+  - OCSPServer
+  - PermittedDNSDomains
+  - PermittedDNSDomainsCritical
+  - PermittedEmailAddresses
+  - PermittedIPRanges
+  - PermittedURIDomains
+  - PolicyIdentifiers (see note below)
+  - Policies (see note below)
+  - SerialNumber
+  - SignatureAlgorithm
+  - Subject
+  - SubjectKeyId
+  - URIs
+  - UnknownExtKeyUsage
+
+The certificate is signed by parent. If parent is equal to template then the
+certificate is self-signed. The parameter pub is the public key of the
+certificate to be generated and priv is the private key of the signer.
+
+The returned slice is the certificate in DER encoding.
+
+The currently supported key types are *rsa.PublicKey, *ecdsa.PublicKey,
+ed25519.PublicKey, and *mldsa.PublicKey. pub must be a supported key type,
+and priv must be a crypto.Signer or crypto.MessageSigner with a supported
+public key.
+
+The AuthorityKeyId will be taken from the SubjectKeyId of parent, if any,
+unless the resulting certificate is self-signed. Otherwise the value from
+template will be used.
+
+If SubjectKeyId from template is empty and the template is a CA, SubjectKeyId
+will be generated from the hash of the public key.
+
+If template.SerialNumber is nil, a serial number will be generated which
+conforms to RFC 5280, Section 4.1.2.2 using entropy from rand.
+
+The PolicyIdentifier and Policies fields can both be used to marshal certificate
+policy OIDs. By default, only the Policies is marshaled, but if the
+GODEBUG setting \"x509usepolicies\" has the value \"0\", the PolicyIdentifiers field will
+be marshaled instead of the Policies field. This changed in Go 1.24. The Policies field can
+be used to marshal policy OIDs which have components that are larger than 31
+bits.
+
+IP addresses in IPAddresses which are in their IPv4-mapped IPv6 form will always be encoded
+in their IPv4 form."
+  {:arglists '([rand template parent pub priv]) :go "crypto/x509.CreateCertificate"}
+  [rand template parent pub priv]
+  (lace.core/let [template (if (lace.core/map? template) (go/->struct lace.go.crypto.x509/Certificate template) template)
+                  parent (if (lace.core/map? parent) (go/->struct lace.go.crypto.x509/Certificate parent) parent)]
+    (lace.go.crypto.x509/CreateCertificate rand template parent pub priv)))
+
+(defn create-certificate-request
+  "CreateCertificateRequest creates a new certificate request based on a
+template. The following members of template are used:
+
+  - SignatureAlgorithm
+  - Subject
+  - DNSNames
+  - EmailAddresses
+  - IPAddresses
+  - URIs
+  - ExtraExtensions
+  - Attributes (deprecated)
+
+priv is the private key to sign the CSR with, and the corresponding public
+key will be included in the CSR. It must implement crypto.Signer or
+crypto.MessageSigner and its Public() method must return a *rsa.PublicKey or
+a *ecdsa.PublicKey or a ed25519.PublicKey or a *mldsa.PublicKey.
+(A *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey or
+*mldsa.PrivateKey satisfies this.)
+
+The returned slice is the certificate request in DER encoding."
+  {:arglists '([rand template priv]) :go "crypto/x509.CreateCertificateRequest"}
+  [rand template priv]
+  (lace.core/let [template (if (lace.core/map? template) (go/->struct lace.go.crypto.x509/CertificateRequest template) template)]
+    (lace.go.crypto.x509/CreateCertificateRequest rand template priv)))
+
+(defn create-revocation-list
+  "CreateRevocationList creates a new X.509 v2 [Certificate] Revocation List,
+according to RFC 5280, based on template.
+
+The CRL is signed by priv which should be a crypto.Signer or
+crypto.MessageSigner associated with the public key in the issuer
+certificate.
+
+The issuer may not be nil, and the crlSign bit must be set in [KeyUsage] in
+order to use it as a CRL issuer.
+
+The issuer distinguished name CRL field and authority key identifier
+extension are populated using the issuer certificate. issuer must have
+SubjectKeyId set."
+  {:arglists '([rand template issuer priv]) :go "crypto/x509.CreateRevocationList"}
+  [rand template issuer priv]
+  (lace.core/let [template (if (lace.core/map? template) (go/->struct lace.go.crypto.x509/RevocationList template) template)
+                  issuer (if (lace.core/map? issuer) (go/->struct lace.go.crypto.x509/Certificate issuer) issuer)]
+    (lace.go.crypto.x509/CreateRevocationList rand template issuer priv)))
+
+(defn decrypt-pem-block
+  "DecryptPEMBlock takes a PEM block encrypted according to RFC 1423 and the
+password used to encrypt it and returns a slice of decrypted DER encoded
+bytes. It inspects the DEK-Info header to determine the algorithm used for
+decryption. If no DEK-Info header is present, an error is returned. If an
+incorrect password is detected an [IncorrectPasswordError] is returned. Because
+of deficiencies in the format, it's not always possible to detect an
+incorrect password. In these cases no error will be returned but the
+decrypted DER bytes will be random noise.
+
+Deprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by
+design. Since it does not authenticate the ciphertext, it is vulnerable to
+padding oracle attacks that can let an attacker recover the plaintext."
+  {:arglists '([b password]) :go "crypto/x509.DecryptPEMBlock"}
+  [b password]
+  (lace.go.crypto.x509/DecryptPEMBlock b password))
+
+(defn encrypt-pem-block
+  "EncryptPEMBlock returns a PEM block of the specified type holding the
+given DER encoded data encrypted with the specified algorithm and
+password according to RFC 1423.
+
+Deprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by
+design. Since it does not authenticate the ciphertext, it is vulnerable to
+padding oracle attacks that can let an attacker recover the plaintext."
+  {:arglists '([rand block-type data password alg]) :go "crypto/x509.EncryptPEMBlock"}
+  [rand block-type data password alg]
+  (lace.go.crypto.x509/EncryptPEMBlock rand block-type data password alg))
+
+(defn encrypted-pem-block?
+  "IsEncryptedPEMBlock returns whether the PEM block is password encrypted
+according to RFC 1423.
+
+Deprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by
+design. Since it does not authenticate the ciphertext, it is vulnerable to
+padding oracle attacks that can let an attacker recover the plaintext."
+  {:arglists '([b]) :go "crypto/x509.IsEncryptedPEMBlock"}
+  [b]
+  (lace.go.crypto.x509/IsEncryptedPEMBlock b))
+
+(defn marshal-ec-private-key
+  "MarshalECPrivateKey converts an EC private key to SEC 1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"EC PRIVATE KEY\".
+For a more flexible key format which is not EC specific, use
+[MarshalPKCS8PrivateKey]."
+  {:arglists '([key]) :go "crypto/x509.MarshalECPrivateKey"}
+  [key]
+  (lace.go.crypto.x509/MarshalECPrivateKey key))
+
+(defn marshal-pkcs1-private-key
+  "MarshalPKCS1PrivateKey converts an [RSA] private key to PKCS #1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"RSA PRIVATE KEY\".
+For a more flexible key format which is not [RSA] specific, use
+[MarshalPKCS8PrivateKey].
+
+The key must have passed validation by calling [rsa.PrivateKey.Validate]
+first. MarshalPKCS1PrivateKey calls [rsa.PrivateKey.Precompute], which may
+modify the key if not already precomputed."
+  {:arglists '([key]) :go "crypto/x509.MarshalPKCS1PrivateKey"}
+  [key]
+  (lace.go.crypto.x509/MarshalPKCS1PrivateKey key))
+
+(defn marshal-pkcs1-public-key
+  "MarshalPKCS1PublicKey converts an [RSA] public key to PKCS #1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"RSA PUBLIC KEY\"."
+  {:arglists '([key]) :go "crypto/x509.MarshalPKCS1PublicKey"}
+  [key]
+  (lace.go.crypto.x509/MarshalPKCS1PublicKey key))
+
+(defn marshal-pkcs8-private-key
+  "MarshalPKCS8PrivateKey converts a private key to PKCS #8, ASN.1 DER form.
+
+The following key types are currently supported: *[rsa.PrivateKey],
+*[ecdsa.PrivateKey], [ed25519.PrivateKey] (not a pointer), *[mldsa.PrivateKey],
+and *[ecdh.PrivateKey]. Unsupported key types result in an error.
+
+This kind of key is commonly encoded in PEM blocks of type \"PRIVATE KEY\".
+
+MarshalPKCS8PrivateKey runs [rsa.PrivateKey.Precompute] on RSA keys."
+  {:arglists '([key]) :go "crypto/x509.MarshalPKCS8PrivateKey"}
+  [key]
+  (lace.go.crypto.x509/MarshalPKCS8PrivateKey key))
+
+(defn marshal-pkix-public-key
+  "MarshalPKIXPublicKey converts a public key to PKIX, ASN.1 DER form.
+The encoded public key is a SubjectPublicKeyInfo structure
+(see RFC 5280, Section 4.1).
+
+The following key types are currently supported: *[rsa.PublicKey],
+*[ecdsa.PublicKey], [ed25519.PublicKey] (not a pointer), *[mldsa.PublicKey],
+and *[ecdh.PublicKey]. Unsupported key types result in an error.
+
+This kind of key is commonly encoded in PEM blocks of type \"PUBLIC KEY\"."
+  {:arglists '([pub]) :go "crypto/x509.MarshalPKIXPublicKey"}
+  [pub]
+  (lace.go.crypto.x509/MarshalPKIXPublicKey pub))
+
+(defn new-cert-pool
+  "NewCertPool returns a new, empty CertPool."
+  {:arglists '([]) :go "crypto/x509.NewCertPool"}
+  []
+  (lace.go.crypto.x509/NewCertPool))
+
+(defn oid-from-ints
+  "OIDFromInts creates a new OID using ints, each integer is a separate component."
+  {:arglists '([oid]) :go "crypto/x509.OIDFromInts"}
+  [oid]
+  (lace.go.crypto.x509/OIDFromInts oid))
+
+(defn parse-certificate
+  "ParseCertificate parses a single certificate from the given ASN.1 DER data.
+
+Before Go 1.23, ParseCertificate accepted certificates with negative serial
+numbers. This behavior can be restored by including \"x509negativeserial=1\" in
+the GODEBUG environment variable."
+  {:arglists '([der]) :go "crypto/x509.ParseCertificate"}
+  [der]
+  (lace.go.crypto.x509/ParseCertificate der))
+
+(defn parse-certificate-request
+  "ParseCertificateRequest parses a single certificate request from the
+given ASN.1 DER data."
+  {:arglists '([asn1-data]) :go "crypto/x509.ParseCertificateRequest"}
+  [asn1-data]
+  (lace.go.crypto.x509/ParseCertificateRequest asn1-data))
+
+(defn parse-certificates
+  "ParseCertificates parses one or more certificates from the given ASN.1 DER
+data. The certificates must be concatenated with no intermediate padding."
+  {:arglists '([der]) :go "crypto/x509.ParseCertificates"}
+  [der]
+  (lace.go.crypto.x509/ParseCertificates der))
+
+(defn parse-crl
+  "ParseCRL parses a CRL from the given bytes. It's often the case that PEM
+encoded CRLs will appear where they should be DER encoded, so this function
+will transparently handle PEM encoding as long as there isn't any leading
+garbage.
+
+Deprecated: Use [ParseRevocationList] instead."
+  {:arglists '([crl-bytes]) :go "crypto/x509.ParseCRL"}
+  [crl-bytes]
+  (lace.go.crypto.x509/ParseCRL crl-bytes))
+
+(defn parse-dercrl
+  "ParseDERCRL parses a DER encoded CRL from the given bytes.
+
+Deprecated: Use [ParseRevocationList] instead."
+  {:arglists '([der-bytes]) :go "crypto/x509.ParseDERCRL"}
+  [der-bytes]
+  (lace.go.crypto.x509/ParseDERCRL der-bytes))
+
+(defn parse-ec-private-key
+  "ParseECPrivateKey parses an EC private key in SEC 1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"EC PRIVATE KEY\"."
+  {:arglists '([der]) :go "crypto/x509.ParseECPrivateKey"}
+  [der]
+  (lace.go.crypto.x509/ParseECPrivateKey der))
+
+(defn parse-pkcs1-private-key
+  "ParsePKCS1PrivateKey parses an [RSA] private key in PKCS #1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"RSA PRIVATE KEY\".
+
+Before Go 1.24, the CRT parameters were ignored and recomputed. To restore
+the old behavior, use the GODEBUG=x509rsacrt=0 environment variable."
+  {:arglists '([der]) :go "crypto/x509.ParsePKCS1PrivateKey"}
+  [der]
+  (lace.go.crypto.x509/ParsePKCS1PrivateKey der))
+
+(defn parse-pkcs1-public-key
+  "ParsePKCS1PublicKey parses an [RSA] public key in PKCS #1, ASN.1 DER form.
+
+This kind of key is commonly encoded in PEM blocks of type \"RSA PUBLIC KEY\"."
+  {:arglists '([der]) :go "crypto/x509.ParsePKCS1PublicKey"}
+  [der]
+  (lace.go.crypto.x509/ParsePKCS1PublicKey der))
+
+(defn parse-pkcs8-private-key
+  "ParsePKCS8PrivateKey parses an unencrypted private key in PKCS #8, ASN.1 DER form.
+
+It returns a *[rsa.PrivateKey], an *[ecdsa.PrivateKey], an [ed25519.PrivateKey] (not
+a pointer), a *[mldsa.PrivateKey], or an *[ecdh.PrivateKey] (for X25519).
+More types might be supported in the future.
+
+This kind of key is commonly encoded in PEM blocks of type \"PRIVATE KEY\".
+
+Before Go 1.24, the CRT parameters of RSA keys were ignored and recomputed.
+To restore the old behavior, use the GODEBUG=x509rsacrt=0 environment variable."
+  {:arglists '([der]) :go "crypto/x509.ParsePKCS8PrivateKey"}
+  [der]
+  (lace.go.crypto.x509/ParsePKCS8PrivateKey der))
+
+(defn parse-pkix-public-key
+  "ParsePKIXPublicKey parses a public key in PKIX, ASN.1 DER form. The encoded
+public key is a SubjectPublicKeyInfo structure (see RFC 5280, Section 4.1).
+
+It returns a *[rsa.PublicKey], *[dsa.PublicKey], *[ecdsa.PublicKey],
+[ed25519.PublicKey] (not a pointer), *[mldsa.PublicKey], or *[ecdh.PublicKey]
+(for X25519). More types might be supported in the future.
+
+This kind of key is commonly encoded in PEM blocks of type \"PUBLIC KEY\"."
+  {:arglists '([der-bytes]) :go "crypto/x509.ParsePKIXPublicKey"}
+  [der-bytes]
+  (lace.go.crypto.x509/ParsePKIXPublicKey der-bytes))
+
+(defn parse-revocation-list
+  "ParseRevocationList parses a X509 v2 [Certificate] Revocation List from the given
+ASN.1 DER data."
+  {:arglists '([der]) :go "crypto/x509.ParseRevocationList"}
+  [der]
+  (lace.go.crypto.x509/ParseRevocationList der))
+
+(defn set-fallback-roots
+  "SetFallbackRoots sets the roots to use during certificate verification, if no
+custom roots are specified and a platform verifier or a system certificate
+pool is not available (for instance in a container which does not have a root
+certificate bundle). SetFallbackRoots will panic if roots is nil.
+
+SetFallbackRoots may only be called once, if called multiple times it will
+panic.
+
+The fallback behavior can be forced on all platforms, even when there is a
+system certificate pool, by setting GODEBUG=x509usefallbackroots=1 (note that
+on Windows and macOS this will disable usage of the platform verification
+APIs and cause the pure Go verifier to be used). Setting
+x509usefallbackroots=1 without calling SetFallbackRoots has no effect."
+  {:arglists '([roots]) :go "crypto/x509.SetFallbackRoots"}
+  [roots]
+  (lace.go.crypto.x509/SetFallbackRoots roots))
+
+(defn system-cert-pool
+  "SystemCertPool returns a copy of the system cert pool.
+
+The environment variables SSL_CERT_FILE and SSL_CERT_DIR can be used to
+override the system default locations for the SSL certificate file and SSL
+certificate files directory, respectively. The latter can be a
+colon-separated list, or a semicolon-separated list on Windows. On platforms
+which have system APIs for certificate verification (macOS and Windows),
+setting SSL_CERT_FILE or SSL_CERT_DIR will prevent those APIs from being
+used, unless the x509sslcertoverrideplatform=0 GODEBUG setting is used. (This
+changed in Go 1.27.)
+
+Any mutations to the returned pool are not written to disk and do not affect
+any other pool returned by SystemCertPool.
+
+New changes in the system cert pool might not be reflected in subsequent calls."
+  {:arglists '([]) :go "crypto/x509.SystemCertPool"}
+  []
+  (lace.go.crypto.x509/SystemCertPool))
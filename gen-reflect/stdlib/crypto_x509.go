@@ -0,0 +1,214 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	x509 "crypto/x509"
+)
+
+//go:embed crypto_x509.clj
+var wrapper_crypto_x509 string
+
+func init() {
+	CertPool_methods := map[string]pkgreflect.Func{}
+	OID_methods := map[string]pkgreflect.Func{}
+	PEMCipher_methods := map[string]pkgreflect.Func{}
+	CertificateInvalidError_methods := map[string]pkgreflect.Func{}
+	HostnameError_methods := map[string]pkgreflect.Func{}
+	InvalidReason_methods := map[string]pkgreflect.Func{}
+	SystemRootsError_methods := map[string]pkgreflect.Func{}
+	UnknownAuthorityError_methods := map[string]pkgreflect.Func{}
+	VerifyOptions_methods := map[string]pkgreflect.Func{}
+	Certificate_methods := map[string]pkgreflect.Func{}
+	CertificateRequest_methods := map[string]pkgreflect.Func{}
+	ConstraintViolationError_methods := map[string]pkgreflect.Func{}
+	ExtKeyUsage_methods := map[string]pkgreflect.Func{}
+	InsecureAlgorithmError_methods := map[string]pkgreflect.Func{}
+	KeyUsage_methods := map[string]pkgreflect.Func{}
+	PublicKeyAlgorithm_methods := map[string]pkgreflect.Func{}
+	RevocationList_methods := map[string]pkgreflect.Func{}
+	RevocationListEntry_methods := map[string]pkgreflect.Func{}
+	SignatureAlgorithm_methods := map[string]pkgreflect.Func{}
+	UnhandledCriticalExtension_methods := map[string]pkgreflect.Func{}
+	CertPool_methods["Clone"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "CertPool", Doc: "Clone returns a copy of s."}
+	CertPool_methods["AddCert"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "cert", Tag: "Certificate"}}, Tag: "any", Doc: "AddCert adds a certificate to a pool."}
+	CertPool_methods["AppendCertsFromPEM"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "pemCerts", Tag: "[]byte"}}, Tag: "bool", Doc: "AppendCertsFromPEM attempts to parse a series of PEM encoded certificates.\nIt appends any certificates found to s and reports whether any certificates\nwere successfully parsed.\n\nOn many Linux systems, /etc/ssl/cert.pem will contain the system wide set\nof root CAs in a format suitable for this function."}
+	CertPool_methods["Subjects"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[][]byte", Doc: "Subjects returns a list of the DER-encoded subjects of\nall of the certificates in the pool.\n\nDeprecated: if s was returned by [SystemCertPool], Subjects\nwill not include the system roots."}
+	CertPool_methods["Equal"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "other", Tag: "CertPool"}}, Tag: "bool", Doc: "Equal reports whether s and other are equal."}
+	CertPool_methods["AddCertWithConstraint"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "cert", Tag: "Certificate"}, {Name: "constraint", Tag: "Unknown"}}, Tag: "any", Doc: "AddCertWithConstraint adds a certificate to the pool with the additional\nconstraint. When Certificate.Verify builds a chain which is rooted by cert,\nit will additionally pass the whole chain to constraint to determine its\nvalidity. If constraint returns a non-nil error, the chain will be discarded.\nconstraint may be called concurrently from multiple goroutines."}
+	OID_methods["Equal"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "other", Tag: "OID"}}, Tag: "bool", Doc: "Equal returns true when oid and other represents the same Object Identifier."}
+	OID_methods["EqualASN1OID"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "other", Tag: "asn1.ObjectIdentifier"}}, Tag: "bool", Doc: "EqualASN1OID returns whether an OID equals an asn1.ObjectIdentifier. If\nasn1.ObjectIdentifier cannot represent the OID specified by oid, because\na component of OID requires more than 31 bits, it returns false."}
+	OID_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns the string representation of the Object Identifier."}
+	CertificateInvalidError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	HostnameError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	UnknownAuthorityError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	SystemRootsError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	SystemRootsError_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	Certificate_methods["Verify"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "opts", Tag: "VerifyOptions"}}, Tag: "any", Doc: "Verify attempts to verify c by building one or more chains from c to a\ncertificate in opts.Roots, using certificates in opts.Intermediates if\nneeded. If successful, it returns one or more chains where the first\nelement of the chain is c and the last element is from opts.Roots.\n\nIf opts.Roots is nil, the platform verifier might be used, and\nverification details might differ from what is described below. If system\nroots are unavailable the returned error will be of type SystemRootsError.\n\nName constraints in the intermediates will be applied to all names claimed\nin the chain, not just opts.DNSName. Thus it is invalid for a leaf to claim\nexample.com if an intermediate doesn't permit it, even if example.com is not\nthe name being validated. Note that DirectoryName constraints are not\nsupported.\n\nName constraint validation follows the rules from RFC 5280, with the\naddition that DNS name constraints may use the leading period format\ndefined for emails and URIs. When a constraint has a leading period\nit indicates that at least one additional label must be prepended to\nthe constrained name to be considered valid.\n\nExtended Key Usage values are enforced nested down a chain, so an intermediate\nor root that enumerates EKUs prevents a leaf from asserting an EKU not in that\nlist. (While this is not specified, it is common practice in order to limit\nthe types of certificates a CA can issue.)\n\nCertificates that use SHA1WithRSA and ECDSAWithSHA1 signatures are not supported,\nand will not be used to build chains.\n\nCertificates other than c in the returned chains should not be modified.\n\nWARNING: this function doesn't do any revocation checking."}
+	Certificate_methods["VerifyHostname"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "h", Tag: "string"}}, Tag: "error", Doc: "VerifyHostname returns nil if c is a valid certificate for the named host.\nOtherwise it returns an error describing the mismatch.\n\nIP addresses can be optionally enclosed in square brackets and are checked\nagainst the IPAddresses field. Other names are checked case insensitively\nagainst the DNSNames field. If the names are valid hostnames, the certificate\nfields can have a wildcard as the complete left-most label (e.g. *.example.com).\n\nNote that the legacy Common Name field is ignored."}
+	SignatureAlgorithm_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	PublicKeyAlgorithm_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	InsecureAlgorithmError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	ConstraintViolationError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Certificate_methods["Equal"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "other", Tag: "Certificate"}}, Tag: "bool", Doc: ""}
+	Certificate_methods["CheckSignatureFrom"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "parent", Tag: "Certificate"}}, Tag: "error", Doc: "CheckSignatureFrom verifies that the signature on c is a valid signature from parent.\n\nThis is a low-level API that performs very limited checks, and not a full\npath verifier. Most users should use [Certificate.Verify] instead."}
+	Certificate_methods["CheckSignature"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "algo", Tag: "SignatureAlgorithm"}, {Name: "signed", Tag: "[]byte"}, {Name: "signature", Tag: "[]byte"}}, Tag: "error", Doc: "CheckSignature verifies that signature is a valid signature over signed from\nc's public key.\n\nThis is a low-level API that performs no validity checks on the certificate.\n\n[MD5WithRSA] signatures are rejected, while [SHA1WithRSA] and [ECDSAWithSHA1]\nsignatures are currently accepted."}
+	Certificate_methods["CheckCRLSignature"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "crl", Tag: "pkix.CertificateList"}}, Tag: "error", Doc: "CheckCRLSignature checks that the signature in crl is from c.\n\nDeprecated: Use [RevocationList.CheckSignatureFrom] instead."}
+	UnhandledCriticalExtension_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Certificate_methods["CreateCRL"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "priv", Tag: "any"}, {Name: "revokedCerts", Tag: "[]pkix.RevokedCertificate"}, {Name: "now", Tag: "time.Time"}, {Name: "expiry", Tag: "time.Time"}}, Tag: "any", Doc: "CreateCRL returns a DER encoded CRL, signed by this Certificate, that\ncontains the given list of revoked certificates.\n\nDeprecated: this method does not generate an RFC 5280 conformant X.509 v2 CRL.\nTo generate a standards compliant CRL, use [CreateRevocationList] instead."}
+	CertificateRequest_methods["CheckSignature"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "CheckSignature reports whether the signature on c is valid."}
+	RevocationList_methods["CheckSignatureFrom"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "parent", Tag: "Certificate"}}, Tag: "error", Doc: "CheckSignatureFrom verifies that the signature on rl is a valid signature\nfrom issuer."}
+	pkgreflect.AddPackage("lace.go.crypto.x509", &pkgreflect.Package{
+		Doc:       "Package x509 implements a subset of the X.509 standard.",
+		WrapperNS: "go.crypto.x509",
+		Wrapper:   wrapper_crypto_x509,
+		Types: map[string]pkgreflect.Type{
+			"CertPool":                   {Doc: "CertPool is a set of certificates.", Value: reflect.TypeOf((*x509.CertPool)(nil)).Elem(), Methods: CertPool_methods},
+			"OID":                        {Doc: "An OID represents an ASN.1 OBJECT IDENTIFIER.", Value: reflect.TypeOf((*x509.OID)(nil)).Elem(), Methods: OID_methods},
+			"PEMCipher":                  {Doc: "", Value: reflect.TypeOf((*x509.PEMCipher)(nil)).Elem(), Methods: PEMCipher_methods},
+			"CertificateInvalidError":    {Doc: "CertificateInvalidError results when an odd error occurs. Users of this\nlibrary probably want to handle all these errors uniformly.", Value: reflect.TypeOf((*x509.CertificateInvalidError)(nil)).Elem(), Methods: CertificateInvalidError_methods},
+			"HostnameError":              {Doc: "HostnameError results when the set of authorized names doesn't match the\nrequested name.", Value: reflect.TypeOf((*x509.HostnameError)(nil)).Elem(), Methods: HostnameError_methods},
+			"InvalidReason":              {Doc: "", Value: reflect.TypeOf((*x509.InvalidReason)(nil)).Elem(), Methods: InvalidReason_methods},
+			"SystemRootsError":           {Doc: "SystemRootsError results when we fail to load the system root certificates.", Value: reflect.TypeOf((*x509.SystemRootsError)(nil)).Elem(), Methods: SystemRootsError_methods},
+			"UnknownAuthorityError":      {Doc: "UnknownAuthorityError results when the certificate issuer is unknown", Value: reflect.TypeOf((*x509.UnknownAuthorityError)(nil)).Elem(), Methods: UnknownAuthorityError_methods},
+			"VerifyOptions":              {Doc: "VerifyOptions contains parameters for Certificate.Verify.", Value: reflect.TypeOf((*x509.VerifyOptions)(nil)).Elem(), Methods: VerifyOptions_methods},
+			"Certificate":                {Doc: "A Certificate represents an X.509 certificate.", Value: reflect.TypeOf((*x509.Certificate)(nil)).Elem(), Methods: Certificate_methods},
+			"CertificateRequest":         {Doc: "CertificateRequest represents a PKCS #10, certificate signature request.", Value: reflect.TypeOf((*x509.CertificateRequest)(nil)).Elem(), Methods: CertificateRequest_methods},
+			"ConstraintViolationError":   {Doc: "ConstraintViolationError results when a requested usage is not permitted by\na certificate. For example: checking a signature when the public key isn't a\ncertificate signing key.", Value: reflect.TypeOf((*x509.ConstraintViolationError)(nil)).Elem(), Methods: ConstraintViolationError_methods},
+			"ExtKeyUsage":                {Doc: "ExtKeyUsage represents an extended set of actions that are valid for a given key.\nEach of the ExtKeyUsage* constants define a unique action.", Value: reflect.TypeOf((*x509.ExtKeyUsage)(nil)).Elem(), Methods: ExtKeyUsage_methods},
+			"InsecureAlgorithmError":     {Doc: "An InsecureAlgorithmError indicates that the [SignatureAlgorithm] used to\ngenerate the signature is not secure, and the signature has been rejected.", Value: reflect.TypeOf((*x509.InsecureAlgorithmError)(nil)).Elem(), Methods: InsecureAlgorithmError_methods},
+			"KeyUsage":                   {Doc: "KeyUsage represents the set of actions that are valid for a given key. It's\na bitmap of the KeyUsage* constants.", Value: reflect.TypeOf((*x509.KeyUsage)(nil)).Elem(), Methods: KeyUsage_methods},
+			"PublicKeyAlgorithm":         {Doc: "", Value: reflect.TypeOf((*x509.PublicKeyAlgorithm)(nil)).Elem(), Methods: PublicKeyAlgorithm_methods},
+			"RevocationList":             {Doc: "RevocationList represents a [Certificate] Revocation List (CRL) as specified\nby RFC 5280.", Value: reflect.TypeOf((*x509.RevocationList)(nil)).Elem(), Methods: RevocationList_methods},
+			"RevocationListEntry":        {Doc: "RevocationListEntry represents an entry in the revokedCertificates\nsequence of a CRL.", Value: reflect.TypeOf((*x509.RevocationListEntry)(nil)).Elem(), Methods: RevocationListEntry_methods},
+			"SignatureAlgorithm":         {Doc: "", Value: reflect.TypeOf((*x509.SignatureAlgorithm)(nil)).Elem(), Methods: SignatureAlgorithm_methods},
+			"UnhandledCriticalExtension": {Doc: "", Value: reflect.TypeOf((*x509.UnhandledCriticalExtension)(nil)).Elem(), Methods: UnhandledCriticalExtension_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"CreateCertificate": {Doc: "CreateCertificate creates a new X.509 v3 certificate based on a template.\nThe following members of template are currently used:\n\n  - AuthorityKeyId\n  - BasicConstraintsValid\n  - CRLDistributionPoints\n  - DNSNames\n  - EmailAddresses\n  - ExcludedDNSDomains\n  - ExcludedEmailAddresses\n  - ExcludedIPRanges\n  - ExcludedURIDomains\n  - ExtKeyUsage\n  - ExtraExtensions\n  - IPAddresses\n  - IsCA\n  - IssuingCertificateURL\n  - KeyUsage\n  - MaxPathLen\n  - MaxPathLenZero\n  - NotAfter\n  - NotBefore\n  - OCSPServer\n  - PermittedDNSDomains\n  - PermittedDNSDomainsCritical\n  - PermittedEmailAddresses\n  - PermittedIPRanges\n  - PermittedURIDomains\n  - PolicyIdentifiers (see note below)\n  - Policies (see note below)\n  - SerialNumber\n  - SignatureAlgorithm\n  - Subject\n  - SubjectKeyId\n  - URIs\n  - UnknownExtKeyUsage\n\nThe certificate is signed by parent. If parent is equal to template then the\ncertificate is self-signed. The parameter pub is the public key of the\ncertificate to be generated and priv is the private key of the signer.\n\nThe returned slice is the certificate in DER encoding.\n\nThe currently supported key types are *rsa.PublicKey, *ecdsa.PublicKey,\ned25519.PublicKey, and *mldsa.PublicKey. pub must be a supported key type,\nand priv must be a crypto.Signer or crypto.MessageSigner with a supported\npublic key.\n\nThe AuthorityKeyId will be taken from the SubjectKeyId of parent, if any,\nunless the resulting certificate is self-signed. Otherwise the value from\ntemplate will be used.\n\nIf SubjectKeyId from template is empty and the template is a CA, SubjectKeyId\nwill be generated from the hash of the public key.\n\nIf template.SerialNumber is nil, a serial number will be generated which\nconforms to RFC 5280, Section 4.1.2.2 using entropy from rand.\n\nThe PolicyIdentifier and Policies fields can both be used to marshal certificate\npolicy OIDs. By default, only the Policies is marshaled, but if the\nGODEBUG setting \"x509usepolicies\" has the value \"0\", the PolicyIdentifiers field will\nbe marshaled instead of the Policies field. This changed in Go 1.24. The Policies field can\nbe used to marshal policy OIDs which have components that are larger than 31\nbits.\n\nIP addresses in IPAddresses which are in their IPv4-mapped IPv6 form will always be encoded\nin their IPv4 form.", Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "template", Tag: "Certificate"}, {Name: "parent", Tag: "Certificate"}, {Name: "pub", Tag: "any"}, {Name: "priv", Tag: "any"}}, Tag: "any", Value: reflect.ValueOf(x509.CreateCertificate)},
+
+			"CreateCertificateRequest": {Doc: "CreateCertificateRequest creates a new certificate request based on a\ntemplate. The following members of template are used:\n\n  - SignatureAlgorithm\n  - Subject\n  - DNSNames\n  - EmailAddresses\n  - IPAddresses\n  - URIs\n  - ExtraExtensions\n  - Attributes (deprecated)\n\npriv is the private key to sign the CSR with, and the corresponding public\nkey will be included in the CSR. It must implement crypto.Signer or\ncrypto.MessageSigner and its Public() method must return a *rsa.PublicKey or\na *ecdsa.PublicKey or a ed25519.PublicKey or a *mldsa.PublicKey.\n(A *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey or\n*mldsa.PrivateKey satisfies this.)\n\nThe returned slice is the certificate request in DER encoding.", Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "template", Tag: "CertificateRequest"}, {Name: "priv", Tag: "any"}}, Tag: "any", Value: reflect.ValueOf(x509.CreateCertificateRequest)},
+
+			"CreateRevocationList": {Doc: "CreateRevocationList creates a new X.509 v2 [Certificate] Revocation List,\naccording to RFC 5280, based on template.\n\nThe CRL is signed by priv which should be a crypto.Signer or\ncrypto.MessageSigner associated with the public key in the issuer\ncertificate.\n\nThe issuer may not be nil, and the crlSign bit must be set in [KeyUsage] in\norder to use it as a CRL issuer.\n\nThe issuer distinguished name CRL field and authority key identifier\nextension are populated using the issuer certificate. issuer must have\nSubjectKeyId set.", Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "template", Tag: "RevocationList"}, {Name: "issuer", Tag: "Certificate"}, {Name: "priv", Tag: "crypto.Signer"}}, Tag: "any", Value: reflect.ValueOf(x509.CreateRevocationList)},
+
+			"DecryptPEMBlock": {Doc: "DecryptPEMBlock takes a PEM block encrypted according to RFC 1423 and the\npassword used to encrypt it and returns a slice of decrypted DER encoded\nbytes. It inspects the DEK-Info header to determine the algorithm used for\ndecryption. If no DEK-Info header is present, an error is returned. If an\nincorrect password is detected an [IncorrectPasswordError] is returned. Because\nof deficiencies in the format, it's not always possible to detect an\nincorrect password. In these cases no error will be returned but the\ndecrypted DER bytes will be random noise.\n\nDeprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by\ndesign. Since it does not authenticate the ciphertext, it is vulnerable to\npadding oracle attacks that can let an attacker recover the plaintext.", Args: []pkgreflect.Arg{{Name: "b", Tag: "pem.Block"}, {Name: "password", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.DecryptPEMBlock)},
+
+			"EncryptPEMBlock": {Doc: "EncryptPEMBlock returns a PEM block of the specified type holding the\ngiven DER encoded data encrypted with the specified algorithm and\npassword according to RFC 1423.\n\nDeprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by\ndesign. Since it does not authenticate the ciphertext, it is vulnerable to\npadding oracle attacks that can let an attacker recover the plaintext.", Args: []pkgreflect.Arg{{Name: "rand", Tag: "io.Reader"}, {Name: "blockType", Tag: "string"}, {Name: "data", Tag: "[]byte"}, {Name: "password", Tag: "[]byte"}, {Name: "alg", Tag: "PEMCipher"}}, Tag: "any", Value: reflect.ValueOf(x509.EncryptPEMBlock)},
+
+			"IsEncryptedPEMBlock": {Doc: "IsEncryptedPEMBlock returns whether the PEM block is password encrypted\naccording to RFC 1423.\n\nDeprecated: Legacy PEM encryption as specified in RFC 1423 is insecure by\ndesign. Since it does not authenticate the ciphertext, it is vulnerable to\npadding oracle attacks that can let an attacker recover the plaintext.", Args: []pkgreflect.Arg{{Name: "b", Tag: "pem.Block"}}, Tag: "bool", Value: reflect.ValueOf(x509.IsEncryptedPEMBlock)},
+
+			"MarshalECPrivateKey": {Doc: "MarshalECPrivateKey converts an EC private key to SEC 1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"EC PRIVATE KEY\".\nFor a more flexible key format which is not EC specific, use\n[MarshalPKCS8PrivateKey].", Args: []pkgreflect.Arg{{Name: "key", Tag: "ecdsa.PrivateKey"}}, Tag: "any", Value: reflect.ValueOf(x509.MarshalECPrivateKey)},
+
+			"MarshalPKCS1PrivateKey": {Doc: "MarshalPKCS1PrivateKey converts an [RSA] private key to PKCS #1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"RSA PRIVATE KEY\".\nFor a more flexible key format which is not [RSA] specific, use\n[MarshalPKCS8PrivateKey].\n\nThe key must have passed validation by calling [rsa.PrivateKey.Validate]\nfirst. MarshalPKCS1PrivateKey calls [rsa.PrivateKey.Precompute], which may\nmodify the key if not already precomputed.", Args: []pkgreflect.Arg{{Name: "key", Tag: "rsa.PrivateKey"}}, Tag: "[]byte", Value: reflect.ValueOf(x509.MarshalPKCS1PrivateKey)},
+
+			"MarshalPKCS1PublicKey": {Doc: "MarshalPKCS1PublicKey converts an [RSA] public key to PKCS #1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"RSA PUBLIC KEY\".", Args: []pkgreflect.Arg{{Name: "key", Tag: "rsa.PublicKey"}}, Tag: "[]byte", Value: reflect.ValueOf(x509.MarshalPKCS1PublicKey)},
+
+			"MarshalPKCS8PrivateKey": {Doc: "MarshalPKCS8PrivateKey converts a private key to PKCS #8, ASN.1 DER form.\n\nThe following key types are currently supported: *[rsa.PrivateKey],\n*[ecdsa.PrivateKey], [ed25519.PrivateKey] (not a pointer), *[mldsa.PrivateKey],\nand *[ecdh.PrivateKey]. Unsupported key types result in an error.\n\nThis kind of key is commonly encoded in PEM blocks of type \"PRIVATE KEY\".\n\nMarshalPKCS8PrivateKey runs [rsa.PrivateKey.Precompute] on RSA keys.", Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}}, Tag: "any", Value: reflect.ValueOf(x509.MarshalPKCS8PrivateKey)},
+
+			"MarshalPKIXPublicKey": {Doc: "MarshalPKIXPublicKey converts a public key to PKIX, ASN.1 DER form.\nThe encoded public key is a SubjectPublicKeyInfo structure\n(see RFC 5280, Section 4.1).\n\nThe following key types are currently supported: *[rsa.PublicKey],\n*[ecdsa.PublicKey], [ed25519.PublicKey] (not a pointer), *[mldsa.PublicKey],\nand *[ecdh.PublicKey]. Unsupported key types result in an error.\n\nThis kind of key is commonly encoded in PEM blocks of type \"PUBLIC KEY\".", Args: []pkgreflect.Arg{{Name: "pub", Tag: "any"}}, Tag: "any", Value: reflect.ValueOf(x509.MarshalPKIXPublicKey)},
+
+			"NewCertPool": {Doc: "NewCertPool returns a new, empty CertPool.", Args: []pkgreflect.Arg{}, Tag: "CertPool", Value: reflect.ValueOf(x509.NewCertPool)},
+
+			"OIDFromInts": {Doc: "OIDFromInts creates a new OID using ints, each integer is a separate component.", Args: []pkgreflect.Arg{{Name: "oid", Tag: "[]uint64"}}, Tag: "any", Value: reflect.ValueOf(x509.OIDFromInts)},
+
+			"ParseCRL": {Doc: "ParseCRL parses a CRL from the given bytes. It's often the case that PEM\nencoded CRLs will appear where they should be DER encoded, so this function\nwill transparently handle PEM encoding as long as there isn't any leading\ngarbage.\n\nDeprecated: Use [ParseRevocationList] instead.", Args: []pkgreflect.Arg{{Name: "crlBytes", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseCRL)},
+
+			"ParseCertificate": {Doc: "ParseCertificate parses a single certificate from the given ASN.1 DER data.\n\nBefore Go 1.23, ParseCertificate accepted certificates with negative serial\nnumbers. This behavior can be restored by including \"x509negativeserial=1\" in\nthe GODEBUG environment variable.", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseCertificate)},
+
+			"ParseCertificateRequest": {Doc: "ParseCertificateRequest parses a single certificate request from the\ngiven ASN.1 DER data.", Args: []pkgreflect.Arg{{Name: "asn1Data", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseCertificateRequest)},
+
+			"ParseCertificates": {Doc: "ParseCertificates parses one or more certificates from the given ASN.1 DER\ndata. The certificates must be concatenated with no intermediate padding.", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseCertificates)},
+
+			"ParseDERCRL": {Doc: "ParseDERCRL parses a DER encoded CRL from the given bytes.\n\nDeprecated: Use [ParseRevocationList] instead.", Args: []pkgreflect.Arg{{Name: "derBytes", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseDERCRL)},
+
+			"ParseECPrivateKey": {Doc: "ParseECPrivateKey parses an EC private key in SEC 1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"EC PRIVATE KEY\".", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseECPrivateKey)},
+
+			"ParsePKCS1PrivateKey": {Doc: "ParsePKCS1PrivateKey parses an [RSA] private key in PKCS #1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"RSA PRIVATE KEY\".\n\nBefore Go 1.24, the CRT parameters were ignored and recomputed. To restore\nthe old behavior, use the GODEBUG=x509rsacrt=0 environment variable.", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParsePKCS1PrivateKey)},
+
+			"ParsePKCS1PublicKey": {Doc: "ParsePKCS1PublicKey parses an [RSA] public key in PKCS #1, ASN.1 DER form.\n\nThis kind of key is commonly encoded in PEM blocks of type \"RSA PUBLIC KEY\".", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParsePKCS1PublicKey)},
+
+			"ParsePKCS8PrivateKey": {Doc: "ParsePKCS8PrivateKey parses an unencrypted private key in PKCS #8, ASN.1 DER form.\n\nIt returns a *[rsa.PrivateKey], an *[ecdsa.PrivateKey], an [ed25519.PrivateKey] (not\na pointer), a *[mldsa.PrivateKey], or an *[ecdh.PrivateKey] (for X25519).\nMore types might be supported in the future.\n\nThis kind of key is commonly encoded in PEM blocks of type \"PRIVATE KEY\".\n\nBefore Go 1.24, the CRT parameters of RSA keys were ignored and recomputed.\nTo restore the old behavior, use the GODEBUG=x509rsacrt=0 environment variable.", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParsePKCS8PrivateKey)},
+
+			"ParsePKIXPublicKey": {Doc: "ParsePKIXPublicKey parses a public key in PKIX, ASN.1 DER form. The encoded\npublic key is a SubjectPublicKeyInfo structure (see RFC 5280, Section 4.1).\n\nIt returns a *[rsa.PublicKey], *[dsa.PublicKey], *[ecdsa.PublicKey],\n[ed25519.PublicKey] (not a pointer), *[mldsa.PublicKey], or *[ecdh.PublicKey]\n(for X25519). More types might be supported in the future.\n\nThis kind of key is commonly encoded in PEM blocks of type \"PUBLIC KEY\".", Args: []pkgreflect.Arg{{Name: "derBytes", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParsePKIXPublicKey)},
+
+			"ParseRevocationList": {Doc: "ParseRevocationList parses a X509 v2 [Certificate] Revocation List from the given\nASN.1 DER data.", Args: []pkgreflect.Arg{{Name: "der", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(x509.ParseRevocationList)},
+
+			"SetFallbackRoots": {Doc: "SetFallbackRoots sets the roots to use during certificate verification, if no\ncustom roots are specified and a platform verifier or a system certificate\npool is not available (for instance in a container which does not have a root\ncertificate bundle). SetFallbackRoots will panic if roots is nil.\n\nSetFallbackRoots may only be called once, if called multiple times it will\npanic.\n\nThe fallback behavior can be forced on all platforms, even when there is a\nsystem certificate pool, by setting GODEBUG=x509usefallbackroots=1 (note that\non Windows and macOS this will disable usage of the platform verification\nAPIs and cause the pure Go verifier to be used). Setting\nx509usefallbackroots=1 without calling SetFallbackRoots has no effect.", Args: []pkgreflect.Arg{{Name: "roots", Tag: "CertPool"}}, Tag: "any", Value: reflect.ValueOf(x509.SetFallbackRoots)},
+
+			"SystemCertPool": {Doc: "SystemCertPool returns a copy of the system cert pool.\n\nThe environment variables SSL_CERT_FILE and SSL_CERT_DIR can be used to\noverride the system default locations for the SSL certificate file and SSL\ncertificate files directory, respectively. The latter can be a\ncolon-separated list, or a semicolon-separated list on Windows. On platforms\nwhich have system APIs for certificate verification (macOS and Windows),\nsetting SSL_CERT_FILE or SSL_CERT_DIR will prevent those APIs from being\nused, unless the x509sslcertoverrideplatform=0 GODEBUG setting is used. (This\nchanged in Go 1.27.)\n\nAny mutations to the returned pool are not written to disk and do not affect\nany other pool returned by SystemCertPool.\n\nNew changes in the system cert pool might not be reflected in subsequent calls.", Args: []pkgreflect.Arg{}, Tag: "any", Value: reflect.ValueOf(x509.SystemCertPool)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrUnsupportedAlgorithm": {Doc: "", Value: reflect.ValueOf(&x509.ErrUnsupportedAlgorithm)},
+			"IncorrectPasswordError":  {Doc: "", Value: reflect.ValueOf(&x509.IncorrectPasswordError)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"CANotAuthorizedForExtKeyUsage": {Doc: "CANotAuthorizedForExtKeyUsage results when an intermediate or root\ncertificate does not permit a requested extended key usage.", Value: reflect.ValueOf(x509.CANotAuthorizedForExtKeyUsage)},
+			"CANotAuthorizedForThisName":    {Doc: "CANotAuthorizedForThisName results when an intermediate or root\ncertificate has a name constraint which doesn't permit a DNS or\nother name (including IP address) in the leaf certificate.", Value: reflect.ValueOf(x509.CANotAuthorizedForThisName)},
+			"DSA":                           {Doc: "", Value: reflect.ValueOf(x509.DSA)},
+			"DSAWithSHA1":                   {Doc: "", Value: reflect.ValueOf(x509.DSAWithSHA1)},
+			"DSAWithSHA256":                 {Doc: "", Value: reflect.ValueOf(x509.DSAWithSHA256)},
+			"ECDSA":                         {Doc: "", Value: reflect.ValueOf(x509.ECDSA)},
+			"ECDSAWithSHA1":                 {Doc: "", Value: reflect.ValueOf(x509.ECDSAWithSHA1)},
+			"ECDSAWithSHA256":               {Doc: "", Value: reflect.ValueOf(x509.ECDSAWithSHA256)},
+			"ECDSAWithSHA384":               {Doc: "", Value: reflect.ValueOf(x509.ECDSAWithSHA384)},
+			"ECDSAWithSHA512":               {Doc: "", Value: reflect.ValueOf(x509.ECDSAWithSHA512)},
+			"Ed25519":                       {Doc: "", Value: reflect.ValueOf(x509.Ed25519)},
+			"Expired":                       {Doc: "Expired results when a certificate has expired, based on the time\ngiven in the VerifyOptions.", Value: reflect.ValueOf(x509.Expired)},
+			"ExtKeyUsageAny":                {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageAny)},
+			"ExtKeyUsageClientAuth":         {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageClientAuth)},
+			"ExtKeyUsageCodeSigning":        {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageCodeSigning)},
+			"ExtKeyUsageEmailProtection":    {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageEmailProtection)},
+			"ExtKeyUsageIPSECEndSystem":     {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageIPSECEndSystem)},
+			"ExtKeyUsageIPSECTunnel":        {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageIPSECTunnel)},
+			"ExtKeyUsageIPSECUser":          {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageIPSECUser)},
+			"ExtKeyUsageMicrosoftCommercialCodeSigning": {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageMicrosoftCommercialCodeSigning)},
+			"ExtKeyUsageMicrosoftKernelCodeSigning":     {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageMicrosoftKernelCodeSigning)},
+			"ExtKeyUsageMicrosoftServerGatedCrypto":     {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageMicrosoftServerGatedCrypto)},
+			"ExtKeyUsageNetscapeServerGatedCrypto":      {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageNetscapeServerGatedCrypto)},
+			"ExtKeyUsageOCSPSigning":                    {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageOCSPSigning)},
+			"ExtKeyUsageServerAuth":                     {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageServerAuth)},
+			"ExtKeyUsageTimeStamping":                   {Doc: "", Value: reflect.ValueOf(x509.ExtKeyUsageTimeStamping)},
+			"IncompatibleUsage":                         {Doc: "IncompatibleUsage results when the certificate's key usage indicates\nthat it may only be used for a different purpose.", Value: reflect.ValueOf(x509.IncompatibleUsage)},
+			"KeyUsageCRLSign":                           {Doc: "", Value: reflect.ValueOf(x509.KeyUsageCRLSign)},
+			"KeyUsageCertSign":                          {Doc: "", Value: reflect.ValueOf(x509.KeyUsageCertSign)},
+			"KeyUsageContentCommitment":                 {Doc: "", Value: reflect.ValueOf(x509.KeyUsageContentCommitment)},
+			"KeyUsageDataEncipherment":                  {Doc: "", Value: reflect.ValueOf(x509.KeyUsageDataEncipherment)},
+			"KeyUsageDecipherOnly":                      {Doc: "", Value: reflect.ValueOf(x509.KeyUsageDecipherOnly)},
+			"KeyUsageDigitalSignature":                  {Doc: "", Value: reflect.ValueOf(x509.KeyUsageDigitalSignature)},
+			"KeyUsageEncipherOnly":                      {Doc: "", Value: reflect.ValueOf(x509.KeyUsageEncipherOnly)},
+			"KeyUsageKeyAgreement":                      {Doc: "", Value: reflect.ValueOf(x509.KeyUsageKeyAgreement)},
+			"KeyUsageKeyEncipherment":                   {Doc: "", Value: reflect.ValueOf(x509.KeyUsageKeyEncipherment)},
+			"MD2WithRSA":                                {Doc: "", Value: reflect.ValueOf(x509.MD2WithRSA)},
+			"MD5WithRSA":                                {Doc: "", Value: reflect.ValueOf(x509.MD5WithRSA)},
+			"NameConstraintsWithoutSANs":                {Doc: "NameConstraintsWithoutSANs is a legacy error and is no longer returned.", Value: reflect.ValueOf(x509.NameConstraintsWithoutSANs)},
+			"NameMismatch":                              {Doc: "NameMismatch results when the subject name of a parent certificate\ndoes not match the issuer name in the child.", Value: reflect.ValueOf(x509.NameMismatch)},
+			"NotAuthorizedToSign":                       {Doc: "NotAuthorizedToSign results when a certificate is signed by another\nwhich isn't marked as a CA certificate.", Value: reflect.ValueOf(x509.NotAuthorizedToSign)},
+			"PEMCipher3DES":                             {Doc: "", Value: reflect.ValueOf(x509.PEMCipher3DES)},
+			"PEMCipherAES128":                           {Doc: "", Value: reflect.ValueOf(x509.PEMCipherAES128)},
+			"PEMCipherAES192":                           {Doc: "", Value: reflect.ValueOf(x509.PEMCipherAES192)},
+			"PEMCipherAES256":                           {Doc: "", Value: reflect.ValueOf(x509.PEMCipherAES256)},
+			"PEMCipherDES":                              {Doc: "", Value: reflect.ValueOf(x509.PEMCipherDES)},
+			"PureEd25519":                               {Doc: "", Value: reflect.ValueOf(x509.PureEd25519)},
+			"RSA":                                       {Doc: "", Value: reflect.ValueOf(x509.RSA)},
+			"SHA1WithRSA":                               {Doc: "", Value: reflect.ValueOf(x509.SHA1WithRSA)},
+			"SHA256WithRSA":                             {Doc: "", Value: reflect.ValueOf(x509.SHA256WithRSA)},
+			"SHA256WithRSAPSS":                          {Doc: "", Value: reflect.ValueOf(x509.SHA256WithRSAPSS)},
+			"SHA384WithRSA":                             {Doc: "", Value: reflect.ValueOf(x509.SHA384WithRSA)},
+			"SHA384WithRSAPSS":                          {Doc: "", Value: reflect.ValueOf(x509.SHA384WithRSAPSS)},
+			"SHA512WithRSA":                             {Doc: "", Value: reflect.ValueOf(x509.SHA512WithRSA)},
+			"SHA512WithRSAPSS":                          {Doc: "", Value: reflect.ValueOf(x509.SHA512WithRSAPSS)},
+			"TooManyConstraints":                        {Doc: "TooManyConstraints results when the number of comparison operations\nneeded to check a certificate exceeds the limit set by\nVerifyOptions.MaxConstraintComparisions. This limit exists to\nprevent pathological certificates can consuming excessive amounts of\nCPU time to verify.", Value: reflect.ValueOf(x509.TooManyConstraints)},
+			"TooManyIntermediates":                      {Doc: "TooManyIntermediates results when a path length constraint is\nviolated.", Value: reflect.ValueOf(x509.TooManyIntermediates)},
+			"UnconstrainedName":                         {Doc: "UnconstrainedName results when a CA certificate contains permitted\nname constraints, but leaf certificate contains a name of an\nunsupported or unconstrained type.", Value: reflect.ValueOf(x509.UnconstrainedName)},
+			"UnknownPublicKeyAlgorithm":                 {Doc: "", Value: reflect.ValueOf(x509.UnknownPublicKeyAlgorithm)},
+			"UnknownSignatureAlgorithm":                 {Doc: "", Value: reflect.ValueOf(x509.UnknownSignatureAlgorithm)},
+		},
+	})
+}
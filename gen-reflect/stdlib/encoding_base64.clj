@@ -0,0 +1,33 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.encoding.base64
+  "Package base64 implements base64 encoding as specified by RFC 4648."
+  (:refer-clojure :exclude [new-decoder new-encoder new-encoding]))
+
+(defn new-decoder
+  "NewDecoder constructs a new base64 stream decoder."
+  {:arglists '([enc r]) :go "encoding/base64.NewDecoder"}
+  [enc r]
+  (lace.go.encoding.base64/NewDecoder enc r))
+
+(defn new-encoder
+  "NewEncoder returns a new base64 stream encoder. Data written to
+the returned writer will be encoded using enc and then written to w.
+Base64 encodings operate in 4-byte blocks; when finished
+writing, the caller must Close the returned encoder to flush any
+partially written blocks."
+  {:arglists '([enc w]) :go "encoding/base64.NewEncoder"}
+  [enc w]
+  (lace.go.encoding.base64/NewEncoder enc w))
+
+(defn new-encoding
+  "NewEncoding returns a new padded Encoding defined by the given alphabet,
+which must be a 64-byte string that contains unique byte values and
+does not contain the padding character or CR / LF ('\\r', '\\n').
+The alphabet is treated as a sequence of byte values
+without any special treatment for multi-byte UTF-8.
+The resulting Encoding uses the default padding character ('='),
+which may be changed or disabled via [Encoding.WithPadding]."
+  {:arglists '([encoder]) :go "encoding/base64.NewEncoding"}
+  [encoder]
+  (lace.go.encoding.base64/NewEncoding encoder))
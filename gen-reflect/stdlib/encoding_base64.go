@@ -0,0 +1,59 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	base64 "encoding/base64"
+)
+
+//go:embed encoding_base64.clj
+var wrapper_encoding_base64 string
+
+func init() {
+	CorruptInputError_methods := map[string]pkgreflect.Func{}
+	Encoding_methods := map[string]pkgreflect.Func{}
+	Encoding_methods["WithPadding"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "padding", Tag: "rune"}}, Tag: "Encoding", Doc: "WithPadding creates a new encoding identical to enc except\nwith a specified padding character, or [NoPadding] to disable padding.\nThe padding character must not be '\\r' or '\\n',\nmust not be contained in the encoding's alphabet,\nmust not be negative, and must be a rune equal or below '\\xff'.\nPadding characters above '\\x7f' are encoded as their exact byte value\nrather than using the UTF-8 representation of the codepoint."}
+	Encoding_methods["Strict"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Encoding", Doc: "Strict creates a new encoding identical to enc except with\nstrict decoding enabled. In this mode, the decoder requires that\ntrailing padding bits are zero, as described in RFC 4648 section 3.5.\n\nNote that the input is still malleable, as new line characters\n(CR and LF) are still ignored."}
+	Encoding_methods["Encode"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "src", Tag: "[]byte"}}, Tag: "any", Doc: "Encode encodes src using the encoding enc,\nwriting [Encoding.EncodedLen](len(src)) bytes to dst.\n\nThe encoding pads the output to a multiple of 4 bytes,\nso Encode is not appropriate for use on individual blocks\nof a large data stream. Use [NewEncoder] instead."}
+	Encoding_methods["AppendEncode"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "src", Tag: "[]byte"}}, Tag: "[]byte", Doc: "AppendEncode appends the base64 encoded src to dst\nand returns the extended buffer."}
+	Encoding_methods["EncodeToString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "[]byte"}}, Tag: "string", Doc: "EncodeToString returns the base64 encoding of src."}
+	Encoding_methods["EncodedLen"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "int", Doc: "EncodedLen returns the length in bytes of the base64 encoding\nof an input buffer of length n."}
+	CorruptInputError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Encoding_methods["AppendDecode"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "src", Tag: "[]byte"}}, Tag: "any", Doc: "AppendDecode appends the base64 decoded src to dst\nand returns the extended buffer.\nIf the input is malformed, it returns the partially decoded src and an error.\nNew line characters (\\r and \\n) are ignored."}
+	Encoding_methods["DecodeString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Doc: "DecodeString returns the bytes represented by the base64 string s.\nIf the input is malformed, it returns the partially decoded data and\n[CorruptInputError]. New line characters (\\r and \\n) are ignored."}
+	Encoding_methods["Decode"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "src", Tag: "[]byte"}}, Tag: "any", Doc: "Decode decodes src using the encoding enc. It writes at most\n[Encoding.DecodedLen](len(src)) bytes to dst and returns the number of bytes\nwritten. The caller must ensure that dst is large enough to hold all\nthe decoded data. If src contains invalid base64 data, it will return the\nnumber of bytes successfully written and [CorruptInputError].\nNew line characters (\\r and \\n) are ignored."}
+	Encoding_methods["DecodedLen"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "int", Doc: "DecodedLen returns the maximum length in bytes of the decoded data\ncorresponding to n bytes of base64-encoded data."}
+	pkgreflect.AddPackage("lace.go.encoding.base64", &pkgreflect.Package{
+		Doc:       "Package base64 implements base64 encoding as specified by RFC 4648.",
+		WrapperNS: "go.encoding.base64",
+		Wrapper:   wrapper_encoding_base64,
+		Types: map[string]pkgreflect.Type{
+			"CorruptInputError": {Doc: "", Value: reflect.TypeOf((*base64.CorruptInputError)(nil)).Elem(), Methods: CorruptInputError_methods},
+			"Encoding":          {Doc: "An Encoding is a radix 64 encoding/decoding scheme, defined by a\n64-character alphabet. The most common encoding is the \"base64\"\nencoding defined in RFC 4648 and used in MIME (RFC 2045) and PEM\n(RFC 1421).  RFC 4648 also defines an alternate encoding, which is\nthe standard encoding with - and _ substituted for + and /.", Value: reflect.TypeOf((*base64.Encoding)(nil)).Elem(), Methods: Encoding_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewDecoder": {Doc: "NewDecoder constructs a new base64 stream decoder.", Args: []pkgreflect.Arg{{Name: "enc", Tag: "Encoding"}, {Name: "r", Tag: "io.Reader"}}, Tag: "io.Reader", Value: reflect.ValueOf(base64.NewDecoder)},
+
+			"NewEncoder": {Doc: "NewEncoder returns a new base64 stream encoder. Data written to\nthe returned writer will be encoded using enc and then written to w.\nBase64 encodings operate in 4-byte blocks; when finished\nwriting, the caller must Close the returned encoder to flush any\npartially written blocks.", Args: []pkgreflect.Arg{{Name: "enc", Tag: "Encoding"}, {Name: "w", Tag: "io.Writer"}}, Tag: "io.WriteCloser", Value: reflect.ValueOf(base64.NewEncoder)},
+
+			"NewEncoding": {Doc: "NewEncoding returns a new padded Encoding defined by the given alphabet,\nwhich must be a 64-byte string that contains unique byte values and\ndoes not contain the padding character or CR / LF ('\\r', '\\n').\nThe alphabet is treated as a sequence of byte values\nwithout any special treatment for multi-byte UTF-8.\nThe resulting Encoding uses the default padding character ('='),\nwhich may be changed or disabled via [Encoding.WithPadding].", Args: []pkgreflect.Arg{{Name: "encoder", Tag: "string"}}, Tag: "Encoding", Value: reflect.ValueOf(base64.NewEncoding)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"RawStdEncoding": {Doc: "", Value: reflect.ValueOf(&base64.RawStdEncoding)},
+			"RawURLEncoding": {Doc: "", Value: reflect.ValueOf(&base64.RawURLEncoding)},
+			"StdEncoding":    {Doc: "", Value: reflect.ValueOf(&base64.StdEncoding)},
+			"URLEncoding":    {Doc: "", Value: reflect.ValueOf(&base64.URLEncoding)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"NoPadding":  {Doc: "", Value: reflect.ValueOf(base64.NoPadding)},
+			"StdPadding": {Doc: "", Value: reflect.ValueOf(base64.StdPadding)},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.encoding.csv
+  "Package csv reads and writes comma-separated values (CSV) files."
+  (:refer-clojure :exclude [new-reader new-writer]))
+
+(defn new-reader
+  "NewReader returns a new Reader that reads from r."
+  {:arglists '([r]) :go "encoding/csv.NewReader"}
+  [r]
+  (lace.go.encoding.csv/NewReader r))
+
+(defn new-writer
+  "NewWriter returns a new Writer that writes to w."
+  {:arglists '([w]) :go "encoding/csv.NewWriter"}
+  [w]
+  (lace.go.encoding.csv/NewWriter w))
@@ -0,0 +1,55 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	csv "encoding/csv"
+)
+
+//go:embed encoding_csv.clj
+var wrapper_encoding_csv string
+
+func init() {
+	ParseError_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Writer_methods := map[string]pkgreflect.Func{}
+	ParseError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	ParseError_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	Reader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Read reads one record (a slice of fields) from r.\nIf the record has an unexpected number of fields,\nRead returns the record along with the error [ErrFieldCount].\nIf the record contains a field that cannot be parsed,\nRead returns a partial record along with the parse error.\nThe partial record contains all fields read before the error.\nIf there is no data left to be read, Read returns nil, [io.EOF].\nIf [Reader.ReuseRecord] is true, the returned slice may be shared\nbetween multiple calls to Read."}
+	Reader_methods["FieldPos"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "field", Tag: "int"}}, Tag: "int", Doc: "FieldPos returns the line and column corresponding to\nthe start of the field with the given index in the slice most recently\nreturned by [Reader.Read]. Numbering of lines and columns starts at 1;\ncolumns are counted in bytes, not runes.\n\nIf this is called with an out-of-bounds index, it panics."}
+	Reader_methods["InputOffset"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int64", Doc: "InputOffset returns the input stream byte offset of the current reader\nposition. The offset gives the location of the end of the most recently\nread row and the beginning of the next row."}
+	Reader_methods["ReadAll"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadAll reads all the remaining records from r.\nEach record is a slice of fields.\nA successful call returns err == nil, not err == [io.EOF]. Because ReadAll is\ndefined to read until EOF, it does not treat end of file as an error to be\nreported."}
+	Writer_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "record", Tag: "[]string"}}, Tag: "error", Doc: "Write writes a single CSV record to w along with any necessary quoting.\nA record is a slice of strings with each string being one field.\nWrites are buffered, so [Writer.Flush] must eventually be called to ensure\nthat the record is written to the underlying [io.Writer]."}
+	Writer_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Flush writes any buffered data to the underlying [io.Writer].\nTo check if an error occurred during Flush, call [Writer.Error]."}
+	Writer_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Error reports any error that has occurred during\na previous [Writer.Write] or [Writer.Flush]."}
+	Writer_methods["WriteAll"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "records", Tag: "[][]string"}}, Tag: "error", Doc: "WriteAll writes multiple CSV records to w using [Writer.Write] and\nthen calls [Writer.Flush], returning any error from the Flush."}
+	pkgreflect.AddPackage("lace.go.encoding.csv", &pkgreflect.Package{
+		Doc:       "Package csv reads and writes comma-separated values (CSV) files.",
+		WrapperNS: "go.encoding.csv",
+		Wrapper:   wrapper_encoding_csv,
+		Types: map[string]pkgreflect.Type{
+			"ParseError": {Doc: "A ParseError is returned for parsing errors.\nLine and column numbers are 1-indexed.", Value: reflect.TypeOf((*csv.ParseError)(nil)).Elem(), Methods: ParseError_methods},
+			"Reader":     {Doc: "A Reader reads records from a CSV-encoded file.\n\nAs returned by [NewReader], a Reader expects input conforming to RFC 4180.\nThe exported fields can be changed to customize the details before the\nfirst call to [Reader.Read] or [Reader.ReadAll].\n\nThe Reader converts all \\r\\n sequences in its input to plain \\n,\nincluding in multiline field values, so that the returned data does\nnot depend on which line-ending convention an input file uses.", Value: reflect.TypeOf((*csv.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Writer":     {Doc: "A Writer writes records using CSV encoding.\n\nAs returned by [NewWriter], a Writer writes records terminated by a\nnewline and uses ',' as the field delimiter. The exported fields can be\nchanged to customize the details before\nthe first call to [Writer.Write] or [Writer.WriteAll].\n\n[Writer.Comma] is the field delimiter.\n\nIf [Writer.UseCRLF] is true,\nthe Writer ends each output line with \\r\\n instead of \\n.\n\nThe writes of individual records are buffered.\nAfter all data has been written, the client should call the\n[Writer.Flush] method to guarantee all data has been forwarded to\nthe underlying [io.Writer].  Any errors that occurred should\nbe checked by calling the [Writer.Error] method.", Value: reflect.TypeOf((*csv.Writer)(nil)).Elem(), Methods: Writer_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewReader": {Doc: "NewReader returns a new Reader that reads from r.", Args: []pkgreflect.Arg{{Name: "r", Tag: "io.Reader"}}, Tag: "Reader", Value: reflect.ValueOf(csv.NewReader)},
+
+			"NewWriter": {Doc: "NewWriter returns a new Writer that writes to w.", Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "Writer", Value: reflect.ValueOf(csv.NewWriter)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrBareQuote":     {Doc: "", Value: reflect.ValueOf(&csv.ErrBareQuote)},
+			"ErrFieldCount":    {Doc: "", Value: reflect.ValueOf(&csv.ErrFieldCount)},
+			"ErrQuote":         {Doc: "", Value: reflect.ValueOf(&csv.ErrQuote)},
+			"ErrTrailingComma": {Doc: "Deprecated: ErrTrailingComma is no longer used.", Value: reflect.ValueOf(&csv.ErrTrailingComma)},
+		},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
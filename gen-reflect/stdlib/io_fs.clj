@@ -0,0 +1,138 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.io.fs
+  "Package fs defines basic interfaces to a file system."
+  (:refer-clojure :exclude [file-info-to-dir-entry format-dir-entry format-file-info glob read-dir read-file stat sub valid-path walk-dir]))
+
+(defn file-info-to-dir-entry
+  "FileInfoToDirEntry returns a [DirEntry] that returns information from info.
+If info is nil, FileInfoToDirEntry returns nil."
+  {:arglists '([info]) :go "io/fs.FileInfoToDirEntry"}
+  [info]
+  (lace.go.io.fs/FileInfoToDirEntry info))
+
+(defn format-dir-entry
+  "FormatDirEntry returns a formatted version of dir for human readability.
+Implementations of [DirEntry] can call this from a String method.
+The outputs for a directory named subdir and a file named hello.go are:
+
+	d subdir/
+	- hello.go"
+  {:arglists '([dir]) :go "io/fs.FormatDirEntry"}
+  [dir]
+  (lace.go.io.fs/FormatDirEntry dir))
+
+(defn format-file-info
+  "FormatFileInfo returns a formatted version of info for human readability.
+Implementations of [FileInfo] can call this from a String method.
+The output for a file named \"hello.go\", 100 bytes, mode 0o644, created
+January 1, 1970 at noon is
+
+	-rw-r--r-- 100 1970-01-01 12:00:00 hello.go"
+  {:arglists '([info]) :go "io/fs.FormatFileInfo"}
+  [info]
+  (lace.go.io.fs/FormatFileInfo info))
+
+(defn glob
+  "Glob returns the names of all files matching pattern or nil
+if there is no matching file. The syntax of patterns is the same
+as in [path.Match]. The pattern may describe hierarchical names such as
+usr/*/bin/ed.
+
+Glob ignores file system errors such as I/O errors reading directories.
+The only possible returned error is [path.ErrBadPattern], reporting that
+the pattern is malformed.
+
+If fsys implements [GlobFS], Glob calls fsys.Glob.
+Otherwise, Glob uses [ReadDir] to traverse the directory tree
+and look for matches for the pattern."
+  {:arglists '([fsys pattern]) :go "io/fs.Glob"}
+  [fsys pattern]
+  (lace.go.io.fs/Glob fsys pattern))
+
+(defn read-dir
+  "ReadDir reads the named directory
+and returns a list of directory entries sorted by filename.
+
+If fsys implements [ReadDirFS], ReadDir calls fsys.ReadDir.
+Otherwise ReadDir calls fsys.Open and uses ReadDir and Close
+on the returned [ReadDirFile]."
+  {:arglists '([fsys name]) :go "io/fs.ReadDir"}
+  [fsys name]
+  (lace.go.io.fs/ReadDir fsys name))
+
+(defn read-file
+  "ReadFile reads the named file from the file system fsys and returns its contents.
+A successful call returns a nil error, not [io.EOF].
+(Because ReadFile reads the whole file, the expected EOF
+from the final Read is not treated as an error to be reported.)
+
+If fsys implements [ReadFileFS], ReadFile calls fsys.ReadFile.
+Otherwise ReadFile calls fsys.Open and uses Read and Close
+on the returned [File]."
+  {:arglists '([fsys name]) :go "io/fs.ReadFile"}
+  [fsys name]
+  (lace.go.io.fs/ReadFile fsys name))
+
+(defn stat
+  "Stat returns a [FileInfo] describing the named file from the file system.
+
+If fsys implements [StatFS], Stat calls fsys.Stat.
+Otherwise, Stat opens the [File] to stat it."
+  {:arglists '([fsys name]) :go "io/fs.Stat"}
+  [fsys name]
+  (lace.go.io.fs/Stat fsys name))
+
+(defn sub
+  "Sub returns an [FS] corresponding to the subtree rooted at fsys's dir.
+
+If dir is \".\", Sub returns fsys unchanged.
+Otherwise, if fsys implements [SubFS], Sub returns fsys.Sub(dir).
+Otherwise, Sub returns a new [FS] implementation sub that,
+in effect, implements sub.Open(name) as fsys.Open(path.Join(dir, name)).
+The implementation also translates calls to ReadDir, ReadFile,
+ReadLink, Lstat, and Glob appropriately. Sub does not check if the
+directory currently exists.
+
+Note that Sub(os.DirFS(\"/\"), \"prefix\") is equivalent to os.DirFS(\"/prefix\")
+and that neither of them guarantees to avoid operating system
+accesses outside \"/prefix\", because the implementation of [os.DirFS]
+does not check for symbolic links inside \"/prefix\" that point to
+other directories. That is, [os.DirFS] is not a general substitute for a
+chroot-style security mechanism, and Sub does not change that fact.
+Use [os.Root] to constrain access to particular directory trees."
+  {:arglists '([fsys dir]) :go "io/fs.Sub"}
+  [fsys dir]
+  (lace.go.io.fs/Sub fsys dir))
+
+(defn valid-path
+  "ValidPath reports whether the given path name
+is valid for use in a call to Open.
+
+Note that paths are slash-separated on all systems, even Windows.
+Paths containing other characters such as backslash and colon
+are accepted as valid, but those characters must never be
+interpreted by an [FS] implementation as path element separators.
+See the [Path Names] section for more details.
+
+[Path Names]: https://pkg.go.dev/io/fs#hdr-Path_Names"
+  {:arglists '([name]) :go "io/fs.ValidPath"}
+  [name]
+  (lace.go.io.fs/ValidPath name))
+
+(defn walk-dir
+  "WalkDir walks the file tree rooted at root, calling fn for each file or
+directory in the tree, including root.
+
+All errors that arise visiting files and directories are filtered by fn:
+see the [fs.WalkDirFunc] documentation for details.
+
+The files are walked in lexical order, which makes the output deterministic
+but requires WalkDir to read an entire directory into memory before proceeding
+to walk that directory.
+
+WalkDir does not follow symbolic links found in directories,
+but if root itself is a symbolic link, its target will be walked."
+  {:arglists '([fsys root fn]) :go "io/fs.WalkDir"}
+  [fsys root fn]
+  (lace.go.io.fs/WalkDir fsys root fn))
@@ -0,0 +1,271 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+	"time"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	fs "io/fs"
+)
+
+type DirEntryImpl struct {
+	InfoFn  func() (fs.FileInfo, error)
+	IsDirFn func() bool
+	NameFn  func() string
+	TypeFn  func() fs.FileMode
+}
+
+func (s *DirEntryImpl) Info() (fs.FileInfo, error) {
+	return s.InfoFn()
+}
+func (s *DirEntryImpl) IsDir() bool {
+	return s.IsDirFn()
+}
+func (s *DirEntryImpl) Name() string {
+	return s.NameFn()
+}
+func (s *DirEntryImpl) Type() fs.FileMode {
+	return s.TypeFn()
+}
+
+type FSImpl struct {
+	OpenFn func(string) (fs.File, error)
+}
+
+func (s *FSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+
+type FileImpl struct {
+	CloseFn func() error
+	ReadFn  func([]byte) (int, error)
+	StatFn  func() (fs.FileInfo, error)
+}
+
+func (s *FileImpl) Close() error {
+	return s.CloseFn()
+}
+func (s *FileImpl) Read(a0 []byte) (int, error) {
+	return s.ReadFn(a0)
+}
+func (s *FileImpl) Stat() (fs.FileInfo, error) {
+	return s.StatFn()
+}
+
+type FileInfoImpl struct {
+	IsDirFn   func() bool
+	ModTimeFn func() time.Time
+	ModeFn    func() fs.FileMode
+	NameFn    func() string
+	SizeFn    func() int64
+	SysFn     func() any
+}
+
+func (s *FileInfoImpl) IsDir() bool {
+	return s.IsDirFn()
+}
+func (s *FileInfoImpl) ModTime() time.Time {
+	return s.ModTimeFn()
+}
+func (s *FileInfoImpl) Mode() fs.FileMode {
+	return s.ModeFn()
+}
+func (s *FileInfoImpl) Name() string {
+	return s.NameFn()
+}
+func (s *FileInfoImpl) Size() int64 {
+	return s.SizeFn()
+}
+func (s *FileInfoImpl) Sys() any {
+	return s.SysFn()
+}
+
+type ReadDirFileImpl struct {
+	CloseFn   func() error
+	ReadFn    func([]byte) (int, error)
+	ReadDirFn func(int) ([]fs.DirEntry, error)
+	StatFn    func() (fs.FileInfo, error)
+}
+
+func (s *ReadDirFileImpl) Close() error {
+	return s.CloseFn()
+}
+func (s *ReadDirFileImpl) Read(a0 []byte) (int, error) {
+	return s.ReadFn(a0)
+}
+func (s *ReadDirFileImpl) ReadDir(a0 int) ([]fs.DirEntry, error) {
+	return s.ReadDirFn(a0)
+}
+func (s *ReadDirFileImpl) Stat() (fs.FileInfo, error) {
+	return s.StatFn()
+}
+
+type GlobFSImpl struct {
+	GlobFn func(string) ([]string, error)
+	OpenFn func(string) (fs.File, error)
+}
+
+func (s *GlobFSImpl) Glob(a0 string) ([]string, error) {
+	return s.GlobFn(a0)
+}
+func (s *GlobFSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+
+type ReadDirFSImpl struct {
+	OpenFn    func(string) (fs.File, error)
+	ReadDirFn func(string) ([]fs.DirEntry, error)
+}
+
+func (s *ReadDirFSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+func (s *ReadDirFSImpl) ReadDir(a0 string) ([]fs.DirEntry, error) {
+	return s.ReadDirFn(a0)
+}
+
+type ReadFileFSImpl struct {
+	OpenFn     func(string) (fs.File, error)
+	ReadFileFn func(string) ([]byte, error)
+}
+
+func (s *ReadFileFSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+func (s *ReadFileFSImpl) ReadFile(a0 string) ([]byte, error) {
+	return s.ReadFileFn(a0)
+}
+
+type StatFSImpl struct {
+	OpenFn func(string) (fs.File, error)
+	StatFn func(string) (fs.FileInfo, error)
+}
+
+func (s *StatFSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+func (s *StatFSImpl) Stat(a0 string) (fs.FileInfo, error) {
+	return s.StatFn(a0)
+}
+
+type SubFSImpl struct {
+	OpenFn func(string) (fs.File, error)
+	SubFn  func(string) (fs.FS, error)
+}
+
+func (s *SubFSImpl) Open(a0 string) (fs.File, error) {
+	return s.OpenFn(a0)
+}
+func (s *SubFSImpl) Sub(a0 string) (fs.FS, error) {
+	return s.SubFn(a0)
+}
+
+//go:embed io_fs.clj
+var wrapper_io_fs string
+
+func init() {
+	DirEntry_methods := map[string]pkgreflect.Func{}
+	FS_methods := map[string]pkgreflect.Func{}
+	File_methods := map[string]pkgreflect.Func{}
+	FileInfo_methods := map[string]pkgreflect.Func{}
+	FileMode_methods := map[string]pkgreflect.Func{}
+	PathError_methods := map[string]pkgreflect.Func{}
+	ReadDirFile_methods := map[string]pkgreflect.Func{}
+	GlobFS_methods := map[string]pkgreflect.Func{}
+	ReadDirFS_methods := map[string]pkgreflect.Func{}
+	ReadFileFS_methods := map[string]pkgreflect.Func{}
+	StatFS_methods := map[string]pkgreflect.Func{}
+	SubFS_methods := map[string]pkgreflect.Func{}
+	WalkDirFunc_methods := map[string]pkgreflect.Func{}
+	FileMode_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	FileMode_methods["IsDir"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "IsDir reports whether m describes a directory.\nThat is, it tests for the [ModeDir] bit being set in m."}
+	FileMode_methods["IsRegular"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "IsRegular reports whether m describes a regular file.\nThat is, it tests that no mode type bits are set."}
+	FileMode_methods["Perm"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "FileMode", Doc: "Perm returns the Unix permission bits in m (m & [ModePerm])."}
+	FileMode_methods["Type"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "FileMode", Doc: "Type returns type bits in m (m & [ModeType])."}
+	PathError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	PathError_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	PathError_methods["Timeout"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "Timeout reports whether this error represents a timeout."}
+	pkgreflect.AddPackage("lace.go.io.fs", &pkgreflect.Package{
+		Doc:       "Package fs defines basic interfaces to a file system.",
+		WrapperNS: "go.io.fs",
+		Wrapper:   wrapper_io_fs,
+		Types: map[string]pkgreflect.Type{
+			"DirEntry":        {Doc: "A DirEntry is an entry read from a directory\n(using the [ReadDir] function or a [ReadDirFile]'s ReadDir method).", Value: reflect.TypeOf((*fs.DirEntry)(nil)).Elem(), Methods: DirEntry_methods},
+			"FS":              {Doc: "An FS provides access to a hierarchical file system.\n\nThe FS interface is the minimum implementation required of the file system.\nA file system may implement additional interfaces,\nsuch as [ReadFileFS], to provide additional or optimized functionality.\n\n[testing/fstest.TestFS] may be used to test implementations of an FS for\ncorrectness.", Value: reflect.TypeOf((*fs.FS)(nil)).Elem(), Methods: FS_methods},
+			"File":            {Doc: "A File provides access to a single file.\nThe File interface is the minimum implementation required of the file.\nDirectory files should also implement [ReadDirFile].\nA file may implement [io.ReaderAt] or [io.Seeker] as optimizations.", Value: reflect.TypeOf((*fs.File)(nil)).Elem(), Methods: File_methods},
+			"FileInfo":        {Doc: "A FileInfo describes a file and is returned by [Stat].", Value: reflect.TypeOf((*fs.FileInfo)(nil)).Elem(), Methods: FileInfo_methods},
+			"FileMode":        {Doc: "A FileMode represents a file's mode and permission bits.\nThe bits have the same definition on all systems, so that\ninformation about files can be moved from one system\nto another portably. Not all bits apply to all systems.\nThe only required bit is [ModeDir] for directories.", Value: reflect.TypeOf((*fs.FileMode)(nil)).Elem(), Methods: FileMode_methods},
+			"PathError":       {Doc: "PathError records an error and the operation and file path that caused it.", Value: reflect.TypeOf((*fs.PathError)(nil)).Elem(), Methods: PathError_methods},
+			"ReadDirFile":     {Doc: "A ReadDirFile is a directory file whose entries can be read with the ReadDir method.\nEvery directory file should implement this interface.\n(It is permissible for any file to implement this interface,\nbut if so ReadDir should return an error for non-directories.)", Value: reflect.TypeOf((*fs.ReadDirFile)(nil)).Elem(), Methods: ReadDirFile_methods},
+			"GlobFS":          {Doc: "A GlobFS is a file system with a Glob method.", Value: reflect.TypeOf((*fs.GlobFS)(nil)).Elem(), Methods: GlobFS_methods},
+			"ReadDirFS":       {Doc: "ReadDirFS is the interface implemented by a file system\nthat provides an optimized implementation of [ReadDir].", Value: reflect.TypeOf((*fs.ReadDirFS)(nil)).Elem(), Methods: ReadDirFS_methods},
+			"ReadFileFS":      {Doc: "ReadFileFS is the interface implemented by a file system\nthat provides an optimized implementation of [ReadFile].", Value: reflect.TypeOf((*fs.ReadFileFS)(nil)).Elem(), Methods: ReadFileFS_methods},
+			"StatFS":          {Doc: "A StatFS is a file system with a Stat method.", Value: reflect.TypeOf((*fs.StatFS)(nil)).Elem(), Methods: StatFS_methods},
+			"SubFS":           {Doc: "A SubFS is a file system with a Sub method.", Value: reflect.TypeOf((*fs.SubFS)(nil)).Elem(), Methods: SubFS_methods},
+			"WalkDirFunc":     {Doc: "WalkDirFunc is the type of the function called by [WalkDir] to visit\neach file or directory.\n\nThe path argument contains the argument to [WalkDir] as a prefix.\nThat is, if WalkDir is called with root argument \"dir\" and finds a file\nnamed \"a\" in that directory, the walk function will be called with\nargument \"dir/a\".\n\nThe d argument is the [DirEntry] for the named path.\n\nThe error result returned by the function controls how [WalkDir]\ncontinues. If the function returns the special value [SkipDir], WalkDir\nskips the current directory (path if d.IsDir() is true, otherwise\npath's parent directory). If the function returns the special value\n[SkipAll], WalkDir skips all remaining files and directories. Otherwise,\nif the function returns a non-nil error, WalkDir stops entirely and\nreturns that error.\n\nThe err argument reports an error related to path, signaling that\n[WalkDir] will not walk into that directory. The function can decide how\nto handle that error; as described earlier, returning the error will\ncause WalkDir to stop walking the entire tree.\n\n[WalkDir] calls the function with a non-nil err argument in two cases.\n\nFirst, if the initial [Stat] on the root directory fails, WalkDir\ncalls the function with path set to root, d set to nil, and err set to\nthe error from [fs.Stat].\n\nSecond, if a directory's ReadDir method (see [ReadDirFile]) fails, WalkDir calls the\nfunction with path set to the directory's path, d set to an\n[DirEntry] describing the directory, and err set to the error from\nReadDir. In this second case, the function is called twice with the\npath of the directory: the first call is before the directory read is\nattempted and has err set to nil, giving the function a chance to\nreturn [SkipDir] or [SkipAll] and avoid the ReadDir entirely. The second call\nis after a failed ReadDir and reports the error from ReadDir.\n(If ReadDir succeeds, there is no second call.)\n\nThe differences between WalkDirFunc compared to [path/filepath.WalkFunc] are:\n\n  - The second argument has type [DirEntry] instead of [FileInfo].\n  - The function is called before reading a directory, to allow [SkipDir]\n    or [SkipAll] to bypass the directory read entirely or skip all remaining\n    files and directories respectively.\n  - If a directory read fails, the function is called a second time\n    for that directory to report the error.", Value: reflect.TypeOf((*fs.WalkDirFunc)(nil)).Elem(), Methods: WalkDirFunc_methods},
+			"DirEntryImpl":    {Doc: `Struct version of interface DirEntry for implementation`, Value: reflect.TypeFor[DirEntryImpl]()},
+			"FSImpl":          {Doc: `Struct version of interface FS for implementation`, Value: reflect.TypeFor[FSImpl]()},
+			"FileImpl":        {Doc: `Struct version of interface File for implementation`, Value: reflect.TypeFor[FileImpl]()},
+			"FileInfoImpl":    {Doc: `Struct version of interface FileInfo for implementation`, Value: reflect.TypeFor[FileInfoImpl]()},
+			"ReadDirFileImpl": {Doc: `Struct version of interface ReadDirFile for implementation`, Value: reflect.TypeFor[ReadDirFileImpl]()},
+			"GlobFSImpl":      {Doc: `Struct version of interface GlobFS for implementation`, Value: reflect.TypeFor[GlobFSImpl]()},
+			"ReadDirFSImpl":   {Doc: `Struct version of interface ReadDirFS for implementation`, Value: reflect.TypeFor[ReadDirFSImpl]()},
+			"ReadFileFSImpl":  {Doc: `Struct version of interface ReadFileFS for implementation`, Value: reflect.TypeFor[ReadFileFSImpl]()},
+			"StatFSImpl":      {Doc: `Struct version of interface StatFS for implementation`, Value: reflect.TypeFor[StatFSImpl]()},
+			"SubFSImpl":       {Doc: `Struct version of interface SubFS for implementation`, Value: reflect.TypeFor[SubFSImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"FileInfoToDirEntry": {Doc: "FileInfoToDirEntry returns a [DirEntry] that returns information from info.\nIf info is nil, FileInfoToDirEntry returns nil.", Args: []pkgreflect.Arg{{Name: "info", Tag: "FileInfo"}}, Tag: "DirEntry", Value: reflect.ValueOf(fs.FileInfoToDirEntry)},
+
+			"FormatDirEntry": {Doc: "FormatDirEntry returns a formatted version of dir for human readability.\nImplementations of [DirEntry] can call this from a String method.\nThe outputs for a directory named subdir and a file named hello.go are:\n\n\td subdir/\n\t- hello.go", Args: []pkgreflect.Arg{{Name: "dir", Tag: "DirEntry"}}, Tag: "string", Value: reflect.ValueOf(fs.FormatDirEntry)},
+
+			"FormatFileInfo": {Doc: "FormatFileInfo returns a formatted version of info for human readability.\nImplementations of [FileInfo] can call this from a String method.\nThe output for a file named \"hello.go\", 100 bytes, mode 0o644, created\nJanuary 1, 1970 at noon is\n\n\t-rw-r--r-- 100 1970-01-01 12:00:00 hello.go", Args: []pkgreflect.Arg{{Name: "info", Tag: "FileInfo"}}, Tag: "string", Value: reflect.ValueOf(fs.FormatFileInfo)},
+
+			"Glob": {Doc: "Glob returns the names of all files matching pattern or nil\nif there is no matching file. The syntax of patterns is the same\nas in [path.Match]. The pattern may describe hierarchical names such as\nusr/*/bin/ed.\n\nGlob ignores file system errors such as I/O errors reading directories.\nThe only possible returned error is [path.ErrBadPattern], reporting that\nthe pattern is malformed.\n\nIf fsys implements [GlobFS], Glob calls fsys.Glob.\nOtherwise, Glob uses [ReadDir] to traverse the directory tree\nand look for matches for the pattern.", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "pattern", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(fs.Glob)},
+
+			"ReadDir": {Doc: "ReadDir reads the named directory\nand returns a list of directory entries sorted by filename.\n\nIf fsys implements [ReadDirFS], ReadDir calls fsys.ReadDir.\nOtherwise ReadDir calls fsys.Open and uses ReadDir and Close\non the returned [ReadDirFile].", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(fs.ReadDir)},
+
+			"ReadFile": {Doc: "ReadFile reads the named file from the file system fsys and returns its contents.\nA successful call returns a nil error, not [io.EOF].\n(Because ReadFile reads the whole file, the expected EOF\nfrom the final Read is not treated as an error to be reported.)\n\nIf fsys implements [ReadFileFS], ReadFile calls fsys.ReadFile.\nOtherwise ReadFile calls fsys.Open and uses Read and Close\non the returned [File].", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(fs.ReadFile)},
+
+			"Stat": {Doc: "Stat returns a [FileInfo] describing the named file from the file system.\n\nIf fsys implements [StatFS], Stat calls fsys.Stat.\nOtherwise, Stat opens the [File] to stat it.", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(fs.Stat)},
+
+			"Sub": {Doc: "Sub returns an [FS] corresponding to the subtree rooted at fsys's dir.\n\nIf dir is \".\", Sub returns fsys unchanged.\nOtherwise, if fsys implements [SubFS], Sub returns fsys.Sub(dir).\nOtherwise, Sub returns a new [FS] implementation sub that,\nin effect, implements sub.Open(name) as fsys.Open(path.Join(dir, name)).\nThe implementation also translates calls to ReadDir, ReadFile,\nReadLink, Lstat, and Glob appropriately. Sub does not check if the\ndirectory currently exists.\n\nNote that Sub(os.DirFS(\"/\"), \"prefix\") is equivalent to os.DirFS(\"/prefix\")\nand that neither of them guarantees to avoid operating system\naccesses outside \"/prefix\", because the implementation of [os.DirFS]\ndoes not check for symbolic links inside \"/prefix\" that point to\nother directories. That is, [os.DirFS] is not a general substitute for a\nchroot-style security mechanism, and Sub does not change that fact.\nUse [os.Root] to constrain access to particular directory trees.", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "dir", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(fs.Sub)},
+
+			"ValidPath": {Doc: "ValidPath reports whether the given path name\nis valid for use in a call to Open.\n\nNote that paths are slash-separated on all systems, even Windows.\nPaths containing other characters such as backslash and colon\nare accepted as valid, but those characters must never be\ninterpreted by an [FS] implementation as path element separators.\nSee the [Path Names] section for more details.\n\n[Path Names]: https://pkg.go.dev/io/fs#hdr-Path_Names", Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(fs.ValidPath)},
+
+			"WalkDir": {Doc: "WalkDir walks the file tree rooted at root, calling fn for each file or\ndirectory in the tree, including root.\n\nAll errors that arise visiting files and directories are filtered by fn:\nsee the [fs.WalkDirFunc] documentation for details.\n\nThe files are walked in lexical order, which makes the output deterministic\nbut requires WalkDir to read an entire directory into memory before proceeding\nto walk that directory.\n\nWalkDir does not follow symbolic links found in directories,\nbut if root itself is a symbolic link, its target will be walked.", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "FS"}, {Name: "root", Tag: "string"}, {Name: "fn", Tag: "WalkDirFunc"}}, Tag: "error", Value: reflect.ValueOf(fs.WalkDir)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrClosed":     {Doc: "", Value: reflect.ValueOf(&fs.ErrClosed)},
+			"ErrExist":      {Doc: "", Value: reflect.ValueOf(&fs.ErrExist)},
+			"ErrInvalid":    {Doc: "", Value: reflect.ValueOf(&fs.ErrInvalid)},
+			"ErrNotExist":   {Doc: "", Value: reflect.ValueOf(&fs.ErrNotExist)},
+			"ErrPermission": {Doc: "", Value: reflect.ValueOf(&fs.ErrPermission)},
+			"SkipAll":       {Doc: "", Value: reflect.ValueOf(&fs.SkipAll)},
+			"SkipDir":       {Doc: "", Value: reflect.ValueOf(&fs.SkipDir)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"ModeAppend":     {Doc: "", Value: reflect.ValueOf(fs.ModeAppend)},
+			"ModeCharDevice": {Doc: "", Value: reflect.ValueOf(fs.ModeCharDevice)},
+			"ModeDevice":     {Doc: "", Value: reflect.ValueOf(fs.ModeDevice)},
+			"ModeDir":        {Doc: "The single letters are the abbreviations\nused by the String method's formatting.", Value: reflect.ValueOf(fs.ModeDir)},
+			"ModeExclusive":  {Doc: "", Value: reflect.ValueOf(fs.ModeExclusive)},
+			"ModeIrregular":  {Doc: "", Value: reflect.ValueOf(fs.ModeIrregular)},
+			"ModeNamedPipe":  {Doc: "", Value: reflect.ValueOf(fs.ModeNamedPipe)},
+			"ModePerm":       {Doc: "", Value: reflect.ValueOf(fs.ModePerm)},
+			"ModeSetgid":     {Doc: "", Value: reflect.ValueOf(fs.ModeSetgid)},
+			"ModeSetuid":     {Doc: "", Value: reflect.ValueOf(fs.ModeSetuid)},
+			"ModeSocket":     {Doc: "", Value: reflect.ValueOf(fs.ModeSocket)},
+			"ModeSticky":     {Doc: "", Value: reflect.ValueOf(fs.ModeSticky)},
+			"ModeSymlink":    {Doc: "", Value: reflect.ValueOf(fs.ModeSymlink)},
+			"ModeTemporary":  {Doc: "", Value: reflect.ValueOf(fs.ModeTemporary)},
+			"ModeType":       {Doc: "Mask for the type bits. For regular files, none will be set.", Value: reflect.ValueOf(fs.ModeType)},
+		},
+	})
+}
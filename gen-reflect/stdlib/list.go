@@ -0,0 +1,36 @@
+// Package stdlib registers a broad set of reflected Go standard library
+// packages under the lace.go namespace, ie. strings is available as
+// lace.go.strings. Each package also has an idiomatic wrapper namespace
+// under go, ie. go.strings/to-upper. Namespaces are only populated when
+// first referenced.
+package stdlib
+
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.strings -lace-name lace.go.strings strings strings.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.strconv -lace-name lace.go.strconv strconv strconv.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.path.filepath -lace-name lace.go.path.filepath path/filepath path_filepath.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.io.fs -lace-name lace.go.io.fs io/fs io_fs.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.bufio -lace-name lace.go.bufio bufio bufio.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.regexp -lace-name lace.go.regexp regexp regexp.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.net.url -lace-name lace.go.net.url net/url net_url.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -impl-prefix HTTP -wrapper go.net.http -lace-name lace.go.net.http net/http net_http.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.encoding.csv -lace-name lace.go.encoding.csv encoding/csv encoding_csv.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.encoding.base64 -lace-name lace.go.encoding.base64 encoding/base64 encoding_base64.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.compress.gzip -lace-name lace.go.compress.gzip compress/gzip compress_gzip.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.archive.tar -lace-name lace.go.archive.tar archive/tar archive_tar.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.archive.zip -lace-name lace.go.archive.zip archive/zip archive_zip.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.sort -lace-name lace.go.sort sort sort.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.math -lace-name lace.go.math math math.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto -lace-name lace.go.crypto crypto crypto.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.aes -lace-name lace.go.crypto.aes crypto/aes crypto_aes.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.cipher -lace-name lace.go.crypto.cipher crypto/cipher crypto_cipher.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.ed25519 -lace-name lace.go.crypto.ed25519 crypto/ed25519 crypto_ed25519.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.hmac -lace-name lace.go.crypto.hmac crypto/hmac crypto_hmac.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.md5 -lace-name lace.go.crypto.md5 crypto/md5 crypto_md5.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.rand -lace-name lace.go.crypto.rand crypto/rand crypto_rand.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.sha1 -lace-name lace.go.crypto.sha1 crypto/sha1 crypto_sha1.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.sha256 -lace-name lace.go.crypto.sha256 crypto/sha256 crypto_sha256.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.sha512 -lace-name lace.go.crypto.sha512 crypto/sha512 crypto_sha512.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.subtle -lace-name lace.go.crypto.subtle crypto/subtle crypto_subtle.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.crypto.x509 -lace-name lace.go.crypto.x509 crypto/x509 crypto_x509.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.os.exec -lace-name lace.go.os.exec os/exec os_exec.go
+//go:generate go run ../../pkg/pkgreflect/cmd/pkgreflect -pkg-name stdlib -wrapper go.sync -lace-name lace.go.sync sync sync.go
@@ -0,0 +1,812 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.math
+  "Package math provides basic constants and mathematical functions."
+  (:refer-clojure :exclude [abs acos acosh asin asinh atan atan2 atanh cbrt ceil copysign cos cosh dim erf erfc erfcinv erfinv exp exp2 expm1 float32bits float32frombits float64bits float64frombits floor fma frexp gamma hypot ilogb inf inf? j0 j1 jn ldexp lgamma log log10 log1p log2 logb max min mod modf na-n na-n? nextafter nextafter32 pow pow10 remainder round round-to-even signbit sin sincos sinh sqrt tan tanh trunc y0 y1 yn]))
+
+(defn abs
+  "Abs returns the absolute value of x.
+
+Special cases are:
+
+	Abs(±Inf) = +Inf
+	Abs(NaN) = NaN"
+  {:arglists '([x]) :go "math.Abs"}
+  [x]
+  (lace.go.math/Abs x))
+
+(defn acos
+  "Acos returns the arccosine, in radians, of x.
+
+Special case is:
+
+	Acos(x) = NaN if x < -1 or x > 1"
+  {:arglists '([x]) :go "math.Acos"}
+  [x]
+  (lace.go.math/Acos x))
+
+(defn acosh
+  "Acosh returns the inverse hyperbolic cosine of x.
+
+Special cases are:
+
+	Acosh(+Inf) = +Inf
+	Acosh(x) = NaN if x < 1
+	Acosh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Acosh"}
+  [x]
+  (lace.go.math/Acosh x))
+
+(defn asin
+  "Asin returns the arcsine, in radians, of x.
+
+Special cases are:
+
+	Asin(±0) = ±0
+	Asin(x) = NaN if x < -1 or x > 1"
+  {:arglists '([x]) :go "math.Asin"}
+  [x]
+  (lace.go.math/Asin x))
+
+(defn asinh
+  "Asinh returns the inverse hyperbolic sine of x.
+
+Special cases are:
+
+	Asinh(±0) = ±0
+	Asinh(±Inf) = ±Inf
+	Asinh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Asinh"}
+  [x]
+  (lace.go.math/Asinh x))
+
+(defn atan
+  "Atan returns the arctangent, in radians, of x.
+
+Special cases are:
+
+	Atan(±0) = ±0
+	Atan(±Inf) = ±Pi/2"
+  {:arglists '([x]) :go "math.Atan"}
+  [x]
+  (lace.go.math/Atan x))
+
+(defn atan2
+  "Atan2 returns the arc tangent of y/x, using
+the signs of the two to determine the quadrant
+of the return value.
+
+Special cases are (in order):
+
+	Atan2(y, NaN) = NaN
+	Atan2(NaN, x) = NaN
+	Atan2(+0, x>=0) = +0
+	Atan2(-0, x>=0) = -0
+	Atan2(+0, x<=-0) = +Pi
+	Atan2(-0, x<=-0) = -Pi
+	Atan2(y>0, 0) = +Pi/2
+	Atan2(y<0, 0) = -Pi/2
+	Atan2(+Inf, +Inf) = +Pi/4
+	Atan2(-Inf, +Inf) = -Pi/4
+	Atan2(+Inf, -Inf) = 3Pi/4
+	Atan2(-Inf, -Inf) = -3Pi/4
+	Atan2(y, +Inf) = 0
+	Atan2(y>0, -Inf) = +Pi
+	Atan2(y<0, -Inf) = -Pi
+	Atan2(+Inf, x) = +Pi/2
+	Atan2(-Inf, x) = -Pi/2"
+  {:arglists '([y x]) :go "math.Atan2"}
+  [y x]
+  (lace.go.math/Atan2 y x))
+
+(defn atanh
+  "Atanh returns the inverse hyperbolic tangent of x.
+
+Special cases are:
+
+	Atanh(1) = +Inf
+	Atanh(±0) = ±0
+	Atanh(-1) = -Inf
+	Atanh(x) = NaN if x < -1 or x > 1
+	Atanh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Atanh"}
+  [x]
+  (lace.go.math/Atanh x))
+
+(defn cbrt
+  "Cbrt returns the cube root of x.
+
+Special cases are:
+
+	Cbrt(±0) = ±0
+	Cbrt(±Inf) = ±Inf
+	Cbrt(NaN) = NaN"
+  {:arglists '([x]) :go "math.Cbrt"}
+  [x]
+  (lace.go.math/Cbrt x))
+
+(defn ceil
+  "Ceil returns the least integer value greater than or equal to x.
+
+Special cases are:
+
+	Ceil(±0) = ±0
+	Ceil(±Inf) = ±Inf
+	Ceil(NaN) = NaN"
+  {:arglists '([x]) :go "math.Ceil"}
+  [x]
+  (lace.go.math/Ceil x))
+
+(defn copysign
+  "Copysign returns a value with the magnitude of f
+and the sign of sign."
+  {:arglists '([f sign]) :go "math.Copysign"}
+  [f sign]
+  (lace.go.math/Copysign f sign))
+
+(defn cos
+  "Cos returns the cosine of the radian argument x.
+
+Special cases are:
+
+	Cos(±Inf) = NaN
+	Cos(NaN) = NaN"
+  {:arglists '([x]) :go "math.Cos"}
+  [x]
+  (lace.go.math/Cos x))
+
+(defn cosh
+  "Cosh returns the hyperbolic cosine of x.
+
+Special cases are:
+
+	Cosh(±0) = 1
+	Cosh(±Inf) = +Inf
+	Cosh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Cosh"}
+  [x]
+  (lace.go.math/Cosh x))
+
+(defn dim
+  "Dim returns the maximum of x-y or 0.
+
+Special cases are:
+
+	Dim(+Inf, +Inf) = NaN
+	Dim(-Inf, -Inf) = NaN
+	Dim(x, NaN) = Dim(NaN, x) = NaN"
+  {:arglists '([x y]) :go "math.Dim"}
+  [x y]
+  (lace.go.math/Dim x y))
+
+(defn erf
+  "Erf returns the error function of x.
+
+Special cases are:
+
+	Erf(+Inf) = 1
+	Erf(-Inf) = -1
+	Erf(NaN) = NaN"
+  {:arglists '([x]) :go "math.Erf"}
+  [x]
+  (lace.go.math/Erf x))
+
+(defn erfc
+  "Erfc returns the complementary error function of x.
+
+Special cases are:
+
+	Erfc(+Inf) = 0
+	Erfc(-Inf) = 2
+	Erfc(NaN) = NaN"
+  {:arglists '([x]) :go "math.Erfc"}
+  [x]
+  (lace.go.math/Erfc x))
+
+(defn erfcinv
+  "Erfcinv returns the inverse of [Erfc](x).
+
+Special cases are:
+
+	Erfcinv(0) = +Inf
+	Erfcinv(2) = -Inf
+	Erfcinv(x) = NaN if x < 0 or x > 2
+	Erfcinv(NaN) = NaN"
+  {:arglists '([x]) :go "math.Erfcinv"}
+  [x]
+  (lace.go.math/Erfcinv x))
+
+(defn erfinv
+  "Erfinv returns the inverse error function of x.
+
+Special cases are:
+
+	Erfinv(1) = +Inf
+	Erfinv(-1) = -Inf
+	Erfinv(x) = NaN if x < -1 or x > 1
+	Erfinv(NaN) = NaN"
+  {:arglists '([x]) :go "math.Erfinv"}
+  [x]
+  (lace.go.math/Erfinv x))
+
+(defn exp
+  "Exp returns e**x, the base-e exponential of x.
+
+Special cases are:
+
+	Exp(+Inf) = +Inf
+	Exp(NaN) = NaN
+
+Very large values overflow to 0 or +Inf.
+Very small values underflow to 1."
+  {:arglists '([x]) :go "math.Exp"}
+  [x]
+  (lace.go.math/Exp x))
+
+(defn exp2
+  "Exp2 returns 2**x, the base-2 exponential of x.
+
+Special cases are the same as [Exp]."
+  {:arglists '([x]) :go "math.Exp2"}
+  [x]
+  (lace.go.math/Exp2 x))
+
+(defn expm1
+  "Expm1 returns e**x - 1, the base-e exponential of x minus 1.
+It is more accurate than [Exp](x) - 1 when x is near zero.
+
+Special cases are:
+
+	Expm1(+Inf) = +Inf
+	Expm1(-Inf) = -1
+	Expm1(NaN) = NaN
+
+Very large values overflow to -1 or +Inf."
+  {:arglists '([x]) :go "math.Expm1"}
+  [x]
+  (lace.go.math/Expm1 x))
+
+(defn float32bits
+  "Float32bits returns the IEEE 754 binary representation of f,
+with the sign bit of f and the result in the same bit position.
+Float32bits(Float32frombits(x)) == x."
+  {:arglists '([f]) :go "math.Float32bits"}
+  [f]
+  (lace.go.math/Float32bits f))
+
+(defn float32frombits
+  "Float32frombits returns the floating-point number corresponding
+to the IEEE 754 binary representation b, with the sign bit of b
+and the result in the same bit position.
+Float32frombits(Float32bits(x)) == x."
+  {:arglists '([b]) :go "math.Float32frombits"}
+  [b]
+  (lace.go.math/Float32frombits b))
+
+(defn float64bits
+  "Float64bits returns the IEEE 754 binary representation of f,
+with the sign bit of f and the result in the same bit position,
+and Float64bits(Float64frombits(x)) == x."
+  {:arglists '([f]) :go "math.Float64bits"}
+  [f]
+  (lace.go.math/Float64bits f))
+
+(defn float64frombits
+  "Float64frombits returns the floating-point number corresponding
+to the IEEE 754 binary representation b, with the sign bit of b
+and the result in the same bit position.
+Float64frombits(Float64bits(x)) == x."
+  {:arglists '([b]) :go "math.Float64frombits"}
+  [b]
+  (lace.go.math/Float64frombits b))
+
+(defn floor
+  "Floor returns the greatest integer value less than or equal to x.
+
+Special cases are:
+
+	Floor(±0) = ±0
+	Floor(±Inf) = ±Inf
+	Floor(NaN) = NaN"
+  {:arglists '([x]) :go "math.Floor"}
+  [x]
+  (lace.go.math/Floor x))
+
+(defn fma
+  "FMA returns x * y + z, computed with only one rounding.
+(That is, FMA returns the fused multiply-add of x, y, and z.)"
+  {:arglists '([x y z]) :go "math.FMA"}
+  [x y z]
+  (lace.go.math/FMA x y z))
+
+(defn frexp
+  "Frexp breaks f into a normalized fraction
+and an integral power of two.
+It returns frac and exp satisfying f == frac × 2**exp,
+with the absolute value of frac in the interval [½, 1).
+
+Special cases are:
+
+	Frexp(±0) = ±0, 0
+	Frexp(±Inf) = ±Inf, 0
+	Frexp(NaN) = NaN, 0"
+  {:arglists '([f]) :go "math.Frexp"}
+  [f]
+  (lace.go.math/Frexp f))
+
+(defn gamma
+  "Gamma returns the Gamma function of x.
+
+Special cases are:
+
+	Gamma(+Inf) = +Inf
+	Gamma(+0) = +Inf
+	Gamma(-0) = -Inf
+	Gamma(x) = NaN for integer x < 0
+	Gamma(-Inf) = NaN
+	Gamma(NaN) = NaN"
+  {:arglists '([x]) :go "math.Gamma"}
+  [x]
+  (lace.go.math/Gamma x))
+
+(defn hypot
+  "Hypot returns [Sqrt](p*p + q*q), taking care to avoid
+unnecessary overflow and underflow.
+
+Special cases are:
+
+	Hypot(±Inf, q) = +Inf
+	Hypot(p, ±Inf) = +Inf
+	Hypot(NaN, q) = NaN
+	Hypot(p, NaN) = NaN"
+  {:arglists '([p q]) :go "math.Hypot"}
+  [p q]
+  (lace.go.math/Hypot p q))
+
+(defn ilogb
+  "Ilogb returns the binary exponent of x as an integer.
+
+Special cases are:
+
+	Ilogb(±Inf) = MaxInt32
+	Ilogb(0) = MinInt32
+	Ilogb(NaN) = MaxInt32"
+  {:arglists '([x]) :go "math.Ilogb"}
+  [x]
+  (lace.go.math/Ilogb x))
+
+(defn inf
+  "Inf returns positive infinity if sign >= 0, negative infinity if sign < 0."
+  {:arglists '([sign]) :go "math.Inf"}
+  [sign]
+  (lace.go.math/Inf sign))
+
+(defn inf?
+  "IsInf reports whether f is an infinity, according to sign.
+If sign > 0, IsInf reports whether f is positive infinity.
+If sign < 0, IsInf reports whether f is negative infinity.
+If sign == 0, IsInf reports whether f is either infinity."
+  {:arglists '([f sign]) :go "math.IsInf"}
+  [f sign]
+  (lace.go.math/IsInf f sign))
+
+(defn j0
+  "J0 returns the order-zero Bessel function of the first kind.
+
+Special cases are:
+
+	J0(±Inf) = 0
+	J0(0) = 1
+	J0(NaN) = NaN"
+  {:arglists '([x]) :go "math.J0"}
+  [x]
+  (lace.go.math/J0 x))
+
+(defn j1
+  "J1 returns the order-one Bessel function of the first kind.
+
+Special cases are:
+
+	J1(±Inf) = 0
+	J1(NaN) = NaN"
+  {:arglists '([x]) :go "math.J1"}
+  [x]
+  (lace.go.math/J1 x))
+
+(defn jn
+  "Jn returns the order-n Bessel function of the first kind.
+
+Special cases are:
+
+	Jn(n, ±Inf) = 0
+	Jn(n, NaN) = NaN"
+  {:arglists '([n x]) :go "math.Jn"}
+  [n x]
+  (lace.go.math/Jn n x))
+
+(defn ldexp
+  "Ldexp is the inverse of [Frexp].
+It returns frac × 2**exp.
+
+Special cases are:
+
+	Ldexp(±0, exp) = ±0
+	Ldexp(±Inf, exp) = ±Inf
+	Ldexp(NaN, exp) = NaN"
+  {:arglists '([frac exp]) :go "math.Ldexp"}
+  [frac exp]
+  (lace.go.math/Ldexp frac exp))
+
+(defn lgamma
+  "Lgamma returns the natural logarithm and sign (-1 or +1) of [Gamma](x).
+
+Special cases are:
+
+	Lgamma(+Inf) = +Inf
+	Lgamma(0) = +Inf
+	Lgamma(-integer) = +Inf
+	Lgamma(-Inf) = -Inf
+	Lgamma(NaN) = NaN"
+  {:arglists '([x]) :go "math.Lgamma"}
+  [x]
+  (lace.go.math/Lgamma x))
+
+(defn log
+  "Log returns the natural logarithm of x.
+
+Special cases are:
+
+	Log(+Inf) = +Inf
+	Log(0) = -Inf
+	Log(x < 0) = NaN
+	Log(NaN) = NaN"
+  {:arglists '([x]) :go "math.Log"}
+  [x]
+  (lace.go.math/Log x))
+
+(defn log10
+  "Log10 returns the decimal logarithm of x.
+The special cases are the same as for [Log]."
+  {:arglists '([x]) :go "math.Log10"}
+  [x]
+  (lace.go.math/Log10 x))
+
+(defn log1p
+  "Log1p returns the natural logarithm of 1 plus its argument x.
+It is more accurate than [Log](1 + x) when x is near zero.
+
+Special cases are:
+
+	Log1p(+Inf) = +Inf
+	Log1p(±0) = ±0
+	Log1p(-1) = -Inf
+	Log1p(x < -1) = NaN
+	Log1p(NaN) = NaN"
+  {:arglists '([x]) :go "math.Log1p"}
+  [x]
+  (lace.go.math/Log1p x))
+
+(defn log2
+  "Log2 returns the binary logarithm of x.
+The special cases are the same as for [Log]."
+  {:arglists '([x]) :go "math.Log2"}
+  [x]
+  (lace.go.math/Log2 x))
+
+(defn logb
+  "Logb returns the binary exponent of x.
+
+Special cases are:
+
+	Logb(±Inf) = +Inf
+	Logb(0) = -Inf
+	Logb(NaN) = NaN"
+  {:arglists '([x]) :go "math.Logb"}
+  [x]
+  (lace.go.math/Logb x))
+
+(defn max
+  "Max returns the larger of x or y.
+
+Special cases are:
+
+	Max(x, +Inf) = Max(+Inf, x) = +Inf
+	Max(x, NaN) = Max(NaN, x) = NaN
+	Max(+0, ±0) = Max(±0, +0) = +0
+	Max(-0, -0) = -0
+
+Note that this differs from the built-in function max when called
+with NaN and +Inf."
+  {:arglists '([x y]) :go "math.Max"}
+  [x y]
+  (lace.go.math/Max x y))
+
+(defn min
+  "Min returns the smaller of x or y.
+
+Special cases are:
+
+	Min(x, -Inf) = Min(-Inf, x) = -Inf
+	Min(x, NaN) = Min(NaN, x) = NaN
+	Min(-0, ±0) = Min(±0, -0) = -0
+
+Note that this differs from the built-in function min when called
+with NaN and -Inf."
+  {:arglists '([x y]) :go "math.Min"}
+  [x y]
+  (lace.go.math/Min x y))
+
+(defn mod
+  "Mod returns the floating-point remainder of x/y.
+The magnitude of the result is less than y and its
+sign agrees with that of x.
+
+Special cases are:
+
+	Mod(±Inf, y) = NaN
+	Mod(NaN, y) = NaN
+	Mod(x, 0) = NaN
+	Mod(x, ±Inf) = x
+	Mod(x, NaN) = NaN"
+  {:arglists '([x y]) :go "math.Mod"}
+  [x y]
+  (lace.go.math/Mod x y))
+
+(defn modf
+  "Modf returns integer and fractional floating-point numbers
+that sum to f. Both values have the same sign as f.
+
+Special cases are:
+
+	Modf(±Inf) = ±Inf, NaN
+	Modf(NaN) = NaN, NaN"
+  {:arglists '([f]) :go "math.Modf"}
+  [f]
+  (lace.go.math/Modf f))
+
+(defn na-n
+  "NaN returns an IEEE 754 “not-a-number” value."
+  {:arglists '([]) :go "math.NaN"}
+  []
+  (lace.go.math/NaN))
+
+(defn na-n?
+  "IsNaN reports whether f is an IEEE 754 “not-a-number” value."
+  {:arglists '([f]) :go "math.IsNaN"}
+  [f]
+  (lace.go.math/IsNaN f))
+
+(defn nextafter
+  "Nextafter returns the next representable float64 value after x towards y.
+
+Special cases are:
+
+	Nextafter(x, y)   = x when x == y
+	Nextafter(0, y)   = ±SmallestNonzeroFloat64 towards y, for y ≠ 0
+	Nextafter(NaN, y) = NaN
+	Nextafter(x, NaN) = NaN"
+  {:arglists '([x y]) :go "math.Nextafter"}
+  [x y]
+  (lace.go.math/Nextafter x y))
+
+(defn nextafter32
+  "Nextafter32 returns the next representable float32 value after x towards y.
+
+Special cases are:
+
+	Nextafter32(x, y)   = x when x == y
+	Nextafter32(0, y)   = ±SmallestNonzeroFloat32 towards y, for y ≠ 0
+	Nextafter32(NaN, y) = NaN
+	Nextafter32(x, NaN) = NaN"
+  {:arglists '([x y]) :go "math.Nextafter32"}
+  [x y]
+  (lace.go.math/Nextafter32 x y))
+
+(defn pow
+  "Pow returns x**y, the base-x exponential of y.
+
+Special cases are (in order):
+
+	Pow(x, ±0) = 1 for any x
+	Pow(1, y) = 1 for any y
+	Pow(x, 1) = x for any x
+	Pow(NaN, y) = NaN
+	Pow(x, NaN) = NaN
+	Pow(±0, y) = ±Inf for y an odd integer < 0
+	Pow(±0, -Inf) = +Inf
+	Pow(±0, +Inf) = +0
+	Pow(±0, y) = +Inf for finite y < 0 and not an odd integer
+	Pow(±0, y) = ±0 for y an odd integer > 0
+	Pow(±0, y) = +0 for finite y > 0 and not an odd integer
+	Pow(-1, ±Inf) = 1
+	Pow(x, +Inf) = +Inf for |x| > 1
+	Pow(x, -Inf) = +0 for |x| > 1
+	Pow(x, +Inf) = +0 for |x| < 1
+	Pow(x, -Inf) = +Inf for |x| < 1
+	Pow(+Inf, y) = +Inf for y > 0
+	Pow(+Inf, y) = +0 for y < 0
+	Pow(-Inf, y) = Pow(-0, -y)
+	Pow(x, y) = NaN for finite x < 0 and finite non-integer y"
+  {:arglists '([x y]) :go "math.Pow"}
+  [x y]
+  (lace.go.math/Pow x y))
+
+(defn pow10
+  "Pow10 returns 10**n, the base-10 exponential of n.
+
+Special cases are:
+
+	Pow10(n) =    0 for n < -323
+	Pow10(n) = +Inf for n > 308"
+  {:arglists '([n]) :go "math.Pow10"}
+  [n]
+  (lace.go.math/Pow10 n))
+
+(defn remainder
+  "Remainder returns the IEEE 754 floating-point remainder of x/y.
+
+Special cases are:
+
+	Remainder(±Inf, y) = NaN
+	Remainder(NaN, y) = NaN
+	Remainder(x, 0) = NaN
+	Remainder(x, ±Inf) = x
+	Remainder(x, NaN) = NaN"
+  {:arglists '([x y]) :go "math.Remainder"}
+  [x y]
+  (lace.go.math/Remainder x y))
+
+(defn round
+  "Round returns the nearest integer, rounding half away from zero.
+
+Special cases are:
+
+	Round(±0) = ±0
+	Round(±Inf) = ±Inf
+	Round(NaN) = NaN"
+  {:arglists '([x]) :go "math.Round"}
+  [x]
+  (lace.go.math/Round x))
+
+(defn round-to-even
+  "RoundToEven returns the nearest integer, rounding ties to even.
+
+Special cases are:
+
+	RoundToEven(±0) = ±0
+	RoundToEven(±Inf) = ±Inf
+	RoundToEven(NaN) = NaN"
+  {:arglists '([x]) :go "math.RoundToEven"}
+  [x]
+  (lace.go.math/RoundToEven x))
+
+(defn signbit
+  "Signbit reports whether x is negative or negative zero."
+  {:arglists '([x]) :go "math.Signbit"}
+  [x]
+  (lace.go.math/Signbit x))
+
+(defn sin
+  "Sin returns the sine of the radian argument x.
+
+Special cases are:
+
+	Sin(±0) = ±0
+	Sin(±Inf) = NaN
+	Sin(NaN) = NaN"
+  {:arglists '([x]) :go "math.Sin"}
+  [x]
+  (lace.go.math/Sin x))
+
+(defn sincos
+  "Sincos returns Sin(x), Cos(x).
+
+Special cases are:
+
+	Sincos(±0) = ±0, 1
+	Sincos(±Inf) = NaN, NaN
+	Sincos(NaN) = NaN, NaN"
+  {:arglists '([x]) :go "math.Sincos"}
+  [x]
+  (lace.go.math/Sincos x))
+
+(defn sinh
+  "Sinh returns the hyperbolic sine of x.
+
+Special cases are:
+
+	Sinh(±0) = ±0
+	Sinh(±Inf) = ±Inf
+	Sinh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Sinh"}
+  [x]
+  (lace.go.math/Sinh x))
+
+(defn sqrt
+  "Sqrt returns the square root of x.
+
+Special cases are:
+
+	Sqrt(+Inf) = +Inf
+	Sqrt(±0) = ±0
+	Sqrt(x < 0) = NaN
+	Sqrt(NaN) = NaN"
+  {:arglists '([x]) :go "math.Sqrt"}
+  [x]
+  (lace.go.math/Sqrt x))
+
+(defn tan
+  "Tan returns the tangent of the radian argument x.
+
+Special cases are:
+
+	Tan(±0) = ±0
+	Tan(±Inf) = NaN
+	Tan(NaN) = NaN"
+  {:arglists '([x]) :go "math.Tan"}
+  [x]
+  (lace.go.math/Tan x))
+
+(defn tanh
+  "Tanh returns the hyperbolic tangent of x.
+
+Special cases are:
+
+	Tanh(±0) = ±0
+	Tanh(±Inf) = ±1
+	Tanh(NaN) = NaN"
+  {:arglists '([x]) :go "math.Tanh"}
+  [x]
+  (lace.go.math/Tanh x))
+
+(defn trunc
+  "Trunc returns the integer value of x.
+
+Special cases are:
+
+	Trunc(±0) = ±0
+	Trunc(±Inf) = ±Inf
+	Trunc(NaN) = NaN"
+  {:arglists '([x]) :go "math.Trunc"}
+  [x]
+  (lace.go.math/Trunc x))
+
+(defn y0
+  "Y0 returns the order-zero Bessel function of the second kind.
+
+Special cases are:
+
+	Y0(+Inf) = 0
+	Y0(0) = -Inf
+	Y0(x < 0) = NaN
+	Y0(NaN) = NaN"
+  {:arglists '([x]) :go "math.Y0"}
+  [x]
+  (lace.go.math/Y0 x))
+
+(defn y1
+  "Y1 returns the order-one Bessel function of the second kind.
+
+Special cases are:
+
+	Y1(+Inf) = 0
+	Y1(0) = -Inf
+	Y1(x < 0) = NaN
+	Y1(NaN) = NaN"
+  {:arglists '([x]) :go "math.Y1"}
+  [x]
+  (lace.go.math/Y1 x))
+
+(defn yn
+  "Yn returns the order-n Bessel function of the second kind.
+
+Special cases are:
+
+	Yn(n, +Inf) = 0
+	Yn(n ≥ 0, 0) = -Inf
+	Yn(n < 0, 0) = +Inf if n is odd, -Inf if n is even
+	Yn(n, x < 0) = NaN
+	Yn(n, NaN) = NaN"
+  {:arglists '([n x]) :go "math.Yn"}
+  [n x]
+  (lace.go.math/Yn n x))
@@ -0,0 +1,194 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	math "math"
+)
+
+//go:embed math.clj
+var wrapper_math string
+
+func init() {
+	pkgreflect.AddPackage("lace.go.math", &pkgreflect.Package{
+		Doc:       "Package math provides basic constants and mathematical functions.",
+		WrapperNS: "go.math",
+		Wrapper:   wrapper_math,
+		Types:     map[string]pkgreflect.Type{},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Abs": {Doc: "Abs returns the absolute value of x.\n\nSpecial cases are:\n\n\tAbs(±Inf) = +Inf\n\tAbs(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Abs)},
+
+			"Acos": {Doc: "Acos returns the arccosine, in radians, of x.\n\nSpecial case is:\n\n\tAcos(x) = NaN if x < -1 or x > 1", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Acos)},
+
+			"Acosh": {Doc: "Acosh returns the inverse hyperbolic cosine of x.\n\nSpecial cases are:\n\n\tAcosh(+Inf) = +Inf\n\tAcosh(x) = NaN if x < 1\n\tAcosh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Acosh)},
+
+			"Asin": {Doc: "Asin returns the arcsine, in radians, of x.\n\nSpecial cases are:\n\n\tAsin(±0) = ±0\n\tAsin(x) = NaN if x < -1 or x > 1", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Asin)},
+
+			"Asinh": {Doc: "Asinh returns the inverse hyperbolic sine of x.\n\nSpecial cases are:\n\n\tAsinh(±0) = ±0\n\tAsinh(±Inf) = ±Inf\n\tAsinh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Asinh)},
+
+			"Atan": {Doc: "Atan returns the arctangent, in radians, of x.\n\nSpecial cases are:\n\n\tAtan(±0) = ±0\n\tAtan(±Inf) = ±Pi/2", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Atan)},
+
+			"Atan2": {Doc: "Atan2 returns the arc tangent of y/x, using\nthe signs of the two to determine the quadrant\nof the return value.\n\nSpecial cases are (in order):\n\n\tAtan2(y, NaN) = NaN\n\tAtan2(NaN, x) = NaN\n\tAtan2(+0, x>=0) = +0\n\tAtan2(-0, x>=0) = -0\n\tAtan2(+0, x<=-0) = +Pi\n\tAtan2(-0, x<=-0) = -Pi\n\tAtan2(y>0, 0) = +Pi/2\n\tAtan2(y<0, 0) = -Pi/2\n\tAtan2(+Inf, +Inf) = +Pi/4\n\tAtan2(-Inf, +Inf) = -Pi/4\n\tAtan2(+Inf, -Inf) = 3Pi/4\n\tAtan2(-Inf, -Inf) = -3Pi/4\n\tAtan2(y, +Inf) = 0\n\tAtan2(y>0, -Inf) = +Pi\n\tAtan2(y<0, -Inf) = -Pi\n\tAtan2(+Inf, x) = +Pi/2\n\tAtan2(-Inf, x) = -Pi/2", Args: []pkgreflect.Arg{{Name: "y", Tag: "float64"}, {Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Atan2)},
+
+			"Atanh": {Doc: "Atanh returns the inverse hyperbolic tangent of x.\n\nSpecial cases are:\n\n\tAtanh(1) = +Inf\n\tAtanh(±0) = ±0\n\tAtanh(-1) = -Inf\n\tAtanh(x) = NaN if x < -1 or x > 1\n\tAtanh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Atanh)},
+
+			"Cbrt": {Doc: "Cbrt returns the cube root of x.\n\nSpecial cases are:\n\n\tCbrt(±0) = ±0\n\tCbrt(±Inf) = ±Inf\n\tCbrt(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Cbrt)},
+
+			"Ceil": {Doc: "Ceil returns the least integer value greater than or equal to x.\n\nSpecial cases are:\n\n\tCeil(±0) = ±0\n\tCeil(±Inf) = ±Inf\n\tCeil(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Ceil)},
+
+			"Copysign": {Doc: "Copysign returns a value with the magnitude of f\nand the sign of sign.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}, {Name: "sign", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Copysign)},
+
+			"Cos": {Doc: "Cos returns the cosine of the radian argument x.\n\nSpecial cases are:\n\n\tCos(±Inf) = NaN\n\tCos(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Cos)},
+
+			"Cosh": {Doc: "Cosh returns the hyperbolic cosine of x.\n\nSpecial cases are:\n\n\tCosh(±0) = 1\n\tCosh(±Inf) = +Inf\n\tCosh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Cosh)},
+
+			"Dim": {Doc: "Dim returns the maximum of x-y or 0.\n\nSpecial cases are:\n\n\tDim(+Inf, +Inf) = NaN\n\tDim(-Inf, -Inf) = NaN\n\tDim(x, NaN) = Dim(NaN, x) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Dim)},
+
+			"Erf": {Doc: "Erf returns the error function of x.\n\nSpecial cases are:\n\n\tErf(+Inf) = 1\n\tErf(-Inf) = -1\n\tErf(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Erf)},
+
+			"Erfc": {Doc: "Erfc returns the complementary error function of x.\n\nSpecial cases are:\n\n\tErfc(+Inf) = 0\n\tErfc(-Inf) = 2\n\tErfc(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Erfc)},
+
+			"Erfcinv": {Doc: "Erfcinv returns the inverse of [Erfc](x).\n\nSpecial cases are:\n\n\tErfcinv(0) = +Inf\n\tErfcinv(2) = -Inf\n\tErfcinv(x) = NaN if x < 0 or x > 2\n\tErfcinv(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Erfcinv)},
+
+			"Erfinv": {Doc: "Erfinv returns the inverse error function of x.\n\nSpecial cases are:\n\n\tErfinv(1) = +Inf\n\tErfinv(-1) = -Inf\n\tErfinv(x) = NaN if x < -1 or x > 1\n\tErfinv(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Erfinv)},
+
+			"Exp": {Doc: "Exp returns e**x, the base-e exponential of x.\n\nSpecial cases are:\n\n\tExp(+Inf) = +Inf\n\tExp(NaN) = NaN\n\nVery large values overflow to 0 or +Inf.\nVery small values underflow to 1.", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Exp)},
+
+			"Exp2": {Doc: "Exp2 returns 2**x, the base-2 exponential of x.\n\nSpecial cases are the same as [Exp].", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Exp2)},
+
+			"Expm1": {Doc: "Expm1 returns e**x - 1, the base-e exponential of x minus 1.\nIt is more accurate than [Exp](x) - 1 when x is near zero.\n\nSpecial cases are:\n\n\tExpm1(+Inf) = +Inf\n\tExpm1(-Inf) = -1\n\tExpm1(NaN) = NaN\n\nVery large values overflow to -1 or +Inf.", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Expm1)},
+
+			"FMA": {Doc: "FMA returns x * y + z, computed with only one rounding.\n(That is, FMA returns the fused multiply-add of x, y, and z.)", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}, {Name: "z", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.FMA)},
+
+			"Float32bits": {Doc: "Float32bits returns the IEEE 754 binary representation of f,\nwith the sign bit of f and the result in the same bit position.\nFloat32bits(Float32frombits(x)) == x.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float32"}}, Tag: "uint32", Value: reflect.ValueOf(math.Float32bits)},
+
+			"Float32frombits": {Doc: "Float32frombits returns the floating-point number corresponding\nto the IEEE 754 binary representation b, with the sign bit of b\nand the result in the same bit position.\nFloat32frombits(Float32bits(x)) == x.", Args: []pkgreflect.Arg{{Name: "b", Tag: "uint32"}}, Tag: "float32", Value: reflect.ValueOf(math.Float32frombits)},
+
+			"Float64bits": {Doc: "Float64bits returns the IEEE 754 binary representation of f,\nwith the sign bit of f and the result in the same bit position,\nand Float64bits(Float64frombits(x)) == x.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}}, Tag: "uint64", Value: reflect.ValueOf(math.Float64bits)},
+
+			"Float64frombits": {Doc: "Float64frombits returns the floating-point number corresponding\nto the IEEE 754 binary representation b, with the sign bit of b\nand the result in the same bit position.\nFloat64frombits(Float64bits(x)) == x.", Args: []pkgreflect.Arg{{Name: "b", Tag: "uint64"}}, Tag: "float64", Value: reflect.ValueOf(math.Float64frombits)},
+
+			"Floor": {Doc: "Floor returns the greatest integer value less than or equal to x.\n\nSpecial cases are:\n\n\tFloor(±0) = ±0\n\tFloor(±Inf) = ±Inf\n\tFloor(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Floor)},
+
+			"Frexp": {Doc: "Frexp breaks f into a normalized fraction\nand an integral power of two.\nIt returns frac and exp satisfying f == frac × 2**exp,\nwith the absolute value of frac in the interval [½, 1).\n\nSpecial cases are:\n\n\tFrexp(±0) = ±0, 0\n\tFrexp(±Inf) = ±Inf, 0\n\tFrexp(NaN) = NaN, 0", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}}, Tag: "any", Value: reflect.ValueOf(math.Frexp)},
+
+			"Gamma": {Doc: "Gamma returns the Gamma function of x.\n\nSpecial cases are:\n\n\tGamma(+Inf) = +Inf\n\tGamma(+0) = +Inf\n\tGamma(-0) = -Inf\n\tGamma(x) = NaN for integer x < 0\n\tGamma(-Inf) = NaN\n\tGamma(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Gamma)},
+
+			"Hypot": {Doc: "Hypot returns [Sqrt](p*p + q*q), taking care to avoid\nunnecessary overflow and underflow.\n\nSpecial cases are:\n\n\tHypot(±Inf, q) = +Inf\n\tHypot(p, ±Inf) = +Inf\n\tHypot(NaN, q) = NaN\n\tHypot(p, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "p", Tag: "float64"}, {Name: "q", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Hypot)},
+
+			"Ilogb": {Doc: "Ilogb returns the binary exponent of x as an integer.\n\nSpecial cases are:\n\n\tIlogb(±Inf) = MaxInt32\n\tIlogb(0) = MinInt32\n\tIlogb(NaN) = MaxInt32", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "int", Value: reflect.ValueOf(math.Ilogb)},
+
+			"Inf": {Doc: "Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.", Args: []pkgreflect.Arg{{Name: "sign", Tag: "int"}}, Tag: "float64", Value: reflect.ValueOf(math.Inf)},
+
+			"IsInf": {Doc: "IsInf reports whether f is an infinity, according to sign.\nIf sign > 0, IsInf reports whether f is positive infinity.\nIf sign < 0, IsInf reports whether f is negative infinity.\nIf sign == 0, IsInf reports whether f is either infinity.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}, {Name: "sign", Tag: "int"}}, Tag: "bool", Value: reflect.ValueOf(math.IsInf)},
+
+			"IsNaN": {Doc: "IsNaN reports whether f is an IEEE 754 “not-a-number” value.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}}, Tag: "bool", Value: reflect.ValueOf(math.IsNaN)},
+
+			"J0": {Doc: "J0 returns the order-zero Bessel function of the first kind.\n\nSpecial cases are:\n\n\tJ0(±Inf) = 0\n\tJ0(0) = 1\n\tJ0(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.J0)},
+
+			"J1": {Doc: "J1 returns the order-one Bessel function of the first kind.\n\nSpecial cases are:\n\n\tJ1(±Inf) = 0\n\tJ1(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.J1)},
+
+			"Jn": {Doc: "Jn returns the order-n Bessel function of the first kind.\n\nSpecial cases are:\n\n\tJn(n, ±Inf) = 0\n\tJn(n, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}, {Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Jn)},
+
+			"Ldexp": {Doc: "Ldexp is the inverse of [Frexp].\nIt returns frac × 2**exp.\n\nSpecial cases are:\n\n\tLdexp(±0, exp) = ±0\n\tLdexp(±Inf, exp) = ±Inf\n\tLdexp(NaN, exp) = NaN", Args: []pkgreflect.Arg{{Name: "frac", Tag: "float64"}, {Name: "exp", Tag: "int"}}, Tag: "float64", Value: reflect.ValueOf(math.Ldexp)},
+
+			"Lgamma": {Doc: "Lgamma returns the natural logarithm and sign (-1 or +1) of [Gamma](x).\n\nSpecial cases are:\n\n\tLgamma(+Inf) = +Inf\n\tLgamma(0) = +Inf\n\tLgamma(-integer) = +Inf\n\tLgamma(-Inf) = -Inf\n\tLgamma(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "any", Value: reflect.ValueOf(math.Lgamma)},
+
+			"Log": {Doc: "Log returns the natural logarithm of x.\n\nSpecial cases are:\n\n\tLog(+Inf) = +Inf\n\tLog(0) = -Inf\n\tLog(x < 0) = NaN\n\tLog(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Log)},
+
+			"Log10": {Doc: "Log10 returns the decimal logarithm of x.\nThe special cases are the same as for [Log].", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Log10)},
+
+			"Log1p": {Doc: "Log1p returns the natural logarithm of 1 plus its argument x.\nIt is more accurate than [Log](1 + x) when x is near zero.\n\nSpecial cases are:\n\n\tLog1p(+Inf) = +Inf\n\tLog1p(±0) = ±0\n\tLog1p(-1) = -Inf\n\tLog1p(x < -1) = NaN\n\tLog1p(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Log1p)},
+
+			"Log2": {Doc: "Log2 returns the binary logarithm of x.\nThe special cases are the same as for [Log].", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Log2)},
+
+			"Logb": {Doc: "Logb returns the binary exponent of x.\n\nSpecial cases are:\n\n\tLogb(±Inf) = +Inf\n\tLogb(0) = -Inf\n\tLogb(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Logb)},
+
+			"Max": {Doc: "Max returns the larger of x or y.\n\nSpecial cases are:\n\n\tMax(x, +Inf) = Max(+Inf, x) = +Inf\n\tMax(x, NaN) = Max(NaN, x) = NaN\n\tMax(+0, ±0) = Max(±0, +0) = +0\n\tMax(-0, -0) = -0\n\nNote that this differs from the built-in function max when called\nwith NaN and +Inf.", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Max)},
+
+			"Min": {Doc: "Min returns the smaller of x or y.\n\nSpecial cases are:\n\n\tMin(x, -Inf) = Min(-Inf, x) = -Inf\n\tMin(x, NaN) = Min(NaN, x) = NaN\n\tMin(-0, ±0) = Min(±0, -0) = -0\n\nNote that this differs from the built-in function min when called\nwith NaN and -Inf.", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Min)},
+
+			"Mod": {Doc: "Mod returns the floating-point remainder of x/y.\nThe magnitude of the result is less than y and its\nsign agrees with that of x.\n\nSpecial cases are:\n\n\tMod(±Inf, y) = NaN\n\tMod(NaN, y) = NaN\n\tMod(x, 0) = NaN\n\tMod(x, ±Inf) = x\n\tMod(x, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Mod)},
+
+			"Modf": {Doc: "Modf returns integer and fractional floating-point numbers\nthat sum to f. Both values have the same sign as f.\n\nSpecial cases are:\n\n\tModf(±Inf) = ±Inf, NaN\n\tModf(NaN) = NaN, NaN", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}}, Tag: "any", Value: reflect.ValueOf(math.Modf)},
+
+			"NaN": {Doc: "NaN returns an IEEE 754 “not-a-number” value.", Args: []pkgreflect.Arg{}, Tag: "float64", Value: reflect.ValueOf(math.NaN)},
+
+			"Nextafter": {Doc: "Nextafter returns the next representable float64 value after x towards y.\n\nSpecial cases are:\n\n\tNextafter(x, y)   = x when x == y\n\tNextafter(0, y)   = ±SmallestNonzeroFloat64 towards y, for y ≠ 0\n\tNextafter(NaN, y) = NaN\n\tNextafter(x, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Nextafter)},
+
+			"Nextafter32": {Doc: "Nextafter32 returns the next representable float32 value after x towards y.\n\nSpecial cases are:\n\n\tNextafter32(x, y)   = x when x == y\n\tNextafter32(0, y)   = ±SmallestNonzeroFloat32 towards y, for y ≠ 0\n\tNextafter32(NaN, y) = NaN\n\tNextafter32(x, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float32"}, {Name: "y", Tag: "float32"}}, Tag: "float32", Value: reflect.ValueOf(math.Nextafter32)},
+
+			"Pow": {Doc: "Pow returns x**y, the base-x exponential of y.\n\nSpecial cases are (in order):\n\n\tPow(x, ±0) = 1 for any x\n\tPow(1, y) = 1 for any y\n\tPow(x, 1) = x for any x\n\tPow(NaN, y) = NaN\n\tPow(x, NaN) = NaN\n\tPow(±0, y) = ±Inf for y an odd integer < 0\n\tPow(±0, -Inf) = +Inf\n\tPow(±0, +Inf) = +0\n\tPow(±0, y) = +Inf for finite y < 0 and not an odd integer\n\tPow(±0, y) = ±0 for y an odd integer > 0\n\tPow(±0, y) = +0 for finite y > 0 and not an odd integer\n\tPow(-1, ±Inf) = 1\n\tPow(x, +Inf) = +Inf for |x| > 1\n\tPow(x, -Inf) = +0 for |x| > 1\n\tPow(x, +Inf) = +0 for |x| < 1\n\tPow(x, -Inf) = +Inf for |x| < 1\n\tPow(+Inf, y) = +Inf for y > 0\n\tPow(+Inf, y) = +0 for y < 0\n\tPow(-Inf, y) = Pow(-0, -y)\n\tPow(x, y) = NaN for finite x < 0 and finite non-integer y", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Pow)},
+
+			"Pow10": {Doc: "Pow10 returns 10**n, the base-10 exponential of n.\n\nSpecial cases are:\n\n\tPow10(n) =    0 for n < -323\n\tPow10(n) = +Inf for n > 308", Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "float64", Value: reflect.ValueOf(math.Pow10)},
+
+			"Remainder": {Doc: "Remainder returns the IEEE 754 floating-point remainder of x/y.\n\nSpecial cases are:\n\n\tRemainder(±Inf, y) = NaN\n\tRemainder(NaN, y) = NaN\n\tRemainder(x, 0) = NaN\n\tRemainder(x, ±Inf) = x\n\tRemainder(x, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}, {Name: "y", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Remainder)},
+
+			"Round": {Doc: "Round returns the nearest integer, rounding half away from zero.\n\nSpecial cases are:\n\n\tRound(±0) = ±0\n\tRound(±Inf) = ±Inf\n\tRound(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Round)},
+
+			"RoundToEven": {Doc: "RoundToEven returns the nearest integer, rounding ties to even.\n\nSpecial cases are:\n\n\tRoundToEven(±0) = ±0\n\tRoundToEven(±Inf) = ±Inf\n\tRoundToEven(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.RoundToEven)},
+
+			"Signbit": {Doc: "Signbit reports whether x is negative or negative zero.", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "bool", Value: reflect.ValueOf(math.Signbit)},
+
+			"Sin": {Doc: "Sin returns the sine of the radian argument x.\n\nSpecial cases are:\n\n\tSin(±0) = ±0\n\tSin(±Inf) = NaN\n\tSin(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Sin)},
+
+			"Sincos": {Doc: "Sincos returns Sin(x), Cos(x).\n\nSpecial cases are:\n\n\tSincos(±0) = ±0, 1\n\tSincos(±Inf) = NaN, NaN\n\tSincos(NaN) = NaN, NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Sincos)},
+
+			"Sinh": {Doc: "Sinh returns the hyperbolic sine of x.\n\nSpecial cases are:\n\n\tSinh(±0) = ±0\n\tSinh(±Inf) = ±Inf\n\tSinh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Sinh)},
+
+			"Sqrt": {Doc: "Sqrt returns the square root of x.\n\nSpecial cases are:\n\n\tSqrt(+Inf) = +Inf\n\tSqrt(±0) = ±0\n\tSqrt(x < 0) = NaN\n\tSqrt(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Sqrt)},
+
+			"Tan": {Doc: "Tan returns the tangent of the radian argument x.\n\nSpecial cases are:\n\n\tTan(±0) = ±0\n\tTan(±Inf) = NaN\n\tTan(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Tan)},
+
+			"Tanh": {Doc: "Tanh returns the hyperbolic tangent of x.\n\nSpecial cases are:\n\n\tTanh(±0) = ±0\n\tTanh(±Inf) = ±1\n\tTanh(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Tanh)},
+
+			"Trunc": {Doc: "Trunc returns the integer value of x.\n\nSpecial cases are:\n\n\tTrunc(±0) = ±0\n\tTrunc(±Inf) = ±Inf\n\tTrunc(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Trunc)},
+
+			"Y0": {Doc: "Y0 returns the order-zero Bessel function of the second kind.\n\nSpecial cases are:\n\n\tY0(+Inf) = 0\n\tY0(0) = -Inf\n\tY0(x < 0) = NaN\n\tY0(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Y0)},
+
+			"Y1": {Doc: "Y1 returns the order-one Bessel function of the second kind.\n\nSpecial cases are:\n\n\tY1(+Inf) = 0\n\tY1(0) = -Inf\n\tY1(x < 0) = NaN\n\tY1(NaN) = NaN", Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Y1)},
+
+			"Yn": {Doc: "Yn returns the order-n Bessel function of the second kind.\n\nSpecial cases are:\n\n\tYn(n, +Inf) = 0\n\tYn(n ≥ 0, 0) = -Inf\n\tYn(n < 0, 0) = +Inf if n is odd, -Inf if n is even\n\tYn(n, x < 0) = NaN\n\tYn(n, NaN) = NaN", Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}, {Name: "x", Tag: "float64"}}, Tag: "float64", Value: reflect.ValueOf(math.Yn)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{
+			"E":                      {Doc: "", Value: reflect.ValueOf(math.E)},
+			"Ln10":                   {Doc: "", Value: reflect.ValueOf(math.Ln10)},
+			"Ln2":                    {Doc: "", Value: reflect.ValueOf(math.Ln2)},
+			"Log10E":                 {Doc: "", Value: reflect.ValueOf(math.Log10E)},
+			"Log2E":                  {Doc: "", Value: reflect.ValueOf(math.Log2E)},
+			"MaxFloat32":             {Doc: "", Value: reflect.ValueOf(math.MaxFloat32)},
+			"MaxFloat64":             {Doc: "", Value: reflect.ValueOf(math.MaxFloat64)},
+			"MaxInt":                 {Doc: "", Value: reflect.ValueOf(math.MaxInt)},
+			"MaxInt16":               {Doc: "", Value: reflect.ValueOf(math.MaxInt16)},
+			"MaxInt32":               {Doc: "", Value: reflect.ValueOf(math.MaxInt32)},
+			"MaxInt64":               {Doc: "", Value: reflect.ValueOf(math.MaxInt64)},
+			"MaxInt8":                {Doc: "", Value: reflect.ValueOf(math.MaxInt8)},
+			"MaxUint":                {Doc: "", Value: reflect.ValueOf(uint64(math.MaxUint))},
+			"MaxUint16":              {Doc: "", Value: reflect.ValueOf(math.MaxUint16)},
+			"MaxUint32":              {Doc: "", Value: reflect.ValueOf(math.MaxUint32)},
+			"MaxUint64":              {Doc: "", Value: reflect.ValueOf(uint64(math.MaxUint64))},
+			"MaxUint8":               {Doc: "", Value: reflect.ValueOf(math.MaxUint8)},
+			"MinInt":                 {Doc: "", Value: reflect.ValueOf(math.MinInt)},
+			"MinInt16":               {Doc: "", Value: reflect.ValueOf(math.MinInt16)},
+			"MinInt32":               {Doc: "", Value: reflect.ValueOf(math.MinInt32)},
+			"MinInt64":               {Doc: "", Value: reflect.ValueOf(math.MinInt64)},
+			"MinInt8":                {Doc: "", Value: reflect.ValueOf(math.MinInt8)},
+			"Phi":                    {Doc: "", Value: reflect.ValueOf(math.Phi)},
+			"Pi":                     {Doc: "", Value: reflect.ValueOf(math.Pi)},
+			"SmallestNonzeroFloat32": {Doc: "", Value: reflect.ValueOf(math.SmallestNonzeroFloat32)},
+			"SmallestNonzeroFloat64": {Doc: "", Value: reflect.ValueOf(math.SmallestNonzeroFloat64)},
+			"Sqrt2":                  {Doc: "", Value: reflect.ValueOf(math.Sqrt2)},
+			"SqrtE":                  {Doc: "", Value: reflect.ValueOf(math.SqrtE)},
+			"SqrtPhi":                {Doc: "", Value: reflect.ValueOf(math.SqrtPhi)},
+			"SqrtPi":                 {Doc: "", Value: reflect.ValueOf(math.SqrtPi)},
+		},
+	})
+}
@@ -0,0 +1,622 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.net.http
+  "Package http provides HTTP client and server implementations."
+  (:refer-clojure :exclude [allow-query-semicolons canonical-header-key detect-content-type error file-server file-server-fs fs get handle handle-func head listen-and-serve listen-and-serve-tls max-bytes-handler max-bytes-reader new-file-transport new-file-transport-fs new-request new-request-with-context new-response-controller new-serve-mux not-found not-found-handler parse-http-version parse-time post post-form proxy-from-environment proxy-url read-request read-response redirect redirect-handler serve serve-content serve-file serve-file-fs serve-tls set-cookie status-text strip-prefix timeout-handler]))
+
+(defn allow-query-semicolons
+  "AllowQuerySemicolons returns a handler that serves requests by converting any
+unescaped semicolons in the URL query to ampersands, and invoking the handler h.
+
+This restores the pre-Go 1.17 behavior of splitting query parameters on both
+semicolons and ampersands. (See golang.org/issue/25192). Note that this
+behavior doesn't match that of many proxies, and the mismatch can lead to
+security issues.
+
+AllowQuerySemicolons should be invoked before [Request.ParseForm] is called."
+  {:arglists '([h]) :go "net/http.AllowQuerySemicolons"}
+  [h]
+  (lace.go.net.http/AllowQuerySemicolons h))
+
+(defn canonical-header-key
+  "CanonicalHeaderKey returns the canonical format of the
+header key s. The canonicalization converts the first
+letter and any letter following a hyphen to upper case;
+the rest are converted to lowercase. For example, the
+canonical key for \"accept-encoding\" is \"Accept-Encoding\".
+If s contains a space or invalid header field bytes, it is
+returned without modifications."
+  {:arglists '([s]) :go "net/http.CanonicalHeaderKey"}
+  [s]
+  (lace.go.net.http/CanonicalHeaderKey s))
+
+(defn detect-content-type
+  "DetectContentType implements the algorithm described
+at https://mimesniff.spec.whatwg.org/ to determine the
+Content-Type of the given data. It considers at most the
+first 512 bytes of data. DetectContentType always returns
+a valid MIME type: if it cannot determine a more specific one, it
+returns \"application/octet-stream\"."
+  {:arglists '([data]) :go "net/http.DetectContentType"}
+  [data]
+  (lace.go.net.http/DetectContentType data))
+
+(defn error
+  "Error replies to the request with the specified error message and HTTP code.
+It does not otherwise end the request; the caller should ensure no further
+writes are done to w.
+The error message should be plain text.
+
+Error deletes the Content-Length header,
+sets Content-Type to “text/plain; charset=utf-8”,
+and sets X-Content-Type-Options to “nosniff”.
+This configures the header properly for the error message,
+in case the caller had set it up expecting a successful output."
+  {:arglists '([w error code]) :go "net/http.Error"}
+  [w error code]
+  (lace.go.net.http/Error w error code))
+
+(defn file-server
+  "FileServer returns a handler that serves HTTP requests
+with the contents of the file system rooted at root.
+
+As a special case, the returned file server redirects any request
+ending in \"/index.html\" to the same path, without the final
+\"index.html\".
+
+To use the operating system's file system implementation,
+use [http.Dir]:
+
+	http.Handle(\"/\", http.FileServer(http.Dir(\"/tmp\")))
+
+To use an [fs.FS] implementation, use [http.FileServerFS] instead."
+  {:arglists '([root]) :go "net/http.FileServer"}
+  [root]
+  (lace.go.net.http/FileServer root))
+
+(defn file-server-fs
+  "FileServerFS returns a handler that serves HTTP requests
+with the contents of the file system fsys.
+The files provided by fsys must implement [io.Seeker].
+
+As a special case, the returned file server redirects any request
+ending in \"/index.html\" to the same path, without the final
+\"index.html\".
+
+	http.Handle(\"/\", http.FileServerFS(fsys))"
+  {:arglists '([root]) :go "net/http.FileServerFS"}
+  [root]
+  (lace.go.net.http/FileServerFS root))
+
+(defn fs
+  "FS converts fsys to a [FileSystem] implementation,
+for use with [FileServer] and [NewFileTransport].
+The files provided by fsys must implement [io.Seeker]."
+  {:arglists '([fsys]) :go "net/http.FS"}
+  [fsys]
+  (lace.go.net.http/FS fsys))
+
+(defn get
+  "Get issues a GET to the specified URL. If the response is one of
+the following redirect codes, Get follows the redirect, up to a
+maximum of 10 redirects:
+
+	301 (Moved Permanently)
+	302 (Found)
+	303 (See Other)
+	307 (Temporary Redirect)
+	308 (Permanent Redirect)
+
+An error is returned if there were too many redirects or if there
+was an HTTP protocol error. A non-2xx response doesn't cause an
+error. Any returned error will be of type [*url.Error]. The url.Error
+value's Timeout method will report true if the request timed out.
+
+When err is nil, resp always contains a non-nil resp.Body.
+Caller should close resp.Body when done reading from it.
+
+Get is a wrapper around DefaultClient.Get.
+
+To make a request with custom headers, use [NewRequest] and
+DefaultClient.Do.
+
+To make a request with a specified context.Context, use [NewRequestWithContext]
+and DefaultClient.Do."
+  {:arglists '([url]) :go "net/http.Get"}
+  [url]
+  (lace.go.net.http/Get url))
+
+(defn handle
+  "Handle registers the handler for the given pattern in [DefaultServeMux].
+The documentation for [ServeMux] explains how patterns are matched."
+  {:arglists '([pattern handler]) :go "net/http.Handle"}
+  [pattern handler]
+  (lace.go.net.http/Handle pattern handler))
+
+(defn handle-func
+  "HandleFunc registers the handler function for the given pattern in [DefaultServeMux].
+The documentation for [ServeMux] explains how patterns are matched."
+  {:arglists '([pattern handler]) :go "net/http.HandleFunc"}
+  [pattern handler]
+  (lace.go.net.http/HandleFunc pattern handler))
+
+(defn head
+  "Head issues a HEAD to the specified URL. If the response is one of
+the following redirect codes, Head follows the redirect, up to a
+maximum of 10 redirects:
+
+	301 (Moved Permanently)
+	302 (Found)
+	303 (See Other)
+	307 (Temporary Redirect)
+	308 (Permanent Redirect)
+
+Head is a wrapper around DefaultClient.Head.
+
+To make a request with a specified [context.Context], use [NewRequestWithContext]
+and DefaultClient.Do."
+  {:arglists '([url]) :go "net/http.Head"}
+  [url]
+  (lace.go.net.http/Head url))
+
+(defn listen-and-serve
+  "ListenAndServe listens on the TCP network address addr and then calls
+[Serve] with handler to handle requests on incoming connections.
+Accepted connections are configured to enable TCP keep-alives.
+
+The handler is typically nil, in which case [DefaultServeMux] is used.
+
+ListenAndServe always returns a non-nil error."
+  {:arglists '([addr handler]) :go "net/http.ListenAndServe"}
+  [addr handler]
+  (lace.go.net.http/ListenAndServe addr handler))
+
+(defn listen-and-serve-tls
+  "ListenAndServeTLS acts identically to [ListenAndServe], except that it
+expects HTTPS connections. Additionally, files containing a certificate and
+matching private key for the server must be provided. If the certificate
+is signed by a certificate authority, the certFile should be the concatenation
+of the server's certificate, any intermediates, and the CA's certificate."
+  {:arglists '([addr cert-file key-file handler]) :go "net/http.ListenAndServeTLS"}
+  [addr cert-file key-file handler]
+  (lace.go.net.http/ListenAndServeTLS addr cert-file key-file handler))
+
+(defn max-bytes-handler
+  "MaxBytesHandler returns a [Handler] that runs h with its [ResponseWriter] and [Request.Body] wrapped by a MaxBytesReader."
+  {:arglists '([h n]) :go "net/http.MaxBytesHandler"}
+  [h n]
+  (lace.go.net.http/MaxBytesHandler h n))
+
+(defn max-bytes-reader
+  "MaxBytesReader is similar to [io.LimitReader] but is intended for
+limiting the size of incoming request bodies. In contrast to
+io.LimitReader, MaxBytesReader's result is a ReadCloser, returns a
+non-nil error of type [*MaxBytesError] for a Read beyond the limit,
+and closes the underlying reader when its Close method is called.
+
+MaxBytesReader prevents clients from accidentally or maliciously
+sending a large request and wasting server resources. If possible,
+it tells the [ResponseWriter] to close the connection after the limit
+has been reached."
+  {:arglists '([w r n]) :go "net/http.MaxBytesReader"}
+  [w r n]
+  (lace.go.net.http/MaxBytesReader w r n))
+
+(defn new-file-transport
+  "NewFileTransport returns a new [RoundTripper], serving the provided
+[FileSystem]. The returned RoundTripper ignores the URL host in its
+incoming requests, as well as most other properties of the
+request.
+
+The typical use case for NewFileTransport is to register the \"file\"
+protocol with a [Transport], as in:
+
+	t := &http.Transport{}
+	t.RegisterProtocol(\"file\", http.NewFileTransport(http.Dir(\"/\")))
+	c := &http.Client{Transport: t}
+	res, err := c.Get(\"file:///etc/passwd\")
+	..."
+  {:arglists '([fs]) :go "net/http.NewFileTransport"}
+  [fs]
+  (lace.go.net.http/NewFileTransport fs))
+
+(defn new-file-transport-fs
+  "NewFileTransportFS returns a new [RoundTripper], serving the provided
+file system fsys. The returned RoundTripper ignores the URL host in its
+incoming requests, as well as most other properties of the
+request. The files provided by fsys must implement [io.Seeker].
+
+The typical use case for NewFileTransportFS is to register the \"file\"
+protocol with a [Transport], as in:
+
+	fsys := os.DirFS(\"/\")
+	t := &http.Transport{}
+	t.RegisterProtocol(\"file\", http.NewFileTransportFS(fsys))
+	c := &http.Client{Transport: t}
+	res, err := c.Get(\"file:///etc/passwd\")
+	..."
+  {:arglists '([fsys]) :go "net/http.NewFileTransportFS"}
+  [fsys]
+  (lace.go.net.http/NewFileTransportFS fsys))
+
+(defn new-request
+  "NewRequest wraps [NewRequestWithContext] using [context.Background]."
+  {:arglists '([method url body]) :go "net/http.NewRequest"}
+  [method url body]
+  (lace.go.net.http/NewRequest method url body))
+
+(defn new-request-with-context
+  "NewRequestWithContext returns a new [Request] given a method, URL, and
+optional body.
+
+If the provided body is also an [io.Closer], the returned
+[Request.Body] is set to body and will be closed (possibly
+asynchronously) by the Client methods Do, Post, and PostForm,
+and [Transport.RoundTrip].
+
+NewRequestWithContext returns a Request suitable for use with
+[Client.Do] or [Transport.RoundTrip]. To create a request for use with
+testing a Server Handler, either use the [net/http/httptest.NewRequest] function,
+use [ReadRequest], or manually update the Request fields.
+For an outgoing client request, the context
+controls the entire lifetime of a request and its response:
+obtaining a connection, sending the request, and reading the
+response headers and body. See the [Request] type's documentation for
+the difference between inbound and outbound request fields.
+
+If body is of type [*bytes.Buffer], [*bytes.Reader], or
+[*strings.Reader], the returned request's ContentLength is set to its
+exact value (instead of -1), GetBody is populated (so 307 and 308
+redirects can replay the body), and Body is set to [NoBody] if the
+ContentLength is 0."
+  {:arglists '([ctx method url body]) :go "net/http.NewRequestWithContext"}
+  [ctx method url body]
+  (lace.go.net.http/NewRequestWithContext ctx method url body))
+
+(defn new-response-controller
+  "NewResponseController creates a [ResponseController] for a request.
+
+The ResponseWriter should be the original value passed to the [Handler.ServeHTTP] method,
+or have an Unwrap method returning the original ResponseWriter.
+
+If the ResponseWriter implements any of the following methods, the ResponseController
+will call them as appropriate:
+
+	Flush()
+	FlushError() error // alternative Flush returning an error
+	Hijack() (net.Conn, *bufio.ReadWriter, error)
+	SetReadDeadline(deadline time.Time) error
+	SetWriteDeadline(deadline time.Time) error
+	EnableFullDuplex() error
+
+If the ResponseWriter does not support a method, ResponseController returns
+an error matching [ErrNotSupported]."
+  {:arglists '([rw]) :go "net/http.NewResponseController"}
+  [rw]
+  (lace.go.net.http/NewResponseController rw))
+
+(defn new-serve-mux
+  "NewServeMux allocates and returns a new [ServeMux]."
+  {:arglists '([]) :go "net/http.NewServeMux"}
+  []
+  (lace.go.net.http/NewServeMux))
+
+(defn not-found
+  "NotFound replies to the request with an HTTP 404 not found error."
+  {:arglists '([w r]) :go "net/http.NotFound"}
+  [w r]
+  (lace.core/let [r (if (lace.core/map? r) (go/->struct lace.go.net.http/Request r) r)]
+    (lace.go.net.http/NotFound w r)))
+
+(defn not-found-handler
+  "NotFoundHandler returns a simple request handler
+that replies to each request with a “404 page not found” reply."
+  {:arglists '([]) :go "net/http.NotFoundHandler"}
+  []
+  (lace.go.net.http/NotFoundHandler))
+
+(defn parse-http-version
+  "ParseHTTPVersion parses an HTTP version string according to RFC 7230, section 2.6.
+\"HTTP/1.0\" returns (1, 0, true). Note that strings without
+a minor version, such as \"HTTP/2\", are not valid."
+  {:arglists '([vers]) :go "net/http.ParseHTTPVersion"}
+  [vers]
+  (lace.go.net.http/ParseHTTPVersion vers))
+
+(defn parse-time
+  "ParseTime parses a time header (such as the Date: header),
+trying each of the three formats allowed by HTTP/1.1:
+[TimeFormat], [time.RFC850], and [time.ANSIC]."
+  {:arglists '([text]) :go "net/http.ParseTime"}
+  [text]
+  (lace.go.net.http/ParseTime text))
+
+(defn post
+  "Post issues a POST to the specified URL.
+
+Caller should close resp.Body when done reading from it.
+
+If the provided body is an [io.Closer], it is closed after the
+request.
+
+Post is a wrapper around DefaultClient.Post.
+
+To set custom headers, use [NewRequest] and DefaultClient.Do.
+
+See the [Client.Do] method documentation for details on how redirects
+are handled.
+
+To make a request with a specified context.Context, use [NewRequestWithContext]
+and DefaultClient.Do."
+  {:arglists '([url content-type body]) :go "net/http.Post"}
+  [url content-type body]
+  (lace.go.net.http/Post url content-type body))
+
+(defn post-form
+  "PostForm issues a POST to the specified URL, with data's keys and
+values URL-encoded as the request body.
+
+The Content-Type header is set to application/x-www-form-urlencoded.
+To set other headers, use [NewRequest] and DefaultClient.Do.
+
+When err is nil, resp always contains a non-nil resp.Body.
+Caller should close resp.Body when done reading from it.
+
+PostForm is a wrapper around DefaultClient.PostForm.
+
+See the [Client.Do] method documentation for details on how redirects
+are handled.
+
+To make a request with a specified [context.Context], use [NewRequestWithContext]
+and DefaultClient.Do."
+  {:arglists '([url data]) :go "net/http.PostForm"}
+  [url data]
+  (lace.go.net.http/PostForm url data))
+
+(defn proxy-from-environment
+  "ProxyFromEnvironment returns the URL of the proxy to use for a
+given request, as indicated by the environment variables
+HTTP_PROXY, HTTPS_PROXY and NO_PROXY (or the lowercase versions
+thereof). Requests use the proxy from the environment variable
+matching their scheme, unless excluded by NO_PROXY.
+
+The environment values may be either a complete URL or a
+\"host[:port]\", in which case the \"http\" scheme is assumed.
+An error is returned if the value is a different form.
+
+A nil URL and nil error are returned if no proxy is defined in the
+environment, or a proxy should not be used for the given request,
+as defined by NO_PROXY.
+
+As a special case, if req.URL.Host is \"localhost\" (with or without
+a port number), then a nil URL and nil error will be returned."
+  {:arglists '([req]) :go "net/http.ProxyFromEnvironment"}
+  [req]
+  (lace.core/let [req (if (lace.core/map? req) (go/->struct lace.go.net.http/Request req) req)]
+    (lace.go.net.http/ProxyFromEnvironment req)))
+
+(defn proxy-url
+  "ProxyURL returns a proxy function (for use in a [Transport])
+that always returns the same URL."
+  {:arglists '([fixed-url]) :go "net/http.ProxyURL"}
+  [fixed-url]
+  (lace.go.net.http/ProxyURL fixed-url))
+
+(defn read-request
+  "ReadRequest reads and parses an incoming request from b.
+
+ReadRequest is a low-level function and should only be used for
+specialized applications; most code should use the [Server] to read
+requests and handle them via the [Handler] interface. ReadRequest
+only supports HTTP/1.x requests. For HTTP/2, use golang.org/x/net/http2."
+  {:arglists '([b]) :go "net/http.ReadRequest"}
+  [b]
+  (lace.go.net.http/ReadRequest b))
+
+(defn read-response
+  "ReadResponse reads and returns an HTTP response from r.
+The req parameter optionally specifies the [Request] that corresponds
+to this [Response]. If nil, a GET request is assumed.
+Clients must call resp.Body.Close when finished reading resp.Body.
+After that call, clients can inspect resp.Trailer to find key/value
+pairs included in the response trailer."
+  {:arglists '([r req]) :go "net/http.ReadResponse"}
+  [r req]
+  (lace.core/let [req (if (lace.core/map? req) (go/->struct lace.go.net.http/Request req) req)]
+    (lace.go.net.http/ReadResponse r req)))
+
+(defn redirect
+  "Redirect replies to the request with a redirect to url,
+which may be a path relative to the request path.
+Any non-ASCII characters in url will be percent-encoded,
+but existing percent encodings will not be changed.
+
+The provided code should be in the 3xx range and is usually
+[StatusMovedPermanently], [StatusFound] or [StatusSeeOther].
+
+If the Content-Type header has not been set, [Redirect] sets it
+to \"text/html; charset=utf-8\" and writes a small HTML body.
+Setting the Content-Type header to any value, including nil,
+disables that behavior."
+  {:arglists '([w r url code]) :go "net/http.Redirect"}
+  [w r url code]
+  (lace.core/let [r (if (lace.core/map? r) (go/->struct lace.go.net.http/Request r) r)]
+    (lace.go.net.http/Redirect w r url code)))
+
+(defn redirect-handler
+  "RedirectHandler returns a request handler that redirects
+each request it receives to the given url using the given
+status code.
+
+The provided code should be in the 3xx range and is usually
+[StatusMovedPermanently], [StatusFound] or [StatusSeeOther]."
+  {:arglists '([url code]) :go "net/http.RedirectHandler"}
+  [url code]
+  (lace.go.net.http/RedirectHandler url code))
+
+(defn serve
+  "Serve accepts incoming HTTP connections on the listener l,
+creating a new service goroutine for each. The service goroutines
+read requests and then call handler to reply to them.
+
+The handler is typically nil, in which case [DefaultServeMux] is used.
+
+HTTP/2 support is only enabled if the Listener returns [*tls.Conn]
+connections or connections which implement the same ConnectionState
+method as *tls.Conn, and the connection state indicates that the \"h2\"
+protocol was negotiated by ALPN.
+
+Serve always returns a non-nil error."
+  {:arglists '([l handler]) :go "net/http.Serve"}
+  [l handler]
+  (lace.go.net.http/Serve l handler))
+
+(defn serve-content
+  "ServeContent replies to the request using the content in the
+provided ReadSeeker. The main benefit of ServeContent over [io.Copy]
+is that it handles Range requests properly, sets the MIME type, and
+handles If-Match, If-Unmodified-Since, If-None-Match, If-Modified-Since,
+and If-Range requests.
+
+If the response's Content-Type header is not set, ServeContent
+first tries to deduce the type from name's file extension and,
+if that fails, falls back to reading the first block of the content
+and passing it to [DetectContentType].
+The name is otherwise unused; in particular it can be empty and is
+never sent in the response.
+
+If modtime is not the zero time or Unix epoch, ServeContent
+includes it in a Last-Modified header in the response. If the
+request includes an If-Modified-Since header, ServeContent uses
+modtime to decide whether the content needs to be sent at all.
+
+The content's Seek method must work: ServeContent uses
+a seek to the end of the content to determine its size.
+Note that [*os.File] implements the [io.ReadSeeker] interface.
+
+If the caller has set w's ETag header formatted per RFC 7232, section 2.3,
+ServeContent uses it to handle requests using If-Match, If-None-Match, or If-Range.
+
+If an error occurs when serving the request (for example, when
+handling an invalid range request), ServeContent responds with an
+error message. By default, ServeContent strips the Cache-Control,
+Content-Encoding, ETag, and Last-Modified headers from error responses.
+The GODEBUG setting httpservecontentkeepheaders=1 causes ServeContent
+to preserve these headers."
+  {:arglists '([w req name modtime content]) :go "net/http.ServeContent"}
+  [w req name modtime content]
+  (lace.core/let [req (if (lace.core/map? req) (go/->struct lace.go.net.http/Request req) req)]
+    (lace.go.net.http/ServeContent w req name modtime content)))
+
+(defn serve-file
+  "ServeFile replies to the request with the contents of the named
+file or directory.
+
+If the provided file or directory name is a relative path, it is
+interpreted relative to the current directory and may ascend to
+parent directories. If the provided name is constructed from user
+input, it should be sanitized before calling [ServeFile].
+
+As a precaution, ServeFile will reject requests where r.URL.Path
+contains a \"..\" path element; this protects against callers who
+might unsafely use [filepath.Join] on r.URL.Path without sanitizing
+it and then use that filepath.Join result as the name argument.
+
+As another special case, ServeFile redirects any request where r.URL.Path
+ends in \"/index.html\" to the same path, without the final
+\"index.html\". To avoid such redirects either modify the path or
+use [ServeContent].
+
+Outside of those two special cases, ServeFile does not use
+r.URL.Path for selecting the file or directory to serve; only the
+file or directory provided in the name argument is used."
+  {:arglists '([w r name]) :go "net/http.ServeFile"}
+  [w r name]
+  (lace.core/let [r (if (lace.core/map? r) (go/->struct lace.go.net.http/Request r) r)]
+    (lace.go.net.http/ServeFile w r name)))
+
+(defn serve-file-fs
+  "ServeFileFS replies to the request with the contents
+of the named file or directory from the file system fsys.
+The files provided by fsys must implement [io.Seeker].
+
+If the provided name is constructed from user input, it should be
+sanitized before calling [ServeFileFS].
+
+As a precaution, ServeFileFS will reject requests where r.URL.Path
+contains a \"..\" path element; this protects against callers who
+might unsafely use [filepath.Join] on r.URL.Path without sanitizing
+it and then use that filepath.Join result as the name argument.
+
+As another special case, ServeFileFS redirects any request where r.URL.Path
+ends in \"/index.html\" to the same path, without the final
+\"index.html\". To avoid such redirects either modify the path or
+use [ServeContent].
+
+Outside of those two special cases, ServeFileFS does not use
+r.URL.Path for selecting the file or directory to serve; only the
+file or directory provided in the name argument is used."
+  {:arglists '([w r fsys name]) :go "net/http.ServeFileFS"}
+  [w r fsys name]
+  (lace.core/let [r (if (lace.core/map? r) (go/->struct lace.go.net.http/Request r) r)]
+    (lace.go.net.http/ServeFileFS w r fsys name)))
+
+(defn serve-tls
+  "ServeTLS accepts incoming HTTPS connections on the listener l,
+creating a new service goroutine for each. The service goroutines
+read requests and then call handler to reply to them.
+
+The handler is typically nil, in which case [DefaultServeMux] is used.
+
+Additionally, files containing a certificate and matching private key
+for the server must be provided. If the certificate is signed by a
+certificate authority, the certFile should be the concatenation
+of the server's certificate, any intermediates, and the CA's certificate.
+
+ServeTLS always returns a non-nil error."
+  {:arglists '([l handler cert-file key-file]) :go "net/http.ServeTLS"}
+  [l handler cert-file key-file]
+  (lace.go.net.http/ServeTLS l handler cert-file key-file))
+
+(defn set-cookie
+  "SetCookie adds a Set-Cookie header to the provided [ResponseWriter]'s headers.
+The provided cookie must have a valid Name. Invalid cookies may be
+silently dropped."
+  {:arglists '([w cookie]) :go "net/http.SetCookie"}
+  [w cookie]
+  (lace.core/let [cookie (if (lace.core/map? cookie) (go/->struct lace.go.net.http/Cookie cookie) cookie)]
+    (lace.go.net.http/SetCookie w cookie)))
+
+(defn status-text
+  "StatusText returns a text for the HTTP status code. It returns the empty
+string if the code is unknown."
+  {:arglists '([code]) :go "net/http.StatusText"}
+  [code]
+  (lace.go.net.http/StatusText code))
+
+(defn strip-prefix
+  "StripPrefix returns a handler that serves HTTP requests by removing the
+given prefix from the request URL's Path (and RawPath if set) and invoking
+the handler h. StripPrefix handles a request for a path that doesn't begin
+with prefix by replying with an HTTP 404 not found error. The prefix must
+match exactly: if the prefix in the request contains escaped characters
+the reply is also an HTTP 404 not found error."
+  {:arglists '([prefix h]) :go "net/http.StripPrefix"}
+  [prefix h]
+  (lace.go.net.http/StripPrefix prefix h))
+
+(defn timeout-handler
+  "TimeoutHandler returns a [Handler] that runs h with the given time limit.
+
+The new Handler calls h.ServeHTTP to handle each request, but if a
+call runs for longer than its time limit, the handler responds with
+a 503 Service Unavailable error and the given message in its body.
+(If msg is empty, a suitable default message will be sent.)
+After such a timeout, writes by h to its [ResponseWriter] will return
+[ErrHandlerTimeout].
+
+TimeoutHandler supports the [Pusher] interface but does not support
+the [Hijacker] or [Flusher] interfaces."
+  {:arglists '([h dt msg]) :go "net/http.TimeoutHandler"}
+  [h dt msg]
+  (lace.go.net.http/TimeoutHandler h dt msg))
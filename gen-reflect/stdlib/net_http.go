@@ -0,0 +1,472 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	bufio "bufio"
+	_ "embed"
+	fs "io/fs"
+	"net"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	http "net/http"
+
+	url "net/url"
+)
+
+type HTTPRoundTripperImpl struct {
+	RoundTripFn func(*http.Request) (*http.Response, error)
+}
+
+func (s *HTTPRoundTripperImpl) RoundTrip(a0 *http.Request) (*http.Response, error) {
+	return s.RoundTripFn(a0)
+}
+
+type HTTPFileImpl struct {
+	CloseFn   func() error
+	ReadFn    func([]byte) (int, error)
+	ReaddirFn func(int) ([]fs.FileInfo, error)
+	SeekFn    func(int64, int) (int64, error)
+	StatFn    func() (fs.FileInfo, error)
+}
+
+func (s *HTTPFileImpl) Close() error {
+	return s.CloseFn()
+}
+func (s *HTTPFileImpl) Read(a0 []byte) (int, error) {
+	return s.ReadFn(a0)
+}
+func (s *HTTPFileImpl) Readdir(a0 int) ([]fs.FileInfo, error) {
+	return s.ReaddirFn(a0)
+}
+func (s *HTTPFileImpl) Seek(a0 int64, a1 int) (int64, error) {
+	return s.SeekFn(a0, a1)
+}
+func (s *HTTPFileImpl) Stat() (fs.FileInfo, error) {
+	return s.StatFn()
+}
+
+type HTTPFileSystemImpl struct {
+	OpenFn func(string) (http.File, error)
+}
+
+func (s *HTTPFileSystemImpl) Open(a0 string) (http.File, error) {
+	return s.OpenFn(a0)
+}
+
+type HTTPPusherImpl struct {
+	PushFn func(string, *http.PushOptions) error
+}
+
+func (s *HTTPPusherImpl) Push(a0 string, a1 *http.PushOptions) error {
+	return s.PushFn(a0, a1)
+}
+
+type HTTPCookieJarImpl struct {
+	CookiesFn    func(*url.URL) []*http.Cookie
+	SetCookiesFn func(*url.URL, []*http.Cookie)
+}
+
+func (s *HTTPCookieJarImpl) Cookies(a0 *url.URL) []*http.Cookie {
+	return s.CookiesFn(a0)
+}
+func (s *HTTPCookieJarImpl) SetCookies(a0 *url.URL, a1 []*http.Cookie) {
+	s.SetCookiesFn(a0, a1)
+}
+
+type HTTPCloseNotifierImpl struct {
+	CloseNotifyFn func() <-chan bool
+}
+
+func (s *HTTPCloseNotifierImpl) CloseNotify() <-chan bool {
+	return s.CloseNotifyFn()
+}
+
+type HTTPFlusherImpl struct {
+	FlushFn func()
+}
+
+func (s *HTTPFlusherImpl) Flush() {
+	s.FlushFn()
+}
+
+type HTTPHandlerImpl struct {
+	ServeHTTPFn func(http.ResponseWriter, *http.Request)
+}
+
+func (s *HTTPHandlerImpl) ServeHTTP(a0 http.ResponseWriter, a1 *http.Request) {
+	s.ServeHTTPFn(a0, a1)
+}
+
+type HTTPHijackerImpl struct {
+	HijackFn func() (net.Conn, *bufio.ReadWriter, error)
+}
+
+func (s *HTTPHijackerImpl) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return s.HijackFn()
+}
+
+type HTTPResponseWriterImpl struct {
+	HeaderFn      func() http.Header
+	WriteFn       func([]byte) (int, error)
+	WriteHeaderFn func(int)
+}
+
+func (s *HTTPResponseWriterImpl) Header() http.Header {
+	return s.HeaderFn()
+}
+func (s *HTTPResponseWriterImpl) Write(a0 []byte) (int, error) {
+	return s.WriteFn(a0)
+}
+func (s *HTTPResponseWriterImpl) WriteHeader(a0 int) {
+	s.WriteHeaderFn(a0)
+}
+
+//go:embed net_http.clj
+var wrapper_net_http string
+
+func init() {
+	Client_methods := map[string]pkgreflect.Func{}
+	RoundTripper_methods := map[string]pkgreflect.Func{}
+	Cookie_methods := map[string]pkgreflect.Func{}
+	SameSite_methods := map[string]pkgreflect.Func{}
+	Dir_methods := map[string]pkgreflect.Func{}
+	File_methods := map[string]pkgreflect.Func{}
+	FileSystem_methods := map[string]pkgreflect.Func{}
+	Header_methods := map[string]pkgreflect.Func{}
+	PushOptions_methods := map[string]pkgreflect.Func{}
+	Pusher_methods := map[string]pkgreflect.Func{}
+	CookieJar_methods := map[string]pkgreflect.Func{}
+	MaxBytesError_methods := map[string]pkgreflect.Func{}
+	ProtocolError_methods := map[string]pkgreflect.Func{}
+	Request_methods := map[string]pkgreflect.Func{}
+	Response_methods := map[string]pkgreflect.Func{}
+	ResponseController_methods := map[string]pkgreflect.Func{}
+	CloseNotifier_methods := map[string]pkgreflect.Func{}
+	ConnState_methods := map[string]pkgreflect.Func{}
+	Flusher_methods := map[string]pkgreflect.Func{}
+	Handler_methods := map[string]pkgreflect.Func{}
+	HandlerFunc_methods := map[string]pkgreflect.Func{}
+	Hijacker_methods := map[string]pkgreflect.Func{}
+	ResponseWriter_methods := map[string]pkgreflect.Func{}
+	ServeMux_methods := map[string]pkgreflect.Func{}
+	Server_methods := map[string]pkgreflect.Func{}
+	Transport_methods := map[string]pkgreflect.Func{}
+	Client_methods["Get"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}}, Tag: "any", Doc: "Get issues a GET to the specified URL. If the response is one of the\nfollowing redirect codes, Get follows the redirect after calling the\n[Client.CheckRedirect] function:\n\n\t301 (Moved Permanently)\n\t302 (Found)\n\t303 (See Other)\n\t307 (Temporary Redirect)\n\t308 (Permanent Redirect)\n\nAn error is returned if the [Client.CheckRedirect] function fails\nor if there was an HTTP protocol error. A non-2xx response doesn't\ncause an error. Any returned error will be of type [*url.Error]. The\nurl.Error value's Timeout method will report true if the request\ntimed out.\n\nWhen err is nil, resp always contains a non-nil resp.Body.\nCaller should close resp.Body when done reading from it.\n\nTo make a request with custom headers, use [NewRequest] and [Client.Do].\n\nTo make a request with a specified context.Context, use [NewRequestWithContext]\nand Client.Do."}
+	Client_methods["Do"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "req", Tag: "Request"}}, Tag: "any", Doc: "Do sends an HTTP request and returns an HTTP response, following\npolicy (such as redirects, cookies, auth) as configured on the\nclient.\n\nAn error is returned if caused by client policy (such as\nCheckRedirect), or failure to speak HTTP (such as a network\nconnectivity problem). A non-2xx status code doesn't cause an\nerror.\n\nIf the returned error is nil, the [Response] will contain a non-nil\nBody which the user is expected to close. If the Body is not both\nread to EOF and closed, the [Client]'s underlying [RoundTripper]\n(typically [Transport]) may not be able to re-use a persistent TCP\nconnection to the server for a subsequent \"keep-alive\" request.\nNote, however, that [Transport] will automatically try to read a\n[Response] Body to EOF asynchronously up to a conservative limit\nwhen a Body is closed.\n\nThe request Body, if non-nil, will be closed by the underlying\nTransport, even on errors. The Body may be closed asynchronously after\nDo returns.\n\nOn error, any Response can be ignored. A non-nil Response with a\nnon-nil error only occurs when CheckRedirect fails, and even then\nthe returned [Response.Body] is already closed.\n\nGenerally [Get], [Post], or [PostForm] will be used instead of Do.\n\nIf the server replies with a redirect, the Client first uses the\nCheckRedirect function to determine whether the redirect should be\nfollowed. If permitted, a 301, 302, or 303 redirect causes\nsubsequent requests to use HTTP method GET\n(or HEAD if the original request was HEAD), with no body.\nA 307 or 308 redirect preserves the original HTTP method and body,\nprovided that the [Request.GetBody] function is defined.\nThe [NewRequest] function automatically sets GetBody for common\nstandard library body types.\n\nNote that the [Client] redirect behavior does not follow the WHATWG\nFetch standard. This is because it was written before established\nstandards existed. As such, by modern standards, [Client] has a\nrather permissive behavior. For example, sensitive headers are\nretained on redirect to a subdomain or to a different scheme on the\nsame host.\n\nAny returned error will be of type [*url.Error]. The url.Error\nvalue's Timeout method will report true if the request timed out."}
+	Client_methods["Post"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}, {Name: "contentType", Tag: "string"}, {Name: "body", Tag: "io.Reader"}}, Tag: "any", Doc: "Post issues a POST to the specified URL.\n\nCaller should close resp.Body when done reading from it.\n\nIf the provided body is an [io.Closer], it is closed after the\nrequest.\n\nTo set custom headers, use [NewRequest] and [Client.Do].\n\nTo make a request with a specified context.Context, use [NewRequestWithContext]\nand [Client.Do].\n\nSee the [Client.Do] method documentation for details on how redirects\nare handled."}
+	Client_methods["PostForm"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}, {Name: "data", Tag: "url.Values"}}, Tag: "any", Doc: "PostForm issues a POST to the specified URL,\nwith data's keys and values URL-encoded as the request body.\n\nThe Content-Type header is set to application/x-www-form-urlencoded.\nTo set other headers, use [NewRequest] and [Client.Do].\n\nWhen err is nil, resp always contains a non-nil resp.Body.\nCaller should close resp.Body when done reading from it.\n\nSee the [Client.Do] method documentation for details on how redirects\nare handled.\n\nTo make a request with a specified context.Context, use [NewRequestWithContext]\nand Client.Do."}
+	Client_methods["Head"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}}, Tag: "any", Doc: "Head issues a HEAD to the specified URL. If the response is one of the\nfollowing redirect codes, Head follows the redirect after calling the\n[Client.CheckRedirect] function:\n\n\t301 (Moved Permanently)\n\t302 (Found)\n\t303 (See Other)\n\t307 (Temporary Redirect)\n\t308 (Permanent Redirect)\n\nTo make a request with a specified [context.Context], use [NewRequestWithContext]\nand [Client.Do]."}
+	Client_methods["CloseIdleConnections"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "CloseIdleConnections closes any connections on its [Transport] which\nwere previously connected from previous requests but are now\nsitting idle in a \"keep-alive\" state. It does not interrupt any\nconnections currently in use.\n\nIf [Client.Transport] does not have a [Client.CloseIdleConnections] method\nthen this method does nothing."}
+	Cookie_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns the serialization of the cookie for use in a [Cookie]\nheader (if only Name and Value are set) or a Set-Cookie response\nheader (if other fields are set).\nIf c is nil or c.Name is invalid, the empty string is returned."}
+	Cookie_methods["Valid"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Valid reports whether the cookie is valid."}
+	Dir_methods["Open"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "any", Doc: "Open implements [FileSystem] using [os.Open], opening files for reading rooted\nand relative to the directory d."}
+	Header_methods["Add"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}, {Name: "value", Tag: "string"}}, Tag: "any", Doc: "Add adds the key, value pair to the header.\nIt appends to any existing values associated with key.\nThe key is case insensitive; it is canonicalized by\n[CanonicalHeaderKey]."}
+	Header_methods["Set"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}, {Name: "value", Tag: "string"}}, Tag: "any", Doc: "Set sets the header entries associated with key to the\nsingle element value. It replaces any existing values\nassociated with key. The key is case insensitive; it is\ncanonicalized by [textproto.CanonicalMIMEHeaderKey].\nTo use non-canonical keys, assign to the map directly."}
+	Header_methods["Get"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "string", Doc: "Get gets the first value associated with the given key. If\nthere are no values associated with the key, Get returns \"\".\nIt is case insensitive; [textproto.CanonicalMIMEHeaderKey] is\nused to canonicalize the provided key. Get assumes that all\nkeys are stored in canonical form. To use non-canonical keys,\naccess the map directly."}
+	Header_methods["Values"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "[]string", Doc: "Values returns all values associated with the given key.\nIt is case insensitive; [textproto.CanonicalMIMEHeaderKey] is\nused to canonicalize the provided key. To use non-canonical\nkeys, access the map directly.\nThe returned slice is not a copy."}
+	Header_methods["Del"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "any", Doc: "Del deletes the values associated with key.\nThe key is case insensitive; it is canonicalized by\n[CanonicalHeaderKey]."}
+	Header_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "error", Doc: "Write writes a header in wire format."}
+	Header_methods["Clone"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Header", Doc: "Clone returns a copy of h or nil if h is nil."}
+	Header_methods["WriteSubset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}, {Name: "exclude", Tag: "Unknown"}}, Tag: "error", Doc: "WriteSubset writes a header in wire format.\nIf exclude is not nil, keys where exclude[key] == true are not written.\nKeys are not canonicalized before checking the exclude map."}
+	ProtocolError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	ProtocolError_methods["Is"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "err", Tag: "error"}}, Tag: "bool", Doc: "Is lets http.ErrNotSupported match errors.ErrUnsupported."}
+	Request_methods["Context"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "context.Context", Doc: "Context returns the request's context. To change the context, use\n[Request.Clone] or [Request.WithContext].\n\nThe returned context is always non-nil; it defaults to the\nbackground context.\n\nFor outgoing client requests, the context controls cancellation.\n\nFor incoming server requests, the context is canceled when the\nclient's connection closes, the request is canceled (with HTTP/2),\nor when the ServeHTTP method returns."}
+	Request_methods["WithContext"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ctx", Tag: "context.Context"}}, Tag: "Request", Doc: "WithContext returns a shallow copy of r with its context changed\nto ctx. The provided ctx must be non-nil.\n\nFor outgoing client request, the context controls the entire\nlifetime of a request and its response: obtaining a connection,\nsending the request, and reading the response headers and body.\n\nTo create a new request with a context, use [NewRequestWithContext].\nTo make a deep copy of a request with a new context, use [Request.Clone]."}
+	Request_methods["Clone"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ctx", Tag: "context.Context"}}, Tag: "Request", Doc: "Clone returns a deep copy of r with its context changed to ctx.\nThe provided ctx must be non-nil.\n\nClone only makes a shallow copy of the Body field.\n\nFor an outgoing client request, the context controls the entire\nlifetime of a request and its response: obtaining a connection,\nsending the request, and reading the response headers and body."}
+	Request_methods["ProtoAtLeast"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "major", Tag: "int"}, {Name: "minor", Tag: "int"}}, Tag: "bool", Doc: "ProtoAtLeast reports whether the HTTP protocol used\nin the request is at least major.minor."}
+	Request_methods["UserAgent"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "UserAgent returns the client's User-Agent, if sent in the request."}
+	Request_methods["Cookies"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]Cookie", Doc: "Cookies parses and returns the HTTP cookies sent with the request."}
+	Request_methods["Cookie"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "any", Doc: "Cookie returns the named cookie provided in the request or\n[ErrNoCookie] if not found.\nIf multiple cookies match the given name, only one cookie will\nbe returned."}
+	Request_methods["AddCookie"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "c", Tag: "Cookie"}}, Tag: "any", Doc: "AddCookie adds a cookie to the request. Per RFC 6265 section 5.4,\nAddCookie does not attach more than one [Cookie] header field. That\nmeans all cookies, if any, are written into the same line,\nseparated by semicolon.\nAddCookie only sanitizes c's name and value, and does not sanitize\na Cookie header already present in the request."}
+	Request_methods["Referer"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Referer returns the referring URL, if sent in the request.\n\nReferer is misspelled as in the request itself, a mistake from the\nearliest days of HTTP.  This value can also be fetched from the\n[Header] map as Header[\"Referer\"]; the benefit of making it available\nas a method is that the compiler can diagnose programs that use the\nalternate (correct English) spelling req.Referrer() but cannot\ndiagnose programs that use Header[\"Referrer\"]."}
+	Request_methods["MultipartReader"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "MultipartReader returns a MIME multipart reader if this is a\nmultipart/form-data or a multipart/mixed POST request, else returns nil and an error.\nUse this function instead of [Request.ParseMultipartForm] to\nprocess the request body as a stream."}
+	Request_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "error", Doc: "Write writes an HTTP/1.1 request, which is the header and body, in wire format.\nThis method consults the following fields of the request:\n\n\tHost\n\tURL\n\tMethod (defaults to \"GET\")\n\tHeader\n\tContentLength\n\tTransferEncoding\n\tBody\n\nIf Body is present, Content-Length is <= 0 and [Request.TransferEncoding]\nhasn't been set to \"identity\", Write adds \"Transfer-Encoding:\nchunked\" to the header. Body is closed after it is sent.\n\nHeader values for Host, Content-Length, Transfer-Encoding,\nand Trailer are not used; these are derived from other Request fields.\nIf the Header does not contain a User-Agent value, Write uses\n\"Go-http-client/1.1\"."}
+	Request_methods["WriteProxy"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "error", Doc: "WriteProxy is like [Request.Write] but writes the request in the form\nexpected by an HTTP proxy. In particular, [Request.WriteProxy] writes the\ninitial Request-URI line of the request with an absolute URI, per\nsection 5.3 of RFC 7230, including the scheme and host.\nIn either case, WriteProxy also writes a Host header, using\neither r.Host or r.URL.Host."}
+	Request_methods["BasicAuth"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "BasicAuth returns the username and password provided in the request's\nAuthorization header, if the request uses HTTP Basic Authentication.\nSee RFC 2617, Section 2."}
+	Request_methods["SetBasicAuth"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "username", Tag: "string"}, {Name: "password", Tag: "string"}}, Tag: "any", Doc: "SetBasicAuth sets the request's Authorization header to use HTTP\nBasic Authentication with the provided username and password.\n\nWith HTTP Basic Authentication the provided username and password\nare not encrypted. It should generally only be used in an HTTPS\nrequest.\n\nThe username may not contain a colon. Some protocols may impose\nadditional requirements on pre-escaping the username and\npassword. For instance, when used with OAuth2, both arguments must\nbe URL encoded first with [url.QueryEscape]."}
+	MaxBytesError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Request_methods["ParseForm"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "ParseForm populates r.Form and r.PostForm.\n\nFor all requests, ParseForm parses the raw query from the URL and updates\nr.Form.\n\nFor POST, PUT, and PATCH requests, it also reads the request body, parses it\nas a form and puts the results into both r.PostForm and r.Form. Request body\nparameters take precedence over URL query string values in r.Form.\n\nIf the request Body's size has not already been limited by [MaxBytesReader],\nthe size is capped at 10MB.\n\nFor other HTTP methods, or when the Content-Type is not\napplication/x-www-form-urlencoded, the request Body is not read, and\nr.PostForm is initialized to a non-nil, empty value.\n\n[Request.ParseMultipartForm] calls ParseForm automatically.\nParseForm is idempotent."}
+	Request_methods["ParseMultipartForm"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "maxMemory", Tag: "int64"}}, Tag: "error", Doc: "ParseMultipartForm parses a request body as multipart/form-data.\nThe whole request body is parsed and up to a total of maxMemory bytes of\nits file parts are stored in memory, with the remainder stored on\ndisk in temporary files.\nParseMultipartForm calls [Request.ParseForm] if necessary.\nIf ParseForm returns an error, ParseMultipartForm returns it but also\ncontinues parsing the request body.\nAfter one call to ParseMultipartForm, subsequent calls have no effect."}
+	Request_methods["FormValue"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "string", Doc: "FormValue returns the first value for the named component of the query.\nThe precedence order:\n 1. application/x-www-form-urlencoded form body (POST, PUT, PATCH only)\n 2. query parameters (always)\n 3. multipart/form-data form body (always)\n\nFormValue calls [Request.ParseMultipartForm] and [Request.ParseForm]\nif necessary and ignores any errors returned by these functions.\nIf key is not present, FormValue returns the empty string.\nTo access multiple values of the same key, call ParseForm and\nthen inspect [Request.Form] directly."}
+	Request_methods["PostFormValue"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "string", Doc: "PostFormValue returns the first value for the named component of the POST,\nPUT, or PATCH request body. URL query parameters are ignored.\nPostFormValue calls [Request.ParseMultipartForm] and [Request.ParseForm] if necessary and ignores\nany errors returned by these functions.\nIf key is not present, PostFormValue returns the empty string."}
+	Request_methods["FormFile"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "any", Doc: "FormFile returns the first file for the provided form key.\nFormFile calls [Request.ParseMultipartForm] and [Request.ParseForm] if necessary."}
+	Request_methods["PathValue"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "string", Doc: "PathValue returns the value for the named path wildcard in the [ServeMux] pattern\nthat matched the request.\nIt returns the empty string if the request was not matched against a pattern\nor there is no such wildcard in the pattern.\n\nThe value is unescaped. For example, if the pattern \"/b/{bucket}\" matches\nthe path \"/b/a%2fb\", PathValue(\"bucket\") returns \"a/b\"."}
+	Request_methods["SetPathValue"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}, {Name: "value", Tag: "string"}}, Tag: "any", Doc: "SetPathValue sets name to value, so that subsequent calls to r.PathValue(name)\nreturn value.\nIt does not unescape value."}
+	Response_methods["Cookies"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]Cookie", Doc: "Cookies parses and returns the cookies set in the Set-Cookie headers."}
+	Response_methods["Location"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Location returns the URL of the response's \"Location\" header,\nif present. Relative redirects are resolved relative to\n[Response.Request]. [ErrNoLocation] is returned if no\nLocation header is present."}
+	Response_methods["ProtoAtLeast"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "major", Tag: "int"}, {Name: "minor", Tag: "int"}}, Tag: "bool", Doc: "ProtoAtLeast reports whether the HTTP protocol used\nin the response is at least major.minor."}
+	Response_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "error", Doc: "Write writes r to w in the HTTP/1.x server response format,\nincluding the status line, headers, body, and optional trailer.\n\nThis method consults the following fields of the response r:\n\n\tStatusCode\n\tProtoMajor\n\tProtoMinor\n\tRequest.Method\n\tTransferEncoding\n\tTrailer\n\tBody\n\tContentLength\n\tHeader, values for non-canonical keys will have unpredictable behavior\n\nThe Response Body is closed after it is sent."}
+	ResponseController_methods["Flush"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Flush flushes buffered data to the client."}
+	ResponseController_methods["Hijack"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Hijack lets the caller take over the connection.\nSee the [Hijacker] interface for details."}
+	ResponseController_methods["SetReadDeadline"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "deadline", Tag: "time.Time"}}, Tag: "error", Doc: "SetReadDeadline sets the deadline for reading the entire request, including the body.\nReads from the request body after the deadline has been exceeded will return an error.\nA zero value means no deadline.\n\nSetting the read deadline after it has been exceeded will not extend it."}
+	ResponseController_methods["SetWriteDeadline"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "deadline", Tag: "time.Time"}}, Tag: "error", Doc: "SetWriteDeadline sets the deadline for writing the response.\nWrites to the response body after the deadline has been exceeded will not block,\nbut may succeed if the data has been buffered.\nA zero value means no deadline.\n\nSetting the write deadline after it has been exceeded will not extend it."}
+	ResponseController_methods["EnableFullDuplex"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "EnableFullDuplex indicates that the request handler will interleave reads from [Request.Body]\nwith writes to the [ResponseWriter].\n\nFor HTTP/1 requests, the Go HTTP server by default consumes any unread portion of\nthe request body before beginning to write the response, preventing handlers from\nconcurrently reading from the request and writing the response.\nCalling EnableFullDuplex disables this behavior and permits handlers to continue to read\nfrom the request while concurrently writing the response.\n\nFor HTTP/2 requests, the Go HTTP server always permits concurrent reads and responses."}
+	Transport_methods["RoundTrip"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "req", Tag: "Request"}}, Tag: "any", Doc: "RoundTrip implements the [RoundTripper] interface.\n\nFor higher-level HTTP client support (such as handling of cookies\nand redirects), see [Get], [Post], and the [Client] type.\n\nLike the RoundTripper interface, the error types returned\nby RoundTrip are unspecified."}
+	HandlerFunc_methods["ServeHTTP"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}}, Tag: "any", Doc: "ServeHTTP calls f(w, r)."}
+	ServeMux_methods["Handler"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "Request"}}, Tag: "any", Doc: "Handler returns the handler to use for the given request,\nconsulting r.Method, r.Host, and r.URL.Path. It always returns\na non-nil handler. If the path is not in its canonical form, the\nhandler will be an internally-generated handler that redirects\nto the canonical path. If the host contains a port, it is ignored\nwhen matching handlers.\n\nThe path and host are used unchanged for CONNECT requests.\n\nHandler also returns the registered pattern that matches the\nrequest or, in the case of internally-generated redirects,\nthe path that will match after following the redirect.\n\nIf there is no registered handler that applies to the request,\nHandler returns a “page not found” or “method not supported”\nhandler and an empty pattern.\n\nHandler does not modify its argument. In particular, it does not\npopulate named path wildcards, so r.PathValue will always return\nthe empty string."}
+	ServeMux_methods["ServeHTTP"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}}, Tag: "any", Doc: "ServeHTTP dispatches the request to the handler whose\npattern most closely matches the request URL."}
+	ServeMux_methods["Handle"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "handler", Tag: "Handler"}}, Tag: "any", Doc: "Handle registers the handler for the given pattern.\nIf the given pattern conflicts with one that is already registered\nor if the pattern is invalid, Handle panics.\n\nSee [ServeMux] for details on valid patterns and conflict rules."}
+	ServeMux_methods["HandleFunc"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "handler", Tag: "Unknown"}}, Tag: "any", Doc: "HandleFunc registers the handler function for the given pattern.\nIf the given pattern conflicts with one that is already registered\nor if the pattern is invalid, HandleFunc panics.\n\nSee [ServeMux] for details on valid patterns and conflict rules."}
+	Server_methods["Close"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Close immediately closes all active net.Listeners and any\nconnections in state [StateNew], [StateActive], or [StateIdle]. For a\ngraceful shutdown, use [Server.Shutdown].\n\nClose does not attempt to close (and does not even know about)\nany hijacked connections, such as WebSockets.\n\nClose returns any error returned from closing the [Server]'s\nunderlying Listener(s)."}
+	Server_methods["Shutdown"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ctx", Tag: "context.Context"}}, Tag: "error", Doc: "Shutdown gracefully shuts down the server without interrupting any\nactive connections. Shutdown works by first closing all open\nlisteners, then closing all idle connections, and then waiting\nindefinitely for connections to return to idle and then shut down.\nIf the provided context expires before the shutdown is complete,\nShutdown returns the context's error, otherwise it returns any\nerror returned from closing the [Server]'s underlying Listener(s).\n\nWhen Shutdown is called, [Serve], [ServeTLS], [ListenAndServe], and\n[ListenAndServeTLS] immediately return [ErrServerClosed]. Make sure the\nprogram doesn't exit and waits instead for Shutdown to return.\n\nShutdown does not attempt to close nor wait for hijacked\nconnections such as WebSockets. The caller of Shutdown should\nseparately notify such long-lived connections of shutdown and wait\nfor them to close, if desired. See [Server.RegisterOnShutdown] for a way to\nregister shutdown notification functions.\n\nOnce Shutdown has been called on a server, it may not be reused;\nfuture calls to methods such as Serve will return ErrServerClosed."}
+	Server_methods["RegisterOnShutdown"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "f", Tag: "Unknown"}}, Tag: "any", Doc: "RegisterOnShutdown registers a function to call on [Server.Shutdown].\nThis can be used to gracefully shutdown connections that have\nundergone ALPN protocol upgrade or that have been hijacked.\nThis function should start protocol-specific graceful shutdown,\nbut should not wait for shutdown to complete."}
+	ConnState_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Server_methods["ListenAndServe"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "ListenAndServe listens on the TCP network address s.Addr and then\ncalls [Serve] to handle requests on incoming connections.\nAccepted connections are configured to enable TCP keep-alives.\n\nIf s.Addr is blank, \":http\" is used.\n\nListenAndServe always returns a non-nil error. After [Server.Shutdown] or [Server.Close],\nthe returned error is [ErrServerClosed]."}
+	Server_methods["Serve"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "l", Tag: "net.Listener"}}, Tag: "error", Doc: "Serve accepts incoming connections on the Listener l, creating a\nnew service goroutine for each. The service goroutines read requests and\nthen call s.Handler to reply to them.\n\nHTTP/2 support is only enabled if the Listener returns [*tls.Conn]\nconnections and they were configured with \"h2\" in the TLS\nConfig.NextProtos.\n\nServe always returns a non-nil error and closes l.\nAfter [Server.Shutdown] or [Server.Close], the returned error is [ErrServerClosed]."}
+	Server_methods["ServeTLS"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "l", Tag: "net.Listener"}, {Name: "certFile", Tag: "string"}, {Name: "keyFile", Tag: "string"}}, Tag: "error", Doc: "ServeTLS accepts incoming connections on the Listener l, creating a\nnew service goroutine for each. The service goroutines perform TLS\nsetup and then read requests, calling s.Handler to reply to them.\n\nFiles containing a certificate and matching private key for the\nserver must be provided if neither the [Server]'s\nTLSConfig.Certificates, TLSConfig.GetCertificate nor\nconfig.GetConfigForClient are populated.\nIf the certificate is signed by a certificate authority, the\ncertFile should be the concatenation of the server's certificate,\nany intermediates, and the CA's certificate.\n\nServeTLS always returns a non-nil error. After [Server.Shutdown] or [Server.Close], the\nreturned error is [ErrServerClosed]."}
+	Server_methods["SetKeepAlivesEnabled"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "v", Tag: "bool"}}, Tag: "any", Doc: "SetKeepAlivesEnabled controls whether HTTP keep-alives are enabled.\nBy default, keep-alives are always enabled. Only very\nresource-constrained environments or servers in the process of\nshutting down should disable them."}
+	Server_methods["ListenAndServeTLS"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "certFile", Tag: "string"}, {Name: "keyFile", Tag: "string"}}, Tag: "error", Doc: "ListenAndServeTLS listens on the TCP network address s.Addr and\nthen calls [ServeTLS] to handle requests on incoming TLS connections.\nAccepted connections are configured to enable TCP keep-alives.\n\nFilenames containing a certificate and matching private key for the\nserver must be provided if neither the [Server]'s TLSConfig.Certificates\nnor TLSConfig.GetCertificate are populated. If the certificate is\nsigned by a certificate authority, the certFile should be the\nconcatenation of the server's certificate, any intermediates, and\nthe CA's certificate.\n\nIf s.Addr is blank, \":https\" is used.\n\nListenAndServeTLS always returns a non-nil error. After [Server.Shutdown] or\n[Server.Close], the returned error is [ErrServerClosed]."}
+	Transport_methods["Clone"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Transport", Doc: "Clone returns a deep copy of t's exported fields."}
+	Transport_methods["RegisterProtocol"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "scheme", Tag: "string"}, {Name: "rt", Tag: "RoundTripper"}}, Tag: "any", Doc: "RegisterProtocol registers a new protocol with scheme.\nThe [Transport] will pass requests using the given scheme to rt.\nIt is rt's responsibility to simulate HTTP request semantics.\n\nRegisterProtocol can be used by other packages to provide\nimplementations of protocol schemes like \"ftp\" or \"file\".\n\nIf rt.RoundTrip returns [ErrSkipAltProtocol], the Transport will\nhandle the [Transport.RoundTrip] itself for that one request, as if the\nprotocol were not registered."}
+	Transport_methods["CloseIdleConnections"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "CloseIdleConnections closes any connections which were previously\nconnected from previous requests but are now sitting idle in\na \"keep-alive\" state. It does not interrupt any connections currently\nin use."}
+	Transport_methods["CancelRequest"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "req", Tag: "Request"}}, Tag: "any", Doc: "CancelRequest cancels an in-flight request by closing its connection.\nCancelRequest should only be called after [Transport.RoundTrip] has returned.\n\nDeprecated: Use [Request.WithContext] to create a request with a\ncancelable context instead. CancelRequest cannot cancel HTTP/2\nrequests. This may become a no-op in a future release of Go."}
+	pkgreflect.AddPackage("lace.go.net.http", &pkgreflect.Package{
+		Doc:       "Package http provides HTTP client and server implementations.",
+		WrapperNS: "go.net.http",
+		Wrapper:   wrapper_net_http,
+		Types: map[string]pkgreflect.Type{
+			"Client":             {Doc: "A Client is an HTTP client. Its zero value ([DefaultClient]) is a\nusable client that uses [DefaultTransport].\n\nThe [Client.Transport] typically has internal state (cached TCP\nconnections), so Clients should be reused instead of created as\nneeded. Clients are safe for concurrent use by multiple goroutines.\n\nA Client is higher-level than a [RoundTripper] (such as [Transport])\nand additionally handles HTTP details such as cookies and\nredirects.\n\nWhen following redirects, the Client will forward all headers set on the\ninitial [Request] except:\n\n  - when forwarding sensitive headers like \"Authorization\",\n    \"WWW-Authenticate\", and \"Cookie\" to untrusted targets.\n    These headers will be ignored when following a redirect to a domain\n    that is not a subdomain match or exact match of the initial domain.\n    For example, a redirect from \"foo.com\" to either \"foo.com\" or \"sub.foo.com\"\n    will forward the sensitive headers, but a redirect to \"bar.com\" will not.\n  - when forwarding the \"Cookie\" header with a non-nil cookie Jar.\n    Since each redirect may mutate the state of the cookie jar,\n    a redirect may possibly alter a cookie set in the initial request.\n    When forwarding the \"Cookie\" header, any mutated cookies will be omitted,\n    with the expectation that the Jar will insert those mutated cookies\n    with the updated values (assuming the origin matches).\n    If Jar is nil, the initial cookies are forwarded without change.", Value: reflect.TypeOf((*http.Client)(nil)).Elem(), Methods: Client_methods},
+			"RoundTripper":       {Doc: "RoundTripper is an interface representing the ability to execute a\nsingle HTTP transaction, obtaining the [Response] for a given [Request].\n\nA RoundTripper must be safe for concurrent use by multiple\ngoroutines.", Value: reflect.TypeOf((*http.RoundTripper)(nil)).Elem(), Methods: RoundTripper_methods},
+			"Cookie":             {Doc: "A Cookie represents an HTTP cookie as sent in the Set-Cookie header of an\nHTTP response or the Cookie header of an HTTP request.\n\nSee https://tools.ietf.org/html/rfc6265 for details.", Value: reflect.TypeOf((*http.Cookie)(nil)).Elem(), Methods: Cookie_methods},
+			"SameSite":           {Doc: "SameSite allows a server to define a cookie attribute making it impossible for\nthe browser to send this cookie along with cross-site requests. The main\ngoal is to mitigate the risk of cross-origin information leakage, and provide\nsome protection against cross-site request forgery attacks.\n\nSee https://tools.ietf.org/html/draft-ietf-httpbis-cookie-same-site-00 for details.", Value: reflect.TypeOf((*http.SameSite)(nil)).Elem(), Methods: SameSite_methods},
+			"Dir":                {Doc: "A Dir implements [FileSystem] using the native file system restricted to a\nspecific directory tree.\n\nWhile the [FileSystem.Open] method takes '/'-separated paths, a Dir's string\nvalue is a directory path on the native file system, not a URL, so it is separated\nby [filepath.Separator], which isn't necessarily '/'.\n\nNote that Dir could expose sensitive files and directories. Dir will follow\nsymlinks pointing out of the directory tree, which can be especially dangerous\nif serving from a directory in which users are able to create arbitrary symlinks.\nDir will also allow access to files and directories starting with a period,\nwhich could expose sensitive directories like .git or sensitive files like\n.htpasswd. To exclude files with a leading period, remove the files/directories\nfrom the server or create a custom FileSystem implementation.\n\nAn empty Dir is treated as \".\".", Value: reflect.TypeOf((*http.Dir)(nil)).Elem(), Methods: Dir_methods},
+			"File":               {Doc: "A File is returned by a [FileSystem]'s Open method and can be\nserved by the [FileServer] implementation.\n\nThe methods should behave the same as those on an [*os.File].", Value: reflect.TypeOf((*http.File)(nil)).Elem(), Methods: File_methods},
+			"FileSystem":         {Doc: "A FileSystem implements access to a collection of named files.\nThe elements in a file path are separated by slash ('/', U+002F)\ncharacters, regardless of host operating system convention.\nSee the [FileServer] function to convert a FileSystem to a [Handler].\n\nThis interface predates the [fs.FS] interface, which can be used instead:\nthe [FS] adapter function converts an fs.FS to a FileSystem.", Value: reflect.TypeOf((*http.FileSystem)(nil)).Elem(), Methods: FileSystem_methods},
+			"Header":             {Doc: "A Header represents the key-value pairs in an HTTP header.\n\nThe keys should be in canonical form, as returned by\n[CanonicalHeaderKey].", Value: reflect.TypeOf((*http.Header)(nil)).Elem(), Methods: Header_methods},
+			"PushOptions":        {Doc: "PushOptions describes options for [Pusher.Push].", Value: reflect.TypeOf((*http.PushOptions)(nil)).Elem(), Methods: PushOptions_methods},
+			"Pusher":             {Doc: "Pusher is the interface implemented by ResponseWriters that support\nHTTP/2 server push. For more background, see\nhttps://tools.ietf.org/html/rfc7540#section-8.2.", Value: reflect.TypeOf((*http.Pusher)(nil)).Elem(), Methods: Pusher_methods},
+			"CookieJar":          {Doc: "A CookieJar manages storage and use of cookies in HTTP requests.\n\nImplementations of CookieJar must be safe for concurrent use by multiple\ngoroutines.\n\nThe net/http/cookiejar package provides a CookieJar implementation.", Value: reflect.TypeOf((*http.CookieJar)(nil)).Elem(), Methods: CookieJar_methods},
+			"MaxBytesError":      {Doc: "MaxBytesError is returned by [MaxBytesReader] when its read limit is exceeded.", Value: reflect.TypeOf((*http.MaxBytesError)(nil)).Elem(), Methods: MaxBytesError_methods},
+			"ProtocolError":      {Doc: "ProtocolError represents an HTTP protocol error.\n\nDeprecated: Not all errors in the http package related to protocol errors\nare of type ProtocolError.", Value: reflect.TypeOf((*http.ProtocolError)(nil)).Elem(), Methods: ProtocolError_methods},
+			"Request":            {Doc: "A Request represents an HTTP request received by a server\nor to be sent by a client.\n\nThe field semantics differ slightly between client and server\nusage. In addition to the notes on the fields below, see the\ndocumentation for [Request.Write] and [RoundTripper].", Value: reflect.TypeOf((*http.Request)(nil)).Elem(), Methods: Request_methods},
+			"Response":           {Doc: "Response represents the response from an HTTP request.\n\nThe [Client] and [Transport] return Responses from servers once\nthe response headers have been received. The response body\nis streamed on demand as the Body field is read.", Value: reflect.TypeOf((*http.Response)(nil)).Elem(), Methods: Response_methods},
+			"ResponseController": {Doc: "A ResponseController is used by an HTTP handler to control the response.\n\nA ResponseController may not be used after the [Handler.ServeHTTP] method has returned.", Value: reflect.TypeOf((*http.ResponseController)(nil)).Elem(), Methods: ResponseController_methods},
+			"CloseNotifier":      {Doc: "The CloseNotifier interface is implemented by ResponseWriters which\nallow detecting when the underlying connection has gone away.\n\nThis mechanism can be used to cancel long operations on the server\nif the client has disconnected before the response is ready.\n\nDeprecated: the CloseNotifier interface predates Go's context package.\nNew code should use [Request.Context] instead.", Value: reflect.TypeOf((*http.CloseNotifier)(nil)).Elem(), Methods: CloseNotifier_methods},
+			"ConnState":          {Doc: "A ConnState represents the state of a client connection to a server.\nIt's used by the optional [Server.ConnState] hook.", Value: reflect.TypeOf((*http.ConnState)(nil)).Elem(), Methods: ConnState_methods},
+			"Flusher":            {Doc: "The Flusher interface is implemented by ResponseWriters that allow\nan HTTP handler to flush buffered data to the client.\n\nThe default HTTP/1.x and HTTP/2 [ResponseWriter] implementations\nsupport [Flusher], but ResponseWriter wrappers may not. Handlers\nshould always test for this ability at runtime.\n\nNote that even for ResponseWriters that support Flush,\nif the client is connected through an HTTP proxy,\nthe buffered data may not reach the client until the response\ncompletes.", Value: reflect.TypeOf((*http.Flusher)(nil)).Elem(), Methods: Flusher_methods},
+			"Handler":            {Doc: "A Handler responds to an HTTP request.\n\n[Handler.ServeHTTP] should write reply headers and data to the [ResponseWriter]\nand then return. Returning signals that the request is finished; it\nis not valid to use the [ResponseWriter] or read from the\n[Request.Body] after or concurrently with the completion of the\nServeHTTP call.\n\nDepending on the HTTP client software, HTTP protocol version, and\nany intermediaries between the client and the Go server, it may not\nbe possible to read from the [Request.Body] after writing to the\n[ResponseWriter]. Cautious handlers should read the [Request.Body]\nfirst, and then reply.\n\nExcept for reading the body, handlers should not modify the\nprovided Request.\n\nIf ServeHTTP panics, the server (the caller of ServeHTTP) assumes\nthat the effect of the panic was isolated to the active request.\nIt recovers the panic, logs a stack trace to the server error log,\nand either closes the network connection or sends an HTTP/2\nRST_STREAM, depending on the HTTP protocol. To abort a handler so\nthe client sees an interrupted response but the server doesn't log\nan error, panic with the value [ErrAbortHandler].", Value: reflect.TypeOf((*http.Handler)(nil)).Elem(), Methods: Handler_methods},
+			"HandlerFunc":        {Doc: "The HandlerFunc type is an adapter to allow the use of\nordinary functions as HTTP handlers. If f is a function\nwith the appropriate signature, HandlerFunc(f) is a\n[Handler] that calls f.", Value: reflect.TypeOf((*http.HandlerFunc)(nil)).Elem(), Methods: HandlerFunc_methods},
+			"Hijacker":           {Doc: "The Hijacker interface is implemented by ResponseWriters that allow\nan HTTP handler to take over the connection.\n\nThe default [ResponseWriter] for HTTP/1.x connections supports\nHijacker, but HTTP/2 connections intentionally do not.\nResponseWriter wrappers may also not support Hijacker. Handlers\nshould always test for this ability at runtime.", Value: reflect.TypeOf((*http.Hijacker)(nil)).Elem(), Methods: Hijacker_methods},
+			"ResponseWriter":     {Doc: "A ResponseWriter interface is used by an HTTP handler to\nconstruct an HTTP response.\n\nA ResponseWriter may not be used after [Handler.ServeHTTP] has returned.", Value: reflect.TypeOf((*http.ResponseWriter)(nil)).Elem(), Methods: ResponseWriter_methods},
+			"ServeMux":           {Doc: "ServeMux is an HTTP request multiplexer.\nIt matches the URL of each incoming request against a list of registered\npatterns and calls the handler for the pattern that\nmost closely matches the URL.\n\n# Patterns\n\nPatterns can match the method, host and path of a request.\nSome examples:\n\n  - \"/index.html\" matches the path \"/index.html\" for any host and method.\n  - \"GET /static/\" matches a GET request whose path begins with \"/static/\".\n  - \"example.com/\" matches any request to the host \"example.com\".\n  - \"example.com/{$}\" matches requests with host \"example.com\" and path \"/\".\n  - \"/b/{bucket}/o/{objectname...}\" matches paths whose first segment is \"b\"\n    and whose third segment is \"o\". The name \"bucket\" denotes the second\n    segment and \"objectname\" denotes the remainder of the path.\n\nIn general, a pattern looks like\n\n\t[METHOD ][HOST]/[PATH]\n\nAll three parts are optional; \"/\" is a valid pattern.\nIf METHOD is present, it must be followed by at least one space or tab.\n\nLiteral (that is, non-wildcard) parts of a pattern match\nthe corresponding parts of a request case-sensitively.\n\nA pattern with no method matches every method. A pattern\nwith the method GET matches both GET and HEAD requests.\nOtherwise, the method must match exactly.\n\nA pattern with no host matches every host.\nA pattern with a host matches URLs on that host only.\n\nA path can include wildcard segments of the form {NAME} or {NAME...}.\nFor example, \"/b/{bucket}/o/{objectname...}\".\nThe wildcard name must be a valid Go identifier.\nWildcards must be full path segments: they must be preceded by a slash and followed by\neither a slash or the end of the string.\nFor example, \"/b_{bucket}\" is not a valid pattern.\n\nNormally a wildcard matches only a single path segment,\nending at the next literal slash (not %2F) in the request URL.\nBut if the \"...\" is present, then the wildcard matches the remainder of the URL path, including slashes.\n(Therefore it is invalid for a \"...\" wildcard to appear anywhere but at the end of a pattern.)\nThe match for a wildcard can be obtained by calling [Request.PathValue] with the wildcard's name.\nA trailing slash in a path acts as an anonymous \"...\" wildcard.\n\nThe special wildcard {$} matches only the end of the URL.\nFor example, the pattern \"/{$}\" matches only the path \"/\",\nwhereas the pattern \"/\" matches every path.\n\nFor matching, both pattern paths and incoming request paths are unescaped segment by segment.\nSo, for example, the path \"/a%2Fb/100%25\" is treated as having two segments, \"a/b\" and \"100%\".\nThe pattern \"/a%2fb/\" matches it, but the pattern \"/a/b/\" does not.\n\n# Precedence\n\nIf two or more patterns match a request, then the most specific pattern takes precedence.\nA pattern P1 is more specific than P2 if P1 matches a strict subset of P2’s requests;\nthat is, if P2 matches all the requests of P1 and more.\nIf neither is more specific, then the patterns conflict.\nThere is one exception to this rule, for backwards compatibility:\nif two patterns would otherwise conflict and one has a host while the other does not,\nthen the pattern with the host takes precedence.\nIf a pattern passed to [ServeMux.Handle] or [ServeMux.HandleFunc] conflicts with\nanother pattern that is already registered, those functions panic.\n\nAs an example of the general rule, \"/images/thumbnails/\" is more specific than \"/images/\",\nso both can be registered.\nThe former matches paths beginning with \"/images/thumbnails/\"\nand the latter will match any other path in the \"/images/\" subtree.\n\nAs another example, consider the patterns \"GET /\" and \"/index.html\":\nboth match a GET request for \"/index.html\", but the former pattern\nmatches all other GET and HEAD requests, while the latter matches any\nrequest for \"/index.html\" that uses a different method.\nThe patterns conflict.\n\n# Trailing-slash redirection\n\nConsider a [ServeMux] with a handler for a subtree, registered using a trailing slash or \"...\" wildcard.\nIf the ServeMux receives a request for the subtree root without a trailing slash,\nit redirects the request by adding the trailing slash.\nThis behavior can be overridden with a separate registration for the path without\nthe trailing slash or \"...\" wildcard. For example, registering \"/images/\" causes ServeMux\nto redirect a request for \"/images\" to \"/images/\", unless \"/images\" has\nbeen registered separately.\n\n# Request sanitizing\n\nServeMux also takes care of sanitizing the URL request path and the Host\nheader, stripping the port number and redirecting any request containing . or\n.. segments or repeated slashes to an equivalent, cleaner URL.\nEscaped path elements such as \"%2e\" for \".\" and \"%2f\" for \"/\" are preserved\nand aren't considered separators for request routing.\n\n# Compatibility\n\nThe pattern syntax and matching behavior of ServeMux changed significantly\nin Go 1.22. To restore the old behavior, set the GODEBUG environment variable\nto \"httpmuxgo121=1\". This setting is read once, at program startup; changes\nduring execution will be ignored.\n\nThe backwards-incompatible changes include:\n  - Wildcards are just ordinary literal path segments in 1.21.\n    For example, the pattern \"/{x}\" will match only that path in 1.21,\n    but will match any one-segment path in 1.22.\n  - In 1.21, no pattern was rejected, unless it was empty or conflicted with an existing pattern.\n    In 1.22, syntactically invalid patterns will cause [ServeMux.Handle] and [ServeMux.HandleFunc] to panic.\n    For example, in 1.21, the patterns \"/{\"  and \"/a{x}\" match themselves,\n    but in 1.22 they are invalid and will cause a panic when registered.\n  - In 1.22, each segment of a pattern is unescaped; this was not done in 1.21.\n    For example, in 1.22 the pattern \"/%61\" matches the path \"/a\" (\"%61\" being the URL escape sequence for \"a\"),\n    but in 1.21 it would match only the path \"/%2561\" (where \"%25\" is the escape for the percent sign).\n  - When matching patterns to paths, in 1.22 each segment of the path is unescaped; in 1.21, the entire path is unescaped.\n    This change mostly affects how paths with %2F escapes adjacent to slashes are treated.\n    See https://go.dev/issue/21955 for details.", Value: reflect.TypeOf((*http.ServeMux)(nil)).Elem(), Methods: ServeMux_methods},
+			"Server":             {Doc: "A Server defines parameters for running an HTTP server.\nThe zero value for Server is a valid configuration.", Value: reflect.TypeOf((*http.Server)(nil)).Elem(), Methods: Server_methods},
+			"Transport":          {Doc: "Transport is an implementation of [RoundTripper] that supports HTTP,\nHTTPS, and HTTP proxies (for either HTTP or HTTPS with CONNECT).\n\nBy default, Transport caches connections for future re-use.\nThis may leave many open connections when accessing many hosts.\nThis behavior can be managed using [Transport.CloseIdleConnections] method\nand the [Transport.MaxIdleConnsPerHost] and [Transport.DisableKeepAlives] fields.\n\nTransports should be reused instead of created as needed.\nTransports are safe for concurrent use by multiple goroutines.\n\nA Transport is a low-level primitive for making HTTP and HTTPS requests.\nFor high-level functionality, such as cookies and redirects, see [Client].\n\nTransport uses HTTP/1.1 for HTTP URLs and either HTTP/1.1 or HTTP/2\nfor HTTPS URLs, depending on whether the server supports HTTP/2,\nand how the Transport is configured. The [DefaultTransport] supports HTTP/2.\nTo explicitly enable HTTP/2 on a transport, set [Transport.Protocols].\n\nResponses with status codes in the 1xx range are either handled\nautomatically (100 expect-continue) or ignored. The one\nexception is HTTP status code 101 (Switching Protocols), which is\nconsidered a terminal status and returned by [Transport.RoundTrip]. To see the\nignored 1xx responses, use the httptrace trace package's\nClientTrace.Got1xxResponse.\n\nTransport only retries a request upon encountering a network error\nif the connection has already been used successfully and if the\nrequest is idempotent and either has no body or has its [Request.GetBody]\ndefined. HTTP requests are considered idempotent if they have HTTP methods\nGET, HEAD, OPTIONS, or TRACE; or if their [Header] map contains an\n\"Idempotency-Key\" or \"X-Idempotency-Key\" entry. If the idempotency key\nvalue is a zero-length slice, the request is treated as idempotent but the\nheader is not sent on the wire.", Value: reflect.TypeOf((*http.Transport)(nil)).Elem(), Methods: Transport_methods},
+			"RoundTripperImpl":   {Doc: `Struct version of interface RoundTripper for implementation`, Value: reflect.TypeFor[HTTPRoundTripperImpl]()},
+			"FileImpl":           {Doc: `Struct version of interface File for implementation`, Value: reflect.TypeFor[HTTPFileImpl]()},
+			"FileSystemImpl":     {Doc: `Struct version of interface FileSystem for implementation`, Value: reflect.TypeFor[HTTPFileSystemImpl]()},
+			"PusherImpl":         {Doc: `Struct version of interface Pusher for implementation`, Value: reflect.TypeFor[HTTPPusherImpl]()},
+			"CookieJarImpl":      {Doc: `Struct version of interface CookieJar for implementation`, Value: reflect.TypeFor[HTTPCookieJarImpl]()},
+			"CloseNotifierImpl":  {Doc: `Struct version of interface CloseNotifier for implementation`, Value: reflect.TypeFor[HTTPCloseNotifierImpl]()},
+			"FlusherImpl":        {Doc: `Struct version of interface Flusher for implementation`, Value: reflect.TypeFor[HTTPFlusherImpl]()},
+			"HandlerImpl":        {Doc: `Struct version of interface Handler for implementation`, Value: reflect.TypeFor[HTTPHandlerImpl]()},
+			"HijackerImpl":       {Doc: `Struct version of interface Hijacker for implementation`, Value: reflect.TypeFor[HTTPHijackerImpl]()},
+			"ResponseWriterImpl": {Doc: `Struct version of interface ResponseWriter for implementation`, Value: reflect.TypeFor[HTTPResponseWriterImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"AllowQuerySemicolons": {Doc: "AllowQuerySemicolons returns a handler that serves requests by converting any\nunescaped semicolons in the URL query to ampersands, and invoking the handler h.\n\nThis restores the pre-Go 1.17 behavior of splitting query parameters on both\nsemicolons and ampersands. (See golang.org/issue/25192). Note that this\nbehavior doesn't match that of many proxies, and the mismatch can lead to\nsecurity issues.\n\nAllowQuerySemicolons should be invoked before [Request.ParseForm] is called.", Args: []pkgreflect.Arg{{Name: "h", Tag: "Handler"}}, Tag: "Handler", Value: reflect.ValueOf(http.AllowQuerySemicolons)},
+
+			"CanonicalHeaderKey": {Doc: "CanonicalHeaderKey returns the canonical format of the\nheader key s. The canonicalization converts the first\nletter and any letter following a hyphen to upper case;\nthe rest are converted to lowercase. For example, the\ncanonical key for \"accept-encoding\" is \"Accept-Encoding\".\nIf s contains a space or invalid header field bytes, it is\nreturned without modifications.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(http.CanonicalHeaderKey)},
+
+			"DetectContentType": {Doc: "DetectContentType implements the algorithm described\nat https://mimesniff.spec.whatwg.org/ to determine the\nContent-Type of the given data. It considers at most the\nfirst 512 bytes of data. DetectContentType always returns\na valid MIME type: if it cannot determine a more specific one, it\nreturns \"application/octet-stream\".", Args: []pkgreflect.Arg{{Name: "data", Tag: "[]byte"}}, Tag: "string", Value: reflect.ValueOf(http.DetectContentType)},
+
+			"Error": {Doc: "Error replies to the request with the specified error message and HTTP code.\nIt does not otherwise end the request; the caller should ensure no further\nwrites are done to w.\nThe error message should be plain text.\n\nError deletes the Content-Length header,\nsets Content-Type to “text/plain; charset=utf-8”,\nand sets X-Content-Type-Options to “nosniff”.\nThis configures the header properly for the error message,\nin case the caller had set it up expecting a successful output.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "error", Tag: "string"}, {Name: "code", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(http.Error)},
+
+			"FS": {Doc: "FS converts fsys to a [FileSystem] implementation,\nfor use with [FileServer] and [NewFileTransport].\nThe files provided by fsys must implement [io.Seeker].", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "fs.FS"}}, Tag: "FileSystem", Value: reflect.ValueOf(http.FS)},
+
+			"FileServer": {Doc: "FileServer returns a handler that serves HTTP requests\nwith the contents of the file system rooted at root.\n\nAs a special case, the returned file server redirects any request\nending in \"/index.html\" to the same path, without the final\n\"index.html\".\n\nTo use the operating system's file system implementation,\nuse [http.Dir]:\n\n\thttp.Handle(\"/\", http.FileServer(http.Dir(\"/tmp\")))\n\nTo use an [fs.FS] implementation, use [http.FileServerFS] instead.", Args: []pkgreflect.Arg{{Name: "root", Tag: "FileSystem"}}, Tag: "Handler", Value: reflect.ValueOf(http.FileServer)},
+
+			"FileServerFS": {Doc: "FileServerFS returns a handler that serves HTTP requests\nwith the contents of the file system fsys.\nThe files provided by fsys must implement [io.Seeker].\n\nAs a special case, the returned file server redirects any request\nending in \"/index.html\" to the same path, without the final\n\"index.html\".\n\n\thttp.Handle(\"/\", http.FileServerFS(fsys))", Args: []pkgreflect.Arg{{Name: "root", Tag: "fs.FS"}}, Tag: "Handler", Value: reflect.ValueOf(http.FileServerFS)},
+
+			"Get": {Doc: "Get issues a GET to the specified URL. If the response is one of\nthe following redirect codes, Get follows the redirect, up to a\nmaximum of 10 redirects:\n\n\t301 (Moved Permanently)\n\t302 (Found)\n\t303 (See Other)\n\t307 (Temporary Redirect)\n\t308 (Permanent Redirect)\n\nAn error is returned if there were too many redirects or if there\nwas an HTTP protocol error. A non-2xx response doesn't cause an\nerror. Any returned error will be of type [*url.Error]. The url.Error\nvalue's Timeout method will report true if the request timed out.\n\nWhen err is nil, resp always contains a non-nil resp.Body.\nCaller should close resp.Body when done reading from it.\n\nGet is a wrapper around DefaultClient.Get.\n\nTo make a request with custom headers, use [NewRequest] and\nDefaultClient.Do.\n\nTo make a request with a specified context.Context, use [NewRequestWithContext]\nand DefaultClient.Do.", Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.Get)},
+
+			"Handle": {Doc: "Handle registers the handler for the given pattern in [DefaultServeMux].\nThe documentation for [ServeMux] explains how patterns are matched.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "handler", Tag: "Handler"}}, Tag: "any", Value: reflect.ValueOf(http.Handle)},
+
+			"HandleFunc": {Doc: "HandleFunc registers the handler function for the given pattern in [DefaultServeMux].\nThe documentation for [ServeMux] explains how patterns are matched.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "handler", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(http.HandleFunc)},
+
+			"Head": {Doc: "Head issues a HEAD to the specified URL. If the response is one of\nthe following redirect codes, Head follows the redirect, up to a\nmaximum of 10 redirects:\n\n\t301 (Moved Permanently)\n\t302 (Found)\n\t303 (See Other)\n\t307 (Temporary Redirect)\n\t308 (Permanent Redirect)\n\nHead is a wrapper around DefaultClient.Head.\n\nTo make a request with a specified [context.Context], use [NewRequestWithContext]\nand DefaultClient.Do.", Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.Head)},
+
+			"ListenAndServe": {Doc: "ListenAndServe listens on the TCP network address addr and then calls\n[Serve] with handler to handle requests on incoming connections.\nAccepted connections are configured to enable TCP keep-alives.\n\nThe handler is typically nil, in which case [DefaultServeMux] is used.\n\nListenAndServe always returns a non-nil error.", Args: []pkgreflect.Arg{{Name: "addr", Tag: "string"}, {Name: "handler", Tag: "Handler"}}, Tag: "error", Value: reflect.ValueOf(http.ListenAndServe)},
+
+			"ListenAndServeTLS": {Doc: "ListenAndServeTLS acts identically to [ListenAndServe], except that it\nexpects HTTPS connections. Additionally, files containing a certificate and\nmatching private key for the server must be provided. If the certificate\nis signed by a certificate authority, the certFile should be the concatenation\nof the server's certificate, any intermediates, and the CA's certificate.", Args: []pkgreflect.Arg{{Name: "addr", Tag: "string"}, {Name: "certFile", Tag: "string"}, {Name: "keyFile", Tag: "string"}, {Name: "handler", Tag: "Handler"}}, Tag: "error", Value: reflect.ValueOf(http.ListenAndServeTLS)},
+
+			"MaxBytesHandler": {Doc: "MaxBytesHandler returns a [Handler] that runs h with its [ResponseWriter] and [Request.Body] wrapped by a MaxBytesReader.", Args: []pkgreflect.Arg{{Name: "h", Tag: "Handler"}, {Name: "n", Tag: "int64"}}, Tag: "Handler", Value: reflect.ValueOf(http.MaxBytesHandler)},
+
+			"MaxBytesReader": {Doc: "MaxBytesReader is similar to [io.LimitReader] but is intended for\nlimiting the size of incoming request bodies. In contrast to\nio.LimitReader, MaxBytesReader's result is a ReadCloser, returns a\nnon-nil error of type [*MaxBytesError] for a Read beyond the limit,\nand closes the underlying reader when its Close method is called.\n\nMaxBytesReader prevents clients from accidentally or maliciously\nsending a large request and wasting server resources. If possible,\nit tells the [ResponseWriter] to close the connection after the limit\nhas been reached.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "io.ReadCloser"}, {Name: "n", Tag: "int64"}}, Tag: "io.ReadCloser", Value: reflect.ValueOf(http.MaxBytesReader)},
+
+			"NewFileTransport": {Doc: "NewFileTransport returns a new [RoundTripper], serving the provided\n[FileSystem]. The returned RoundTripper ignores the URL host in its\nincoming requests, as well as most other properties of the\nrequest.\n\nThe typical use case for NewFileTransport is to register the \"file\"\nprotocol with a [Transport], as in:\n\n\tt := &http.Transport{}\n\tt.RegisterProtocol(\"file\", http.NewFileTransport(http.Dir(\"/\")))\n\tc := &http.Client{Transport: t}\n\tres, err := c.Get(\"file:///etc/passwd\")\n\t...", Args: []pkgreflect.Arg{{Name: "fs", Tag: "FileSystem"}}, Tag: "RoundTripper", Value: reflect.ValueOf(http.NewFileTransport)},
+
+			"NewFileTransportFS": {Doc: "NewFileTransportFS returns a new [RoundTripper], serving the provided\nfile system fsys. The returned RoundTripper ignores the URL host in its\nincoming requests, as well as most other properties of the\nrequest. The files provided by fsys must implement [io.Seeker].\n\nThe typical use case for NewFileTransportFS is to register the \"file\"\nprotocol with a [Transport], as in:\n\n\tfsys := os.DirFS(\"/\")\n\tt := &http.Transport{}\n\tt.RegisterProtocol(\"file\", http.NewFileTransportFS(fsys))\n\tc := &http.Client{Transport: t}\n\tres, err := c.Get(\"file:///etc/passwd\")\n\t...", Args: []pkgreflect.Arg{{Name: "fsys", Tag: "fs.FS"}}, Tag: "RoundTripper", Value: reflect.ValueOf(http.NewFileTransportFS)},
+
+			"NewRequest": {Doc: "NewRequest wraps [NewRequestWithContext] using [context.Background].", Args: []pkgreflect.Arg{{Name: "method", Tag: "string"}, {Name: "url", Tag: "string"}, {Name: "body", Tag: "io.Reader"}}, Tag: "any", Value: reflect.ValueOf(http.NewRequest)},
+
+			"NewRequestWithContext": {Doc: "NewRequestWithContext returns a new [Request] given a method, URL, and\noptional body.\n\nIf the provided body is also an [io.Closer], the returned\n[Request.Body] is set to body and will be closed (possibly\nasynchronously) by the Client methods Do, Post, and PostForm,\nand [Transport.RoundTrip].\n\nNewRequestWithContext returns a Request suitable for use with\n[Client.Do] or [Transport.RoundTrip]. To create a request for use with\ntesting a Server Handler, either use the [net/http/httptest.NewRequest] function,\nuse [ReadRequest], or manually update the Request fields.\nFor an outgoing client request, the context\ncontrols the entire lifetime of a request and its response:\nobtaining a connection, sending the request, and reading the\nresponse headers and body. See the [Request] type's documentation for\nthe difference between inbound and outbound request fields.\n\nIf body is of type [*bytes.Buffer], [*bytes.Reader], or\n[*strings.Reader], the returned request's ContentLength is set to its\nexact value (instead of -1), GetBody is populated (so 307 and 308\nredirects can replay the body), and Body is set to [NoBody] if the\nContentLength is 0.", Args: []pkgreflect.Arg{{Name: "ctx", Tag: "context.Context"}, {Name: "method", Tag: "string"}, {Name: "url", Tag: "string"}, {Name: "body", Tag: "io.Reader"}}, Tag: "any", Value: reflect.ValueOf(http.NewRequestWithContext)},
+
+			"NewResponseController": {Doc: "NewResponseController creates a [ResponseController] for a request.\n\nThe ResponseWriter should be the original value passed to the [Handler.ServeHTTP] method,\nor have an Unwrap method returning the original ResponseWriter.\n\nIf the ResponseWriter implements any of the following methods, the ResponseController\nwill call them as appropriate:\n\n\tFlush()\n\tFlushError() error // alternative Flush returning an error\n\tHijack() (net.Conn, *bufio.ReadWriter, error)\n\tSetReadDeadline(deadline time.Time) error\n\tSetWriteDeadline(deadline time.Time) error\n\tEnableFullDuplex() error\n\nIf the ResponseWriter does not support a method, ResponseController returns\nan error matching [ErrNotSupported].", Args: []pkgreflect.Arg{{Name: "rw", Tag: "ResponseWriter"}}, Tag: "ResponseController", Value: reflect.ValueOf(http.NewResponseController)},
+
+			"NewServeMux": {Doc: "NewServeMux allocates and returns a new [ServeMux].", Args: []pkgreflect.Arg{}, Tag: "ServeMux", Value: reflect.ValueOf(http.NewServeMux)},
+
+			"NotFound": {Doc: "NotFound replies to the request with an HTTP 404 not found error.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}}, Tag: "any", Value: reflect.ValueOf(http.NotFound)},
+
+			"NotFoundHandler": {Doc: "NotFoundHandler returns a simple request handler\nthat replies to each request with a “404 page not found” reply.", Args: []pkgreflect.Arg{}, Tag: "Handler", Value: reflect.ValueOf(http.NotFoundHandler)},
+
+			"ParseHTTPVersion": {Doc: "ParseHTTPVersion parses an HTTP version string according to RFC 7230, section 2.6.\n\"HTTP/1.0\" returns (1, 0, true). Note that strings without\na minor version, such as \"HTTP/2\", are not valid.", Args: []pkgreflect.Arg{{Name: "vers", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.ParseHTTPVersion)},
+
+			"ParseTime": {Doc: "ParseTime parses a time header (such as the Date: header),\ntrying each of the three formats allowed by HTTP/1.1:\n[TimeFormat], [time.RFC850], and [time.ANSIC].", Args: []pkgreflect.Arg{{Name: "text", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.ParseTime)},
+
+			"Post": {Doc: "Post issues a POST to the specified URL.\n\nCaller should close resp.Body when done reading from it.\n\nIf the provided body is an [io.Closer], it is closed after the\nrequest.\n\nPost is a wrapper around DefaultClient.Post.\n\nTo set custom headers, use [NewRequest] and DefaultClient.Do.\n\nSee the [Client.Do] method documentation for details on how redirects\nare handled.\n\nTo make a request with a specified context.Context, use [NewRequestWithContext]\nand DefaultClient.Do.", Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}, {Name: "contentType", Tag: "string"}, {Name: "body", Tag: "io.Reader"}}, Tag: "any", Value: reflect.ValueOf(http.Post)},
+
+			"PostForm": {Doc: "PostForm issues a POST to the specified URL, with data's keys and\nvalues URL-encoded as the request body.\n\nThe Content-Type header is set to application/x-www-form-urlencoded.\nTo set other headers, use [NewRequest] and DefaultClient.Do.\n\nWhen err is nil, resp always contains a non-nil resp.Body.\nCaller should close resp.Body when done reading from it.\n\nPostForm is a wrapper around DefaultClient.PostForm.\n\nSee the [Client.Do] method documentation for details on how redirects\nare handled.\n\nTo make a request with a specified [context.Context], use [NewRequestWithContext]\nand DefaultClient.Do.", Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}, {Name: "data", Tag: "url.Values"}}, Tag: "any", Value: reflect.ValueOf(http.PostForm)},
+
+			"ProxyFromEnvironment": {Doc: "ProxyFromEnvironment returns the URL of the proxy to use for a\ngiven request, as indicated by the environment variables\nHTTP_PROXY, HTTPS_PROXY and NO_PROXY (or the lowercase versions\nthereof). Requests use the proxy from the environment variable\nmatching their scheme, unless excluded by NO_PROXY.\n\nThe environment values may be either a complete URL or a\n\"host[:port]\", in which case the \"http\" scheme is assumed.\nAn error is returned if the value is a different form.\n\nA nil URL and nil error are returned if no proxy is defined in the\nenvironment, or a proxy should not be used for the given request,\nas defined by NO_PROXY.\n\nAs a special case, if req.URL.Host is \"localhost\" (with or without\na port number), then a nil URL and nil error will be returned.", Args: []pkgreflect.Arg{{Name: "req", Tag: "Request"}}, Tag: "any", Value: reflect.ValueOf(http.ProxyFromEnvironment)},
+
+			"ProxyURL": {Doc: "ProxyURL returns a proxy function (for use in a [Transport])\nthat always returns the same URL.", Args: []pkgreflect.Arg{{Name: "fixedURL", Tag: "url.URL"}}, Tag: "Unknown", Value: reflect.ValueOf(http.ProxyURL)},
+
+			"ReadRequest": {Doc: "ReadRequest reads and parses an incoming request from b.\n\nReadRequest is a low-level function and should only be used for\nspecialized applications; most code should use the [Server] to read\nrequests and handle them via the [Handler] interface. ReadRequest\nonly supports HTTP/1.x requests. For HTTP/2, use golang.org/x/net/http2.", Args: []pkgreflect.Arg{{Name: "b", Tag: "bufio.Reader"}}, Tag: "any", Value: reflect.ValueOf(http.ReadRequest)},
+
+			"ReadResponse": {Doc: "ReadResponse reads and returns an HTTP response from r.\nThe req parameter optionally specifies the [Request] that corresponds\nto this [Response]. If nil, a GET request is assumed.\nClients must call resp.Body.Close when finished reading resp.Body.\nAfter that call, clients can inspect resp.Trailer to find key/value\npairs included in the response trailer.", Args: []pkgreflect.Arg{{Name: "r", Tag: "bufio.Reader"}, {Name: "req", Tag: "Request"}}, Tag: "any", Value: reflect.ValueOf(http.ReadResponse)},
+
+			"Redirect": {Doc: "Redirect replies to the request with a redirect to url,\nwhich may be a path relative to the request path.\nAny non-ASCII characters in url will be percent-encoded,\nbut existing percent encodings will not be changed.\n\nThe provided code should be in the 3xx range and is usually\n[StatusMovedPermanently], [StatusFound] or [StatusSeeOther].\n\nIf the Content-Type header has not been set, [Redirect] sets it\nto \"text/html; charset=utf-8\" and writes a small HTML body.\nSetting the Content-Type header to any value, including nil,\ndisables that behavior.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}, {Name: "url", Tag: "string"}, {Name: "code", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(http.Redirect)},
+
+			"RedirectHandler": {Doc: "RedirectHandler returns a request handler that redirects\neach request it receives to the given url using the given\nstatus code.\n\nThe provided code should be in the 3xx range and is usually\n[StatusMovedPermanently], [StatusFound] or [StatusSeeOther].", Args: []pkgreflect.Arg{{Name: "url", Tag: "string"}, {Name: "code", Tag: "int"}}, Tag: "Handler", Value: reflect.ValueOf(http.RedirectHandler)},
+
+			"Serve": {Doc: "Serve accepts incoming HTTP connections on the listener l,\ncreating a new service goroutine for each. The service goroutines\nread requests and then call handler to reply to them.\n\nThe handler is typically nil, in which case [DefaultServeMux] is used.\n\nHTTP/2 support is only enabled if the Listener returns [*tls.Conn]\nconnections or connections which implement the same ConnectionState\nmethod as *tls.Conn, and the connection state indicates that the \"h2\"\nprotocol was negotiated by ALPN.\n\nServe always returns a non-nil error.", Args: []pkgreflect.Arg{{Name: "l", Tag: "net.Listener"}, {Name: "handler", Tag: "Handler"}}, Tag: "error", Value: reflect.ValueOf(http.Serve)},
+
+			"ServeContent": {Doc: "ServeContent replies to the request using the content in the\nprovided ReadSeeker. The main benefit of ServeContent over [io.Copy]\nis that it handles Range requests properly, sets the MIME type, and\nhandles If-Match, If-Unmodified-Since, If-None-Match, If-Modified-Since,\nand If-Range requests.\n\nIf the response's Content-Type header is not set, ServeContent\nfirst tries to deduce the type from name's file extension and,\nif that fails, falls back to reading the first block of the content\nand passing it to [DetectContentType].\nThe name is otherwise unused; in particular it can be empty and is\nnever sent in the response.\n\nIf modtime is not the zero time or Unix epoch, ServeContent\nincludes it in a Last-Modified header in the response. If the\nrequest includes an If-Modified-Since header, ServeContent uses\nmodtime to decide whether the content needs to be sent at all.\n\nThe content's Seek method must work: ServeContent uses\na seek to the end of the content to determine its size.\nNote that [*os.File] implements the [io.ReadSeeker] interface.\n\nIf the caller has set w's ETag header formatted per RFC 7232, section 2.3,\nServeContent uses it to handle requests using If-Match, If-None-Match, or If-Range.\n\nIf an error occurs when serving the request (for example, when\nhandling an invalid range request), ServeContent responds with an\nerror message. By default, ServeContent strips the Cache-Control,\nContent-Encoding, ETag, and Last-Modified headers from error responses.\nThe GODEBUG setting httpservecontentkeepheaders=1 causes ServeContent\nto preserve these headers.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "req", Tag: "Request"}, {Name: "name", Tag: "string"}, {Name: "modtime", Tag: "time.Time"}, {Name: "content", Tag: "io.ReadSeeker"}}, Tag: "any", Value: reflect.ValueOf(http.ServeContent)},
+
+			"ServeFile": {Doc: "ServeFile replies to the request with the contents of the named\nfile or directory.\n\nIf the provided file or directory name is a relative path, it is\ninterpreted relative to the current directory and may ascend to\nparent directories. If the provided name is constructed from user\ninput, it should be sanitized before calling [ServeFile].\n\nAs a precaution, ServeFile will reject requests where r.URL.Path\ncontains a \"..\" path element; this protects against callers who\nmight unsafely use [filepath.Join] on r.URL.Path without sanitizing\nit and then use that filepath.Join result as the name argument.\n\nAs another special case, ServeFile redirects any request where r.URL.Path\nends in \"/index.html\" to the same path, without the final\n\"index.html\". To avoid such redirects either modify the path or\nuse [ServeContent].\n\nOutside of those two special cases, ServeFile does not use\nr.URL.Path for selecting the file or directory to serve; only the\nfile or directory provided in the name argument is used.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.ServeFile)},
+
+			"ServeFileFS": {Doc: "ServeFileFS replies to the request with the contents\nof the named file or directory from the file system fsys.\nThe files provided by fsys must implement [io.Seeker].\n\nIf the provided name is constructed from user input, it should be\nsanitized before calling [ServeFileFS].\n\nAs a precaution, ServeFileFS will reject requests where r.URL.Path\ncontains a \"..\" path element; this protects against callers who\nmight unsafely use [filepath.Join] on r.URL.Path without sanitizing\nit and then use that filepath.Join result as the name argument.\n\nAs another special case, ServeFileFS redirects any request where r.URL.Path\nends in \"/index.html\" to the same path, without the final\n\"index.html\". To avoid such redirects either modify the path or\nuse [ServeContent].\n\nOutside of those two special cases, ServeFileFS does not use\nr.URL.Path for selecting the file or directory to serve; only the\nfile or directory provided in the name argument is used.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "r", Tag: "Request"}, {Name: "fsys", Tag: "fs.FS"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(http.ServeFileFS)},
+
+			"ServeTLS": {Doc: "ServeTLS accepts incoming HTTPS connections on the listener l,\ncreating a new service goroutine for each. The service goroutines\nread requests and then call handler to reply to them.\n\nThe handler is typically nil, in which case [DefaultServeMux] is used.\n\nAdditionally, files containing a certificate and matching private key\nfor the server must be provided. If the certificate is signed by a\ncertificate authority, the certFile should be the concatenation\nof the server's certificate, any intermediates, and the CA's certificate.\n\nServeTLS always returns a non-nil error.", Args: []pkgreflect.Arg{{Name: "l", Tag: "net.Listener"}, {Name: "handler", Tag: "Handler"}, {Name: "certFile", Tag: "string"}, {Name: "keyFile", Tag: "string"}}, Tag: "error", Value: reflect.ValueOf(http.ServeTLS)},
+
+			"SetCookie": {Doc: "SetCookie adds a Set-Cookie header to the provided [ResponseWriter]'s headers.\nThe provided cookie must have a valid Name. Invalid cookies may be\nsilently dropped.", Args: []pkgreflect.Arg{{Name: "w", Tag: "ResponseWriter"}, {Name: "cookie", Tag: "Cookie"}}, Tag: "any", Value: reflect.ValueOf(http.SetCookie)},
+
+			"StatusText": {Doc: "StatusText returns a text for the HTTP status code. It returns the empty\nstring if the code is unknown.", Args: []pkgreflect.Arg{{Name: "code", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(http.StatusText)},
+
+			"StripPrefix": {Doc: "StripPrefix returns a handler that serves HTTP requests by removing the\ngiven prefix from the request URL's Path (and RawPath if set) and invoking\nthe handler h. StripPrefix handles a request for a path that doesn't begin\nwith prefix by replying with an HTTP 404 not found error. The prefix must\nmatch exactly: if the prefix in the request contains escaped characters\nthe reply is also an HTTP 404 not found error.", Args: []pkgreflect.Arg{{Name: "prefix", Tag: "string"}, {Name: "h", Tag: "Handler"}}, Tag: "Handler", Value: reflect.ValueOf(http.StripPrefix)},
+
+			"TimeoutHandler": {Doc: "TimeoutHandler returns a [Handler] that runs h with the given time limit.\n\nThe new Handler calls h.ServeHTTP to handle each request, but if a\ncall runs for longer than its time limit, the handler responds with\na 503 Service Unavailable error and the given message in its body.\n(If msg is empty, a suitable default message will be sent.)\nAfter such a timeout, writes by h to its [ResponseWriter] will return\n[ErrHandlerTimeout].\n\nTimeoutHandler supports the [Pusher] interface but does not support\nthe [Hijacker] or [Flusher] interfaces.", Args: []pkgreflect.Arg{{Name: "h", Tag: "Handler"}, {Name: "dt", Tag: "time.Duration"}, {Name: "msg", Tag: "string"}}, Tag: "Handler", Value: reflect.ValueOf(http.TimeoutHandler)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"DefaultClient":           {Doc: "", Value: reflect.ValueOf(&http.DefaultClient)},
+			"DefaultServeMux":         {Doc: "", Value: reflect.ValueOf(&http.DefaultServeMux)},
+			"DefaultTransport":        {Doc: "", Value: reflect.ValueOf(&http.DefaultTransport)},
+			"ErrAbortHandler":         {Doc: "", Value: reflect.ValueOf(&http.ErrAbortHandler)},
+			"ErrBodyNotAllowed":       {Doc: "ErrBodyNotAllowed is returned by ResponseWriter.Write calls\nwhen the HTTP method or response code does not permit a\nbody.", Value: reflect.ValueOf(&http.ErrBodyNotAllowed)},
+			"ErrBodyReadAfterClose":   {Doc: "", Value: reflect.ValueOf(&http.ErrBodyReadAfterClose)},
+			"ErrContentLength":        {Doc: "ErrContentLength is returned by ResponseWriter.Write calls\nwhen a Handler set a Content-Length response header with a\ndeclared size and then attempted to write more bytes than\ndeclared.", Value: reflect.ValueOf(&http.ErrContentLength)},
+			"ErrHandlerTimeout":       {Doc: "", Value: reflect.ValueOf(&http.ErrHandlerTimeout)},
+			"ErrHeaderTooLong":        {Doc: "Deprecated: ErrHeaderTooLong is no longer returned by\nanything in the net/http package. Callers should not\ncompare errors against this variable.", Value: reflect.ValueOf(&http.ErrHeaderTooLong)},
+			"ErrHijacked":             {Doc: "ErrHijacked is returned by ResponseWriter.Write calls when\nthe underlying connection has been hijacked using the\nHijacker interface. A zero-byte write on a hijacked\nconnection will return ErrHijacked without any other side\neffects.", Value: reflect.ValueOf(&http.ErrHijacked)},
+			"ErrLineTooLong":          {Doc: "", Value: reflect.ValueOf(&http.ErrLineTooLong)},
+			"ErrMissingBoundary":      {Doc: "ErrMissingBoundary is returned by Request.MultipartReader when the\nrequest's Content-Type does not include a \"boundary\" parameter.", Value: reflect.ValueOf(&http.ErrMissingBoundary)},
+			"ErrMissingContentLength": {Doc: "Deprecated: ErrMissingContentLength is no longer returned by\nanything in the net/http package. Callers should not\ncompare errors against this variable.", Value: reflect.ValueOf(&http.ErrMissingContentLength)},
+			"ErrMissingFile":          {Doc: "", Value: reflect.ValueOf(&http.ErrMissingFile)},
+			"ErrNoCookie":             {Doc: "", Value: reflect.ValueOf(&http.ErrNoCookie)},
+			"ErrNoLocation":           {Doc: "", Value: reflect.ValueOf(&http.ErrNoLocation)},
+			"ErrNotMultipart":         {Doc: "ErrNotMultipart is returned by Request.MultipartReader when the\nrequest's Content-Type is not multipart/form-data.", Value: reflect.ValueOf(&http.ErrNotMultipart)},
+			"ErrNotSupported":         {Doc: "ErrNotSupported indicates that a feature is not supported.\n\nIt is returned by ResponseController methods to indicate that\nthe handler does not support the method, and by the Push method\nof Pusher implementations to indicate that HTTP/2 Push support\nis not available.", Value: reflect.ValueOf(&http.ErrNotSupported)},
+			"ErrSchemeMismatch":       {Doc: "", Value: reflect.ValueOf(&http.ErrSchemeMismatch)},
+			"ErrServerClosed":         {Doc: "", Value: reflect.ValueOf(&http.ErrServerClosed)},
+			"ErrShortBody":            {Doc: "Deprecated: ErrShortBody is no longer returned by\nanything in the net/http package. Callers should not\ncompare errors against this variable.", Value: reflect.ValueOf(&http.ErrShortBody)},
+			"ErrSkipAltProtocol":      {Doc: "", Value: reflect.ValueOf(&http.ErrSkipAltProtocol)},
+			"ErrUnexpectedTrailer":    {Doc: "Deprecated: ErrUnexpectedTrailer is no longer returned by\nanything in the net/http package. Callers should not\ncompare errors against this variable.", Value: reflect.ValueOf(&http.ErrUnexpectedTrailer)},
+			"ErrUseLastResponse":      {Doc: "", Value: reflect.ValueOf(&http.ErrUseLastResponse)},
+			"ErrWriteAfterFlush":      {Doc: "Deprecated: ErrWriteAfterFlush is no longer returned by\nanything in the net/http package. Callers should not\ncompare errors against this variable.", Value: reflect.ValueOf(&http.ErrWriteAfterFlush)},
+			"LocalAddrContextKey":     {Doc: "LocalAddrContextKey is a context key. It can be used in\nHTTP handlers with Context.Value to access the local\naddress the connection arrived on.\nThe associated value will be of type net.Addr.", Value: reflect.ValueOf(&http.LocalAddrContextKey)},
+			"NoBody":                  {Doc: "", Value: reflect.ValueOf(&http.NoBody)},
+			"ServerContextKey":        {Doc: "ServerContextKey is a context key. It can be used in HTTP\nhandlers with Context.Value to access the server that\nstarted the handler. The associated value will be of\ntype *Server.", Value: reflect.ValueOf(&http.ServerContextKey)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"DefaultMaxHeaderBytes":               {Doc: "", Value: reflect.ValueOf(http.DefaultMaxHeaderBytes)},
+			"DefaultMaxIdleConnsPerHost":          {Doc: "", Value: reflect.ValueOf(http.DefaultMaxIdleConnsPerHost)},
+			"MethodConnect":                       {Doc: "", Value: reflect.ValueOf(http.MethodConnect)},
+			"MethodDelete":                        {Doc: "", Value: reflect.ValueOf(http.MethodDelete)},
+			"MethodGet":                           {Doc: "", Value: reflect.ValueOf(http.MethodGet)},
+			"MethodHead":                          {Doc: "", Value: reflect.ValueOf(http.MethodHead)},
+			"MethodOptions":                       {Doc: "", Value: reflect.ValueOf(http.MethodOptions)},
+			"MethodPatch":                         {Doc: "", Value: reflect.ValueOf(http.MethodPatch)},
+			"MethodPost":                          {Doc: "", Value: reflect.ValueOf(http.MethodPost)},
+			"MethodPut":                           {Doc: "", Value: reflect.ValueOf(http.MethodPut)},
+			"MethodTrace":                         {Doc: "", Value: reflect.ValueOf(http.MethodTrace)},
+			"SameSiteDefaultMode":                 {Doc: "", Value: reflect.ValueOf(http.SameSiteDefaultMode)},
+			"SameSiteLaxMode":                     {Doc: "", Value: reflect.ValueOf(http.SameSiteLaxMode)},
+			"SameSiteNoneMode":                    {Doc: "", Value: reflect.ValueOf(http.SameSiteNoneMode)},
+			"SameSiteStrictMode":                  {Doc: "", Value: reflect.ValueOf(http.SameSiteStrictMode)},
+			"StateActive":                         {Doc: "StateActive represents a connection that has read 1 or more\nbytes of a request. The Server.ConnState hook for\nStateActive fires before the request has entered a handler\nand doesn't fire again until the request has been\nhandled. After the request is handled, the state\ntransitions to StateClosed, StateHijacked, or StateIdle.\nFor HTTP/2, StateActive fires on the transition from zero\nto one active request, and only transitions away once all\nactive requests are complete. That means that ConnState\ncannot be used to do per-request work; ConnState only notes\nthe overall state of the connection.", Value: reflect.ValueOf(http.StateActive)},
+			"StateClosed":                         {Doc: "StateClosed represents a closed connection.\nThis is a terminal state. Hijacked connections do not\ntransition to StateClosed.", Value: reflect.ValueOf(http.StateClosed)},
+			"StateHijacked":                       {Doc: "StateHijacked represents a hijacked connection.\nThis is a terminal state. It does not transition to StateClosed.", Value: reflect.ValueOf(http.StateHijacked)},
+			"StateIdle":                           {Doc: "StateIdle represents a connection that has finished\nhandling a request and is in the keep-alive state, waiting\nfor a new request. Connections transition from StateIdle\nto either StateActive or StateClosed.", Value: reflect.ValueOf(http.StateIdle)},
+			"StateNew":                            {Doc: "StateNew represents a new connection that is expected to\nsend a request immediately. Connections begin at this\nstate and then transition to either StateActive or\nStateClosed.", Value: reflect.ValueOf(http.StateNew)},
+			"StatusAccepted":                      {Doc: "", Value: reflect.ValueOf(http.StatusAccepted)},
+			"StatusAlreadyReported":               {Doc: "", Value: reflect.ValueOf(http.StatusAlreadyReported)},
+			"StatusBadGateway":                    {Doc: "", Value: reflect.ValueOf(http.StatusBadGateway)},
+			"StatusBadRequest":                    {Doc: "", Value: reflect.ValueOf(http.StatusBadRequest)},
+			"StatusConflict":                      {Doc: "", Value: reflect.ValueOf(http.StatusConflict)},
+			"StatusContinue":                      {Doc: "", Value: reflect.ValueOf(http.StatusContinue)},
+			"StatusCreated":                       {Doc: "", Value: reflect.ValueOf(http.StatusCreated)},
+			"StatusEarlyHints":                    {Doc: "", Value: reflect.ValueOf(http.StatusEarlyHints)},
+			"StatusExpectationFailed":             {Doc: "", Value: reflect.ValueOf(http.StatusExpectationFailed)},
+			"StatusFailedDependency":              {Doc: "", Value: reflect.ValueOf(http.StatusFailedDependency)},
+			"StatusForbidden":                     {Doc: "", Value: reflect.ValueOf(http.StatusForbidden)},
+			"StatusFound":                         {Doc: "", Value: reflect.ValueOf(http.StatusFound)},
+			"StatusGatewayTimeout":                {Doc: "", Value: reflect.ValueOf(http.StatusGatewayTimeout)},
+			"StatusGone":                          {Doc: "", Value: reflect.ValueOf(http.StatusGone)},
+			"StatusHTTPVersionNotSupported":       {Doc: "", Value: reflect.ValueOf(http.StatusHTTPVersionNotSupported)},
+			"StatusIMUsed":                        {Doc: "", Value: reflect.ValueOf(http.StatusIMUsed)},
+			"StatusInsufficientStorage":           {Doc: "", Value: reflect.ValueOf(http.StatusInsufficientStorage)},
+			"StatusInternalServerError":           {Doc: "", Value: reflect.ValueOf(http.StatusInternalServerError)},
+			"StatusLengthRequired":                {Doc: "", Value: reflect.ValueOf(http.StatusLengthRequired)},
+			"StatusLocked":                        {Doc: "", Value: reflect.ValueOf(http.StatusLocked)},
+			"StatusLoopDetected":                  {Doc: "", Value: reflect.ValueOf(http.StatusLoopDetected)},
+			"StatusMethodNotAllowed":              {Doc: "", Value: reflect.ValueOf(http.StatusMethodNotAllowed)},
+			"StatusMisdirectedRequest":            {Doc: "", Value: reflect.ValueOf(http.StatusMisdirectedRequest)},
+			"StatusMovedPermanently":              {Doc: "", Value: reflect.ValueOf(http.StatusMovedPermanently)},
+			"StatusMultiStatus":                   {Doc: "", Value: reflect.ValueOf(http.StatusMultiStatus)},
+			"StatusMultipleChoices":               {Doc: "", Value: reflect.ValueOf(http.StatusMultipleChoices)},
+			"StatusNetworkAuthenticationRequired": {Doc: "", Value: reflect.ValueOf(http.StatusNetworkAuthenticationRequired)},
+			"StatusNoContent":                     {Doc: "", Value: reflect.ValueOf(http.StatusNoContent)},
+			"StatusNonAuthoritativeInfo":          {Doc: "", Value: reflect.ValueOf(http.StatusNonAuthoritativeInfo)},
+			"StatusNotAcceptable":                 {Doc: "", Value: reflect.ValueOf(http.StatusNotAcceptable)},
+			"StatusNotExtended":                   {Doc: "", Value: reflect.ValueOf(http.StatusNotExtended)},
+			"StatusNotFound":                      {Doc: "", Value: reflect.ValueOf(http.StatusNotFound)},
+			"StatusNotImplemented":                {Doc: "", Value: reflect.ValueOf(http.StatusNotImplemented)},
+			"StatusNotModified":                   {Doc: "", Value: reflect.ValueOf(http.StatusNotModified)},
+			"StatusOK":                            {Doc: "", Value: reflect.ValueOf(http.StatusOK)},
+			"StatusPartialContent":                {Doc: "", Value: reflect.ValueOf(http.StatusPartialContent)},
+			"StatusPaymentRequired":               {Doc: "", Value: reflect.ValueOf(http.StatusPaymentRequired)},
+			"StatusPermanentRedirect":             {Doc: "", Value: reflect.ValueOf(http.StatusPermanentRedirect)},
+			"StatusPreconditionFailed":            {Doc: "", Value: reflect.ValueOf(http.StatusPreconditionFailed)},
+			"StatusPreconditionRequired":          {Doc: "", Value: reflect.ValueOf(http.StatusPreconditionRequired)},
+			"StatusProcessing":                    {Doc: "", Value: reflect.ValueOf(http.StatusProcessing)},
+			"StatusProxyAuthRequired":             {Doc: "", Value: reflect.ValueOf(http.StatusProxyAuthRequired)},
+			"StatusRequestEntityTooLarge":         {Doc: "", Value: reflect.ValueOf(http.StatusRequestEntityTooLarge)},
+			"StatusRequestHeaderFieldsTooLarge":   {Doc: "", Value: reflect.ValueOf(http.StatusRequestHeaderFieldsTooLarge)},
+			"StatusRequestTimeout":                {Doc: "", Value: reflect.ValueOf(http.StatusRequestTimeout)},
+			"StatusRequestURITooLong":             {Doc: "", Value: reflect.ValueOf(http.StatusRequestURITooLong)},
+			"StatusRequestedRangeNotSatisfiable":  {Doc: "", Value: reflect.ValueOf(http.StatusRequestedRangeNotSatisfiable)},
+			"StatusResetContent":                  {Doc: "", Value: reflect.ValueOf(http.StatusResetContent)},
+			"StatusSeeOther":                      {Doc: "", Value: reflect.ValueOf(http.StatusSeeOther)},
+			"StatusServiceUnavailable":            {Doc: "", Value: reflect.ValueOf(http.StatusServiceUnavailable)},
+			"StatusSwitchingProtocols":            {Doc: "", Value: reflect.ValueOf(http.StatusSwitchingProtocols)},
+			"StatusTeapot":                        {Doc: "", Value: reflect.ValueOf(http.StatusTeapot)},
+			"StatusTemporaryRedirect":             {Doc: "", Value: reflect.ValueOf(http.StatusTemporaryRedirect)},
+			"StatusTooEarly":                      {Doc: "", Value: reflect.ValueOf(http.StatusTooEarly)},
+			"StatusTooManyRequests":               {Doc: "", Value: reflect.ValueOf(http.StatusTooManyRequests)},
+			"StatusUnauthorized":                  {Doc: "", Value: reflect.ValueOf(http.StatusUnauthorized)},
+			"StatusUnavailableForLegalReasons":    {Doc: "", Value: reflect.ValueOf(http.StatusUnavailableForLegalReasons)},
+			"StatusUnprocessableEntity":           {Doc: "", Value: reflect.ValueOf(http.StatusUnprocessableEntity)},
+			"StatusUnsupportedMediaType":          {Doc: "", Value: reflect.ValueOf(http.StatusUnsupportedMediaType)},
+			"StatusUpgradeRequired":               {Doc: "", Value: reflect.ValueOf(http.StatusUpgradeRequired)},
+			"StatusUseProxy":                      {Doc: "", Value: reflect.ValueOf(http.StatusUseProxy)},
+			"StatusVariantAlsoNegotiates":         {Doc: "", Value: reflect.ValueOf(http.StatusVariantAlsoNegotiates)},
+			"TimeFormat":                          {Doc: "", Value: reflect.ValueOf(http.TimeFormat)},
+			"TrailerPrefix":                       {Doc: "", Value: reflect.ValueOf(http.TrailerPrefix)},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.net.url
+  "Package url parses URLs and implements query escaping."
+  (:refer-clojure :exclude [join-path parse parse-query parse-request-uri path-escape path-unescape query-escape query-unescape user user-password]))
+
+(defn join-path
+  "JoinPath returns a [URL] string with the provided path elements joined to
+the existing path of base and the resulting path cleaned of any ./ or ../ elements.
+Path elements must already be in escaped form, as produced by [PathEscape]."
+  {:arglists '([base & elem]) :go "net/url.JoinPath"}
+  [base & elem]
+  (lace.go.net.url/JoinPath base (lace.core/vec elem)))
+
+(defn parse
+  "Parse parses a raw url into a [URL] structure.
+
+The url may be relative (a path, without a host) or absolute
+(starting with a scheme). Trying to parse a hostname and path
+without a scheme is invalid but may not necessarily return an
+error, due to parsing ambiguities."
+  {:arglists '([raw-url]) :go "net/url.Parse"}
+  [raw-url]
+  (lace.go.net.url/Parse raw-url))
+
+(defn parse-query
+  "ParseQuery parses the URL-encoded query string and returns
+a map listing the values specified for each key.
+ParseQuery always returns a non-nil map containing all the
+valid query parameters found; err describes the first decoding error
+encountered, if any.
+
+Query is expected to be a list of key=value settings separated by ampersands.
+A setting without an equals sign is interpreted as a key set to an empty
+value.
+Settings containing a non-URL-encoded semicolon are considered invalid."
+  {:arglists '([query]) :go "net/url.ParseQuery"}
+  [query]
+  (lace.go.net.url/ParseQuery query))
+
+(defn parse-request-uri
+  "ParseRequestURI parses a raw url into a [URL] structure. It assumes that
+url was received in an HTTP request, so the url is interpreted
+only as an absolute URI or an absolute path.
+The string url is assumed not to have a #fragment suffix.
+(Web browsers strip #fragment before sending the URL to a web server.)"
+  {:arglists '([raw-url]) :go "net/url.ParseRequestURI"}
+  [raw-url]
+  (lace.go.net.url/ParseRequestURI raw-url))
+
+(defn path-escape
+  "PathEscape escapes the string so it can be safely placed inside a [URL] path segment,
+replacing special characters (including /) with %XX sequences as needed."
+  {:arglists '([s]) :go "net/url.PathEscape"}
+  [s]
+  (lace.go.net.url/PathEscape s))
+
+(defn path-unescape
+  "PathUnescape does the inverse transformation of [PathEscape],
+converting each 3-byte encoded substring of the form \"%AB\" into the
+hex-decoded byte 0xAB. It returns an error if any % is not followed
+by two hexadecimal digits.
+
+PathUnescape is identical to [QueryUnescape] except that it does not
+unescape '+' to ' ' (space)."
+  {:arglists '([s]) :go "net/url.PathUnescape"}
+  [s]
+  (lace.go.net.url/PathUnescape s))
+
+(defn query-escape
+  "QueryEscape escapes the string so it can be safely placed
+inside a [URL] query."
+  {:arglists '([s]) :go "net/url.QueryEscape"}
+  [s]
+  (lace.go.net.url/QueryEscape s))
+
+(defn query-unescape
+  "QueryUnescape does the inverse transformation of [QueryEscape],
+converting each 3-byte encoded substring of the form \"%AB\" into the
+hex-decoded byte 0xAB.
+It returns an error if any % is not followed by two hexadecimal
+digits."
+  {:arglists '([s]) :go "net/url.QueryUnescape"}
+  [s]
+  (lace.go.net.url/QueryUnescape s))
+
+(defn user
+  "User returns a [Userinfo] containing the provided username
+and no password set."
+  {:arglists '([username]) :go "net/url.User"}
+  [username]
+  (lace.go.net.url/User username))
+
+(defn user-password
+  "UserPassword returns a [Userinfo] containing the provided username
+and password.
+
+This functionality should only be used with legacy web sites.
+RFC 2396 warns that interpreting Userinfo this way
+“is NOT RECOMMENDED, because the passing of authentication
+information in clear text (such as URI) has proven to be a
+security risk in almost every case where it has been used.”"
+  {:arglists '([username password]) :go "net/url.UserPassword"}
+  [username password]
+  (lace.go.net.url/UserPassword username password))
@@ -0,0 +1,91 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	url "net/url"
+)
+
+//go:embed net_url.clj
+var wrapper_net_url string
+
+func init() {
+	Error_methods := map[string]pkgreflect.Func{}
+	EscapeError_methods := map[string]pkgreflect.Func{}
+	InvalidHostError_methods := map[string]pkgreflect.Func{}
+	URL_methods := map[string]pkgreflect.Func{}
+	Userinfo_methods := map[string]pkgreflect.Func{}
+	Values_methods := map[string]pkgreflect.Func{}
+	Error_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	Error_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Error_methods["Timeout"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: ""}
+	Error_methods["Temporary"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: ""}
+	EscapeError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	InvalidHostError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Userinfo_methods["Username"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Username returns the username."}
+	Userinfo_methods["Password"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Password returns the password in case it is set, and whether it is set."}
+	Userinfo_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns the encoded userinfo information in the standard form\nof \"username[:password]\"."}
+	URL_methods["EscapedPath"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "EscapedPath returns the escaped form of u.Path.\nIn general there are multiple possible escaped forms of any path.\nEscapedPath returns u.RawPath when it is a valid escaping of u.Path.\nOtherwise EscapedPath ignores u.RawPath and computes an escaped\nform on its own.\nThe [URL.String] and [URL.RequestURI] methods use EscapedPath to construct\ntheir results.\nIn general, code should call EscapedPath instead of\nreading u.RawPath directly."}
+	URL_methods["EscapedFragment"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "EscapedFragment returns the escaped form of u.Fragment.\nIn general there are multiple possible escaped forms of any fragment.\nEscapedFragment returns u.RawFragment when it is a valid escaping of u.Fragment.\nOtherwise EscapedFragment ignores u.RawFragment and computes an escaped\nform on its own.\nThe [URL.String] method uses EscapedFragment to construct its result.\nIn general, code should call EscapedFragment instead of\nreading u.RawFragment directly."}
+	URL_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String reassembles the [URL] into a valid URL string.\nThe general form of the result is one of:\n\n\tscheme:opaque?query#fragment\n\tscheme://userinfo@host/path?query#fragment\n\nIf u.Opaque is non-empty, String uses the first form;\notherwise it uses the second form.\nAny non-ASCII characters in host are escaped.\nTo obtain the path, String uses u.EscapedPath().\n\nIn the second form, the following rules apply:\n  - if u.Scheme is empty, scheme: is omitted.\n  - if u.User is nil, userinfo@ is omitted.\n  - if u.Host is empty, host/ is omitted.\n  - if u.Scheme and u.Host are empty and u.User is nil,\n    the entire scheme://userinfo@host/ is omitted.\n  - if u.Host is non-empty and u.Path begins with a /,\n    the form host/path does not add its own /.\n  - if u.RawQuery is empty, ?query is omitted.\n  - if u.Fragment is empty, #fragment is omitted."}
+	URL_methods["Redacted"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Redacted is like [URL.String] but replaces any password with \"xxxxx\".\nOnly the password in u.User is redacted."}
+	Values_methods["Get"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "string", Doc: "Get gets the first value associated with the given key.\nIf there are no values associated with the key, Get returns\nthe empty string. To access multiple values, use the map\ndirectly."}
+	Values_methods["Set"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}, {Name: "value", Tag: "string"}}, Tag: "any", Doc: "Set sets the key to value. It replaces any existing\nvalues."}
+	Values_methods["Add"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}, {Name: "value", Tag: "string"}}, Tag: "any", Doc: "Add adds the value to key. It appends to any existing\nvalues associated with key."}
+	Values_methods["Del"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "any", Doc: "Del deletes the values associated with key."}
+	Values_methods["Has"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "string"}}, Tag: "bool", Doc: "Has checks whether a given key is set."}
+	Values_methods["Encode"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Encode encodes the values into “URL encoded” form\n(\"bar=baz&foo=quux\") sorted by key."}
+	URL_methods["IsAbs"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "IsAbs reports whether the [URL] is absolute.\nAbsolute means that it has a non-empty scheme."}
+	URL_methods["Parse"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ref", Tag: "string"}}, Tag: "any", Doc: "Parse parses a [URL] in the context of the receiver. The provided URL\nmay be relative or absolute. Parse returns nil, err on parse\nfailure, otherwise its return value is the same as [URL.ResolveReference]."}
+	URL_methods["ResolveReference"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "ref", Tag: "URL"}}, Tag: "URL", Doc: "ResolveReference resolves a URI reference to an absolute URI from\nan absolute base URI u, per RFC 3986 Section 5.2. The URI reference\nmay be relative or absolute. ResolveReference always returns a new\n[URL] instance, even if the returned URL is identical to either the\nbase or reference. If ref is an absolute URL, then ResolveReference\nignores base and returns a copy of ref."}
+	URL_methods["Query"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Values", Doc: "Query parses RawQuery and returns the corresponding values.\nIt silently discards malformed value pairs.\nTo check errors use [ParseQuery]."}
+	URL_methods["RequestURI"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "RequestURI returns the encoded path?query or opaque?query\nstring that would be used in an HTTP request for u."}
+	URL_methods["Hostname"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Hostname returns u.Host, stripping any valid port number if present.\n\nIf the result is enclosed in square brackets, as literal IPv6 addresses are,\nthe square brackets are removed from the result."}
+	URL_methods["Port"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "Port returns the port part of u.Host, without the leading colon.\n\nIf u.Host doesn't contain a valid numeric port, Port returns an empty string."}
+	URL_methods["MarshalBinary"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: ""}
+	URL_methods["UnmarshalBinary"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "text", Tag: "[]byte"}}, Tag: "error", Doc: ""}
+	URL_methods["JoinPath"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "elem", Tag: "Unknown"}}, Tag: "URL", Doc: "JoinPath returns a new [URL] with the provided path elements joined to\nany existing path and the resulting path cleaned of any ./ or ../ elements.\nAny sequences of multiple / characters will be reduced to a single /.\nPath elements must already be in escaped form, as produced by [PathEscape]."}
+	pkgreflect.AddPackage("lace.go.net.url", &pkgreflect.Package{
+		Doc:       "Package url parses URLs and implements query escaping.",
+		WrapperNS: "go.net.url",
+		Wrapper:   wrapper_net_url,
+		Types: map[string]pkgreflect.Type{
+			"Error":            {Doc: "Error reports an error and the operation and URL that caused it.", Value: reflect.TypeOf((*url.Error)(nil)).Elem(), Methods: Error_methods},
+			"EscapeError":      {Doc: "", Value: reflect.TypeOf((*url.EscapeError)(nil)).Elem(), Methods: EscapeError_methods},
+			"InvalidHostError": {Doc: "", Value: reflect.TypeOf((*url.InvalidHostError)(nil)).Elem(), Methods: InvalidHostError_methods},
+			"URL":              {Doc: "A URL represents a parsed URL (technically, a URI reference).\n\nThe general form represented is:\n\n\t[scheme:][//[userinfo@]host][/]path[?query][#fragment]\n\nURLs that do not start with a slash after the scheme are interpreted as:\n\n\tscheme:opaque[?query][#fragment]\n\nThe Host field contains the host and port subcomponents of the URL.\nWhen the port is present, it is separated from the host with a colon.\nWhen the host is an IPv6 address, it must be enclosed in square brackets:\n\"[fe80::1]:80\". The [net.JoinHostPort] function combines a host and port\ninto a string suitable for the Host field, adding square brackets to\nthe host when necessary.\n\nNote that the Path field is stored in decoded form: /%47%6f%2f becomes /Go/.\nA consequence is that it is impossible to tell which slashes in the Path were\nslashes in the raw URL and which were %2f. This distinction is rarely important,\nbut when it is, the code should use the [URL.EscapedPath] method, which preserves\nthe original encoding of Path. The Fragment field is also stored in decoded form,\nuse [URL.EscapedFragment] to retrieve the original encoding.\n\nThe [URL.String] method uses the [URL.EscapedPath] method to obtain the path.", Value: reflect.TypeOf((*url.URL)(nil)).Elem(), Methods: URL_methods},
+			"Userinfo":         {Doc: "The Userinfo type is an immutable encapsulation of username and\npassword details for a [URL]. An existing Userinfo value is guaranteed\nto have a username set (potentially empty, as allowed by RFC 2396),\nand optionally a password.", Value: reflect.TypeOf((*url.Userinfo)(nil)).Elem(), Methods: Userinfo_methods},
+			"Values":           {Doc: "Values maps a string key to a list of values.\nIt is typically used for query parameters and form values.\nUnlike in the http.Header map, the keys in a Values map\nare case-sensitive.", Value: reflect.TypeOf((*url.Values)(nil)).Elem(), Methods: Values_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"JoinPath": {Doc: "JoinPath returns a [URL] string with the provided path elements joined to\nthe existing path of base and the resulting path cleaned of any ./ or ../ elements.\nPath elements must already be in escaped form, as produced by [PathEscape].", Args: []pkgreflect.Arg{{Name: "base", Tag: "string"}, {Name: "elem", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(url.JoinPath)},
+
+			"Parse": {Doc: "Parse parses a raw url into a [URL] structure.\n\nThe url may be relative (a path, without a host) or absolute\n(starting with a scheme). Trying to parse a hostname and path\nwithout a scheme is invalid but may not necessarily return an\nerror, due to parsing ambiguities.", Args: []pkgreflect.Arg{{Name: "rawURL", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(url.Parse)},
+
+			"ParseQuery": {Doc: "ParseQuery parses the URL-encoded query string and returns\na map listing the values specified for each key.\nParseQuery always returns a non-nil map containing all the\nvalid query parameters found; err describes the first decoding error\nencountered, if any.\n\nQuery is expected to be a list of key=value settings separated by ampersands.\nA setting without an equals sign is interpreted as a key set to an empty\nvalue.\nSettings containing a non-URL-encoded semicolon are considered invalid.", Args: []pkgreflect.Arg{{Name: "query", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(url.ParseQuery)},
+
+			"ParseRequestURI": {Doc: "ParseRequestURI parses a raw url into a [URL] structure. It assumes that\nurl was received in an HTTP request, so the url is interpreted\nonly as an absolute URI or an absolute path.\nThe string url is assumed not to have a #fragment suffix.\n(Web browsers strip #fragment before sending the URL to a web server.)", Args: []pkgreflect.Arg{{Name: "rawURL", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(url.ParseRequestURI)},
+
+			"PathEscape": {Doc: "PathEscape escapes the string so it can be safely placed inside a [URL] path segment,\nreplacing special characters (including /) with %XX sequences as needed.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(url.PathEscape)},
+
+			"PathUnescape": {Doc: "PathUnescape does the inverse transformation of [PathEscape],\nconverting each 3-byte encoded substring of the form \"%AB\" into the\nhex-decoded byte 0xAB. It returns an error if any % is not followed\nby two hexadecimal digits.\n\nPathUnescape is identical to [QueryUnescape] except that it does not\nunescape '+' to ' ' (space).", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(url.PathUnescape)},
+
+			"QueryEscape": {Doc: "QueryEscape escapes the string so it can be safely placed\ninside a [URL] query.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(url.QueryEscape)},
+
+			"QueryUnescape": {Doc: "QueryUnescape does the inverse transformation of [QueryEscape],\nconverting each 3-byte encoded substring of the form \"%AB\" into the\nhex-decoded byte 0xAB.\nIt returns an error if any % is not followed by two hexadecimal\ndigits.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(url.QueryUnescape)},
+
+			"User": {Doc: "User returns a [Userinfo] containing the provided username\nand no password set.", Args: []pkgreflect.Arg{{Name: "username", Tag: "string"}}, Tag: "Userinfo", Value: reflect.ValueOf(url.User)},
+
+			"UserPassword": {Doc: "UserPassword returns a [Userinfo] containing the provided username\nand password.\n\nThis functionality should only be used with legacy web sites.\nRFC 2396 warns that interpreting Userinfo this way\n“is NOT RECOMMENDED, because the passing of authentication\ninformation in clear text (such as URI) has proven to be a\nsecurity risk in almost every case where it has been used.”", Args: []pkgreflect.Arg{{Name: "username", Tag: "string"}, {Name: "password", Tag: "string"}}, Tag: "Userinfo", Value: reflect.ValueOf(url.UserPassword)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
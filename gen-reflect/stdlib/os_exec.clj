@@ -0,0 +1,72 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.os.exec
+  "Package exec runs external commands."
+  (:refer-clojure :exclude [command command-context look-path]))
+
+(defn command
+  "Command returns the [Cmd] struct to execute the named program with
+the given arguments.
+
+It sets only the Path and Args in the returned structure.
+
+If name contains no path separators, Command uses [LookPath] to
+resolve name to a complete path if possible. Otherwise it uses name
+directly as Path.
+
+The returned Cmd's Args field is constructed from the command name
+followed by the elements of arg, so arg should not include the
+command name itself. For example, Command(\"echo\", \"hello\").
+Args[0] is always name, not the possibly resolved Path.
+
+On Windows, processes receive the whole command line as a single string
+and do their own parsing. Command combines and quotes Args into a command
+line string with an algorithm compatible with applications using
+CommandLineToArgvW (which is the most common way). Notable exceptions are
+msiexec.exe and cmd.exe (and thus, all batch files), which have a different
+unquoting algorithm. In these or other similar cases, you can do the
+quoting yourself and provide the full command line in SysProcAttr.CmdLine,
+leaving Args empty."
+  {:arglists '([name & arg]) :go "os/exec.Command"}
+  [name & arg]
+  (lace.go.os.exec/Command name (lace.core/vec arg)))
+
+(defn command-context
+  "CommandContext is like [Command] but includes a context.
+
+The provided context is used to interrupt the process
+(by calling cmd.Cancel or [os.Process.Kill])
+if the context becomes done before the command completes on its own.
+
+CommandContext sets the command's Cancel function to invoke the Kill method
+on its Process, and leaves its WaitDelay unset. The caller may change the
+cancellation behavior by modifying those fields before starting the command."
+  {:arglists '([ctx name & arg]) :go "os/exec.CommandContext"}
+  [ctx name & arg]
+  (lace.go.os.exec/CommandContext ctx name (lace.core/vec arg)))
+
+(defn look-path
+  "LookPath searches for an executable named file in the current path,
+following the conventions of the host operating system.
+If file contains a slash, it is tried directly and the default path is not consulted.
+Otherwise, on success the result is an absolute path.
+
+LookPath returns an error satisfying [errors.Is](err, [ErrDot])
+if the resolved path is relative to the current directory.
+See the package documentation for more details.
+
+LookPath looks for an executable named file in the
+directories named by the PATH environment variable,
+except as described below.
+
+  - On Windows, the file must have an extension named by
+    the PATHEXT environment variable.
+    When PATHEXT is unset, the file must have
+    a \".com\", \".exe\", \".bat\", or \".cmd\" extension.
+  - On Plan 9, LookPath consults the path environment variable.
+    If file begins with \"/\", \"#\", \"./\", or \"../\", it is tried
+    directly and the path is not consulted.
+  - On Wasm, LookPath always returns an error."
+  {:arglists '([file]) :go "os/exec.LookPath"}
+  [file]
+  (lace.go.os.exec/LookPath file))
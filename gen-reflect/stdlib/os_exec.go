@@ -0,0 +1,59 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	exec "os/exec"
+)
+
+//go:embed os_exec.clj
+var wrapper_os_exec string
+
+func init() {
+	Cmd_methods := map[string]pkgreflect.Func{}
+	Error_methods := map[string]pkgreflect.Func{}
+	ExitError_methods := map[string]pkgreflect.Func{}
+	Error_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Error_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	Cmd_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns a human-readable description of c.\nIt is intended only for debugging.\nIn particular, it is not suitable for use as input to a shell.\nThe output of String may vary across Go releases."}
+	Cmd_methods["Run"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Run starts the specified command and waits for it to complete.\n\nThe returned error is nil if the command runs, has no problems\ncopying stdin, stdout, and stderr, and exits with a zero exit\nstatus.\n\nIf the command starts but does not complete successfully, the error is of\ntype [*ExitError]. Other error types may be returned for other situations.\n\nIf the calling goroutine has locked the operating system thread\nwith [runtime.LockOSThread] and modified any inheritable OS-level\nthread state (for example, Linux or Plan 9 name spaces), the new\nprocess will inherit the caller's thread state."}
+	Cmd_methods["Start"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Start starts the specified command but does not wait for it to complete.\n\nIf Start returns successfully, the c.Process field will be set.\n\nAfter a successful call to Start the [Cmd.Wait] method must be called in\norder to release associated system resources."}
+	ExitError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	Cmd_methods["Wait"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "Wait waits for the command to exit and waits for any copying to\nstdin or copying from stdout or stderr to complete.\n\nThe command must have been started by [Cmd.Start].\n\nThe returned error is nil if the command runs, has no problems\ncopying stdin, stdout, and stderr, and exits with a zero exit\nstatus.\n\nIf the command fails to run or doesn't complete successfully, the\nerror is of type [*ExitError]. Other error types may be\nreturned for I/O problems.\n\nIf any of c.Stdin, c.Stdout or c.Stderr are not an [*os.File], Wait also waits\nfor the respective I/O loop copying to or from the process to complete.\n\nWait must not be called concurrently from multiple goroutines.\nA custom Cmd.Cancel function should not call Wait.\n\nWait releases any resources associated with the [Cmd]."}
+	Cmd_methods["Output"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Output runs the command and returns its standard output.\nAny returned error will usually be of type [*ExitError].\nIf c.Stderr was nil and the returned error is of type\n[*ExitError], Output populates the Stderr field of the\nreturned error."}
+	Cmd_methods["CombinedOutput"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "CombinedOutput runs the command and returns its combined standard\noutput and standard error."}
+	Cmd_methods["StdinPipe"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "StdinPipe returns a pipe that will be connected to the command's\nstandard input when the command starts.\nThe pipe will be closed automatically after [Cmd.Wait] sees the command exit.\nA caller need only call Close to force the pipe to close sooner.\nFor example, if the command being run will not exit until standard input\nis closed, the caller must close the pipe."}
+	Cmd_methods["StdoutPipe"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "StdoutPipe returns a pipe that will be connected to the command's\nstandard output when the command starts.\n\n[Cmd.Wait] will close the pipe after seeing the command exit, so most callers\nneed not close the pipe themselves. It is thus incorrect to call Wait\nbefore all reads from the pipe have completed.\nFor the same reason, it is incorrect to call [Cmd.Run] when using StdoutPipe.\nSee the example for idiomatic usage."}
+	Cmd_methods["StderrPipe"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "StderrPipe returns a pipe that will be connected to the command's\nstandard error when the command starts.\n\n[Cmd.Wait] will close the pipe after seeing the command exit, so most callers\nneed not close the pipe themselves. It is thus incorrect to call Wait\nbefore all reads from the pipe have completed.\nFor the same reason, it is incorrect to use [Cmd.Run] when using StderrPipe.\nSee the StdoutPipe example for idiomatic usage."}
+	Cmd_methods["Environ"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]string", Doc: "Environ returns a copy of the environment in which the command would be run\nas it is currently configured."}
+	pkgreflect.AddPackage("lace.go.os.exec", &pkgreflect.Package{
+		Doc:       "Package exec runs external commands.",
+		WrapperNS: "go.os.exec",
+		Wrapper:   wrapper_os_exec,
+		Types: map[string]pkgreflect.Type{
+			"Cmd":       {Doc: "Cmd represents an external command being prepared or run.\n\nA Cmd cannot be reused after calling its [Cmd.Start], [Cmd.Run],\n[Cmd.Output], or [Cmd.CombinedOutput] methods.", Value: reflect.TypeOf((*exec.Cmd)(nil)).Elem(), Methods: Cmd_methods},
+			"Error":     {Doc: "Error is returned by [LookPath] when it fails to classify a file as an\nexecutable.", Value: reflect.TypeOf((*exec.Error)(nil)).Elem(), Methods: Error_methods},
+			"ExitError": {Doc: "An ExitError reports an unsuccessful exit by a command.", Value: reflect.TypeOf((*exec.ExitError)(nil)).Elem(), Methods: ExitError_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Command": {Doc: "Command returns the [Cmd] struct to execute the named program with\nthe given arguments.\n\nIt sets only the Path and Args in the returned structure.\n\nIf name contains no path separators, Command uses [LookPath] to\nresolve name to a complete path if possible. Otherwise it uses name\ndirectly as Path.\n\nThe returned Cmd's Args field is constructed from the command name\nfollowed by the elements of arg, so arg should not include the\ncommand name itself. For example, Command(\"echo\", \"hello\").\nArgs[0] is always name, not the possibly resolved Path.\n\nOn Windows, processes receive the whole command line as a single string\nand do their own parsing. Command combines and quotes Args into a command\nline string with an algorithm compatible with applications using\nCommandLineToArgvW (which is the most common way). Notable exceptions are\nmsiexec.exe and cmd.exe (and thus, all batch files), which have a different\nunquoting algorithm. In these or other similar cases, you can do the\nquoting yourself and provide the full command line in SysProcAttr.CmdLine,\nleaving Args empty.", Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}, {Name: "arg", Tag: "Unknown"}}, Tag: "Cmd", Value: reflect.ValueOf(exec.Command)},
+
+			"CommandContext": {Doc: "CommandContext is like [Command] but includes a context.\n\nThe provided context is used to interrupt the process\n(by calling cmd.Cancel or [os.Process.Kill])\nif the context becomes done before the command completes on its own.\n\nCommandContext sets the command's Cancel function to invoke the Kill method\non its Process, and leaves its WaitDelay unset. The caller may change the\ncancellation behavior by modifying those fields before starting the command.", Args: []pkgreflect.Arg{{Name: "ctx", Tag: "context.Context"}, {Name: "name", Tag: "string"}, {Name: "arg", Tag: "Unknown"}}, Tag: "Cmd", Value: reflect.ValueOf(exec.CommandContext)},
+
+			"LookPath": {Doc: "LookPath searches for an executable named file in the current path,\nfollowing the conventions of the host operating system.\nIf file contains a slash, it is tried directly and the default path is not consulted.\nOtherwise, on success the result is an absolute path.\n\nLookPath returns an error satisfying [errors.Is](err, [ErrDot])\nif the resolved path is relative to the current directory.\nSee the package documentation for more details.\n\nLookPath looks for an executable named file in the\ndirectories named by the PATH environment variable,\nexcept as described below.\n\n  - On Windows, the file must have an extension named by\n    the PATHEXT environment variable.\n    When PATHEXT is unset, the file must have\n    a \".com\", \".exe\", \".bat\", or \".cmd\" extension.\n  - On Plan 9, LookPath consults the path environment variable.\n    If file begins with \"/\", \"#\", \"./\", or \"../\", it is tried\n    directly and the path is not consulted.\n  - On Wasm, LookPath always returns an error.", Args: []pkgreflect.Arg{{Name: "file", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(exec.LookPath)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrDot":       {Doc: "", Value: reflect.ValueOf(&exec.ErrDot)},
+			"ErrNotFound":  {Doc: "", Value: reflect.ValueOf(&exec.ErrNotFound)},
+			"ErrWaitDelay": {Doc: "", Value: reflect.ValueOf(&exec.ErrWaitDelay)},
+		},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
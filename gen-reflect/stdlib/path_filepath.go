@@ -0,0 +1,79 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	filepath "path/filepath"
+)
+
+//go:embed path_filepath.clj
+var wrapper_path_filepath string
+
+func init() {
+	WalkFunc_methods := map[string]pkgreflect.Func{}
+	pkgreflect.AddPackage("lace.go.path.filepath", &pkgreflect.Package{
+		Doc:       "Package filepath implements utility routines for manipulating filename paths in a way compatible with the target operating system-defined file paths.",
+		WrapperNS: "go.path.filepath",
+		Wrapper:   wrapper_path_filepath,
+		Types: map[string]pkgreflect.Type{
+			"WalkFunc": {Doc: "WalkFunc is the type of the function called by [Walk] to visit each\nfile or directory.\n\nThe path argument contains the argument to Walk as a prefix.\nThat is, if Walk is called with root argument \"dir\" and finds a file\nnamed \"a\" in that directory, the walk function will be called with\nargument \"dir/a\".\n\nThe directory and file are joined with Join, which may clean the\ndirectory name: if Walk is called with the root argument \"x/../dir\"\nand finds a file named \"a\" in that directory, the walk function will\nbe called with argument \"dir/a\", not \"x/../dir/a\".\n\nThe info argument is the fs.FileInfo for the named path.\n\nThe error result returned by the function controls how Walk continues.\nIf the function returns the special value [SkipDir], Walk skips the\ncurrent directory (path if info.IsDir() is true, otherwise path's\nparent directory). If the function returns the special value [SkipAll],\nWalk skips all remaining files and directories. Otherwise, if the function\nreturns a non-nil error, Walk stops entirely and returns that error.\n\nThe err argument reports an error related to path, signaling that Walk\nwill not walk into that directory. The function can decide how to\nhandle that error; as described earlier, returning the error will\ncause Walk to stop walking the entire tree.\n\nWalk calls the function with a non-nil err argument in two cases.\n\nFirst, if an [os.Lstat] on the root directory or any directory or file\nin the tree fails, Walk calls the function with path set to that\ndirectory or file's path, info set to nil, and err set to the error\nfrom os.Lstat.\n\nSecond, if a directory's Readdirnames method fails, Walk calls the\nfunction with path set to the directory's path, info, set to an\n[fs.FileInfo] describing the directory, and err set to the error from\nReaddirnames.", Value: reflect.TypeOf((*filepath.WalkFunc)(nil)).Elem(), Methods: WalkFunc_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Abs": {Doc: "Abs returns an absolute representation of path.\nIf the path is not absolute it will be joined with the current\nworking directory to turn it into an absolute path. The absolute\npath name for a given file is not guaranteed to be unique.\nAbs calls [Clean] on the result.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(filepath.Abs)},
+
+			"Base": {Doc: "Base returns the last element of path.\nTrailing path separators are removed before extracting the last element.\nIf the path is empty, Base returns \".\".\nIf the path consists entirely of separators, Base returns a single separator.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.Base)},
+
+			"Clean": {Doc: "Clean returns the shortest path name equivalent to path\nby purely lexical processing. It applies the following rules\niteratively until no further processing can be done:\n\n 1. Replace multiple [Separator] elements with a single one.\n 2. Eliminate each . path name element (the current directory).\n 3. Eliminate each inner .. path name element (the parent directory)\n    along with the non-.. element that precedes it.\n 4. Eliminate .. elements that begin a rooted path:\n    that is, replace \"/..\" by \"/\" at the beginning of a path,\n    assuming Separator is '/'.\n\nThe returned path ends in a slash only if it represents a root directory,\nsuch as \"/\" on Unix or `C:\\` on Windows.\n\nFinally, any occurrences of slash are replaced by Separator.\n\nIf the result of this process is an empty string, Clean\nreturns the string \".\".\n\nOn Windows, Clean does not modify the volume name other than to replace\noccurrences of \"/\" with `\\`.\nFor example, Clean(\"//host/share/../x\") returns `\\\\host\\share\\x`.\n\nSee also Rob Pike, “Lexical File Names in Plan 9 or\nGetting Dot-Dot Right,”\nhttps://9p.io/sys/doc/lexnames.html", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.Clean)},
+
+			"Dir": {Doc: "Dir returns all but the last element of path, typically the path's directory.\nAfter dropping the final element, Dir calls [Clean] on the path and trailing\nslashes are removed.\nIf the path is empty, Dir returns \".\".\nIf the path consists entirely of separators, Dir returns a single separator.\nThe returned path does not end in a separator unless it is the root directory.\n\nOn Windows, given a volume-only name such as \"C:\", Dir returns \"C:.\",\nthe current directory on drive C. To obtain the drive's root \"C:\\\",\nuse [VolumeName] combined with a separator.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.Dir)},
+
+			"EvalSymlinks": {Doc: "EvalSymlinks returns the path name after the evaluation of any symbolic\nlinks.\nIf path is relative the result will be relative to the current directory,\nunless one of the components is an absolute symbolic link.\nEvalSymlinks calls [Clean] on the result.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(filepath.EvalSymlinks)},
+
+			"Ext": {Doc: "Ext returns the file name extension used by path.\nThe extension is the suffix beginning at the final dot\nin the final element of path; it is empty if there is\nno dot.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.Ext)},
+
+			"FromSlash": {Doc: "FromSlash returns the result of replacing each slash ('/') character\nin path with a separator character. Multiple slashes are replaced\nby multiple separators.\n\nSee also the Localize function, which converts a slash-separated path\nas used by the io/fs package to an operating system path.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.FromSlash)},
+
+			"Glob": {Doc: "Glob returns the names of all files matching pattern or nil\nif there is no matching file. The syntax of patterns is the same\nas in [Match]. The pattern may describe hierarchical names such as\n/usr/*/bin/ed (assuming the [Separator] is '/').\n\nGlob ignores file system errors such as I/O errors reading directories.\nThe only possible returned error is [ErrBadPattern], when pattern\nis malformed.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(filepath.Glob)},
+
+			"HasPrefix": {Doc: "HasPrefix exists for historical compatibility and should not be used.\n\nDeprecated: HasPrefix does not respect path boundaries and\ndoes not ignore case when required.", Args: []pkgreflect.Arg{{Name: "p", Tag: "string"}, {Name: "prefix", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(filepath.HasPrefix)},
+
+			"IsAbs": {Doc: "IsAbs reports whether the path is absolute.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(filepath.IsAbs)},
+
+			"IsLocal": {Doc: "IsLocal reports whether path, using lexical analysis only, has all of these properties:\n\n  - is within the subtree rooted at the directory in which path is evaluated\n  - is not an absolute path\n  - is not empty\n  - on Windows, is not a reserved name such as \"NUL\"\n\nIf IsLocal(path) returns true, then\nJoin(base, path) will always produce a path contained within base and\nClean(path) will always produce an unrooted path with no \"..\" path elements.\n\nIsLocal is a purely lexical operation.\nIn particular, it does not account for the effect of any symbolic links\nthat may exist in the filesystem.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(filepath.IsLocal)},
+
+			"Join": {Doc: "Join joins any number of path elements into a single path,\nseparating them with an OS specific [Separator]. Empty elements\nare ignored. The result is Cleaned. However, if the argument\nlist is empty or all its elements are empty, Join returns\nan empty string.\nOn Windows, the result will only be a UNC path if the first\nnon-empty element is a UNC path.", Args: []pkgreflect.Arg{{Name: "elem", Tag: "Unknown"}}, Tag: "string", Value: reflect.ValueOf(filepath.Join)},
+
+			"Match": {Doc: "Match reports whether name matches the shell file name pattern.\nThe pattern syntax is:\n\n\tpattern:\n\t\t{ term }\n\tterm:\n\t\t'*'         matches any sequence of non-Separator characters\n\t\t'?'         matches any single non-Separator character\n\t\t'[' [ '^' ] { character-range } ']'\n\t\t            character class (must be non-empty)\n\t\tc           matches character c (c != '*', '?', '\\\\', '[')\n\t\t'\\\\' c      matches character c (except on Windows)\n\n\tcharacter-range:\n\t\tc           matches character c (c != '\\\\', '-', ']')\n\t\t'\\\\' c      matches character c (except on Windows)\n\t\tlo '-' hi   matches character c for lo <= c <= hi\n\nPath segments in the pattern must be separated by [Separator].\n\nMatch requires pattern to match all of name, not just a substring.\nThe only possible returned error is [ErrBadPattern], when pattern\nis malformed.\n\nOn Windows, escaping is disabled. Instead, '\\\\' is treated as\npath separator.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "name", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(filepath.Match)},
+
+			"Rel": {Doc: "Rel returns a relative path that is lexically equivalent to targPath when\njoined to basePath with an intervening separator. That is,\n[Join](basePath, Rel(basePath, targPath)) is equivalent to targPath itself.\n\nThe returned path will always be relative to basePath, even if basePath and\ntargPath share no elements. Rel calls [Clean] on the result.\n\nAn error is returned if targPath can't be made relative to basePath\nor if knowing the current working directory would be necessary to compute it.", Args: []pkgreflect.Arg{{Name: "basePath", Tag: "string"}, {Name: "targPath", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(filepath.Rel)},
+
+			"Split": {Doc: "Split splits path immediately following the final [Separator],\nseparating it into a directory and file name component.\nIf there is no Separator in path, Split returns an empty dir\nand file set to path.\nThe returned values have the property that path = dir+file.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.Split)},
+
+			"SplitList": {Doc: "SplitList splits a list of paths joined by the OS-specific [ListSeparator],\nusually found in PATH or GOPATH environment variables.\nUnlike strings.Split, SplitList returns an empty slice when passed an empty\nstring.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "[]string", Value: reflect.ValueOf(filepath.SplitList)},
+
+			"ToSlash": {Doc: "ToSlash returns the result of replacing each separator character\nin path with a slash ('/') character. Multiple separators are\nreplaced by multiple slashes.", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.ToSlash)},
+
+			"VolumeName": {Doc: "VolumeName returns leading volume name.\nGiven \"C:\\foo\\bar\" it returns \"C:\" on Windows.\nGiven \"\\\\host\\share\\foo\" it returns \"\\\\host\\share\".\nOn other platforms it returns \"\".", Args: []pkgreflect.Arg{{Name: "path", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(filepath.VolumeName)},
+
+			"Walk": {Doc: "Walk walks the file tree rooted at root, calling fn for each file or\ndirectory in the tree, including root.\n\nAll errors that arise visiting files and directories are filtered by fn:\nsee the [WalkFunc] documentation for details.\n\nThe files are walked in lexical order, which makes the output deterministic\nbut requires Walk to read an entire directory into memory before proceeding\nto walk that directory.\n\nWalk does not follow symbolic links.\n\nWalk is less efficient than [WalkDir], introduced in Go 1.16,\nwhich avoids calling os.Lstat on every visited file or directory.", Args: []pkgreflect.Arg{{Name: "root", Tag: "string"}, {Name: "fn", Tag: "WalkFunc"}}, Tag: "error", Value: reflect.ValueOf(filepath.Walk)},
+
+			"WalkDir": {Doc: "WalkDir walks the file tree rooted at root, calling fn for each file or\ndirectory in the tree, including root.\n\nAll errors that arise visiting files and directories are filtered by fn:\nsee the [fs.WalkDirFunc] documentation for details.\n\nThe files are walked in lexical order, which makes the output deterministic\nbut requires WalkDir to read an entire directory into memory before proceeding\nto walk that directory.\n\nWalkDir does not follow symbolic links.\n\nWalkDir calls fn with paths that use the separator character appropriate\nfor the operating system. This is unlike [io/fs.WalkDir], which always\nuses slash separated paths.", Args: []pkgreflect.Arg{{Name: "root", Tag: "string"}, {Name: "fn", Tag: "fs.WalkDirFunc"}}, Tag: "error", Value: reflect.ValueOf(filepath.WalkDir)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrBadPattern": {Doc: "", Value: reflect.ValueOf(&filepath.ErrBadPattern)},
+			"SkipAll":       {Doc: "", Value: reflect.ValueOf(&filepath.SkipAll)},
+			"SkipDir":       {Doc: "", Value: reflect.ValueOf(&filepath.SkipDir)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"ListSeparator": {Doc: "", Value: reflect.ValueOf(filepath.ListSeparator)},
+			"Separator":     {Doc: "", Value: reflect.ValueOf(filepath.Separator)},
+		},
+	})
+}
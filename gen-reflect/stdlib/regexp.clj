@@ -0,0 +1,92 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.regexp
+  "Package regexp implements regular expression search."
+  (:refer-clojure :exclude [compile compile-posix match match-reader match-string must-compile must-compile-posix quote-meta]))
+
+(defn compile
+  "Compile parses a regular expression and returns, if successful,
+a [Regexp] object that can be used to match against text.
+
+When matching against text, the regexp returns a match that
+begins as early as possible in the input (leftmost), and among those
+it chooses the one that a backtracking search would have found first.
+This so-called leftmost-first matching is the same semantics
+that Perl, Python, and other implementations use, although this
+package implements it without the expense of backtracking.
+For POSIX leftmost-longest matching, see [CompilePOSIX]."
+  {:arglists '([expr]) :go "regexp.Compile"}
+  [expr]
+  (lace.go.regexp/Compile expr))
+
+(defn compile-posix
+  "CompilePOSIX is like [Compile] but restricts the regular expression
+to POSIX ERE (egrep) syntax and changes the match semantics to
+leftmost-longest.
+
+That is, when matching against text, the regexp returns a match that
+begins as early as possible in the input (leftmost), and among those
+it chooses a match that is as long as possible.
+This so-called leftmost-longest matching is the same semantics
+that early regular expression implementations used and that POSIX
+specifies.
+
+However, there can be multiple leftmost-longest matches, with different
+submatch choices, and here this package diverges from POSIX.
+Among the possible leftmost-longest matches, this package chooses
+the one that a backtracking search would have found first, while POSIX
+specifies that the match be chosen to maximize the length of the first
+subexpression, then the second, and so on from left to right.
+The POSIX rule is computationally prohibitive and not even well-defined.
+See https://swtch.com/~rsc/regexp/regexp2.html#posix for details."
+  {:arglists '([expr]) :go "regexp.CompilePOSIX"}
+  [expr]
+  (lace.go.regexp/CompilePOSIX expr))
+
+(defn match
+  "Match reports whether the byte slice b
+contains any match of the regular expression pattern.
+More complicated queries need to use [Compile] and the full [Regexp] interface."
+  {:arglists '([pattern b]) :go "regexp.Match"}
+  [pattern b]
+  (lace.go.regexp/Match pattern b))
+
+(defn match-reader
+  "MatchReader reports whether the text returned by the [io.RuneReader]
+contains any match of the regular expression pattern.
+More complicated queries need to use [Compile] and the full [Regexp] interface."
+  {:arglists '([pattern r]) :go "regexp.MatchReader"}
+  [pattern r]
+  (lace.go.regexp/MatchReader pattern r))
+
+(defn match-string
+  "MatchString reports whether the string s
+contains any match of the regular expression pattern.
+More complicated queries need to use [Compile] and the full [Regexp] interface."
+  {:arglists '([pattern s]) :go "regexp.MatchString"}
+  [pattern s]
+  (lace.go.regexp/MatchString pattern s))
+
+(defn must-compile
+  "MustCompile is like [Compile] but panics if the expression cannot be parsed.
+It simplifies safe initialization of global variables holding compiled regular
+expressions."
+  {:arglists '([str]) :go "regexp.MustCompile"}
+  [str]
+  (lace.go.regexp/MustCompile str))
+
+(defn must-compile-posix
+  "MustCompilePOSIX is like [CompilePOSIX] but panics if the expression cannot be parsed.
+It simplifies safe initialization of global variables holding compiled regular
+expressions."
+  {:arglists '([str]) :go "regexp.MustCompilePOSIX"}
+  [str]
+  (lace.go.regexp/MustCompilePOSIX str))
+
+(defn quote-meta
+  "QuoteMeta returns a string that escapes all regular expression metacharacters
+inside the argument text; the returned string is a regular expression matching
+the literal text."
+  {:arglists '([s]) :go "regexp.QuoteMeta"}
+  [s]
+  (lace.go.regexp/QuoteMeta s))
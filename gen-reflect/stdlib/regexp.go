@@ -0,0 +1,87 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	regexp "regexp"
+)
+
+//go:embed regexp.clj
+var wrapper_regexp string
+
+func init() {
+	Regexp_methods := map[string]pkgreflect.Func{}
+	Regexp_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns the source text used to compile the regular expression."}
+	Regexp_methods["Copy"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Regexp", Doc: "Copy returns a new [Regexp] object copied from re.\nCalling [Regexp.Longest] on one copy does not affect another.\n\nDeprecated: In earlier releases, when using a [Regexp] in multiple goroutines,\ngiving each goroutine its own copy helped to avoid lock contention.\nAs of Go 1.12, using Copy is no longer necessary to avoid lock contention.\nCopy may still be appropriate if the reason for its use is to make\ntwo copies with different [Regexp.Longest] settings."}
+	Regexp_methods["Longest"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Longest makes future searches prefer the leftmost-longest match.\nThat is, when matching against text, the regexp returns a match that\nbegins as early as possible in the input (leftmost), and among those\nit chooses a match that is as long as possible.\nThis method modifies the [Regexp] and may not be called concurrently\nwith any other methods."}
+	Regexp_methods["NumSubexp"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "NumSubexp returns the number of parenthesized subexpressions in this [Regexp]."}
+	Regexp_methods["SubexpNames"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "[]string", Doc: "SubexpNames returns the names of the parenthesized subexpressions\nin this [Regexp]. The name for the first sub-expression is names[1],\nso that if m is a match slice, the name for m[i] is SubexpNames()[i].\nSince the Regexp as a whole cannot be named, names[0] is always\nthe empty string. The slice should not be modified."}
+	Regexp_methods["SubexpIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "name", Tag: "string"}}, Tag: "int", Doc: "SubexpIndex returns the index of the first subexpression with the given name,\nor -1 if there is no subexpression with that name.\n\nNote that multiple subexpressions can be written using the same name, as in\n(?P<bob>a+)(?P<bob>b+), which declares two subexpressions named \"bob\".\nIn this case, SubexpIndex returns the index of the leftmost such subexpression\nin the regular expression."}
+	Regexp_methods["LiteralPrefix"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "LiteralPrefix returns a literal string that must begin any match\nof the regular expression re. It returns the boolean true if the\nliteral string comprises the entire regular expression."}
+	Regexp_methods["MatchReader"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.RuneReader"}}, Tag: "bool", Doc: "MatchReader reports whether the text returned by the [io.RuneReader]\ncontains any match of the regular expression re."}
+	Regexp_methods["MatchString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "bool", Doc: "MatchString reports whether the string s\ncontains any match of the regular expression re."}
+	Regexp_methods["Match"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "bool", Doc: "Match reports whether the byte slice b\ncontains any match of the regular expression re."}
+	Regexp_methods["ReplaceAllString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "string"}, {Name: "repl", Tag: "string"}}, Tag: "string", Doc: "ReplaceAllString returns a copy of src, replacing matches of the [Regexp]\nwith the replacement string repl.\nInside repl, $ signs are interpreted as in [Regexp.Expand]."}
+	Regexp_methods["ReplaceAllLiteralString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "string"}, {Name: "repl", Tag: "string"}}, Tag: "string", Doc: "ReplaceAllLiteralString returns a copy of src, replacing matches of the [Regexp]\nwith the replacement string repl. The replacement repl is substituted directly,\nwithout using [Regexp.Expand]."}
+	Regexp_methods["ReplaceAllStringFunc"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "string"}, {Name: "repl", Tag: "Unknown"}}, Tag: "string", Doc: "ReplaceAllStringFunc returns a copy of src in which all matches of the\n[Regexp] have been replaced by the return value of function repl applied\nto the matched substring. The replacement returned by repl is substituted\ndirectly, without using [Regexp.Expand]."}
+	Regexp_methods["ReplaceAll"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "[]byte"}, {Name: "repl", Tag: "[]byte"}}, Tag: "[]byte", Doc: "ReplaceAll returns a copy of src, replacing matches of the [Regexp]\nwith the replacement text repl.\nInside repl, $ signs are interpreted as in [Regexp.Expand]."}
+	Regexp_methods["ReplaceAllLiteral"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "[]byte"}, {Name: "repl", Tag: "[]byte"}}, Tag: "[]byte", Doc: "ReplaceAllLiteral returns a copy of src, replacing matches of the [Regexp]\nwith the replacement bytes repl. The replacement repl is substituted directly,\nwithout using [Regexp.Expand]."}
+	Regexp_methods["ReplaceAllFunc"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "src", Tag: "[]byte"}, {Name: "repl", Tag: "Unknown"}}, Tag: "[]byte", Doc: "ReplaceAllFunc returns a copy of src in which all matches of the\n[Regexp] have been replaced by the return value of function repl applied\nto the matched byte slice. The replacement returned by repl is substituted\ndirectly, without using [Regexp.Expand]."}
+	Regexp_methods["Find"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "[]byte", Doc: "Find returns the text of the leftmost match for re in b.\nThe return value is nil for no match."}
+	Regexp_methods["FindString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Doc: "FindString returns the text of the leftmost match for re in s.\nThe return value is the empty string both for an empty match and for no match.\nTo distinguish those two cases, use [Regexp.FindStringIndex] or [Regexp.FindStringSubmatch]."}
+	Regexp_methods["FindIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "[]int", Doc: "FindIndex returns the location of the leftmost match for re in b.\nThe match itself is at b[m[0]:m[1]].\nThe return value is nil for no match."}
+	Regexp_methods["FindStringIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "[]int", Doc: "FindStringIndex returns the location of the leftmost match for re in s.\nThe match itself is at s[m[0]:m[1]].\nThe return value is nil for no match."}
+	Regexp_methods["FindReaderIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.RuneReader"}}, Tag: "[]int", Doc: "FindReaderIndex returns the location of the leftmost match for re in r.\nThe match starts at byte index m[0] and ends just before byte index m[1].\nThe return value is nil for no match.\n\nFindReaderIndex may read arbitrarily far from r,\nincluding reading beyond the returned match."}
+	Regexp_methods["FindSubmatch"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "[][]byte", Doc: "FindSubmatch returns the first match for re in b, including submatches.\nThe overall match is m[0], the first submatch is m[1], and so on.\nThe return value is nil for no match."}
+	Regexp_methods["FindStringSubmatch"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "[]string", Doc: "FindStringSubmatch returns the first match for re in s, including submatches.\nThe overall match is s[0], the first submatch is s[1], and so on.\nThe return value is nil for no match."}
+	Regexp_methods["FindSubmatchIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "[]int", Doc: "FindSubmatchIndex returns the first match for re in b, including submatches.\nThe overall match is b[m[0]:m[1]], the first submatch is b[m[2]:m[3]], and so on.\nThe return value is nil for no match."}
+	Regexp_methods["FindStringSubmatchIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "[]int", Doc: "FindStringSubmatchIndex returns the first match for re in s, including submatches.\nThe overall match is s[m[0]:m[1]], the first submatch is s[m[2]:m[3]], and so on.\nThe return value is nil for no match."}
+	Regexp_methods["FindReaderSubmatchIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "io.RuneReader"}}, Tag: "[]int", Doc: "FindReaderSubmatchIndex returns the first match for re in r, including submatches.\nThe overall match is at byte index m[0] up to m[1],\nthe first submatch is at byte index m[2] up to m[3], and so on.\nThe return value is nil for no match.\n\nFindReaderSubmatchIndex may read arbitrarily far from r,\nincluding reading beyond the returned match."}
+	Regexp_methods["FindAll"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}, {Name: "n", Tag: "int"}}, Tag: "[][]byte", Doc: "FindAll returns all the matches for re in b.\nIf n >= 0, FindAll returns no more than n matches.\nSee [Regexp.All] for the equivalent iterator form."}
+	Regexp_methods["FindAllString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[]string", Doc: "FindAllString returns all the matches for re in s.\nIf n >= 0, FindAllString returns no more than n matches.\nSee [Regexp.AllString] for the equivalent iterator form."}
+	Regexp_methods["FindAllIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}, {Name: "n", Tag: "int"}}, Tag: "[][]int", Doc: "FindAllIndex returns the locations of all matches for re in b.\nIf n >= 0, FindAllIndex returns no more than n matches.\nSee [Regexp.AllIndex] for the equivalent iterator form."}
+	Regexp_methods["FindAllStringIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[][]int", Doc: "FindAllStringIndex returns the locations of all matches for re in s.\nIf n >= 0, FindAllStringIndex returns no more than n matches.\nSee [Regexp.AllStringIndex] for the equivalent iterator form."}
+	Regexp_methods["FindAllSubmatch"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}, {Name: "n", Tag: "int"}}, Tag: "[][][]byte", Doc: "FindAllSubmatch returns the locations of all matches for re in b,\nincluding submatch locations.\nIn each returned match m, the overall match is m[0],\nthe first submatch is m[1], and so on.\nIf n >= 0, FindAllSubmatch returns no more than n matches.\nSee [Regexp.AllSubmatch] for the equivalent iterator form."}
+	Regexp_methods["FindAllStringSubmatch"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[][]string", Doc: "FindAllStringSubmatch returns the locations of all matches for re in s,\nincluding submatch locations.\nIn each returned match m, m[0] is the overall match,\nm[1] is the first submatch, and so on.\nIf n >= 0, FindAllStringSubmatch returns no more than n matches.\nSee [Regexp.AllStringSubmatch] for the equivalent iterator form."}
+	Regexp_methods["FindAllSubmatchIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}, {Name: "n", Tag: "int"}}, Tag: "[][]int", Doc: "FindAllSubmatchIndex returns the locations of all matches for re in b,\nincluding submatch locations.\nIn each returned match m, the overall match is b[m[0]:m[1]],\nthe first submatch is b[m[2]:m[3]], and so on.\nIf n >= 0, FindAllSubmatchIndex returns no more than n matches.\nSee [Regexp.AllSubmatchIndex] for the equivalent iterator form."}
+	Regexp_methods["FindAllStringSubmatchIndex"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[][]int", Doc: "FindAllStringSubmatchIndex returns the locations of all matches for re in s,\nincluding submatch locations.\nIn each returned match m, the overall match is s[m[0]:m[1]],\nthe first submatch is s[m[2]:m[3]], and so on.\nIf n >= 0, FindAllStringSubmatchIndex returns no more than n matches.\nSee [Regexp.AllStringSubmatchIndex] for the equivalent iterator form."}
+	Regexp_methods["Expand"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "template", Tag: "[]byte"}, {Name: "src", Tag: "[]byte"}, {Name: "match", Tag: "[]int"}}, Tag: "[]byte", Doc: "Expand appends template to dst and returns the result; during the\nappend, Expand replaces variables in the template with corresponding\nmatches drawn from src. The match slice should have been returned by\n[Regexp.FindSubmatchIndex].\n\nIn the template, a variable is denoted by a substring of the form\n$name or ${name}, where name is a non-empty sequence of letters,\ndigits, and underscores. A purely numeric name like $1 refers to\nthe submatch with the corresponding index; other names refer to\ncapturing parentheses named with the (?P<name>...) syntax. A\nreference to an out of range or unmatched index or a name that is not\npresent in the regular expression is replaced with an empty slice.\n\nIn the $name form, name is taken to be as long as possible: $1x is\nequivalent to ${1x}, not ${1}x, and, $10 is equivalent to ${10}, not ${1}0.\n\nTo insert a literal $ in the output, use $$ in the template."}
+	Regexp_methods["ExpandString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "template", Tag: "string"}, {Name: "src", Tag: "string"}, {Name: "match", Tag: "[]int"}}, Tag: "[]byte", Doc: "ExpandString is like [Regexp.Expand] but the template and source are strings.\nIt appends to and returns a byte slice in order to give the calling\ncode control over allocation."}
+	Regexp_methods["Split"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[]string", Doc: "Split slices s into substrings separated by the expression and returns a slice of\nthe substrings between those expression matches.\n\nThe slice returned by this method consists of all the substrings of s\nnot contained in the slice returned by [Regexp.FindAllString]. When called on an expression\nthat contains no metacharacters, it is equivalent to [strings.SplitN].\n\nExample:\n\n\ts := regexp.MustCompile(\"a*\").Split(\"abaabaccadaaae\", 5)\n\t// s: [\"\", \"b\", \"b\", \"c\", \"cadaaae\"]\n\nThe count determines the number of substrings to return:\n  - n > 0: at most n substrings; the last substring will be the unsplit remainder;\n  - n == 0: the result is nil (zero substrings);\n  - n < 0: all substrings."}
+	Regexp_methods["MarshalText"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "MarshalText implements [encoding.TextMarshaler]. The output\nmatches that of calling the [Regexp.AppendText] method.\n\nSee [Regexp.AppendText] for more information."}
+	Regexp_methods["UnmarshalText"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "text", Tag: "[]byte"}}, Tag: "error", Doc: "UnmarshalText implements [encoding.TextUnmarshaler] by calling\n[Compile] on the encoded value."}
+	pkgreflect.AddPackage("lace.go.regexp", &pkgreflect.Package{
+		Doc:       "Package regexp implements regular expression search.",
+		WrapperNS: "go.regexp",
+		Wrapper:   wrapper_regexp,
+		Types: map[string]pkgreflect.Type{
+			"Regexp": {Doc: "Regexp is the representation of a compiled regular expression.\nA Regexp is safe for concurrent use by multiple goroutines,\nexcept for configuration methods, such as [Regexp.Longest].", Value: reflect.TypeOf((*regexp.Regexp)(nil)).Elem(), Methods: Regexp_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Compile": {Doc: "Compile parses a regular expression and returns, if successful,\na [Regexp] object that can be used to match against text.\n\nWhen matching against text, the regexp returns a match that\nbegins as early as possible in the input (leftmost), and among those\nit chooses the one that a backtracking search would have found first.\nThis so-called leftmost-first matching is the same semantics\nthat Perl, Python, and other implementations use, although this\npackage implements it without the expense of backtracking.\nFor POSIX leftmost-longest matching, see [CompilePOSIX].", Args: []pkgreflect.Arg{{Name: "expr", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(regexp.Compile)},
+
+			"CompilePOSIX": {Doc: "CompilePOSIX is like [Compile] but restricts the regular expression\nto POSIX ERE (egrep) syntax and changes the match semantics to\nleftmost-longest.\n\nThat is, when matching against text, the regexp returns a match that\nbegins as early as possible in the input (leftmost), and among those\nit chooses a match that is as long as possible.\nThis so-called leftmost-longest matching is the same semantics\nthat early regular expression implementations used and that POSIX\nspecifies.\n\nHowever, there can be multiple leftmost-longest matches, with different\nsubmatch choices, and here this package diverges from POSIX.\nAmong the possible leftmost-longest matches, this package chooses\nthe one that a backtracking search would have found first, while POSIX\nspecifies that the match be chosen to maximize the length of the first\nsubexpression, then the second, and so on from left to right.\nThe POSIX rule is computationally prohibitive and not even well-defined.\nSee https://swtch.com/~rsc/regexp/regexp2.html#posix for details.", Args: []pkgreflect.Arg{{Name: "expr", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(regexp.CompilePOSIX)},
+
+			"Match": {Doc: "Match reports whether the byte slice b\ncontains any match of the regular expression pattern.\nMore complicated queries need to use [Compile] and the full [Regexp] interface.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "b", Tag: "[]byte"}}, Tag: "any", Value: reflect.ValueOf(regexp.Match)},
+
+			"MatchReader": {Doc: "MatchReader reports whether the text returned by the [io.RuneReader]\ncontains any match of the regular expression pattern.\nMore complicated queries need to use [Compile] and the full [Regexp] interface.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "r", Tag: "io.RuneReader"}}, Tag: "any", Value: reflect.ValueOf(regexp.MatchReader)},
+
+			"MatchString": {Doc: "MatchString reports whether the string s\ncontains any match of the regular expression pattern.\nMore complicated queries need to use [Compile] and the full [Regexp] interface.", Args: []pkgreflect.Arg{{Name: "pattern", Tag: "string"}, {Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(regexp.MatchString)},
+
+			"MustCompile": {Doc: "MustCompile is like [Compile] but panics if the expression cannot be parsed.\nIt simplifies safe initialization of global variables holding compiled regular\nexpressions.", Args: []pkgreflect.Arg{{Name: "str", Tag: "string"}}, Tag: "Regexp", Value: reflect.ValueOf(regexp.MustCompile)},
+
+			"MustCompilePOSIX": {Doc: "MustCompilePOSIX is like [CompilePOSIX] but panics if the expression cannot be parsed.\nIt simplifies safe initialization of global variables holding compiled regular\nexpressions.", Args: []pkgreflect.Arg{{Name: "str", Tag: "string"}}, Tag: "Regexp", Value: reflect.ValueOf(regexp.MustCompilePOSIX)},
+
+			"QuoteMeta": {Doc: "QuoteMeta returns a string that escapes all regular expression metacharacters\ninside the argument text; the returned string is a regular expression matching\nthe literal text.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(regexp.QuoteMeta)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
@@ -0,0 +1,245 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.sort
+  "Package sort provides primitives for sorting slices and user-defined collections."
+  (:refer-clojure :exclude [find float64s float64s-are-sorted ints ints-are-sorted reverse search search-float64s search-ints search-strings slice slice-is-sorted slice-stable sort sorted? stable strings strings-are-sorted]))
+
+(defn find
+  "Find uses binary search to find and return the smallest index i in [0, n)
+at which cmp(i) <= 0. If there is no such index i, Find returns i = n.
+The found result is true if i < n and cmp(i) == 0.
+Find calls cmp(i) only for i in the range [0, n).
+
+To permit binary search, Find requires that cmp(i) > 0 for a leading
+prefix of the range, cmp(i) == 0 in the middle, and cmp(i) < 0 for
+the final suffix of the range. (Each subrange could be empty.)
+The usual way to establish this condition is to interpret cmp(i)
+as a comparison of a desired target value t against entry i in an
+underlying indexed data structure x, returning <0, 0, and >0
+when t < x[i], t == x[i], and t > x[i], respectively.
+
+For example, to look for a particular string in a sorted, random-access
+list of strings:
+
+	i, found := sort.Find(x.Len(), func(i int) int {
+	    return strings.Compare(target, x.At(i))
+	})
+	if found {
+	    fmt.Printf(\"found %s at entry %d\\n\", target, i)
+	} else {
+	    fmt.Printf(\"%s not found, would insert at %d\", target, i)
+	}"
+  {:arglists '([n cmp]) :go "sort.Find"}
+  [n cmp]
+  (lace.core/let [[v ok] (lace.go.sort/Find n cmp)] (if ok v nil)))
+
+(defn float64s
+  "Float64s sorts a slice of float64s in increasing order.
+Not-a-number (NaN) values are ordered before other values.
+
+Note: as of Go 1.22, this function simply calls [slices.Sort]."
+  {:arglists '([x]) :go "sort.Float64s"}
+  [x]
+  (lace.go.sort/Float64s x))
+
+(defn float64s-are-sorted
+  "Float64sAreSorted reports whether the slice x is sorted in increasing order,
+with not-a-number (NaN) values before any other values.
+
+Note: as of Go 1.22, this function simply calls [slices.IsSorted]."
+  {:arglists '([x]) :go "sort.Float64sAreSorted"}
+  [x]
+  (lace.go.sort/Float64sAreSorted x))
+
+(defn ints
+  "Ints sorts a slice of ints in increasing order.
+
+Note: as of Go 1.22, this function simply calls [slices.Sort]."
+  {:arglists '([x]) :go "sort.Ints"}
+  [x]
+  (lace.go.sort/Ints x))
+
+(defn ints-are-sorted
+  "IntsAreSorted reports whether the slice x is sorted in increasing order.
+
+Note: as of Go 1.22, this function simply calls [slices.IsSorted]."
+  {:arglists '([x]) :go "sort.IntsAreSorted"}
+  [x]
+  (lace.go.sort/IntsAreSorted x))
+
+(defn reverse
+  "Reverse returns the reverse order for data."
+  {:arglists '([data]) :go "sort.Reverse"}
+  [data]
+  (lace.go.sort/Reverse data))
+
+(defn search
+  "Search uses binary search to find and return the smallest index i
+in [0, n) at which f(i) is true, assuming that on the range [0, n),
+f(i) == true implies f(i+1) == true. That is, Search requires that
+f is false for some (possibly empty) prefix of the input range [0, n)
+and then true for the (possibly empty) remainder; Search returns
+the first true index. If there is no such index, Search returns n.
+(Note that the \"not found\" return value is not -1 as in, for instance,
+strings.Index.)
+Search calls f(i) only for i in the range [0, n).
+
+A common use of Search is to find the index i for a value x in
+a sorted, indexable data structure such as an array or slice.
+In this case, the argument f, typically a closure, captures the value
+to be searched for, and how the data structure is indexed and
+ordered.
+
+For instance, given a slice data sorted in ascending order,
+the call Search(len(data), func(i int) bool { return data[i] >= 23 })
+returns the smallest index i such that data[i] >= 23. If the caller
+wants to find whether 23 is in the slice, it must test data[i] == 23
+separately.
+
+Searching data sorted in descending order would use the <=
+operator instead of the >= operator.
+
+To complete the example above, the following code tries to find the value
+x in an integer slice data sorted in ascending order:
+
+	x := 23
+	i := sort.Search(len(data), func(i int) bool { return data[i] >= x })
+	if i < len(data) && data[i] == x {
+		// x is present at data[i]
+	} else {
+		// x is not present in data,
+		// but i is the index where it would be inserted.
+	}
+
+As a more whimsical example, this program guesses your number:
+
+	func GuessingGame() {
+		var s string
+		fmt.Printf(\"Pick an integer from 0 to 100.\\n\")
+		answer := sort.Search(100, func(i int) bool {
+			fmt.Printf(\"Is your number <= %d? \", i)
+			fmt.Scanf(\"%s\", &s)
+			return s != \"\" && s[0] == 'y'
+		})
+		fmt.Printf(\"Your number is %d.\\n\", answer)
+	}"
+  {:arglists '([n f]) :go "sort.Search"}
+  [n f]
+  (lace.go.sort/Search n f))
+
+(defn search-float64s
+  "SearchFloat64s searches for x in a sorted slice of float64s and returns the index
+as specified by [Search]. The return value is the index to insert x if x is not
+present (it could be len(a)).
+The slice must be sorted in ascending order."
+  {:arglists '([a x]) :go "sort.SearchFloat64s"}
+  [a x]
+  (lace.go.sort/SearchFloat64s a x))
+
+(defn search-ints
+  "SearchInts searches for x in a sorted slice of ints and returns the index
+as specified by [Search]. The return value is the index to insert x if x is
+not present (it could be len(a)).
+The slice must be sorted in ascending order."
+  {:arglists '([a x]) :go "sort.SearchInts"}
+  [a x]
+  (lace.go.sort/SearchInts a x))
+
+(defn search-strings
+  "SearchStrings searches for x in a sorted slice of strings and returns the index
+as specified by Search. The return value is the index to insert x if x is not
+present (it could be len(a)).
+The slice must be sorted in ascending order."
+  {:arglists '([a x]) :go "sort.SearchStrings"}
+  [a x]
+  (lace.go.sort/SearchStrings a x))
+
+(defn slice
+  "Slice sorts the slice x given the provided less function.
+It panics if x is not a slice.
+
+The sort is not guaranteed to be stable: equal elements
+may be reversed from their original order.
+For a stable sort, use [SliceStable].
+
+The less function must satisfy the same requirements as
+the Interface type's Less method.
+
+Note: in many situations, the newer [slices.SortFunc] function is more
+ergonomic and runs faster."
+  {:arglists '([x less]) :go "sort.Slice"}
+  [x less]
+  (lace.go.sort/Slice x less))
+
+(defn slice-is-sorted
+  "SliceIsSorted reports whether the slice x is sorted according to the provided less function.
+It panics if x is not a slice.
+
+Note: in many situations, the newer [slices.IsSortedFunc] function is more
+ergonomic and runs faster."
+  {:arglists '([x less]) :go "sort.SliceIsSorted"}
+  [x less]
+  (lace.go.sort/SliceIsSorted x less))
+
+(defn slice-stable
+  "SliceStable sorts the slice x using the provided less
+function, keeping equal elements in their original order.
+It panics if x is not a slice.
+
+The less function must satisfy the same requirements as
+the Interface type's Less method.
+
+Note: in many situations, the newer [slices.SortStableFunc] function is more
+ergonomic and runs faster."
+  {:arglists '([x less]) :go "sort.SliceStable"}
+  [x less]
+  (lace.go.sort/SliceStable x less))
+
+(defn sort
+  "Sort sorts data in ascending order as determined by the Less method.
+It makes one call to data.Len to determine n and O(n*log(n)) calls to
+data.Less and data.Swap. The sort is not guaranteed to be stable.
+
+Note: in many situations, the newer [slices.SortFunc] function is more
+ergonomic and runs faster."
+  {:arglists '([data]) :go "sort.Sort"}
+  [data]
+  (lace.go.sort/Sort data))
+
+(defn sorted?
+  "IsSorted reports whether data is sorted.
+
+Note: in many situations, the newer [slices.IsSortedFunc] function is more
+ergonomic and runs faster."
+  {:arglists '([data]) :go "sort.IsSorted"}
+  [data]
+  (lace.go.sort/IsSorted data))
+
+(defn stable
+  "Stable sorts data in ascending order as determined by the Less method,
+while keeping the original order of equal elements.
+
+It makes one call to data.Len to determine n, O(n*log(n)) calls to
+data.Less and O(n*log(n)*log(n)) calls to data.Swap.
+
+Note: in many situations, the newer slices.SortStableFunc function is more
+ergonomic and runs faster."
+  {:arglists '([data]) :go "sort.Stable"}
+  [data]
+  (lace.go.sort/Stable data))
+
+(defn strings
+  "Strings sorts a slice of strings in increasing order.
+
+Note: as of Go 1.22, this function simply calls [slices.Sort]."
+  {:arglists '([x]) :go "sort.Strings"}
+  [x]
+  (lace.go.sort/Strings x))
+
+(defn strings-are-sorted
+  "StringsAreSorted reports whether the slice x is sorted in increasing order.
+
+Note: as of Go 1.22, this function simply calls [slices.IsSorted]."
+  {:arglists '([x]) :go "sort.StringsAreSorted"}
+  [x]
+  (lace.go.sort/StringsAreSorted x))
@@ -0,0 +1,106 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	sort "sort"
+)
+
+type InterfaceImpl struct {
+	LenFn  func() int
+	LessFn func(int, int) bool
+	SwapFn func(int, int)
+}
+
+func (s *InterfaceImpl) Len() int {
+	return s.LenFn()
+}
+func (s *InterfaceImpl) Less(a0 int, a1 int) bool {
+	return s.LessFn(a0, a1)
+}
+func (s *InterfaceImpl) Swap(a0 int, a1 int) {
+	s.SwapFn(a0, a1)
+}
+
+//go:embed sort.clj
+var wrapper_sort string
+
+func init() {
+	Float64Slice_methods := map[string]pkgreflect.Func{}
+	IntSlice_methods := map[string]pkgreflect.Func{}
+	Interface_methods := map[string]pkgreflect.Func{}
+	StringSlice_methods := map[string]pkgreflect.Func{}
+	IntSlice_methods["Search"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "int"}}, Tag: "int", Doc: "Search returns the result of applying [SearchInts] to the receiver and x."}
+	Float64Slice_methods["Search"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "float64"}}, Tag: "int", Doc: "Search returns the result of applying [SearchFloat64s] to the receiver and x."}
+	StringSlice_methods["Search"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "string"}}, Tag: "int", Doc: "Search returns the result of applying [SearchStrings] to the receiver and x."}
+	IntSlice_methods["Len"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: ""}
+	IntSlice_methods["Less"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "bool", Doc: ""}
+	IntSlice_methods["Swap"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "any", Doc: ""}
+	IntSlice_methods["Sort"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Sort is a convenience method: x.Sort() calls Sort(x)."}
+	Float64Slice_methods["Len"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: ""}
+	Float64Slice_methods["Less"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "bool", Doc: "Less reports whether x[i] should be ordered before x[j], as required by the sort Interface.\nNote that floating-point comparison by itself is not a transitive relation: it does not\nreport a consistent ordering for not-a-number (NaN) values.\nThis implementation of Less places NaN values before any others, by using:\n\n\tx[i] < x[j] || (math.IsNaN(x[i]) && !math.IsNaN(x[j]))"}
+	Float64Slice_methods["Swap"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "any", Doc: ""}
+	Float64Slice_methods["Sort"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Sort is a convenience method: x.Sort() calls Sort(x)."}
+	StringSlice_methods["Len"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: ""}
+	StringSlice_methods["Less"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "bool", Doc: ""}
+	StringSlice_methods["Swap"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}, {Name: "j", Tag: "int"}}, Tag: "any", Doc: ""}
+	StringSlice_methods["Sort"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Sort is a convenience method: x.Sort() calls Sort(x)."}
+	pkgreflect.AddPackage("lace.go.sort", &pkgreflect.Package{
+		Doc:       "Package sort provides primitives for sorting slices and user-defined collections.",
+		WrapperNS: "go.sort",
+		Wrapper:   wrapper_sort,
+		Types: map[string]pkgreflect.Type{
+			"Float64Slice":  {Doc: "Float64Slice implements Interface for a []float64, sorting in increasing order,\nwith not-a-number (NaN) values ordered before other values.", Value: reflect.TypeOf((*sort.Float64Slice)(nil)).Elem(), Methods: Float64Slice_methods},
+			"IntSlice":      {Doc: "IntSlice attaches the methods of Interface to []int, sorting in increasing order.", Value: reflect.TypeOf((*sort.IntSlice)(nil)).Elem(), Methods: IntSlice_methods},
+			"Interface":     {Doc: "An implementation of Interface can be sorted by the routines in this package.\nThe methods refer to elements of the underlying collection by integer index.", Value: reflect.TypeOf((*sort.Interface)(nil)).Elem(), Methods: Interface_methods},
+			"StringSlice":   {Doc: "StringSlice attaches the methods of Interface to []string, sorting in increasing order.", Value: reflect.TypeOf((*sort.StringSlice)(nil)).Elem(), Methods: StringSlice_methods},
+			"InterfaceImpl": {Doc: `Struct version of interface Interface for implementation`, Value: reflect.TypeFor[InterfaceImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Find": {Doc: "Find uses binary search to find and return the smallest index i in [0, n)\nat which cmp(i) <= 0. If there is no such index i, Find returns i = n.\nThe found result is true if i < n and cmp(i) == 0.\nFind calls cmp(i) only for i in the range [0, n).\n\nTo permit binary search, Find requires that cmp(i) > 0 for a leading\nprefix of the range, cmp(i) == 0 in the middle, and cmp(i) < 0 for\nthe final suffix of the range. (Each subrange could be empty.)\nThe usual way to establish this condition is to interpret cmp(i)\nas a comparison of a desired target value t against entry i in an\nunderlying indexed data structure x, returning <0, 0, and >0\nwhen t < x[i], t == x[i], and t > x[i], respectively.\n\nFor example, to look for a particular string in a sorted, random-access\nlist of strings:\n\n\ti, found := sort.Find(x.Len(), func(i int) int {\n\t    return strings.Compare(target, x.At(i))\n\t})\n\tif found {\n\t    fmt.Printf(\"found %s at entry %d\\n\", target, i)\n\t} else {\n\t    fmt.Printf(\"%s not found, would insert at %d\", target, i)\n\t}", Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}, {Name: "cmp", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(sort.Find)},
+
+			"Float64s": {Doc: "Float64s sorts a slice of float64s in increasing order.\nNot-a-number (NaN) values are ordered before other values.\n\nNote: as of Go 1.22, this function simply calls [slices.Sort].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]float64"}}, Tag: "any", Value: reflect.ValueOf(sort.Float64s)},
+
+			"Float64sAreSorted": {Doc: "Float64sAreSorted reports whether the slice x is sorted in increasing order,\nwith not-a-number (NaN) values before any other values.\n\nNote: as of Go 1.22, this function simply calls [slices.IsSorted].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]float64"}}, Tag: "bool", Value: reflect.ValueOf(sort.Float64sAreSorted)},
+
+			"Ints": {Doc: "Ints sorts a slice of ints in increasing order.\n\nNote: as of Go 1.22, this function simply calls [slices.Sort].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]int"}}, Tag: "any", Value: reflect.ValueOf(sort.Ints)},
+
+			"IntsAreSorted": {Doc: "IntsAreSorted reports whether the slice x is sorted in increasing order.\n\nNote: as of Go 1.22, this function simply calls [slices.IsSorted].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]int"}}, Tag: "bool", Value: reflect.ValueOf(sort.IntsAreSorted)},
+
+			"IsSorted": {Doc: "IsSorted reports whether data is sorted.\n\nNote: in many situations, the newer [slices.IsSortedFunc] function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "data", Tag: "Interface"}}, Tag: "bool", Value: reflect.ValueOf(sort.IsSorted)},
+
+			"Reverse": {Doc: "Reverse returns the reverse order for data.", Args: []pkgreflect.Arg{{Name: "data", Tag: "Interface"}}, Tag: "Interface", Value: reflect.ValueOf(sort.Reverse)},
+
+			"Search": {Doc: "Search uses binary search to find and return the smallest index i\nin [0, n) at which f(i) is true, assuming that on the range [0, n),\nf(i) == true implies f(i+1) == true. That is, Search requires that\nf is false for some (possibly empty) prefix of the input range [0, n)\nand then true for the (possibly empty) remainder; Search returns\nthe first true index. If there is no such index, Search returns n.\n(Note that the \"not found\" return value is not -1 as in, for instance,\nstrings.Index.)\nSearch calls f(i) only for i in the range [0, n).\n\nA common use of Search is to find the index i for a value x in\na sorted, indexable data structure such as an array or slice.\nIn this case, the argument f, typically a closure, captures the value\nto be searched for, and how the data structure is indexed and\nordered.\n\nFor instance, given a slice data sorted in ascending order,\nthe call Search(len(data), func(i int) bool { return data[i] >= 23 })\nreturns the smallest index i such that data[i] >= 23. If the caller\nwants to find whether 23 is in the slice, it must test data[i] == 23\nseparately.\n\nSearching data sorted in descending order would use the <=\noperator instead of the >= operator.\n\nTo complete the example above, the following code tries to find the value\nx in an integer slice data sorted in ascending order:\n\n\tx := 23\n\ti := sort.Search(len(data), func(i int) bool { return data[i] >= x })\n\tif i < len(data) && data[i] == x {\n\t\t// x is present at data[i]\n\t} else {\n\t\t// x is not present in data,\n\t\t// but i is the index where it would be inserted.\n\t}\n\nAs a more whimsical example, this program guesses your number:\n\n\tfunc GuessingGame() {\n\t\tvar s string\n\t\tfmt.Printf(\"Pick an integer from 0 to 100.\\n\")\n\t\tanswer := sort.Search(100, func(i int) bool {\n\t\t\tfmt.Printf(\"Is your number <= %d? \", i)\n\t\t\tfmt.Scanf(\"%s\", &s)\n\t\t\treturn s != \"\" && s[0] == 'y'\n\t\t})\n\t\tfmt.Printf(\"Your number is %d.\\n\", answer)\n\t}", Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}, {Name: "f", Tag: "Unknown"}}, Tag: "int", Value: reflect.ValueOf(sort.Search)},
+
+			"SearchFloat64s": {Doc: "SearchFloat64s searches for x in a sorted slice of float64s and returns the index\nas specified by [Search]. The return value is the index to insert x if x is not\npresent (it could be len(a)).\nThe slice must be sorted in ascending order.", Args: []pkgreflect.Arg{{Name: "a", Tag: "[]float64"}, {Name: "x", Tag: "float64"}}, Tag: "int", Value: reflect.ValueOf(sort.SearchFloat64s)},
+
+			"SearchInts": {Doc: "SearchInts searches for x in a sorted slice of ints and returns the index\nas specified by [Search]. The return value is the index to insert x if x is\nnot present (it could be len(a)).\nThe slice must be sorted in ascending order.", Args: []pkgreflect.Arg{{Name: "a", Tag: "[]int"}, {Name: "x", Tag: "int"}}, Tag: "int", Value: reflect.ValueOf(sort.SearchInts)},
+
+			"SearchStrings": {Doc: "SearchStrings searches for x in a sorted slice of strings and returns the index\nas specified by Search. The return value is the index to insert x if x is not\npresent (it could be len(a)).\nThe slice must be sorted in ascending order.", Args: []pkgreflect.Arg{{Name: "a", Tag: "[]string"}, {Name: "x", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(sort.SearchStrings)},
+
+			"Slice": {Doc: "Slice sorts the slice x given the provided less function.\nIt panics if x is not a slice.\n\nThe sort is not guaranteed to be stable: equal elements\nmay be reversed from their original order.\nFor a stable sort, use [SliceStable].\n\nThe less function must satisfy the same requirements as\nthe Interface type's Less method.\n\nNote: in many situations, the newer [slices.SortFunc] function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "x", Tag: "any"}, {Name: "less", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(sort.Slice)},
+
+			"SliceIsSorted": {Doc: "SliceIsSorted reports whether the slice x is sorted according to the provided less function.\nIt panics if x is not a slice.\n\nNote: in many situations, the newer [slices.IsSortedFunc] function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "x", Tag: "any"}, {Name: "less", Tag: "Unknown"}}, Tag: "bool", Value: reflect.ValueOf(sort.SliceIsSorted)},
+
+			"SliceStable": {Doc: "SliceStable sorts the slice x using the provided less\nfunction, keeping equal elements in their original order.\nIt panics if x is not a slice.\n\nThe less function must satisfy the same requirements as\nthe Interface type's Less method.\n\nNote: in many situations, the newer [slices.SortStableFunc] function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "x", Tag: "any"}, {Name: "less", Tag: "Unknown"}}, Tag: "any", Value: reflect.ValueOf(sort.SliceStable)},
+
+			"Sort": {Doc: "Sort sorts data in ascending order as determined by the Less method.\nIt makes one call to data.Len to determine n and O(n*log(n)) calls to\ndata.Less and data.Swap. The sort is not guaranteed to be stable.\n\nNote: in many situations, the newer [slices.SortFunc] function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "data", Tag: "Interface"}}, Tag: "any", Value: reflect.ValueOf(sort.Sort)},
+
+			"Stable": {Doc: "Stable sorts data in ascending order as determined by the Less method,\nwhile keeping the original order of equal elements.\n\nIt makes one call to data.Len to determine n, O(n*log(n)) calls to\ndata.Less and O(n*log(n)*log(n)) calls to data.Swap.\n\nNote: in many situations, the newer slices.SortStableFunc function is more\nergonomic and runs faster.", Args: []pkgreflect.Arg{{Name: "data", Tag: "Interface"}}, Tag: "any", Value: reflect.ValueOf(sort.Stable)},
+
+			"Strings": {Doc: "Strings sorts a slice of strings in increasing order.\n\nNote: as of Go 1.22, this function simply calls [slices.Sort].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]string"}}, Tag: "any", Value: reflect.ValueOf(sort.Strings)},
+
+			"StringsAreSorted": {Doc: "StringsAreSorted reports whether the slice x is sorted in increasing order.\n\nNote: as of Go 1.22, this function simply calls [slices.IsSorted].", Args: []pkgreflect.Arg{{Name: "x", Tag: "[]string"}}, Tag: "bool", Value: reflect.ValueOf(sort.StringsAreSorted)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
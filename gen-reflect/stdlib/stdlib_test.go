@@ -0,0 +1,109 @@
+package stdlib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lab47/lace/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadAll(t *testing.T) {
+	r := require.New(t)
+
+	env, err := core.NewEnv()
+	r.NoError(err)
+
+	_, err = env.Eval("(lace.reflect/load-all!)")
+	r.NoError(err)
+
+	_, stats := env.PkgReflectReport()
+
+	var bindings, wrappers int
+
+	for _, st := range stats {
+		switch {
+		case strings.HasPrefix(st.Namespace, "lace.go."):
+			bindings++
+		case strings.HasPrefix(st.Namespace, "go."):
+			wrappers++
+		default:
+			continue
+		}
+
+		r.True(st.Loaded, "package %s not loaded", st.Package)
+		r.NotZero(st.Vars, "package %s defined nothing", st.Package)
+
+		ns := env.FindNamespace(core.MakeSymbol(st.Namespace))
+		r.NotNil(ns, "namespace %s missing", st.Namespace)
+	}
+
+	r.Equal(29, bindings)
+	r.Equal(29, wrappers)
+}
+
+func TestCalls(t *testing.T) {
+	env, err := core.NewEnv()
+	require.NoError(t, err)
+
+	tests := []struct {
+		code   string
+		expect any
+	}{
+		{`(lace.go.strings/ToUpper "abc")`, core.MakeString("ABC")},
+		{`(lace.go.strconv/Itoa 42)`, core.MakeString("42")},
+		{`(lace.go.path.filepath/Base "/a/b")`, core.MakeString("b")},
+		{`(lace.go.net.url/QueryEscape "a b")`, core.MakeString("a+b")},
+		{`(lace.go.path.filepath/Join "a" "b")`, core.MakeString("a/b")},
+		{`(lace.go.path.filepath/Join "a")`, core.MakeString("a")},
+		{`(lace.go.path.filepath/Join)`, core.MakeString("")},
+		{`(lace.go.path.filepath/Join ["a" "b"])`, core.MakeString("a/b")},
+		{`(lace.go.path.filepath/Join (list "a" "b"))`, core.MakeString("a/b")},
+		{`(lace.go.strings/Join ["a" "b"] ",")`, core.MakeString("a,b")},
+		{`(go.strings/to-upper "abc")`, core.MakeString("ABC")},
+		{`(go.path.filepath/join "a" "b" "c")`, core.MakeString("a/b/c")},
+		{`(go.path.filepath/abs? "/a")`, core.MakeBoolean(true)},
+		{`(go.strings/has-prefix? "abc" "a")`, core.MakeBoolean(true)},
+		{`(go.strings/cut-prefix "abc" "a")`, core.MakeString("bc")},
+		{`(go.strings/cut-prefix "abc" "x")`, core.NIL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			r := require.New(t)
+
+			obj, err := env.Eval(tt.code)
+			r.NoError(err)
+
+			r.True(core.Equals(env, tt.expect, obj), "got %v", obj)
+		})
+	}
+}
+
+func TestCallChecks(t *testing.T) {
+	env, err := core.NewEnv()
+	require.NoError(t, err)
+
+	tests := []struct {
+		code string
+		msg  string
+	}{
+		{`(fn [] (lace.go.strings/ToUpper))`, "Wrong number of args (0)"},
+		{`(fn [] (lace.go.strings/ToUpper "a" "b"))`, "Wrong number of args (2)"},
+		{`(fn [] (lace.go.strconv/Itoa "x"))`, "Arg[0] of lace.go.strconv/Itoa must have type int"},
+		{`(fn [] (lace.go.path.filepath/Join "a" 1))`, "Arg[1] of lace.go.path.filepath/Join must have type string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			r := require.New(t)
+
+			// The calls are never made, so the errors come from parsing.
+			_, err := env.Eval(tt.code)
+			r.ErrorContains(err, tt.msg)
+		})
+	}
+
+	_, err = env.Eval(`(fn [x] (lace.go.strconv/Itoa x))`)
+	require.NoError(t, err)
+}
@@ -0,0 +1,372 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.strconv
+  "Package strconv implements conversions to and from string representations of basic data types."
+  (:refer-clojure :exclude [append-bool append-float append-int append-quote append-quote-rune append-quote-rune-to-ascii append-quote-rune-to-graphic append-quote-to-ascii append-quote-to-graphic append-uint atoi can-backquote format-bool format-complex format-float format-int format-uint graphic? itoa parse-bool parse-complex parse-float parse-int parse-uint print? quote quote-rune quote-rune-to-ascii quote-rune-to-graphic quote-to-ascii quote-to-graphic quoted-prefix unquote unquote-char]))
+
+(defn append-bool
+  "AppendBool appends \"true\" or \"false\", according to the value of b,
+to dst and returns the extended buffer."
+  {:arglists '([dst b]) :go "strconv.AppendBool"}
+  [dst b]
+  (lace.go.strconv/AppendBool dst b))
+
+(defn append-float
+  "AppendFloat appends the string form of the floating-point number f,
+as generated by [FormatFloat], to dst and returns the extended buffer."
+  {:arglists '([dst f fmt prec bit-size]) :go "strconv.AppendFloat"}
+  [dst f fmt prec bit-size]
+  (lace.go.strconv/AppendFloat dst f fmt prec bit-size))
+
+(defn append-int
+  "AppendInt appends the string form of the integer i,
+as generated by [FormatInt], to dst and returns the extended buffer."
+  {:arglists '([dst i base]) :go "strconv.AppendInt"}
+  [dst i base]
+  (lace.go.strconv/AppendInt dst i base))
+
+(defn append-quote
+  "AppendQuote appends a double-quoted Go string literal representing s,
+as generated by [Quote], to dst and returns the extended buffer."
+  {:arglists '([dst s]) :go "strconv.AppendQuote"}
+  [dst s]
+  (lace.go.strconv/AppendQuote dst s))
+
+(defn append-quote-rune
+  "AppendQuoteRune appends a single-quoted Go character literal representing the rune,
+as generated by [QuoteRune], to dst and returns the extended buffer."
+  {:arglists '([dst r]) :go "strconv.AppendQuoteRune"}
+  [dst r]
+  (lace.go.strconv/AppendQuoteRune dst r))
+
+(defn append-quote-rune-to-ascii
+  "AppendQuoteRuneToASCII appends a single-quoted Go character literal representing the rune,
+as generated by [QuoteRuneToASCII], to dst and returns the extended buffer."
+  {:arglists '([dst r]) :go "strconv.AppendQuoteRuneToASCII"}
+  [dst r]
+  (lace.go.strconv/AppendQuoteRuneToASCII dst r))
+
+(defn append-quote-rune-to-graphic
+  "AppendQuoteRuneToGraphic appends a single-quoted Go character literal representing the rune,
+as generated by [QuoteRuneToGraphic], to dst and returns the extended buffer."
+  {:arglists '([dst r]) :go "strconv.AppendQuoteRuneToGraphic"}
+  [dst r]
+  (lace.go.strconv/AppendQuoteRuneToGraphic dst r))
+
+(defn append-quote-to-ascii
+  "AppendQuoteToASCII appends a double-quoted Go string literal representing s,
+as generated by [QuoteToASCII], to dst and returns the extended buffer."
+  {:arglists '([dst s]) :go "strconv.AppendQuoteToASCII"}
+  [dst s]
+  (lace.go.strconv/AppendQuoteToASCII dst s))
+
+(defn append-quote-to-graphic
+  "AppendQuoteToGraphic appends a double-quoted Go string literal representing s,
+as generated by [QuoteToGraphic], to dst and returns the extended buffer."
+  {:arglists '([dst s]) :go "strconv.AppendQuoteToGraphic"}
+  [dst s]
+  (lace.go.strconv/AppendQuoteToGraphic dst s))
+
+(defn append-uint
+  "AppendUint appends the string form of the unsigned integer i,
+as generated by [FormatUint], to dst and returns the extended buffer."
+  {:arglists '([dst i base]) :go "strconv.AppendUint"}
+  [dst i base]
+  (lace.go.strconv/AppendUint dst i base))
+
+(defn atoi
+  "Atoi is equivalent to ParseInt(s, 10, 0), converted to type int."
+  {:arglists '([s]) :go "strconv.Atoi"}
+  [s]
+  (lace.go.strconv/Atoi s))
+
+(defn can-backquote
+  "CanBackquote reports whether the string s can be represented
+unchanged as a single-line backquoted string without control
+characters other than tab."
+  {:arglists '([s]) :go "strconv.CanBackquote"}
+  [s]
+  (lace.go.strconv/CanBackquote s))
+
+(defn format-bool
+  "FormatBool returns \"true\" or \"false\" according to the value of b."
+  {:arglists '([b]) :go "strconv.FormatBool"}
+  [b]
+  (lace.go.strconv/FormatBool b))
+
+(defn format-complex
+  "FormatComplex converts the complex number c to a string of the
+form (a+bi) where a and b are the real and imaginary parts,
+formatted according to the format fmt and precision prec.
+
+The format fmt and precision prec have the same meaning as in [FormatFloat].
+It rounds the result assuming that the original was obtained from a complex
+value of bitSize bits, which must be 64 for complex64 and 128 for complex128."
+  {:arglists '([c fmt prec bit-size]) :go "strconv.FormatComplex"}
+  [c fmt prec bit-size]
+  (lace.go.strconv/FormatComplex c fmt prec bit-size))
+
+(defn format-float
+  "FormatFloat converts the floating-point number f to a string,
+according to the format fmt and precision prec. It rounds the
+result assuming that the original was obtained from a floating-point
+value of bitSize bits (32 for float32, 64 for float64).
+
+The format fmt is one of
+  - 'b' (-ddddp±ddd, a binary exponent),
+  - 'e' (-d.dddde±dd, a decimal exponent),
+  - 'E' (-d.ddddE±dd, a decimal exponent),
+  - 'f' (-ddd.dddd, no exponent),
+  - 'g' ('e' for large exponents, 'f' otherwise),
+  - 'G' ('E' for large exponents, 'f' otherwise),
+  - 'x' (-0xd.ddddp±ddd, a hexadecimal fraction and binary exponent), or
+  - 'X' (-0Xd.ddddP±ddd, a hexadecimal fraction and binary exponent).
+
+The precision prec controls the number of digits (excluding the exponent)
+printed by the 'e', 'E', 'f', 'g', 'G', 'x', and 'X' formats.
+For 'e', 'E', 'f', 'x', and 'X', it is the number of digits after the decimal point.
+For 'g' and 'G' it is the maximum number of significant digits (trailing
+zeros are removed).
+The special precision -1 uses the smallest number of digits
+necessary such that ParseFloat will return f exactly.
+The exponent is written as a decimal integer;
+for all formats other than 'b', it will be at least two digits."
+  {:arglists '([f fmt prec bit-size]) :go "strconv.FormatFloat"}
+  [f fmt prec bit-size]
+  (lace.go.strconv/FormatFloat f fmt prec bit-size))
+
+(defn format-int
+  "FormatInt returns the string representation of i in the given base,
+for 2 <= base <= 36. The result uses the lower-case letters 'a' to 'z'
+for digit values >= 10."
+  {:arglists '([i base]) :go "strconv.FormatInt"}
+  [i base]
+  (lace.go.strconv/FormatInt i base))
+
+(defn format-uint
+  "FormatUint returns the string representation of i in the given base,
+for 2 <= base <= 36. The result uses the lower-case letters 'a' to 'z'
+for digit values >= 10."
+  {:arglists '([i base]) :go "strconv.FormatUint"}
+  [i base]
+  (lace.go.strconv/FormatUint i base))
+
+(defn graphic?
+  "IsGraphic reports whether the rune is defined as a Graphic by Unicode. Such
+characters include letters, marks, numbers, punctuation, symbols, and
+spaces, from categories L, M, N, P, S, and Zs."
+  {:arglists '([r]) :go "strconv.IsGraphic"}
+  [r]
+  (lace.go.strconv/IsGraphic r))
+
+(defn itoa
+  "Itoa is equivalent to [FormatInt](int64(i), 10)."
+  {:arglists '([i]) :go "strconv.Itoa"}
+  [i]
+  (lace.go.strconv/Itoa i))
+
+(defn parse-bool
+  "ParseBool returns the boolean value represented by the string.
+It accepts 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.
+Any other value returns an error."
+  {:arglists '([str]) :go "strconv.ParseBool"}
+  [str]
+  (lace.go.strconv/ParseBool str))
+
+(defn parse-complex
+  "ParseComplex converts the string s to a complex number
+with the precision specified by bitSize: 64 for complex64, or 128 for complex128.
+When bitSize=64, the result still has type complex128, but it will be
+convertible to complex64 without changing its value.
+
+The number represented by s must be of the form N, Ni, or N±Ni, where N stands
+for a floating-point number as recognized by [ParseFloat], and i is the imaginary
+component. If the second N is unsigned, a + sign is required between the two components
+as indicated by the ±. If the second N is NaN, only a + sign is accepted.
+The form may be parenthesized and cannot contain any spaces.
+The resulting complex number consists of the two components converted by ParseFloat.
+
+The errors that ParseComplex returns have concrete type [*NumError]
+and include err.Num = s.
+
+If s is not syntactically well-formed, ParseComplex returns err.Err = ErrSyntax.
+
+If s is syntactically well-formed but either component is more than 1/2 ULP
+away from the largest floating point number of the given component's size,
+ParseComplex returns err.Err = ErrRange and c = ±Inf for the respective component."
+  {:arglists '([s bit-size]) :go "strconv.ParseComplex"}
+  [s bit-size]
+  (lace.go.strconv/ParseComplex s bit-size))
+
+(defn parse-float
+  "ParseFloat converts the string s to a floating-point number
+with the precision specified by bitSize: 32 for float32, or 64 for float64.
+When bitSize=32, the result still has type float64, but it will be
+convertible to float32 without changing its value.
+
+ParseFloat accepts decimal and hexadecimal floating-point numbers
+as defined by the Go syntax for [floating-point literals].
+If s is well-formed and near a valid floating-point number,
+ParseFloat returns the nearest floating-point number rounded
+using IEEE754 unbiased rounding.
+(Parsing a hexadecimal floating-point value only rounds when
+there are more bits in the hexadecimal representation than
+will fit in the mantissa.)
+
+The errors that ParseFloat returns have concrete type *NumError
+and include err.Num = s.
+
+If s is not syntactically well-formed, ParseFloat returns err.Err = ErrSyntax.
+
+If s is syntactically well-formed but is more than 1/2 ULP
+away from the largest floating point number of the given size,
+ParseFloat returns f = ±Inf, err.Err = ErrRange.
+
+ParseFloat recognizes the string \"NaN\", and the (possibly signed) strings \"Inf\" and \"Infinity\"
+as their respective special floating point values. It ignores case when matching.
+
+[floating-point literals]: https://go.dev/ref/spec#Floating-point_literals"
+  {:arglists '([s bit-size]) :go "strconv.ParseFloat"}
+  [s bit-size]
+  (lace.go.strconv/ParseFloat s bit-size))
+
+(defn parse-int
+  "ParseInt interprets a string s in the given base (0, 2 to 36) and
+bit size (0 to 64) and returns the corresponding value i.
+
+The string may begin with a leading sign: \"+\" or \"-\".
+
+If the base argument is 0, the true base is implied by the string's
+prefix following the sign (if present): 2 for \"0b\", 8 for \"0\" or \"0o\",
+16 for \"0x\", and 10 otherwise. Also, for argument base 0 only,
+underscore characters are permitted as defined by the Go syntax for
+[integer literals].
+
+The bitSize argument specifies the integer type
+that the result must fit into. Bit sizes 0, 8, 16, 32, and 64
+correspond to int, int8, int16, int32, and int64.
+If bitSize is below 0 or above 64, an error is returned.
+
+The errors that ParseInt returns have concrete type [*NumError]
+and include err.Num = s. If s is empty or contains invalid
+digits, err.Err = [ErrSyntax] and the returned value is 0;
+if the value corresponding to s cannot be represented by a
+signed integer of the given size, err.Err = [ErrRange] and the
+returned value is the maximum magnitude integer of the
+appropriate bitSize and sign.
+
+[integer literals]: https://go.dev/ref/spec#Integer_literals"
+  {:arglists '([s base bit-size]) :go "strconv.ParseInt"}
+  [s base bit-size]
+  (lace.go.strconv/ParseInt s base bit-size))
+
+(defn parse-uint
+  "ParseUint is like [ParseInt] but for unsigned numbers.
+
+A sign prefix is not permitted."
+  {:arglists '([s base bit-size]) :go "strconv.ParseUint"}
+  [s base bit-size]
+  (lace.go.strconv/ParseUint s base bit-size))
+
+(defn print?
+  "IsPrint reports whether the rune is defined as printable by Go, with
+the same definition as [unicode.IsPrint]: letters, numbers, punctuation,
+symbols and ASCII space."
+  {:arglists '([r]) :go "strconv.IsPrint"}
+  [r]
+  (lace.go.strconv/IsPrint r))
+
+(defn quote
+  "Quote returns a double-quoted Go string literal representing s. The
+returned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100) for
+control characters and non-printable characters as defined by
+[IsPrint]."
+  {:arglists '([s]) :go "strconv.Quote"}
+  [s]
+  (lace.go.strconv/Quote s))
+
+(defn quote-rune
+  "QuoteRune returns a single-quoted Go character literal representing the
+rune. The returned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100)
+for control characters and non-printable characters as defined by [IsPrint].
+If r is not a valid Unicode code point, it is interpreted as the Unicode
+replacement character U+FFFD."
+  {:arglists '([r]) :go "strconv.QuoteRune"}
+  [r]
+  (lace.go.strconv/QuoteRune r))
+
+(defn quote-rune-to-ascii
+  "QuoteRuneToASCII returns a single-quoted Go character literal representing
+the rune. The returned string uses Go escape sequences (\\t, \\n, \\xFF,
+\\u0100) for non-ASCII characters and non-printable characters as defined
+by [IsPrint].
+If r is not a valid Unicode code point, it is interpreted as the Unicode
+replacement character U+FFFD."
+  {:arglists '([r]) :go "strconv.QuoteRuneToASCII"}
+  [r]
+  (lace.go.strconv/QuoteRuneToASCII r))
+
+(defn quote-rune-to-graphic
+  "QuoteRuneToGraphic returns a single-quoted Go character literal representing
+the rune. If the rune is not a Unicode graphic character,
+as defined by [IsGraphic], the returned string will use a Go escape sequence
+(\\t, \\n, \\xFF, \\u0100).
+If r is not a valid Unicode code point, it is interpreted as the Unicode
+replacement character U+FFFD."
+  {:arglists '([r]) :go "strconv.QuoteRuneToGraphic"}
+  [r]
+  (lace.go.strconv/QuoteRuneToGraphic r))
+
+(defn quote-to-ascii
+  "QuoteToASCII returns a double-quoted Go string literal representing s.
+The returned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100) for
+non-ASCII characters and non-printable characters as defined by [IsPrint]."
+  {:arglists '([s]) :go "strconv.QuoteToASCII"}
+  [s]
+  (lace.go.strconv/QuoteToASCII s))
+
+(defn quote-to-graphic
+  "QuoteToGraphic returns a double-quoted Go string literal representing s.
+The returned string leaves Unicode graphic characters, as defined by
+[IsGraphic], unchanged and uses Go escape sequences (\\t, \\n, \\xFF, \\u0100)
+for non-graphic characters."
+  {:arglists '([s]) :go "strconv.QuoteToGraphic"}
+  [s]
+  (lace.go.strconv/QuoteToGraphic s))
+
+(defn quoted-prefix
+  "QuotedPrefix returns the quoted string (as understood by [Unquote]) at the prefix of s.
+If s does not start with a valid quoted string, QuotedPrefix returns an error."
+  {:arglists '([s]) :go "strconv.QuotedPrefix"}
+  [s]
+  (lace.go.strconv/QuotedPrefix s))
+
+(defn unquote
+  "Unquote interprets s as a single-quoted, double-quoted,
+or backquoted Go string literal, returning the string value
+that s quotes.  (If s is single-quoted, it would be a Go
+character literal; Unquote returns the corresponding
+one-character string. For an empty character literal
+Unquote returns the empty string.)"
+  {:arglists '([s]) :go "strconv.Unquote"}
+  [s]
+  (lace.go.strconv/Unquote s))
+
+(defn unquote-char
+  "UnquoteChar decodes the first character or byte in the escaped string
+or character literal represented by the string s.
+It returns four values:
+
+ 1. value, the decoded Unicode code point or byte value;
+ 2. multibyte, a boolean indicating whether the decoded character requires a multibyte UTF-8 representation;
+ 3. tail, the remainder of the string after the character; and
+ 4. an error that will be nil if the character is syntactically valid.
+
+The second argument, quote, specifies the type of literal being parsed
+and therefore which escaped quote character is permitted.
+If set to a single quote, it permits the sequence \\' and disallows unescaped '.
+If set to a double quote, it permits \\\" and disallows unescaped \".
+If set to zero, it does not permit either escape and allows both quote characters to appear unescaped."
+  {:arglists '([s quote]) :go "strconv.UnquoteChar"}
+  [s quote]
+  (lace.go.strconv/UnquoteChar s quote))
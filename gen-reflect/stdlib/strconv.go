@@ -0,0 +1,107 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	strconv "strconv"
+)
+
+//go:embed strconv.clj
+var wrapper_strconv string
+
+func init() {
+	NumError_methods := map[string]pkgreflect.Func{}
+	NumError_methods["Error"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: ""}
+	NumError_methods["Unwrap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: ""}
+	pkgreflect.AddPackage("lace.go.strconv", &pkgreflect.Package{
+		Doc:       "Package strconv implements conversions to and from string representations of basic data types.",
+		WrapperNS: "go.strconv",
+		Wrapper:   wrapper_strconv,
+		Types: map[string]pkgreflect.Type{
+			"NumError": {Doc: "A NumError records a failed conversion.", Value: reflect.TypeOf((*strconv.NumError)(nil)).Elem(), Methods: NumError_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"AppendBool": {Doc: "AppendBool appends \"true\" or \"false\", according to the value of b,\nto dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "b", Tag: "bool"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendBool)},
+
+			"AppendFloat": {Doc: "AppendFloat appends the string form of the floating-point number f,\nas generated by [FormatFloat], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "f", Tag: "float64"}, {Name: "fmt", Tag: "byte"}, {Name: "prec", Tag: "int"}, {Name: "bitSize", Tag: "int"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendFloat)},
+
+			"AppendInt": {Doc: "AppendInt appends the string form of the integer i,\nas generated by [FormatInt], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "i", Tag: "int64"}, {Name: "base", Tag: "int"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendInt)},
+
+			"AppendQuote": {Doc: "AppendQuote appends a double-quoted Go string literal representing s,\nas generated by [Quote], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "s", Tag: "string"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuote)},
+
+			"AppendQuoteRune": {Doc: "AppendQuoteRune appends a single-quoted Go character literal representing the rune,\nas generated by [QuoteRune], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "r", Tag: "rune"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuoteRune)},
+
+			"AppendQuoteRuneToASCII": {Doc: "AppendQuoteRuneToASCII appends a single-quoted Go character literal representing the rune,\nas generated by [QuoteRuneToASCII], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "r", Tag: "rune"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuoteRuneToASCII)},
+
+			"AppendQuoteRuneToGraphic": {Doc: "AppendQuoteRuneToGraphic appends a single-quoted Go character literal representing the rune,\nas generated by [QuoteRuneToGraphic], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "r", Tag: "rune"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuoteRuneToGraphic)},
+
+			"AppendQuoteToASCII": {Doc: "AppendQuoteToASCII appends a double-quoted Go string literal representing s,\nas generated by [QuoteToASCII], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "s", Tag: "string"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuoteToASCII)},
+
+			"AppendQuoteToGraphic": {Doc: "AppendQuoteToGraphic appends a double-quoted Go string literal representing s,\nas generated by [QuoteToGraphic], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "s", Tag: "string"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendQuoteToGraphic)},
+
+			"AppendUint": {Doc: "AppendUint appends the string form of the unsigned integer i,\nas generated by [FormatUint], to dst and returns the extended buffer.", Args: []pkgreflect.Arg{{Name: "dst", Tag: "[]byte"}, {Name: "i", Tag: "uint64"}, {Name: "base", Tag: "int"}}, Tag: "[]byte", Value: reflect.ValueOf(strconv.AppendUint)},
+
+			"Atoi": {Doc: "Atoi is equivalent to ParseInt(s, 10, 0), converted to type int.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strconv.Atoi)},
+
+			"CanBackquote": {Doc: "CanBackquote reports whether the string s can be represented\nunchanged as a single-line backquoted string without control\ncharacters other than tab.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strconv.CanBackquote)},
+
+			"FormatBool": {Doc: "FormatBool returns \"true\" or \"false\" according to the value of b.", Args: []pkgreflect.Arg{{Name: "b", Tag: "bool"}}, Tag: "string", Value: reflect.ValueOf(strconv.FormatBool)},
+
+			"FormatComplex": {Doc: "FormatComplex converts the complex number c to a string of the\nform (a+bi) where a and b are the real and imaginary parts,\nformatted according to the format fmt and precision prec.\n\nThe format fmt and precision prec have the same meaning as in [FormatFloat].\nIt rounds the result assuming that the original was obtained from a complex\nvalue of bitSize bits, which must be 64 for complex64 and 128 for complex128.", Args: []pkgreflect.Arg{{Name: "c", Tag: "complex128"}, {Name: "fmt", Tag: "byte"}, {Name: "prec", Tag: "int"}, {Name: "bitSize", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strconv.FormatComplex)},
+
+			"FormatFloat": {Doc: "FormatFloat converts the floating-point number f to a string,\naccording to the format fmt and precision prec. It rounds the\nresult assuming that the original was obtained from a floating-point\nvalue of bitSize bits (32 for float32, 64 for float64).\n\nThe format fmt is one of\n  - 'b' (-ddddp±ddd, a binary exponent),\n  - 'e' (-d.dddde±dd, a decimal exponent),\n  - 'E' (-d.ddddE±dd, a decimal exponent),\n  - 'f' (-ddd.dddd, no exponent),\n  - 'g' ('e' for large exponents, 'f' otherwise),\n  - 'G' ('E' for large exponents, 'f' otherwise),\n  - 'x' (-0xd.ddddp±ddd, a hexadecimal fraction and binary exponent), or\n  - 'X' (-0Xd.ddddP±ddd, a hexadecimal fraction and binary exponent).\n\nThe precision prec controls the number of digits (excluding the exponent)\nprinted by the 'e', 'E', 'f', 'g', 'G', 'x', and 'X' formats.\nFor 'e', 'E', 'f', 'x', and 'X', it is the number of digits after the decimal point.\nFor 'g' and 'G' it is the maximum number of significant digits (trailing\nzeros are removed).\nThe special precision -1 uses the smallest number of digits\nnecessary such that ParseFloat will return f exactly.\nThe exponent is written as a decimal integer;\nfor all formats other than 'b', it will be at least two digits.", Args: []pkgreflect.Arg{{Name: "f", Tag: "float64"}, {Name: "fmt", Tag: "byte"}, {Name: "prec", Tag: "int"}, {Name: "bitSize", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strconv.FormatFloat)},
+
+			"FormatInt": {Doc: "FormatInt returns the string representation of i in the given base,\nfor 2 <= base <= 36. The result uses the lower-case letters 'a' to 'z'\nfor digit values >= 10.", Args: []pkgreflect.Arg{{Name: "i", Tag: "int64"}, {Name: "base", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strconv.FormatInt)},
+
+			"FormatUint": {Doc: "FormatUint returns the string representation of i in the given base,\nfor 2 <= base <= 36. The result uses the lower-case letters 'a' to 'z'\nfor digit values >= 10.", Args: []pkgreflect.Arg{{Name: "i", Tag: "uint64"}, {Name: "base", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strconv.FormatUint)},
+
+			"IsGraphic": {Doc: "IsGraphic reports whether the rune is defined as a Graphic by Unicode. Such\ncharacters include letters, marks, numbers, punctuation, symbols, and\nspaces, from categories L, M, N, P, S, and Zs.", Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "bool", Value: reflect.ValueOf(strconv.IsGraphic)},
+
+			"IsPrint": {Doc: "IsPrint reports whether the rune is defined as printable by Go, with\nthe same definition as [unicode.IsPrint]: letters, numbers, punctuation,\nsymbols and ASCII space.", Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "bool", Value: reflect.ValueOf(strconv.IsPrint)},
+
+			"Itoa": {Doc: "Itoa is equivalent to [FormatInt](int64(i), 10).", Args: []pkgreflect.Arg{{Name: "i", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strconv.Itoa)},
+
+			"ParseBool": {Doc: "ParseBool returns the boolean value represented by the string.\nIt accepts 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.\nAny other value returns an error.", Args: []pkgreflect.Arg{{Name: "str", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strconv.ParseBool)},
+
+			"ParseComplex": {Doc: "ParseComplex converts the string s to a complex number\nwith the precision specified by bitSize: 64 for complex64, or 128 for complex128.\nWhen bitSize=64, the result still has type complex128, but it will be\nconvertible to complex64 without changing its value.\n\nThe number represented by s must be of the form N, Ni, or N±Ni, where N stands\nfor a floating-point number as recognized by [ParseFloat], and i is the imaginary\ncomponent. If the second N is unsigned, a + sign is required between the two components\nas indicated by the ±. If the second N is NaN, only a + sign is accepted.\nThe form may be parenthesized and cannot contain any spaces.\nThe resulting complex number consists of the two components converted by ParseFloat.\n\nThe errors that ParseComplex returns have concrete type [*NumError]\nand include err.Num = s.\n\nIf s is not syntactically well-formed, ParseComplex returns err.Err = ErrSyntax.\n\nIf s is syntactically well-formed but either component is more than 1/2 ULP\naway from the largest floating point number of the given component's size,\nParseComplex returns err.Err = ErrRange and c = ±Inf for the respective component.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "bitSize", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(strconv.ParseComplex)},
+
+			"ParseFloat": {Doc: "ParseFloat converts the string s to a floating-point number\nwith the precision specified by bitSize: 32 for float32, or 64 for float64.\nWhen bitSize=32, the result still has type float64, but it will be\nconvertible to float32 without changing its value.\n\nParseFloat accepts decimal and hexadecimal floating-point numbers\nas defined by the Go syntax for [floating-point literals].\nIf s is well-formed and near a valid floating-point number,\nParseFloat returns the nearest floating-point number rounded\nusing IEEE754 unbiased rounding.\n(Parsing a hexadecimal floating-point value only rounds when\nthere are more bits in the hexadecimal representation than\nwill fit in the mantissa.)\n\nThe errors that ParseFloat returns have concrete type *NumError\nand include err.Num = s.\n\nIf s is not syntactically well-formed, ParseFloat returns err.Err = ErrSyntax.\n\nIf s is syntactically well-formed but is more than 1/2 ULP\naway from the largest floating point number of the given size,\nParseFloat returns f = ±Inf, err.Err = ErrRange.\n\nParseFloat recognizes the string \"NaN\", and the (possibly signed) strings \"Inf\" and \"Infinity\"\nas their respective special floating point values. It ignores case when matching.\n\n[floating-point literals]: https://go.dev/ref/spec#Floating-point_literals", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "bitSize", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(strconv.ParseFloat)},
+
+			"ParseInt": {Doc: "ParseInt interprets a string s in the given base (0, 2 to 36) and\nbit size (0 to 64) and returns the corresponding value i.\n\nThe string may begin with a leading sign: \"+\" or \"-\".\n\nIf the base argument is 0, the true base is implied by the string's\nprefix following the sign (if present): 2 for \"0b\", 8 for \"0\" or \"0o\",\n16 for \"0x\", and 10 otherwise. Also, for argument base 0 only,\nunderscore characters are permitted as defined by the Go syntax for\n[integer literals].\n\nThe bitSize argument specifies the integer type\nthat the result must fit into. Bit sizes 0, 8, 16, 32, and 64\ncorrespond to int, int8, int16, int32, and int64.\nIf bitSize is below 0 or above 64, an error is returned.\n\nThe errors that ParseInt returns have concrete type [*NumError]\nand include err.Num = s. If s is empty or contains invalid\ndigits, err.Err = [ErrSyntax] and the returned value is 0;\nif the value corresponding to s cannot be represented by a\nsigned integer of the given size, err.Err = [ErrRange] and the\nreturned value is the maximum magnitude integer of the\nappropriate bitSize and sign.\n\n[integer literals]: https://go.dev/ref/spec#Integer_literals", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "base", Tag: "int"}, {Name: "bitSize", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(strconv.ParseInt)},
+
+			"ParseUint": {Doc: "ParseUint is like [ParseInt] but for unsigned numbers.\n\nA sign prefix is not permitted.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "base", Tag: "int"}, {Name: "bitSize", Tag: "int"}}, Tag: "any", Value: reflect.ValueOf(strconv.ParseUint)},
+
+			"Quote": {Doc: "Quote returns a double-quoted Go string literal representing s. The\nreturned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100) for\ncontrol characters and non-printable characters as defined by\n[IsPrint].", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strconv.Quote)},
+
+			"QuoteRune": {Doc: "QuoteRune returns a single-quoted Go character literal representing the\nrune. The returned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100)\nfor control characters and non-printable characters as defined by [IsPrint].\nIf r is not a valid Unicode code point, it is interpreted as the Unicode\nreplacement character U+FFFD.", Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "string", Value: reflect.ValueOf(strconv.QuoteRune)},
+
+			"QuoteRuneToASCII": {Doc: "QuoteRuneToASCII returns a single-quoted Go character literal representing\nthe rune. The returned string uses Go escape sequences (\\t, \\n, \\xFF,\n\\u0100) for non-ASCII characters and non-printable characters as defined\nby [IsPrint].\nIf r is not a valid Unicode code point, it is interpreted as the Unicode\nreplacement character U+FFFD.", Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "string", Value: reflect.ValueOf(strconv.QuoteRuneToASCII)},
+
+			"QuoteRuneToGraphic": {Doc: "QuoteRuneToGraphic returns a single-quoted Go character literal representing\nthe rune. If the rune is not a Unicode graphic character,\nas defined by [IsGraphic], the returned string will use a Go escape sequence\n(\\t, \\n, \\xFF, \\u0100).\nIf r is not a valid Unicode code point, it is interpreted as the Unicode\nreplacement character U+FFFD.", Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "string", Value: reflect.ValueOf(strconv.QuoteRuneToGraphic)},
+
+			"QuoteToASCII": {Doc: "QuoteToASCII returns a double-quoted Go string literal representing s.\nThe returned string uses Go escape sequences (\\t, \\n, \\xFF, \\u0100) for\nnon-ASCII characters and non-printable characters as defined by [IsPrint].", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strconv.QuoteToASCII)},
+
+			"QuoteToGraphic": {Doc: "QuoteToGraphic returns a double-quoted Go string literal representing s.\nThe returned string leaves Unicode graphic characters, as defined by\n[IsGraphic], unchanged and uses Go escape sequences (\\t, \\n, \\xFF, \\u0100)\nfor non-graphic characters.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strconv.QuoteToGraphic)},
+
+			"QuotedPrefix": {Doc: "QuotedPrefix returns the quoted string (as understood by [Unquote]) at the prefix of s.\nIf s does not start with a valid quoted string, QuotedPrefix returns an error.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strconv.QuotedPrefix)},
+
+			"Unquote": {Doc: "Unquote interprets s as a single-quoted, double-quoted,\nor backquoted Go string literal, returning the string value\nthat s quotes.  (If s is single-quoted, it would be a Go\ncharacter literal; Unquote returns the corresponding\none-character string. For an empty character literal\nUnquote returns the empty string.)", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strconv.Unquote)},
+
+			"UnquoteChar": {Doc: "UnquoteChar decodes the first character or byte in the escaped string\nor character literal represented by the string s.\nIt returns four values:\n\n 1. value, the decoded Unicode code point or byte value;\n 2. multibyte, a boolean indicating whether the decoded character requires a multibyte UTF-8 representation;\n 3. tail, the remainder of the string after the character; and\n 4. an error that will be nil if the character is syntactically valid.\n\nThe second argument, quote, specifies the type of literal being parsed\nand therefore which escaped quote character is permitted.\nIf set to a single quote, it permits the sequence \\' and disallows unescaped '.\nIf set to a double quote, it permits \\\" and disallows unescaped \".\nIf set to zero, it does not permit either escape and allows both quote characters to appear unescaped.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "quote", Tag: "byte"}}, Tag: "any", Value: reflect.ValueOf(strconv.UnquoteChar)},
+		},
+
+		Variables: map[string]pkgreflect.Value{
+			"ErrRange":  {Doc: "", Value: reflect.ValueOf(&strconv.ErrRange)},
+			"ErrSyntax": {Doc: "", Value: reflect.ValueOf(&strconv.ErrSyntax)},
+		},
+
+		Consts: map[string]pkgreflect.Value{
+			"IntSize": {Doc: "", Value: reflect.ValueOf(strconv.IntSize)},
+		},
+	})
+}
@@ -0,0 +1,447 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.strings
+  "Package strings implements simple functions to manipulate UTF-8 encoded strings."
+  (:refer-clojure :exclude [clone compare contains contains-any contains-func contains-rune count cut cut-prefix cut-suffix equal-fold fields fields-func has-prefix? has-suffix? index index-any index-byte index-func index-rune join last-index last-index-any last-index-byte last-index-func map new-reader new-replacer repeat replace replace-all split split-after split-after-n split-n title to-lower to-lower-special to-title to-title-special to-upper to-upper-special to-valid-utf8 trim trim-func trim-left trim-left-func trim-prefix trim-right trim-right-func trim-space trim-suffix]))
+
+(defn clone
+  "Clone returns a fresh copy of s.
+It guarantees to make a copy of s into a new allocation,
+which can be important when retaining only a small substring
+of a much larger string. Using Clone can help such programs
+use less memory. Of course, since using Clone makes a copy,
+overuse of Clone can make programs use more memory.
+Clone should typically be used only rarely, and only when
+profiling indicates that it is needed.
+For strings of length zero the string \"\" will be returned
+and no allocation is made."
+  {:arglists '([s]) :go "strings.Clone"}
+  [s]
+  (lace.go.strings/Clone s))
+
+(defn compare
+  "Compare returns an integer comparing two strings lexicographically.
+The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+
+Use Compare when you need to perform a three-way comparison (with
+[slices.SortFunc], for example). It is usually clearer and always faster
+to use the built-in string comparison operators ==, <, >, and so on."
+  {:arglists '([a b]) :go "strings.Compare"}
+  [a b]
+  (lace.go.strings/Compare a b))
+
+(defn contains
+  "Contains reports whether substr is within s."
+  {:arglists '([s substr]) :go "strings.Contains"}
+  [s substr]
+  (lace.go.strings/Contains s substr))
+
+(defn contains-any
+  "ContainsAny reports whether any Unicode code points in chars are within s."
+  {:arglists '([s chars]) :go "strings.ContainsAny"}
+  [s chars]
+  (lace.go.strings/ContainsAny s chars))
+
+(defn contains-func
+  "ContainsFunc reports whether any Unicode code points r within s satisfy f(r).
+It stops as soon as a call to f returns true."
+  {:arglists '([s f]) :go "strings.ContainsFunc"}
+  [s f]
+  (lace.go.strings/ContainsFunc s f))
+
+(defn contains-rune
+  "ContainsRune reports whether the Unicode code point r is within s."
+  {:arglists '([s r]) :go "strings.ContainsRune"}
+  [s r]
+  (lace.go.strings/ContainsRune s r))
+
+(defn count
+  "Count counts the number of non-overlapping instances of substr in s.
+If substr is an empty string, Count returns 1 + the number of Unicode code points in s."
+  {:arglists '([s substr]) :go "strings.Count"}
+  [s substr]
+  (lace.go.strings/Count s substr))
+
+(defn cut
+  "Cut slices s around the first instance of sep,
+returning the text before and after sep.
+The found result reports whether sep appears in s.
+If sep does not appear in s, cut returns s, \"\", false."
+  {:arglists '([s sep]) :go "strings.Cut"}
+  [s sep]
+  (lace.go.strings/Cut s sep))
+
+(defn cut-prefix
+  "CutPrefix returns s without the provided leading prefix string
+and reports whether it found the prefix.
+If s doesn't start with prefix, CutPrefix returns s, false.
+If prefix is the empty string, CutPrefix returns s, true."
+  {:arglists '([s prefix]) :go "strings.CutPrefix"}
+  [s prefix]
+  (lace.core/let [[v ok] (lace.go.strings/CutPrefix s prefix)] (if ok v nil)))
+
+(defn cut-suffix
+  "CutSuffix returns s without the provided ending suffix string
+and reports whether it found the suffix.
+If s doesn't end with suffix, CutSuffix returns s, false.
+If suffix is the empty string, CutSuffix returns s, true."
+  {:arglists '([s suffix]) :go "strings.CutSuffix"}
+  [s suffix]
+  (lace.core/let [[v ok] (lace.go.strings/CutSuffix s suffix)] (if ok v nil)))
+
+(defn equal-fold
+  "EqualFold reports whether s and t, interpreted as UTF-8 strings,
+are equal under simple Unicode case-folding, which is a more general
+form of case-insensitivity."
+  {:arglists '([s t]) :go "strings.EqualFold"}
+  [s t]
+  (lace.go.strings/EqualFold s t))
+
+(defn fields
+  "Fields splits the string s around each instance of one or more consecutive white space
+characters, as defined by [unicode.IsSpace], returning a slice of substrings of s or an
+empty slice if s contains only white space. Every element of the returned slice is
+non-empty. Unlike [Split], leading and trailing runs of white space characters
+are discarded."
+  {:arglists '([s]) :go "strings.Fields"}
+  [s]
+  (lace.go.strings/Fields s))
+
+(defn fields-func
+  "FieldsFunc splits the string s at each run of Unicode code points c satisfying f(c)
+and returns an array of slices of s. If all code points in s satisfy f(c) or the
+string is empty, an empty slice is returned. Every element of the returned slice is
+non-empty. Unlike [Split], leading and trailing runs of code points satisfying f(c)
+are discarded.
+
+FieldsFunc makes no guarantees about the order in which it calls f(c)
+and assumes that f always returns the same value for a given c."
+  {:arglists '([s f]) :go "strings.FieldsFunc"}
+  [s f]
+  (lace.go.strings/FieldsFunc s f))
+
+(defn has-prefix?
+  "HasPrefix reports whether the string s begins with prefix."
+  {:arglists '([s prefix]) :go "strings.HasPrefix"}
+  [s prefix]
+  (lace.go.strings/HasPrefix s prefix))
+
+(defn has-suffix?
+  "HasSuffix reports whether the string s ends with suffix."
+  {:arglists '([s suffix]) :go "strings.HasSuffix"}
+  [s suffix]
+  (lace.go.strings/HasSuffix s suffix))
+
+(defn index
+  "Index returns the index of the first instance of substr in s, or -1 if substr is not present in s."
+  {:arglists '([s substr]) :go "strings.Index"}
+  [s substr]
+  (lace.go.strings/Index s substr))
+
+(defn index-any
+  "IndexAny returns the index of the first instance of any Unicode code point
+from chars in s, or -1 if no Unicode code point from chars is present in s."
+  {:arglists '([s chars]) :go "strings.IndexAny"}
+  [s chars]
+  (lace.go.strings/IndexAny s chars))
+
+(defn index-byte
+  "IndexByte returns the index of the first instance of c in s, or -1 if c is not present in s."
+  {:arglists '([s c]) :go "strings.IndexByte"}
+  [s c]
+  (lace.go.strings/IndexByte s c))
+
+(defn index-func
+  "IndexFunc returns the index into s of the first Unicode
+code point satisfying f(c), or -1 if none do."
+  {:arglists '([s f]) :go "strings.IndexFunc"}
+  [s f]
+  (lace.go.strings/IndexFunc s f))
+
+(defn index-rune
+  "IndexRune returns the index of the first instance of the Unicode code point
+r, or -1 if rune is not present in s.
+If r is [utf8.RuneError], it returns the first instance of any
+invalid UTF-8 byte sequence."
+  {:arglists '([s r]) :go "strings.IndexRune"}
+  [s r]
+  (lace.go.strings/IndexRune s r))
+
+(defn join
+  "Join concatenates the elements of its first argument to create a single string. The separator
+string sep is placed between elements in the resulting string."
+  {:arglists '([elems sep]) :go "strings.Join"}
+  [elems sep]
+  (lace.go.strings/Join elems sep))
+
+(defn last-index
+  "LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s."
+  {:arglists '([s substr]) :go "strings.LastIndex"}
+  [s substr]
+  (lace.go.strings/LastIndex s substr))
+
+(defn last-index-any
+  "LastIndexAny returns the index of the last instance of any Unicode code
+point from chars in s, or -1 if no Unicode code point from chars is
+present in s."
+  {:arglists '([s chars]) :go "strings.LastIndexAny"}
+  [s chars]
+  (lace.go.strings/LastIndexAny s chars))
+
+(defn last-index-byte
+  "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s."
+  {:arglists '([s c]) :go "strings.LastIndexByte"}
+  [s c]
+  (lace.go.strings/LastIndexByte s c))
+
+(defn last-index-func
+  "LastIndexFunc returns the index into s of the last
+Unicode code point satisfying f(c), or -1 if none do."
+  {:arglists '([s f]) :go "strings.LastIndexFunc"}
+  [s f]
+  (lace.go.strings/LastIndexFunc s f))
+
+(defn map
+  "Map returns a copy of the string s with all its characters modified
+according to the mapping function. If mapping returns a negative value, the character is
+dropped from the string with no replacement."
+  {:arglists '([mapping s]) :go "strings.Map"}
+  [mapping s]
+  (lace.go.strings/Map mapping s))
+
+(defn new-reader
+  "NewReader returns a new [Reader] reading from s.
+It is similar to [bytes.NewBufferString] but more efficient and non-writable."
+  {:arglists '([s]) :go "strings.NewReader"}
+  [s]
+  (lace.go.strings/NewReader s))
+
+(defn new-replacer
+  "NewReplacer returns a new [Replacer] from a list of old, new string
+pairs. Replacements are performed in the order they appear in the
+target string, without overlapping matches. The old string
+comparisons are done in argument order.
+
+NewReplacer panics if given an odd number of arguments."
+  {:arglists '([& oldnew]) :go "strings.NewReplacer"}
+  [& oldnew]
+  (lace.go.strings/NewReplacer (lace.core/vec oldnew)))
+
+(defn repeat
+  "Repeat returns a new string consisting of count copies of the string s.
+
+It panics if count is negative or if the result of (len(s) * count)
+overflows."
+  {:arglists '([s count]) :go "strings.Repeat"}
+  [s count]
+  (lace.go.strings/Repeat s count))
+
+(defn replace
+  "Replace returns a copy of the string s with the first n
+non-overlapping instances of old replaced by new.
+If old is empty, it matches at the beginning of the string
+and after each UTF-8 sequence, yielding up to k+1 replacements
+for a k-rune string.
+If n < 0, there is no limit on the number of replacements."
+  {:arglists '([s old new n]) :go "strings.Replace"}
+  [s old new n]
+  (lace.go.strings/Replace s old new n))
+
+(defn replace-all
+  "ReplaceAll returns a copy of the string s with all
+non-overlapping instances of old replaced by new.
+If old is empty, it matches at the beginning of the string
+and after each UTF-8 sequence, yielding up to k+1 replacements
+for a k-rune string."
+  {:arglists '([s old new]) :go "strings.ReplaceAll"}
+  [s old new]
+  (lace.go.strings/ReplaceAll s old new))
+
+(defn split
+  "Split slices s into all substrings separated by sep and returns a slice of
+the substrings between those separators.
+
+If s does not contain sep and sep is not empty, Split returns a
+slice of length 1 whose only element is s.
+
+If sep is empty, Split splits after each UTF-8 sequence. If both s
+and sep are empty, Split returns an empty slice.
+
+It is equivalent to [SplitN] with a count of -1.
+
+To split around the first instance of a separator, see [Cut]."
+  {:arglists '([s sep]) :go "strings.Split"}
+  [s sep]
+  (lace.go.strings/Split s sep))
+
+(defn split-after
+  "SplitAfter slices s into all substrings after each instance of sep and
+returns a slice of those substrings.
+
+If s does not contain sep and sep is not empty, SplitAfter returns
+a slice of length 1 whose only element is s.
+
+If sep is empty, SplitAfter splits after each UTF-8 sequence. If
+both s and sep are empty, SplitAfter returns an empty slice.
+
+It is equivalent to [SplitAfterN] with a count of -1."
+  {:arglists '([s sep]) :go "strings.SplitAfter"}
+  [s sep]
+  (lace.go.strings/SplitAfter s sep))
+
+(defn split-after-n
+  "SplitAfterN slices s into substrings after each instance of sep and
+returns a slice of those substrings.
+
+The count determines the number of substrings to return:
+  - n > 0: at most n substrings; the last substring will be the unsplit remainder;
+  - n == 0: the result is nil (zero substrings);
+  - n < 0: all substrings.
+
+Edge cases for s and sep (for example, empty strings) are handled
+as described in the documentation for [SplitAfter]."
+  {:arglists '([s sep n]) :go "strings.SplitAfterN"}
+  [s sep n]
+  (lace.go.strings/SplitAfterN s sep n))
+
+(defn split-n
+  "SplitN slices s into substrings separated by sep and returns a slice of
+the substrings between those separators.
+
+The count determines the number of substrings to return:
+  - n > 0: at most n substrings; the last substring will be the unsplit remainder;
+  - n == 0: the result is nil (zero substrings);
+  - n < 0: all substrings.
+
+Edge cases for s and sep (for example, empty strings) are handled
+as described in the documentation for [Split].
+
+To split around the first instance of a separator, see [Cut]."
+  {:arglists '([s sep n]) :go "strings.SplitN"}
+  [s sep n]
+  (lace.go.strings/SplitN s sep n))
+
+(defn title
+  "Title returns a copy of the string s with all Unicode letters that begin words
+mapped to their Unicode title case.
+
+Deprecated: The rule Title uses for word boundaries does not handle Unicode
+punctuation properly. Use golang.org/x/text/cases instead."
+  {:arglists '([s]) :go "strings.Title"}
+  [s]
+  (lace.go.strings/Title s))
+
+(defn to-lower
+  "ToLower returns s with all Unicode letters mapped to their lower case."
+  {:arglists '([s]) :go "strings.ToLower"}
+  [s]
+  (lace.go.strings/ToLower s))
+
+(defn to-lower-special
+  "ToLowerSpecial returns a copy of the string s with all Unicode letters mapped to their
+lower case using the case mapping specified by c."
+  {:arglists '([c s]) :go "strings.ToLowerSpecial"}
+  [c s]
+  (lace.go.strings/ToLowerSpecial c s))
+
+(defn to-title
+  "ToTitle returns a copy of the string s with all Unicode letters mapped to
+their Unicode title case."
+  {:arglists '([s]) :go "strings.ToTitle"}
+  [s]
+  (lace.go.strings/ToTitle s))
+
+(defn to-title-special
+  "ToTitleSpecial returns a copy of the string s with all Unicode letters mapped to their
+Unicode title case, giving priority to the special casing rules."
+  {:arglists '([c s]) :go "strings.ToTitleSpecial"}
+  [c s]
+  (lace.go.strings/ToTitleSpecial c s))
+
+(defn to-upper
+  "ToUpper returns s with all Unicode letters mapped to their upper case."
+  {:arglists '([s]) :go "strings.ToUpper"}
+  [s]
+  (lace.go.strings/ToUpper s))
+
+(defn to-upper-special
+  "ToUpperSpecial returns a copy of the string s with all Unicode letters mapped to their
+upper case using the case mapping specified by c."
+  {:arglists '([c s]) :go "strings.ToUpperSpecial"}
+  [c s]
+  (lace.go.strings/ToUpperSpecial c s))
+
+(defn to-valid-utf8
+  "ToValidUTF8 returns a copy of the string s with each run of invalid UTF-8 byte sequences
+replaced by the replacement string, which may be empty."
+  {:arglists '([s replacement]) :go "strings.ToValidUTF8"}
+  [s replacement]
+  (lace.go.strings/ToValidUTF8 s replacement))
+
+(defn trim
+  "Trim returns a slice of the string s with all leading and
+trailing Unicode code points contained in cutset removed."
+  {:arglists '([s cutset]) :go "strings.Trim"}
+  [s cutset]
+  (lace.go.strings/Trim s cutset))
+
+(defn trim-func
+  "TrimFunc returns a slice of the string s with all leading
+and trailing Unicode code points c satisfying f(c) removed."
+  {:arglists '([s f]) :go "strings.TrimFunc"}
+  [s f]
+  (lace.go.strings/TrimFunc s f))
+
+(defn trim-left
+  "TrimLeft returns a slice of the string s with all leading
+Unicode code points contained in cutset removed.
+
+To remove a prefix, use [TrimPrefix] instead."
+  {:arglists '([s cutset]) :go "strings.TrimLeft"}
+  [s cutset]
+  (lace.go.strings/TrimLeft s cutset))
+
+(defn trim-left-func
+  "TrimLeftFunc returns a slice of the string s with all leading
+Unicode code points c satisfying f(c) removed."
+  {:arglists '([s f]) :go "strings.TrimLeftFunc"}
+  [s f]
+  (lace.go.strings/TrimLeftFunc s f))
+
+(defn trim-prefix
+  "TrimPrefix returns s without the provided leading prefix string.
+If s doesn't start with prefix, s is returned unchanged."
+  {:arglists '([s prefix]) :go "strings.TrimPrefix"}
+  [s prefix]
+  (lace.go.strings/TrimPrefix s prefix))
+
+(defn trim-right
+  "TrimRight returns a slice of the string s, with all trailing
+Unicode code points contained in cutset removed.
+
+To remove a suffix, use [TrimSuffix] instead."
+  {:arglists '([s cutset]) :go "strings.TrimRight"}
+  [s cutset]
+  (lace.go.strings/TrimRight s cutset))
+
+(defn trim-right-func
+  "TrimRightFunc returns a slice of the string s with all trailing
+Unicode code points c satisfying f(c) removed."
+  {:arglists '([s f]) :go "strings.TrimRightFunc"}
+  [s f]
+  (lace.go.strings/TrimRightFunc s f))
+
+(defn trim-space
+  "TrimSpace returns a slice (substring) of the string s,
+with all leading and trailing white space removed,
+as defined by Unicode."
+  {:arglists '([s]) :go "strings.TrimSpace"}
+  [s]
+  (lace.go.strings/TrimSpace s))
+
+(defn trim-suffix
+  "TrimSuffix returns s without the provided trailing suffix string.
+If s doesn't end with suffix, s is returned unchanged."
+  {:arglists '([s suffix]) :go "strings.TrimSuffix"}
+  [s suffix]
+  (lace.go.strings/TrimSuffix s suffix))
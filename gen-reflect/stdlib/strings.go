@@ -0,0 +1,162 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	strings "strings"
+)
+
+//go:embed strings.clj
+var wrapper_strings string
+
+func init() {
+	Builder_methods := map[string]pkgreflect.Func{}
+	Reader_methods := map[string]pkgreflect.Func{}
+	Replacer_methods := map[string]pkgreflect.Func{}
+	Builder_methods["String"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "string", Doc: "String returns the accumulated string."}
+	Builder_methods["Len"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Len returns the number of accumulated bytes; b.Len() == len(b.String())."}
+	Builder_methods["Cap"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Cap returns the capacity of the builder's underlying byte slice. It is the\ntotal space allocated for the string being built and includes any bytes\nalready written."}
+	Builder_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Reset resets the [Builder] to be empty."}
+	Builder_methods["Grow"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "n", Tag: "int"}}, Tag: "any", Doc: "Grow grows b's capacity, if necessary, to guarantee space for\nanother n bytes. After Grow(n), at least n bytes can be written to b\nwithout another allocation. If n is negative, Grow panics."}
+	Builder_methods["Write"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "p", Tag: "[]byte"}}, Tag: "any", Doc: "Write appends the contents of p to b's buffer.\nWrite always returns len(p), nil."}
+	Builder_methods["WriteByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "c", Tag: "byte"}}, Tag: "error", Doc: "WriteByte appends the byte c to b's buffer.\nThe returned error is always nil."}
+	Builder_methods["WriteRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "r", Tag: "rune"}}, Tag: "any", Doc: "WriteRune appends the UTF-8 encoding of Unicode code point r to b's buffer.\nIt returns the number of bytes written and a nil error."}
+	Builder_methods["WriteString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Doc: "WriteString appends the contents of s to b's buffer.\nIt returns the length of s and a nil error."}
+	Reader_methods["Len"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int", Doc: "Len returns the number of bytes of the unread portion of the\nstring."}
+	Reader_methods["Size"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "int64", Doc: "Size returns the original length of the underlying string.\nSize is the number of bytes available for reading via [Reader.ReadAt].\nThe returned value is always the same and is not affected by calls\nto any other method."}
+	Reader_methods["Read"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}}, Tag: "any", Doc: "Read implements the [io.Reader] interface."}
+	Reader_methods["ReadAt"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "b", Tag: "[]byte"}, {Name: "off", Tag: "int64"}}, Tag: "any", Doc: "ReadAt implements the [io.ReaderAt] interface."}
+	Reader_methods["ReadByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadByte implements the [io.ByteReader] interface."}
+	Reader_methods["UnreadByte"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "UnreadByte implements the [io.ByteScanner] interface."}
+	Reader_methods["ReadRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "ReadRune implements the [io.RuneReader] interface."}
+	Reader_methods["UnreadRune"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "error", Doc: "UnreadRune implements the [io.RuneScanner] interface."}
+	Reader_methods["Seek"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "offset", Tag: "int64"}, {Name: "whence", Tag: "int"}}, Tag: "any", Doc: "Seek implements the [io.Seeker] interface."}
+	Reader_methods["WriteTo"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}}, Tag: "any", Doc: "WriteTo implements the [io.WriterTo] interface."}
+	Reader_methods["Reset"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "any", Doc: "Reset resets the [Reader] to be reading from s."}
+	Replacer_methods["Replace"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Doc: "Replace returns a copy of s with all replacements performed."}
+	Replacer_methods["WriteString"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "w", Tag: "io.Writer"}, {Name: "s", Tag: "string"}}, Tag: "any", Doc: "WriteString writes s to w with all replacements performed."}
+	pkgreflect.AddPackage("lace.go.strings", &pkgreflect.Package{
+		Doc:       "Package strings implements simple functions to manipulate UTF-8 encoded strings.",
+		WrapperNS: "go.strings",
+		Wrapper:   wrapper_strings,
+		Types: map[string]pkgreflect.Type{
+			"Builder":  {Doc: "A Builder is used to efficiently build a string using [Builder.Write] methods.\nIt minimizes memory copying. The zero value is ready to use.\nDo not copy a non-zero Builder.", Value: reflect.TypeOf((*strings.Builder)(nil)).Elem(), Methods: Builder_methods},
+			"Reader":   {Doc: "A Reader implements the [io.Reader], [io.ReaderAt], [io.ByteReader], [io.ByteScanner],\n[io.RuneReader], [io.RuneScanner], [io.Seeker], and [io.WriterTo] interfaces by reading\nfrom a string.\nThe zero value for Reader operates like a Reader of an empty string.", Value: reflect.TypeOf((*strings.Reader)(nil)).Elem(), Methods: Reader_methods},
+			"Replacer": {Doc: "Replacer replaces a list of strings with replacements.\nIt is safe for concurrent use by multiple goroutines.", Value: reflect.TypeOf((*strings.Replacer)(nil)).Elem(), Methods: Replacer_methods},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"Clone": {Doc: "Clone returns a fresh copy of s.\nIt guarantees to make a copy of s into a new allocation,\nwhich can be important when retaining only a small substring\nof a much larger string. Using Clone can help such programs\nuse less memory. Of course, since using Clone makes a copy,\noveruse of Clone can make programs use more memory.\nClone should typically be used only rarely, and only when\nprofiling indicates that it is needed.\nFor strings of length zero the string \"\" will be returned\nand no allocation is made.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.Clone)},
+
+			"Compare": {Doc: "Compare returns an integer comparing two strings lexicographically.\nThe result will be 0 if a == b, -1 if a < b, and +1 if a > b.\n\nUse Compare when you need to perform a three-way comparison (with\n[slices.SortFunc], for example). It is usually clearer and always faster\nto use the built-in string comparison operators ==, <, >, and so on.", Args: []pkgreflect.Arg{{Name: "a", Tag: "string"}, {Name: "b", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.Compare)},
+
+			"Contains": {Doc: "Contains reports whether substr is within s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "substr", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strings.Contains)},
+
+			"ContainsAny": {Doc: "ContainsAny reports whether any Unicode code points in chars are within s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "chars", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strings.ContainsAny)},
+
+			"ContainsFunc": {Doc: "ContainsFunc reports whether any Unicode code points r within s satisfy f(r).\nIt stops as soon as a call to f returns true.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "bool", Value: reflect.ValueOf(strings.ContainsFunc)},
+
+			"ContainsRune": {Doc: "ContainsRune reports whether the Unicode code point r is within s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "r", Tag: "rune"}}, Tag: "bool", Value: reflect.ValueOf(strings.ContainsRune)},
+
+			"Count": {Doc: "Count counts the number of non-overlapping instances of substr in s.\nIf substr is an empty string, Count returns 1 + the number of Unicode code points in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "substr", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.Count)},
+
+			"Cut": {Doc: "Cut slices s around the first instance of sep,\nreturning the text before and after sep.\nThe found result reports whether sep appears in s.\nIf sep does not appear in s, cut returns s, \"\", false.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "sep", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strings.Cut)},
+
+			"CutPrefix": {Doc: "CutPrefix returns s without the provided leading prefix string\nand reports whether it found the prefix.\nIf s doesn't start with prefix, CutPrefix returns s, false.\nIf prefix is the empty string, CutPrefix returns s, true.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "prefix", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strings.CutPrefix)},
+
+			"CutSuffix": {Doc: "CutSuffix returns s without the provided ending suffix string\nand reports whether it found the suffix.\nIf s doesn't end with suffix, CutSuffix returns s, false.\nIf suffix is the empty string, CutSuffix returns s, true.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "suffix", Tag: "string"}}, Tag: "any", Value: reflect.ValueOf(strings.CutSuffix)},
+
+			"EqualFold": {Doc: "EqualFold reports whether s and t, interpreted as UTF-8 strings,\nare equal under simple Unicode case-folding, which is a more general\nform of case-insensitivity.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "t", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strings.EqualFold)},
+
+			"Fields": {Doc: "Fields splits the string s around each instance of one or more consecutive white space\ncharacters, as defined by [unicode.IsSpace], returning a slice of substrings of s or an\nempty slice if s contains only white space. Every element of the returned slice is\nnon-empty. Unlike [Split], leading and trailing runs of white space characters\nare discarded.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "[]string", Value: reflect.ValueOf(strings.Fields)},
+
+			"FieldsFunc": {Doc: "FieldsFunc splits the string s at each run of Unicode code points c satisfying f(c)\nand returns an array of slices of s. If all code points in s satisfy f(c) or the\nstring is empty, an empty slice is returned. Every element of the returned slice is\nnon-empty. Unlike [Split], leading and trailing runs of code points satisfying f(c)\nare discarded.\n\nFieldsFunc makes no guarantees about the order in which it calls f(c)\nand assumes that f always returns the same value for a given c.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "[]string", Value: reflect.ValueOf(strings.FieldsFunc)},
+
+			"HasPrefix": {Doc: "HasPrefix reports whether the string s begins with prefix.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "prefix", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strings.HasPrefix)},
+
+			"HasSuffix": {Doc: "HasSuffix reports whether the string s ends with suffix.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "suffix", Tag: "string"}}, Tag: "bool", Value: reflect.ValueOf(strings.HasSuffix)},
+
+			"Index": {Doc: "Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "substr", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.Index)},
+
+			"IndexAny": {Doc: "IndexAny returns the index of the first instance of any Unicode code point\nfrom chars in s, or -1 if no Unicode code point from chars is present in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "chars", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.IndexAny)},
+
+			"IndexByte": {Doc: "IndexByte returns the index of the first instance of c in s, or -1 if c is not present in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "c", Tag: "byte"}}, Tag: "int", Value: reflect.ValueOf(strings.IndexByte)},
+
+			"IndexFunc": {Doc: "IndexFunc returns the index into s of the first Unicode\ncode point satisfying f(c), or -1 if none do.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "int", Value: reflect.ValueOf(strings.IndexFunc)},
+
+			"IndexRune": {Doc: "IndexRune returns the index of the first instance of the Unicode code point\nr, or -1 if rune is not present in s.\nIf r is [utf8.RuneError], it returns the first instance of any\ninvalid UTF-8 byte sequence.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "r", Tag: "rune"}}, Tag: "int", Value: reflect.ValueOf(strings.IndexRune)},
+
+			"Join": {Doc: "Join concatenates the elements of its first argument to create a single string. The separator\nstring sep is placed between elements in the resulting string.", Args: []pkgreflect.Arg{{Name: "elems", Tag: "[]string"}, {Name: "sep", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.Join)},
+
+			"LastIndex": {Doc: "LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "substr", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.LastIndex)},
+
+			"LastIndexAny": {Doc: "LastIndexAny returns the index of the last instance of any Unicode code\npoint from chars in s, or -1 if no Unicode code point from chars is\npresent in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "chars", Tag: "string"}}, Tag: "int", Value: reflect.ValueOf(strings.LastIndexAny)},
+
+			"LastIndexByte": {Doc: "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "c", Tag: "byte"}}, Tag: "int", Value: reflect.ValueOf(strings.LastIndexByte)},
+
+			"LastIndexFunc": {Doc: "LastIndexFunc returns the index into s of the last\nUnicode code point satisfying f(c), or -1 if none do.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "int", Value: reflect.ValueOf(strings.LastIndexFunc)},
+
+			"Map": {Doc: "Map returns a copy of the string s with all its characters modified\naccording to the mapping function. If mapping returns a negative value, the character is\ndropped from the string with no replacement.", Args: []pkgreflect.Arg{{Name: "mapping", Tag: "Unknown"}, {Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.Map)},
+
+			"NewReader": {Doc: "NewReader returns a new [Reader] reading from s.\nIt is similar to [bytes.NewBufferString] but more efficient and non-writable.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "Reader", Value: reflect.ValueOf(strings.NewReader)},
+
+			"NewReplacer": {Doc: "NewReplacer returns a new [Replacer] from a list of old, new string\npairs. Replacements are performed in the order they appear in the\ntarget string, without overlapping matches. The old string\ncomparisons are done in argument order.\n\nNewReplacer panics if given an odd number of arguments.", Args: []pkgreflect.Arg{{Name: "oldnew", Tag: "Unknown"}}, Tag: "Replacer", Value: reflect.ValueOf(strings.NewReplacer)},
+
+			"Repeat": {Doc: "Repeat returns a new string consisting of count copies of the string s.\n\nIt panics if count is negative or if the result of (len(s) * count)\noverflows.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "count", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strings.Repeat)},
+
+			"Replace": {Doc: "Replace returns a copy of the string s with the first n\nnon-overlapping instances of old replaced by new.\nIf old is empty, it matches at the beginning of the string\nand after each UTF-8 sequence, yielding up to k+1 replacements\nfor a k-rune string.\nIf n < 0, there is no limit on the number of replacements.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "old", Tag: "string"}, {Name: "new", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "string", Value: reflect.ValueOf(strings.Replace)},
+
+			"ReplaceAll": {Doc: "ReplaceAll returns a copy of the string s with all\nnon-overlapping instances of old replaced by new.\nIf old is empty, it matches at the beginning of the string\nand after each UTF-8 sequence, yielding up to k+1 replacements\nfor a k-rune string.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "old", Tag: "string"}, {Name: "new", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ReplaceAll)},
+
+			"Split": {Doc: "Split slices s into all substrings separated by sep and returns a slice of\nthe substrings between those separators.\n\nIf s does not contain sep and sep is not empty, Split returns a\nslice of length 1 whose only element is s.\n\nIf sep is empty, Split splits after each UTF-8 sequence. If both s\nand sep are empty, Split returns an empty slice.\n\nIt is equivalent to [SplitN] with a count of -1.\n\nTo split around the first instance of a separator, see [Cut].", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "sep", Tag: "string"}}, Tag: "[]string", Value: reflect.ValueOf(strings.Split)},
+
+			"SplitAfter": {Doc: "SplitAfter slices s into all substrings after each instance of sep and\nreturns a slice of those substrings.\n\nIf s does not contain sep and sep is not empty, SplitAfter returns\na slice of length 1 whose only element is s.\n\nIf sep is empty, SplitAfter splits after each UTF-8 sequence. If\nboth s and sep are empty, SplitAfter returns an empty slice.\n\nIt is equivalent to [SplitAfterN] with a count of -1.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "sep", Tag: "string"}}, Tag: "[]string", Value: reflect.ValueOf(strings.SplitAfter)},
+
+			"SplitAfterN": {Doc: "SplitAfterN slices s into substrings after each instance of sep and\nreturns a slice of those substrings.\n\nThe count determines the number of substrings to return:\n  - n > 0: at most n substrings; the last substring will be the unsplit remainder;\n  - n == 0: the result is nil (zero substrings);\n  - n < 0: all substrings.\n\nEdge cases for s and sep (for example, empty strings) are handled\nas described in the documentation for [SplitAfter].", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "sep", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[]string", Value: reflect.ValueOf(strings.SplitAfterN)},
+
+			"SplitN": {Doc: "SplitN slices s into substrings separated by sep and returns a slice of\nthe substrings between those separators.\n\nThe count determines the number of substrings to return:\n  - n > 0: at most n substrings; the last substring will be the unsplit remainder;\n  - n == 0: the result is nil (zero substrings);\n  - n < 0: all substrings.\n\nEdge cases for s and sep (for example, empty strings) are handled\nas described in the documentation for [Split].\n\nTo split around the first instance of a separator, see [Cut].", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "sep", Tag: "string"}, {Name: "n", Tag: "int"}}, Tag: "[]string", Value: reflect.ValueOf(strings.SplitN)},
+
+			"Title": {Doc: "Title returns a copy of the string s with all Unicode letters that begin words\nmapped to their Unicode title case.\n\nDeprecated: The rule Title uses for word boundaries does not handle Unicode\npunctuation properly. Use golang.org/x/text/cases instead.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.Title)},
+
+			"ToLower": {Doc: "ToLower returns s with all Unicode letters mapped to their lower case.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToLower)},
+
+			"ToLowerSpecial": {Doc: "ToLowerSpecial returns a copy of the string s with all Unicode letters mapped to their\nlower case using the case mapping specified by c.", Args: []pkgreflect.Arg{{Name: "c", Tag: "unicode.SpecialCase"}, {Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToLowerSpecial)},
+
+			"ToTitle": {Doc: "ToTitle returns a copy of the string s with all Unicode letters mapped to\ntheir Unicode title case.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToTitle)},
+
+			"ToTitleSpecial": {Doc: "ToTitleSpecial returns a copy of the string s with all Unicode letters mapped to their\nUnicode title case, giving priority to the special casing rules.", Args: []pkgreflect.Arg{{Name: "c", Tag: "unicode.SpecialCase"}, {Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToTitleSpecial)},
+
+			"ToUpper": {Doc: "ToUpper returns s with all Unicode letters mapped to their upper case.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToUpper)},
+
+			"ToUpperSpecial": {Doc: "ToUpperSpecial returns a copy of the string s with all Unicode letters mapped to their\nupper case using the case mapping specified by c.", Args: []pkgreflect.Arg{{Name: "c", Tag: "unicode.SpecialCase"}, {Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToUpperSpecial)},
+
+			"ToValidUTF8": {Doc: "ToValidUTF8 returns a copy of the string s with each run of invalid UTF-8 byte sequences\nreplaced by the replacement string, which may be empty.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "replacement", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.ToValidUTF8)},
+
+			"Trim": {Doc: "Trim returns a slice of the string s with all leading and\ntrailing Unicode code points contained in cutset removed.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "cutset", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.Trim)},
+
+			"TrimFunc": {Doc: "TrimFunc returns a slice of the string s with all leading\nand trailing Unicode code points c satisfying f(c) removed.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimFunc)},
+
+			"TrimLeft": {Doc: "TrimLeft returns a slice of the string s with all leading\nUnicode code points contained in cutset removed.\n\nTo remove a prefix, use [TrimPrefix] instead.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "cutset", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimLeft)},
+
+			"TrimLeftFunc": {Doc: "TrimLeftFunc returns a slice of the string s with all leading\nUnicode code points c satisfying f(c) removed.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimLeftFunc)},
+
+			"TrimPrefix": {Doc: "TrimPrefix returns s without the provided leading prefix string.\nIf s doesn't start with prefix, s is returned unchanged.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "prefix", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimPrefix)},
+
+			"TrimRight": {Doc: "TrimRight returns a slice of the string s, with all trailing\nUnicode code points contained in cutset removed.\n\nTo remove a suffix, use [TrimSuffix] instead.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "cutset", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimRight)},
+
+			"TrimRightFunc": {Doc: "TrimRightFunc returns a slice of the string s with all trailing\nUnicode code points c satisfying f(c) removed.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "f", Tag: "Unknown"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimRightFunc)},
+
+			"TrimSpace": {Doc: "TrimSpace returns a slice (substring) of the string s,\nwith all leading and trailing white space removed,\nas defined by Unicode.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimSpace)},
+
+			"TrimSuffix": {Doc: "TrimSuffix returns s without the provided trailing suffix string.\nIf s doesn't end with suffix, s is returned unchanged.", Args: []pkgreflect.Arg{{Name: "s", Tag: "string"}, {Name: "suffix", Tag: "string"}}, Tag: "string", Value: reflect.ValueOf(strings.TrimSuffix)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
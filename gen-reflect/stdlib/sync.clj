@@ -0,0 +1,20 @@
+;; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+
+(ns go.sync
+  "Package sync provides basic synchronization primitives such as mutual exclusion locks."
+  (:refer-clojure :exclude [new-cond once-func]))
+
+(defn new-cond
+  "NewCond returns a new Cond with Locker l."
+  {:arglists '([l]) :go "sync.NewCond"}
+  [l]
+  (lace.go.sync/NewCond l))
+
+(defn once-func
+  "OnceFunc returns a function that invokes f only once. The returned function
+may be called concurrently.
+
+If f panics, the returned function will panic with the same value on every call."
+  {:arglists '([f]) :go "sync.OnceFunc"}
+  [f]
+  (lace.go.sync/OnceFunc f))
@@ -0,0 +1,91 @@
+// Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.
+package stdlib
+
+import (
+	_ "embed"
+	"reflect"
+
+	"github.com/lab47/lace/pkg/pkgreflect"
+
+	sync "sync"
+)
+
+type LockerImpl struct {
+	LockFn   func()
+	UnlockFn func()
+}
+
+func (s *LockerImpl) Lock() {
+	s.LockFn()
+}
+func (s *LockerImpl) Unlock() {
+	s.UnlockFn()
+}
+
+//go:embed sync.clj
+var wrapper_sync string
+
+func init() {
+	Cond_methods := map[string]pkgreflect.Func{}
+	Map_methods := map[string]pkgreflect.Func{}
+	Locker_methods := map[string]pkgreflect.Func{}
+	Mutex_methods := map[string]pkgreflect.Func{}
+	Once_methods := map[string]pkgreflect.Func{}
+	Pool_methods := map[string]pkgreflect.Func{}
+	RWMutex_methods := map[string]pkgreflect.Func{}
+	WaitGroup_methods := map[string]pkgreflect.Func{}
+	Cond_methods["Wait"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Wait atomically unlocks c.L and suspends execution\nof the calling goroutine. After later resuming execution,\nWait locks c.L before returning. Unlike in other systems,\nWait cannot return unless awoken by [Cond.Broadcast] or [Cond.Signal].\n\nBecause c.L is not locked while Wait is waiting, the caller\ntypically cannot assume that the condition is true when\nWait returns. Instead, the caller should Wait in a loop:\n\n\tc.L.Lock()\n\tfor !condition() {\n\t    c.Wait()\n\t}\n\t... make use of condition ...\n\tc.L.Unlock()"}
+	Cond_methods["Signal"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Signal wakes one goroutine waiting on c, if there is any.\n\nIt is allowed but not required for the caller to hold c.L\nduring the call.\n\nSignal() does not affect goroutine scheduling priority; if other goroutines\nare attempting to lock c.L, they may be awoken before a \"waiting\" goroutine."}
+	Cond_methods["Broadcast"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Broadcast wakes all goroutines waiting on c.\n\nIt is allowed but not required for the caller to hold c.L during the call.\nThe time it takes to run Broadcast is proportional to the number of waiting goroutines;\nbe aware that holding the lock across a call to Broadcast\nwill extend the amount of time that the lock is held."}
+	Map_methods["Load"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}}, Tag: "any", Doc: "Load returns the value stored in the map for a key, or nil if no\nvalue is present.\nThe ok result indicates whether value was found in the map."}
+	Map_methods["Store"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}, {Name: "value", Tag: "any"}}, Tag: "any", Doc: "Store sets the value for a key."}
+	Map_methods["LoadOrStore"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}, {Name: "value", Tag: "any"}}, Tag: "any", Doc: "LoadOrStore returns the existing value for the key if present.\nOtherwise, it stores and returns the given value.\nThe loaded result is true if the value was loaded, false if stored."}
+	Map_methods["LoadAndDelete"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}}, Tag: "any", Doc: "LoadAndDelete deletes the value for a key, returning the previous value if any.\nThe loaded result reports whether the key was present."}
+	Map_methods["Delete"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}}, Tag: "any", Doc: "Delete deletes the value for a key.\nIf the key is not in the map, Delete does nothing."}
+	Map_methods["Swap"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}, {Name: "value", Tag: "any"}}, Tag: "any", Doc: "Swap swaps the value for a key and returns the previous value if any.\nThe loaded result reports whether the key was present."}
+	Map_methods["CompareAndSwap"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}, {Name: "old", Tag: "any"}, {Name: "new", Tag: "any"}}, Tag: "bool", Doc: "CompareAndSwap swaps the old and new values for key\nif the value stored in the map is equal to old.\nThe old value must be of a comparable type."}
+	Map_methods["CompareAndDelete"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "key", Tag: "any"}, {Name: "old", Tag: "any"}}, Tag: "bool", Doc: "CompareAndDelete deletes the entry for key if its value is equal to old.\nThe old value must be of a comparable type.\n\nIf there is no current value for key in the map, CompareAndDelete\nreturns false (even if the old value is the nil interface value)."}
+	Map_methods["Range"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "f", Tag: "Unknown"}}, Tag: "any", Doc: "Range calls f sequentially for each key and value present in the map.\nIf f returns false, range stops the iteration.\n\nRange does not necessarily correspond to any consistent snapshot of the Map's\ncontents: no key will be visited more than once, but if the value for any key\nis stored or deleted concurrently (including by f), Range may reflect any\nmapping for that key from any point during the Range call. Range does not\nblock other methods on the receiver; even f itself may call any method on m.\n\nRange may be O(N) with the number of elements in the map even if f returns\nfalse after a constant number of calls."}
+	Mutex_methods["Lock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Lock locks m.\nIf the lock is already in use, the calling goroutine\nblocks until the mutex is available."}
+	Mutex_methods["TryLock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "TryLock tries to lock m and reports whether it succeeded.\n\nNote that while correct uses of TryLock do exist, they are rare,\nand use of TryLock is often a sign of a deeper problem\nin a particular use of mutexes."}
+	Mutex_methods["Unlock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Unlock unlocks m.\nIt is a run-time error if m is not locked on entry to Unlock.\n\nA locked [Mutex] is not associated with a particular goroutine.\nIt is allowed for one goroutine to lock a Mutex and then\narrange for another goroutine to unlock it."}
+	Once_methods["Do"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "f", Tag: "Unknown"}}, Tag: "any", Doc: "Do calls the function f if and only if Do is being called for the\nfirst time for this instance of [Once]. In other words, given\n\n\tvar once Once\n\nif once.Do(f) is called multiple times, only the first call will invoke f,\neven if f has a different value in each invocation. A new instance of\nOnce is required for each function to execute.\n\nDo is intended for initialization that must be run exactly once. Since f\nis niladic, it may be necessary to use a function literal to capture the\narguments to a function to be invoked by Do:\n\n\tconfig.once.Do(func() { config.init(filename) })\n\nBecause no call to Do returns until the one call to f returns, if f causes\nDo to be called, it will deadlock.\n\nIf f panics, Do considers it to have returned; future calls of Do return\nwithout calling f."}
+	Pool_methods["Put"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "x", Tag: "any"}}, Tag: "any", Doc: "Put adds x to the pool."}
+	Pool_methods["Get"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Get selects an arbitrary item from the [Pool], removes it from the\nPool, and returns it to the caller.\nGet may choose to ignore the pool and treat it as empty.\nCallers should not assume any relation between values passed to [Pool.Put] and\nthe values returned by Get.\n\nIf Get would otherwise return nil and p.New is non-nil, Get returns\nthe result of calling p.New."}
+	RWMutex_methods["RLock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "RLock locks rw for reading.\n\nIt should not be used for recursive read locking; a blocked Lock\ncall excludes new readers from acquiring the lock. See the\ndocumentation on the [RWMutex] type."}
+	RWMutex_methods["TryRLock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "TryRLock tries to lock rw for reading and reports whether it succeeded.\n\nNote that while correct uses of TryRLock do exist, they are rare,\nand use of TryRLock is often a sign of a deeper problem\nin a particular use of mutexes."}
+	RWMutex_methods["RUnlock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "RUnlock undoes a single [RWMutex.RLock] call;\nit does not affect other simultaneous readers.\nIt is a run-time error if rw is not locked for reading\non entry to RUnlock."}
+	RWMutex_methods["Lock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Lock locks rw for writing.\nIf the lock is already locked for reading or writing,\nLock blocks until the lock is available."}
+	RWMutex_methods["TryLock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "bool", Doc: "TryLock tries to lock rw for writing and reports whether it succeeded.\n\nNote that while correct uses of TryLock do exist, they are rare,\nand use of TryLock is often a sign of a deeper problem\nin a particular use of mutexes."}
+	RWMutex_methods["Unlock"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Unlock unlocks rw for writing. It is a run-time error if rw is\nnot locked for writing on entry to Unlock.\n\nAs with Mutexes, a locked [RWMutex] is not associated with a particular\ngoroutine. One goroutine may [RWMutex.RLock] ([RWMutex.Lock]) a RWMutex and then\narrange for another goroutine to [RWMutex.RUnlock] ([RWMutex.Unlock]) it."}
+	RWMutex_methods["RLocker"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "Locker", Doc: "RLocker returns a [Locker] interface that implements\nthe [Locker.Lock] and [Locker.Unlock] methods by calling rw.RLock and rw.RUnlock."}
+	WaitGroup_methods["Add"] = pkgreflect.Func{Args: []pkgreflect.Arg{{Name: "delta", Tag: "int"}}, Tag: "any", Doc: "Add adds delta, which may be negative, to the [WaitGroup] task counter.\nIf the counter becomes zero, all goroutines blocked on [WaitGroup.Wait] are released.\nIf the counter goes negative, Add panics.\n\nCallers should prefer [WaitGroup.Go].\n\nNote that calls with a positive delta that occur when the counter is zero\nmust happen before a Wait. Calls with a negative delta, or calls with a\npositive delta that start when the counter is greater than zero, may happen\nat any time.\nTypically this means the calls to Add should execute before the statement\ncreating the goroutine or other event to be waited for.\nIf a WaitGroup is reused to wait for several independent sets of events,\nnew Add calls must happen after all previous Wait calls have returned.\nSee the WaitGroup example."}
+	WaitGroup_methods["Done"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Done decrements the [WaitGroup] task counter by one.\nIt is equivalent to Add(-1).\n\nCallers should prefer [WaitGroup.Go].\n\nIn the terminology of [the Go memory model], a call to Done\n\"synchronizes before\" the return of any Wait call that it unblocks.\n\n[the Go memory model]: https://go.dev/ref/mem"}
+	WaitGroup_methods["Wait"] = pkgreflect.Func{Args: []pkgreflect.Arg{}, Tag: "any", Doc: "Wait blocks until the [WaitGroup] task counter is zero."}
+	pkgreflect.AddPackage("lace.go.sync", &pkgreflect.Package{
+		Doc:       "Package sync provides basic synchronization primitives such as mutual exclusion locks.",
+		WrapperNS: "go.sync",
+		Wrapper:   wrapper_sync,
+		Types: map[string]pkgreflect.Type{
+			"Cond":       {Doc: "Cond implements a condition variable, a rendezvous point\nfor goroutines waiting for or announcing the occurrence\nof an event.\n\nEach Cond has an associated Locker L (often a [*Mutex] or [*RWMutex]),\nwhich must be held when changing the condition and\nwhen calling the [Cond.Wait] method.\n\nA Cond must not be copied after first use.\n\nIn the terminology of [the Go memory model], Cond arranges that\na call to [Cond.Broadcast] or [Cond.Signal] “synchronizes before” any Wait call\nthat it unblocks.\n\nFor many simple use cases, users will be better off using channels than a\nCond (Broadcast corresponds to closing a channel, and Signal corresponds to\nsending on a channel).\n\nFor more on replacements for [sync.Cond], see [Roberto Clapis's series on\nadvanced concurrency patterns], as well as [Bryan Mills's talk on concurrency\npatterns].\n\n[the Go memory model]: https://go.dev/ref/mem\n[Roberto Clapis's series on advanced concurrency patterns]: https://blogtitle.github.io/categories/concurrency/\n[Bryan Mills's talk on concurrency patterns]: https://drive.google.com/file/d/1nPdvhB0PutEJzdCq5ms6UI58dp50fcAN/view", Value: reflect.TypeOf((*sync.Cond)(nil)).Elem(), Methods: Cond_methods},
+			"Map":        {Doc: "Map is like a Go map[any]any but is safe for concurrent use\nby multiple goroutines without additional locking or coordination.\nLoads, stores, and deletes run in amortized constant time.\n\nThe Map type is specialized. Most code should use a plain Go map instead,\nwith separate locking or coordination, for better type safety and to make it\neasier to maintain other invariants along with the map content.\n\nThe Map type is optimized for two common use cases: (1) when the entry for a given\nkey is only ever written once but read many times, as in caches that only grow,\nor (2) when multiple goroutines read, write, and overwrite entries for disjoint\nsets of keys. In these two cases, use of a Map may significantly reduce lock\ncontention compared to a Go map paired with a separate [Mutex] or [RWMutex].\n\nThe zero Map is empty and ready for use. A Map must not be copied after first use.\n\nIn the terminology of [the Go memory model], Map arranges that a write operation\n“synchronizes before” any read operation that observes the effect of the write, where\nread and write operations are defined as follows.\n[Map.Load], [Map.LoadAndDelete], [Map.LoadOrStore], [Map.Swap], [Map.CompareAndSwap],\nand [Map.CompareAndDelete] are read operations;\n[Map.Delete], [Map.LoadAndDelete], [Map.Store], and [Map.Swap] are write operations;\n[Map.LoadOrStore] is a write operation when it returns loaded set to false;\n[Map.CompareAndSwap] is a write operation when it returns swapped set to true;\nand [Map.CompareAndDelete] is a write operation when it returns deleted set to true.\n\n[the Go memory model]: https://go.dev/ref/mem", Value: reflect.TypeOf((*sync.Map)(nil)).Elem(), Methods: Map_methods},
+			"Locker":     {Doc: "A Locker represents an object that can be locked and unlocked.", Value: reflect.TypeOf((*sync.Locker)(nil)).Elem(), Methods: Locker_methods},
+			"Mutex":      {Doc: "A Mutex is a mutual exclusion lock.\nThe zero value for a Mutex is an unlocked mutex.\n\nA Mutex must not be copied after first use.\n\nIn the terminology of [the Go memory model],\nthe n'th call to [Mutex.Unlock] “synchronizes before” the m'th call to [Mutex.Lock]\nfor any n < m.\nA successful call to [Mutex.TryLock] is equivalent to a call to Lock.\nA failed call to TryLock does not establish any “synchronizes before”\nrelation at all.\n\n[the Go memory model]: https://go.dev/ref/mem", Value: reflect.TypeOf((*sync.Mutex)(nil)).Elem(), Methods: Mutex_methods},
+			"Once":       {Doc: "Once is an object that will perform exactly one action.\n\nA Once must not be copied after first use.\n\nIn the terminology of [the Go memory model],\nthe return from f “synchronizes before”\nthe return from any call of once.Do(f).\n\n[the Go memory model]: https://go.dev/ref/mem", Value: reflect.TypeOf((*sync.Once)(nil)).Elem(), Methods: Once_methods},
+			"Pool":       {Doc: "A Pool is a set of temporary objects that may be individually saved and\nretrieved.\n\nAny item stored in the Pool may be removed automatically at any time without\nnotification. If the Pool holds the only reference when this happens, the\nitem might be deallocated.\n\nA Pool is safe for use by multiple goroutines simultaneously.\n\nPool's purpose is to cache allocated but unused items for later reuse,\nrelieving pressure on the garbage collector. That is, it makes it easy to\nbuild efficient, thread-safe free lists. However, it is not suitable for all\nfree lists.\n\nAn appropriate use of a Pool is to manage a group of temporary items\nsilently shared among and potentially reused by concurrent independent\nclients of a package. Pool provides a way to amortize allocation overhead\nacross many clients.\n\nAn example of good use of a Pool is in the fmt package, which maintains a\ndynamically-sized store of temporary output buffers. The store scales under\nload (when many goroutines are actively printing) and shrinks when\nquiescent.\n\nOn the other hand, a free list maintained as part of a short-lived object is\nnot a suitable use for a Pool, since the overhead does not amortize well in\nthat scenario. It is more efficient to have such objects implement their own\nfree list.\n\nA Pool must not be copied after first use.\n\nIn the terminology of [the Go memory model], a call to Put(x) “synchronizes before”\na call to [Pool.Get] returning that same value x.\nSimilarly, a call to New returning x “synchronizes before”\na call to Get returning that same value x.\n\n[the Go memory model]: https://go.dev/ref/mem", Value: reflect.TypeOf((*sync.Pool)(nil)).Elem(), Methods: Pool_methods},
+			"RWMutex":    {Doc: "A RWMutex is a reader/writer mutual exclusion lock.\nThe lock can be held by an arbitrary number of readers or a single writer.\nThe zero value for a RWMutex is an unlocked mutex.\n\nA RWMutex must not be copied after first use.\n\nIf any goroutine calls [RWMutex.Lock] while the lock is already held by\none or more readers, concurrent calls to [RWMutex.RLock] will block until\nthe writer has acquired (and released) the lock, to ensure that\nthe lock eventually becomes available to the writer.\nNote that this prohibits recursive read-locking.\nA [RWMutex.RLock] cannot be upgraded into a [RWMutex.Lock],\nnor can a [RWMutex.Lock] be downgraded into a [RWMutex.RLock].\n\nIn the terminology of [the Go memory model],\nthe n'th call to [RWMutex.Unlock] “synchronizes before” the m'th call to Lock\nfor any n < m, just as for [Mutex].\nFor any call to RLock, there exists an n such that\nthe n'th call to Unlock “synchronizes before” that call to RLock,\nand the corresponding call to [RWMutex.RUnlock] “synchronizes before”\nthe n+1'th call to Lock.\n\n[the Go memory model]: https://go.dev/ref/mem", Value: reflect.TypeOf((*sync.RWMutex)(nil)).Elem(), Methods: RWMutex_methods},
+			"WaitGroup":  {Doc: "A WaitGroup is a counting semaphore typically used to wait\nfor a group of goroutines or tasks to finish.\n\nTypically, a main goroutine will start tasks, each in a new\ngoroutine, by calling [WaitGroup.Go] and then wait for all tasks to\ncomplete by calling [WaitGroup.Wait]. For example:\n\n\tvar wg sync.WaitGroup\n\twg.Go(task1)\n\twg.Go(task2)\n\twg.Wait()\n\nA WaitGroup may also be used for tracking tasks without using Go to\nstart new goroutines by using [WaitGroup.Add] and [WaitGroup.Done].\n\nThe previous example can be rewritten using explicitly created\ngoroutines along with Add and Done:\n\n\tvar wg sync.WaitGroup\n\twg.Add(1)\n\tgo func() {\n\t\tdefer wg.Done()\n\t\ttask1()\n\t}()\n\twg.Add(1)\n\tgo func() {\n\t\tdefer wg.Done()\n\t\ttask2()\n\t}()\n\twg.Wait()\n\nThis pattern is common in code that predates [WaitGroup.Go].\n\nA WaitGroup must not be copied after first use.", Value: reflect.TypeOf((*sync.WaitGroup)(nil)).Elem(), Methods: WaitGroup_methods},
+			"LockerImpl": {Doc: `Struct version of interface Locker for implementation`, Value: reflect.TypeFor[LockerImpl]()},
+		},
+
+		Functions: map[string]pkgreflect.FuncValue{
+			"NewCond": {Doc: "NewCond returns a new Cond with Locker l.", Args: []pkgreflect.Arg{{Name: "l", Tag: "Locker"}}, Tag: "Cond", Value: reflect.ValueOf(sync.NewCond)},
+
+			"OnceFunc": {Doc: "OnceFunc returns a function that invokes f only once. The returned function\nmay be called concurrently.\n\nIf f panics, the returned function will panic with the same value on every call.", Args: []pkgreflect.Arg{{Name: "f", Tag: "Unknown"}}, Tag: "Unknown", Value: reflect.ValueOf(sync.OnceFunc)},
+		},
+
+		Variables: map[string]pkgreflect.Value{},
+
+		Consts: map[string]pkgreflect.Value{},
+	})
+}
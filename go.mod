@@ -4,6 +4,7 @@ require (
 	github.com/candid82/liner v1.4.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/fatih/color v1.17.0
+	github.com/fsnotify/fsnotify v1.7.0
 	github.com/fxamacker/cbor/v2 v2.6.0
 	github.com/jcburley/go-spew v1.3.0
 	github.com/lab47/lablog v0.0.0-20240519002649-1b49a2af5c09
@@ -37,6 +38,7 @@ require (
 	github.com/x448/float16 v0.8.4 // indirect
 	golang.org/x/mod v0.17.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 )
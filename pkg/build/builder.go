@@ -1,17 +1,22 @@
 package build
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"fmt"
 	"go/format"
+	"io/fs"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/core"
 	"github.com/lab47/lace/pkg/pkgreflect"
 	"github.com/mr-tron/base58"
 	"golang.org/x/crypto/blake2b"
@@ -24,25 +29,46 @@ type GoImport struct {
 }
 
 type Config struct {
-	Name      string     `yaml:"name"`
-	Main      string     `yaml:"main"`
-	GoImports []GoImport `yaml:"go-imports"`
+	Name         string          `yaml:"name"`
+	Version      string          `yaml:"version"`
+	Main         string          `yaml:"main"`
+	Paths        []string        `yaml:"paths"`
+	GoImports    []GoImport      `yaml:"go-imports"`
+	Dependencies []Dependency    `yaml:"dependencies"`
+	Targets      []Target        `yaml:"targets"`
+	Tasks        map[string]Task `yaml:"tasks"`
 }
 
-type Builder struct {
-	log logger.Logger
-	dir string
-	cfg *Config
+// LoadConfig reads the lace.yml of the project in dir.
+func LoadConfig(dir string) (*Config, error) {
+	cfg, err := readConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cfg.Dependencies {
+		err = cfg.Dependencies[i].validate()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	goMod []byte
+	err = cfg.validateTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
 }
 
-func LoadBuilder(log logger.Logger, dir string) (*Builder, error) {
+func readConfig(dir string) (*Config, error) {
 	f, err := os.Open(filepath.Join(dir, "lace.yml"))
 	if err != nil {
 		return nil, err
 	}
 
+	defer f.Close()
+
 	var cfg Config
 
 	err = yaml.NewDecoder(f).Decode(&cfg)
@@ -50,9 +76,27 @@ func LoadBuilder(log logger.Logger, dir string) (*Builder, error) {
 		return nil, err
 	}
 
+	return &cfg, nil
+}
+
+type Builder struct {
+	log logger.Logger
+	dir string
+	cfg *Config
+
+	goMod   []byte
+	offline bool
+}
+
+func LoadBuilder(log logger.Logger, dir string) (*Builder, error) {
+	cfg, err := LoadConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+
 	b := &Builder{
 		log: log,
-		cfg: &cfg,
+		cfg: cfg,
 		dir: dir,
 	}
 
@@ -90,12 +134,14 @@ func (b *Builder) setupGoMod() error {
 
 func (b *Builder) writeGoMod() error {
 	c := exec.Command("go", "mod", "init", b.cfg.Name)
+	c.Dir = b.dir
 	out, err := c.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("error initializing go mod: %s", string(out))
 	}
 
-	return nil
+	b.goMod, err = os.ReadFile(filepath.Join(b.dir, "go.mod"))
+	return err
 }
 
 var fileCleanup = strings.NewReplacer(
@@ -105,16 +151,197 @@ var fileCleanup = strings.NewReplacer(
 	"\\", "____",
 )
 
-func (b *Builder) buildId() string {
+// sourceDir is the directory in the build tree that the project's lace
+// sources are copied to and embedded from.
+const sourceDir = "lace-src"
+
+// source is a lace source file of the project or a dependency, at name
+// within fsys.
+type source struct {
+	name string
+	fsys fs.FS
+}
+
+func (s *source) read() ([]byte, error) {
+	return fs.ReadFile(s.fsys, s.name)
+}
+
+// ClassPath returns the directories the lace code of the project in dir is
+// loaded from: its paths, or the project directory if it has none, followed
+// by the directories of its dependencies, which are fetched if needed. A
+// path may also be a .zip or .jar archive of namespaces.
+func ClassPath(ctx context.Context, log logger.Logger, dir string, cfg *Config, offline bool) ([]string, error) {
+	paths := cfg.Paths
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	var cp []string
+
+	for _, p := range paths {
+		cp = append(cp, filepath.Join(dir, filepath.FromSlash(p)))
+	}
+
+	if len(cfg.Dependencies) == 0 {
+		return cp, nil
+	}
+
+	r := NewResolver(log, dir, cfg)
+	r.Offline = offline
+
+	lock, err := r.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(cp, r.ClassPath(lock)...), nil
+}
+
+// sources returns the project's lace sources followed by those of its
+// dependencies, in the order of its class path. A namespace in the project
+// shadows one of the same name in a dependency. Hidden directories, the
+// build trees and the artifacts aren't searched.
+func (b *Builder) sources(ctx context.Context) ([]source, error) {
+	cp, err := ClassPath(ctx, b.log, b.dir, b.cfg, b.offline)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+
+	var srcs []source
+
+	for _, dir := range cp {
+		fsys, err := openPath(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		found, err := findSources(fsys, seen)
+		if err != nil {
+			return nil, err
+		}
+
+		srcs = append(srcs, found...)
+	}
+
+	return srcs, nil
+}
+
+// openPath returns the class path element at path as an fs.FS. Archives
+// are read into memory, as the build reads their sources more than once.
+func openPath(path string) (fs.FS, error) {
+	if !core.IsArchive(path) {
+		return os.DirFS(path), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+}
+
+func findSources(fsys fs.FS, seen map[string]bool) ([]source, error) {
+	var srcs []source
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			base := d.Name()
+			if name != "." && (base == "artifacts" || strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_")) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if path.Ext(name) != ".clj" {
+			return nil
+		}
+
+		if seen[name] {
+			return nil
+		}
+
+		seen[name] = true
+		srcs = append(srcs, source{name: name, fsys: fsys})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(srcs, func(i, j int) bool {
+		return srcs[i].name < srcs[j].name
+	})
+
+	return srcs, nil
+}
+
+func (b *Builder) buildId(sources []source) (string, error) {
 	h, _ := blake2b.New256(nil)
 	err := cbor.NewEncoder(h).Encode(b.cfg)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
 	h.Write(b.goMod)
 
-	return base58.Encode(h.Sum(nil))
+	for _, src := range sources {
+		data, err := src.read()
+		if err != nil {
+			return "", err
+		}
+
+		sum := blake2b.Sum256(data)
+
+		fmt.Fprintf(h, "%s\x00%d\x00", src.name, len(data))
+		h.Write(sum[:])
+	}
+
+	return base58.Encode(h.Sum(nil)), nil
+}
+
+// copySources replaces the sources in the build tree at dir with the
+// current ones.
+func (b *Builder) copySources(dir string, sources []source) error {
+	dest := filepath.Join(dir, sourceDir)
+
+	err := os.RemoveAll(dest)
+	if err != nil {
+		return err
+	}
+
+	for _, src := range sources {
+		data, err := src.read()
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(src.name))
+
+		err = os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(target, data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetOffline makes the build use only the dependencies already in the
+// cache rather than fetching them.
+func (b *Builder) SetOffline(offline bool) {
+	b.offline = offline
 }
 
 func (b *Builder) Clean() {
@@ -123,11 +350,19 @@ func (b *Builder) Clean() {
 }
 
 func (b *Builder) Run(ctx context.Context) (string, error) {
-	id := b.buildId()
+	sources, err := b.sources(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := b.buildId(sources)
+	if err != nil {
+		return "", err
+	}
 
 	exePath := filepath.Join(b.dir, "artifacts", b.cfg.Name)
 
-	err := os.MkdirAll(filepath.Dir(exePath), 0755)
+	err = os.MkdirAll(filepath.Dir(exePath), 0755)
 	if err != nil {
 		return "", err
 	}
@@ -141,8 +376,32 @@ func (b *Builder) Run(ctx context.Context) (string, error) {
 
 	b.log.Info("beginning build", "name", b.cfg.Name, "id", id)
 
+	dir, err := b.prepare(ctx, id, sources)
+	if err != nil {
+		return "", err
+	}
+
+	b.log.Info("compiling")
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", exePath, ".")
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if err != nil {
+		return "", err
+	}
+
+	os.WriteFile(exePath+".build-id.txt", []byte(id), 0644)
+
+	return exePath, nil
+}
+
+// prepare generates the Go module for the program in its _build_ directory,
+// returning the directory.
+func (b *Builder) prepare(ctx context.Context, id string, sources []source) (string, error) {
 	dir := filepath.Join(b.dir, "_build_"+b.cfg.Name)
-	err = os.MkdirAll(dir, 0755)
+	err := os.MkdirAll(dir, 0755)
 	if err != nil {
 		return "", err
 	}
@@ -173,25 +432,20 @@ func (b *Builder) Run(ctx context.Context) (string, error) {
 		}
 	}
 
-	b.log.Info("writing main.go")
-	err = b.writeMain(dir, id)
+	b.log.Info("copying sources", "count", len(sources))
+	err = b.copySources(dir, sources)
 	if err != nil {
 		return "", err
 	}
 
-	b.log.Info("configuring dependencies")
-	cmd := exec.CommandContext(ctx, "go", "get")
-	cmd.Dir = dir
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err = cmd.Run()
+	b.log.Info("writing main.go")
+	err = b.writeMain(dir, id, len(sources) > 0)
 	if err != nil {
 		return "", err
 	}
 
-	b.log.Info("compiling")
-	cmd = exec.CommandContext(ctx, "go", "build", "-o", exePath, ".")
+	b.log.Info("configuring dependencies")
+	cmd := exec.CommandContext(ctx, "go", "get")
 	cmd.Dir = dir
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -201,12 +455,10 @@ func (b *Builder) Run(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	os.WriteFile(exePath+".build-id.txt", []byte(id), 0644)
-
-	return exePath, nil
+	return dir, nil
 }
 
-func (b *Builder) writeMain(dir, id string) error {
+func (b *Builder) writeMain(dir, id string, embedSources bool) error {
 	var data []byte
 
 	if b.cfg.Main == "" {
@@ -216,25 +468,59 @@ package main
 import "github.com/lab47/lace/cli"
 
 var Program = "%s"
+var Version = "dev"
+var BuildId = "%s"
 
 func main() {
+    cli.SetBuildInfo(Program, Version, BuildId)
     cli.Main()
 }
-    `, b.cfg.Name))
-	} else {
+    `, b.cfg.Name, id))
+	} else if !embedSources {
 		data = []byte(fmt.Sprintf(`
 package main
 
 import "github.com/lab47/lace/cli"
 
 var Program = "%s"
+var Version = "dev"
 var BuildId = "%s"
 
 func main() {
+    cli.SetBuildInfo(Program, Version, BuildId)
     cli.MainIn(%q)
 }
     `, b.cfg.Name, id, b.cfg.Main))
+	} else {
+		// The sources are embedded so the binary runs without the project
+		// next to it, served from the classpath ahead of the working dir.
+		data = []byte(fmt.Sprintf(`
+package main
 
+import (
+    "embed"
+    "io/fs"
+
+    "github.com/lab47/lace/cli"
+)
+
+var Program = "%s"
+var Version = "dev"
+var BuildId = "%s"
+
+//go:embed %s
+var sources embed.FS
+
+func main() {
+    src, err := fs.Sub(sources, %q)
+    if err != nil {
+        panic(err)
+    }
+
+    cli.SetBuildInfo(Program, Version, BuildId)
+    cli.MainInFS(%q, src)
+}
+    `, b.cfg.Name, id, sourceDir, sourceDir, b.cfg.Main))
 	}
 
 	data, err := format.Source(data)
@@ -0,0 +1,140 @@
+package build
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSources(t *testing.T) {
+	t.Run("finds sources in directories and archives", func(t *testing.T) {
+		dir := t.TempDir()
+
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "app"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "app", "core.clj"), []byte("(ns app.core)"), 0644))
+
+		f, err := os.Create(filepath.Join(dir, "libs.zip"))
+		require.NoError(t, err)
+
+		zw := zip.NewWriter(f)
+		for _, name := range []string{"lib/util.clj", "app/core.clj"} {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+
+			_, err = w.Write([]byte("(ns " + strings.TrimSuffix(strings.ReplaceAll(name, "/", "."), ".clj") + ")"))
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, zw.Close())
+		require.NoError(t, f.Close())
+
+		b := &Builder{
+			log: logger.New(logger.Info),
+			dir: dir,
+			cfg: &Config{Name: "app", Paths: []string{"src", "libs.zip"}},
+		}
+
+		srcs, err := b.sources(context.Background())
+		require.NoError(t, err)
+		require.Len(t, srcs, 2)
+
+		require.Equal(t, "app/core.clj", srcs[0].name)
+		require.Equal(t, "lib/util.clj", srcs[1].name)
+
+		data, err := srcs[0].read()
+		require.NoError(t, err)
+		require.Equal(t, "(ns app.core)", string(data))
+
+		data, err = srcs[1].read()
+		require.NoError(t, err)
+		require.Equal(t, "(ns lib.util)", string(data))
+	})
+}
+
+func TestBuildTree(t *testing.T) {
+	newBuilder := func(t *testing.T, files map[string]string) *Builder {
+		dir := t.TempDir()
+		writeFiles(t, dir, files)
+
+		return &Builder{
+			log: logger.New(logger.Info),
+			dir: dir,
+			cfg: &Config{Name: "app", Paths: []string{"src"}},
+		}
+	}
+
+	t.Run("changes the build id when a source is edited", func(t *testing.T) {
+		r := require.New(t)
+
+		b := newBuilder(t, map[string]string{
+			"src/app/core.clj": "(ns app.core)",
+			"src/app/util.clj": "(ns app.util)",
+		})
+
+		srcs, err := b.sources(context.Background())
+		r.NoError(err)
+
+		id, err := b.buildId(srcs)
+		r.NoError(err)
+
+		same, err := b.buildId(srcs)
+		r.NoError(err)
+		r.Equal(id, same)
+
+		writeFiles(t, b.dir, map[string]string{"src/app/util.clj": "(ns app.util)\n(def x 1)"})
+
+		srcs, err = b.sources(context.Background())
+		r.NoError(err)
+
+		edited, err := b.buildId(srcs)
+		r.NoError(err)
+		r.NotEqual(id, edited)
+	})
+
+	t.Run("mirrors the sources into the build tree", func(t *testing.T) {
+		r := require.New(t)
+
+		b := newBuilder(t, map[string]string{
+			"src/app/core.clj":     "(ns app.core)",
+			"src/app/lib/util.clj": "(ns app.lib.util)",
+		})
+
+		srcs, err := b.sources(context.Background())
+		r.NoError(err)
+
+		tree := t.TempDir()
+
+		// A source removed since the last build doesn't linger in the tree.
+		writeFiles(t, filepath.Join(tree, sourceDir), map[string]string{"app/old.clj": "(ns app.old)"})
+
+		r.NoError(b.copySources(tree, srcs))
+
+		var names []string
+
+		err = filepath.WalkDir(filepath.Join(tree, sourceDir), func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			rel, err := filepath.Rel(filepath.Join(tree, sourceDir), path)
+			if err != nil {
+				return err
+			}
+
+			names = append(names, filepath.ToSlash(rel))
+			return nil
+		})
+		r.NoError(err)
+		r.Equal([]string{"app/core.clj", "app/lib/util.clj"}, names)
+
+		data, err := os.ReadFile(filepath.Join(tree, sourceDir, "app", "lib", "util.clj"))
+		r.NoError(err)
+		r.Equal("(ns app.lib.util)", string(data))
+	})
+}
@@ -0,0 +1,678 @@
+package build
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lab47/lablog/logger"
+	"gopkg.in/yaml.v3"
+)
+
+// Dependency is a lace library used by a project, fetched from exactly one
+// of a git repository, a tarball URL or a local path. Root is the directory
+// within it that holds the library's namespaces, defaulting to the top.
+type Dependency struct {
+	Name string `yaml:"name"`
+	Git  string `yaml:"git,omitempty"`
+	Ref  string `yaml:"ref,omitempty"`
+	URL  string `yaml:"url,omitempty"`
+	Path string `yaml:"path,omitempty"`
+	Root string `yaml:"root,omitempty"`
+}
+
+func (d *Dependency) validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("dependency is missing a name")
+	}
+
+	n := 0
+	for _, s := range []string{d.Git, d.URL, d.Path} {
+		if s != "" {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return fmt.Errorf("dependency %s must have exactly one of git, url or path", d.Name)
+	}
+
+	if d.Ref != "" && d.Git == "" {
+		return fmt.Errorf("dependency %s has a ref but isn't a git dependency", d.Name)
+	}
+
+	return nil
+}
+
+// Source describes where the dependency comes from.
+func (d *Dependency) Source() string {
+	switch {
+	case d.Git != "" && d.Ref != "":
+		return "git " + d.Git + "@" + d.Ref
+	case d.Git != "":
+		return "git " + d.Git
+	case d.URL != "":
+		return "url " + d.URL
+	default:
+		return "path " + d.Path
+	}
+}
+
+// LockedDependency is a dependency as resolved by a fetch. Rev is the commit
+// a git ref resolved to, and Sha256 is the hash of the fetched tree. Requires
+// names the dependencies the library itself declares. A path dependency is
+// relative to the project, or when declared by a library that was fetched,
+// to the tree of the library named by In.
+type LockedDependency struct {
+	Dependency `yaml:",inline"`
+
+	In       string   `yaml:"in,omitempty"`
+	Rev      string   `yaml:"rev,omitempty"`
+	Sha256   string   `yaml:"sha256"`
+	Requires []string `yaml:"requires,omitempty"`
+}
+
+// Lock is the contents of lace.lock, which pins every dependency of a
+// project, including those of its dependencies, so builds are reproducible.
+type Lock struct {
+	Dependencies []*LockedDependency `yaml:"dependencies"`
+}
+
+const LockFile = "lace.lock"
+
+// ReadLock reads the lock of the project in dir. A project without one has
+// an empty lock.
+func ReadLock(dir string) (*Lock, error) {
+	var lock Lock
+
+	data, err := os.ReadFile(filepath.Join(dir, LockFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &lock, nil
+		}
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(data, &lock)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", LockFile, err)
+	}
+
+	return &lock, nil
+}
+
+// Write writes the lock into the project in dir.
+func (l *Lock) Write(dir string) error {
+	var buf bytes.Buffer
+	buf.WriteString("# Generated by lace deps fetch. Do not edit.\n")
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	err := enc.Encode(l)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, LockFile), buf.Bytes(), 0644)
+}
+
+// Find returns the locked dependency with the given name, or nil.
+func (l *Lock) Find(name string) *LockedDependency {
+	for _, dep := range l.Dependencies {
+		if dep.Name == name {
+			return dep
+		}
+	}
+
+	return nil
+}
+
+// Resolver fetches the dependencies of a project into the cache and keeps
+// its lace.lock up to date. With Offline set, only dependencies already
+// in the cache are used.
+type Resolver struct {
+	log logger.Logger
+	dir string
+	cfg *Config
+
+	Cache   string
+	Offline bool
+	Client  *http.Client
+}
+
+// DefaultCache returns the directory fetched dependencies are kept in,
+// which is LACE_DEPS_CACHE if set. Each is stored under its sha256.
+func DefaultCache() string {
+	if dir := os.Getenv("LACE_DEPS_CACHE"); dir != "" {
+		return dir
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".laced", "deps", "sha256")
+}
+
+func NewResolver(log logger.Logger, dir string, cfg *Config) *Resolver {
+	return &Resolver{
+		log:    log,
+		dir:    dir,
+		cfg:    cfg,
+		Cache:  DefaultCache(),
+		Client: http.DefaultClient,
+	}
+}
+
+// Fetch resolves the project's dependencies and theirs, fetching any that
+// aren't in the cache, and writes the result to lace.lock. Dependencies
+// already in the lock are fetched at their locked revision and must match
+// their locked hash. When two libraries declare the same name, the one
+// closest to the project wins.
+func (r *Resolver) Fetch(ctx context.Context) (*Lock, error) {
+	prev, err := ReadLock(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each dependency is resolved relative to the directory of the library
+	// that declared it, and a path can't leave the tree that library was
+	// fetched into, named by in and rooted at top.
+	type pending struct {
+		dep  Dependency
+		base string
+		in   string
+		top  string
+	}
+
+	var queue []pending
+	for _, dep := range r.cfg.Dependencies {
+		queue = append(queue, pending{dep: dep, base: r.dir, top: r.dir})
+	}
+
+	lock := &Lock{}
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		if lock.Find(p.dep.Name) != nil {
+			continue
+		}
+
+		locked, dir, err := r.resolve(ctx, p.dep, p.base, prev.Find(p.dep.Name))
+		if err != nil {
+			return nil, err
+		}
+
+		in, top := p.in, p.top
+		if p.dep.Path != "" {
+			rel, err := filepath.Rel(top, dir)
+			if err != nil || (in != "" && (rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)))) {
+				return nil, fmt.Errorf("dependency %s has a path outside of dependency %s", p.dep.Name, in)
+			}
+
+			locked.In = in
+			locked.Path = filepath.ToSlash(rel)
+		} else {
+			in, top = p.dep.Name, dir
+		}
+
+		cfg, err := readConfig(dir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("error reading lace.yml of dependency %s: %w", p.dep.Name, err)
+		}
+
+		if cfg != nil {
+			for _, sub := range cfg.Dependencies {
+				locked.Requires = append(locked.Requires, sub.Name)
+				queue = append(queue, pending{dep: sub, base: dir, in: in, top: top})
+			}
+		}
+
+		lock.Dependencies = append(lock.Dependencies, locked)
+	}
+
+	err = lock.Write(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, dep Dependency, base string, prev *LockedDependency) (*LockedDependency, string, error) {
+	err := dep.validate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	locked := &LockedDependency{Dependency: dep}
+
+	if dep.Path != "" {
+		dir := dep.Path
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(base, dir)
+		}
+
+		locked.Sha256, err = HashTree(dir)
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading dependency %s: %w", dep.Name, err)
+		}
+
+		return locked, dir, nil
+	}
+
+	if prev != nil && prev.Dependency == locked.Dependency && prev.Sha256 != "" {
+		locked.Rev = prev.Rev
+		locked.Sha256 = prev.Sha256
+
+		dir := filepath.Join(r.Cache, prev.Sha256)
+		if _, err := os.Stat(dir); err == nil {
+			return locked, dir, nil
+		}
+	}
+
+	if r.Offline {
+		if locked.Sha256 == "" {
+			return nil, "", fmt.Errorf("dependency %s isn't locked and can't be fetched offline", dep.Name)
+		}
+		return nil, "", fmt.Errorf("dependency %s (%s) isn't in the cache and can't be fetched offline", dep.Name, locked.Sha256)
+	}
+
+	r.log.Info("fetching dependency", "name", dep.Name, "source", dep.Source())
+
+	err = os.MkdirAll(r.Cache, 0755)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tmp, err := os.MkdirTemp(r.Cache, ".fetch-")
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer os.RemoveAll(tmp)
+
+	if dep.Git != "" {
+		rev := locked.Rev
+		if rev == "" {
+			rev = dep.Ref
+		}
+
+		locked.Rev, err = fetchGit(ctx, dep.Git, rev, tmp)
+	} else {
+		err = r.fetchURL(ctx, dep.URL, tmp)
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("error fetching dependency %s: %w", dep.Name, err)
+	}
+
+	sum, err := HashTree(tmp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if locked.Sha256 != "" && locked.Sha256 != sum {
+		return nil, "", fmt.Errorf("dependency %s doesn't match lace.lock: expected sha256 %s, got %s", dep.Name, locked.Sha256, sum)
+	}
+
+	locked.Sha256 = sum
+
+	dir := filepath.Join(r.Cache, sum)
+	if _, err := os.Stat(dir); err != nil {
+		err = os.Rename(tmp, dir)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return locked, dir, nil
+}
+
+// Dir returns the directory a locked dependency is found in.
+func (r *Resolver) Dir(lock *Lock, dep *LockedDependency) string {
+	if dep.Path != "" {
+		if filepath.IsAbs(dep.Path) {
+			return dep.Path
+		}
+
+		base := r.dir
+		if in := lock.Find(dep.In); dep.In != "" && in != nil {
+			base = r.Dir(lock, in)
+		}
+
+		return filepath.Join(base, filepath.FromSlash(dep.Path))
+	}
+
+	return filepath.Join(r.Cache, dep.Sha256)
+}
+
+// Verify checks that lace.lock covers every dependency in lace.yml and that
+// every locked dependency is present and matches its hash.
+func (r *Resolver) Verify() (*Lock, error) {
+	lock, err := ReadLock(r.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dep := range r.cfg.Dependencies {
+		locked := lock.Find(dep.Name)
+		if locked == nil {
+			return nil, fmt.Errorf("dependency %s is missing from %s", dep.Name, LockFile)
+		}
+
+		if dep.Path == "" && locked.Dependency != dep {
+			return nil, fmt.Errorf("dependency %s has changed since %s was written", dep.Name, LockFile)
+		}
+	}
+
+	for _, dep := range lock.Dependencies {
+		sum, err := HashTree(r.Dir(lock, dep))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("dependency %s isn't in the cache", dep.Name)
+			}
+			return nil, err
+		}
+
+		if sum != dep.Sha256 {
+			return nil, fmt.Errorf("dependency %s doesn't match %s: expected sha256 %s, got %s", dep.Name, LockFile, dep.Sha256, sum)
+		}
+	}
+
+	return lock, nil
+}
+
+// Tree writes the project's dependencies and theirs to w as a tree.
+func (r *Resolver) Tree(lock *Lock, w io.Writer) error {
+	var names []string
+	for _, dep := range r.cfg.Dependencies {
+		names = append(names, dep.Name)
+	}
+
+	fmt.Fprintln(w, r.cfg.Name)
+
+	var walk func(names []string, prefix string, seen map[string]bool) error
+
+	walk = func(names []string, prefix string, seen map[string]bool) error {
+		for i, name := range names {
+			dep := lock.Find(name)
+			if dep == nil {
+				return fmt.Errorf("dependency %s is missing from %s", name, LockFile)
+			}
+
+			branch, indent := "├── ", "│   "
+			if i == len(names)-1 {
+				branch, indent = "└── ", "    "
+			}
+
+			line := dep.Name + " " + dep.Source()
+			if dep.Rev != "" && dep.Rev != dep.Ref {
+				line += " (" + shortRev(dep.Rev) + ")"
+			}
+
+			if seen[name] {
+				fmt.Fprintln(w, prefix+branch+line+" (cycle)")
+				continue
+			}
+
+			fmt.Fprintln(w, prefix+branch+line)
+
+			seen[name] = true
+			err := walk(dep.Requires, prefix+indent, seen)
+			delete(seen, name)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return walk(names, "", map[string]bool{})
+}
+
+func shortRev(rev string) string {
+	if len(rev) > 12 {
+		return rev[:12]
+	}
+
+	return rev
+}
+
+// ClassPath returns the directories holding the namespaces of the locked
+// dependencies, in lock order.
+func (r *Resolver) ClassPath(lock *Lock) []string {
+	var dirs []string
+
+	for _, dep := range lock.Dependencies {
+		dirs = append(dirs, filepath.Join(r.Dir(lock, dep), filepath.FromSlash(dep.Root)))
+	}
+
+	return dirs
+}
+
+func fetchGit(ctx context.Context, url, rev, dir string) (string, error) {
+	git := func(args ...string) (string, error) {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = dir
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(string(out)))
+		}
+
+		return strings.TrimSpace(string(out)), nil
+	}
+
+	// Neither can be taken by git as an option, such as --upload-pack
+	// running a command.
+	if strings.HasPrefix(url, "-") {
+		return "", fmt.Errorf("invalid git url: %s", url)
+	}
+
+	if strings.HasPrefix(rev, "-") {
+		return "", fmt.Errorf("invalid git ref: %s", rev)
+	}
+
+	_, err := git("clone", "--quiet", "--no-checkout", "--", url, ".")
+	if err != nil {
+		return "", err
+	}
+
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	// The trailing -- marks rev as a revision rather than a path.
+	_, err = git("-c", "advice.detachedHead=false", "checkout", "--quiet", rev, "--")
+	if err != nil {
+		return "", err
+	}
+
+	commit, err := git("rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	err = os.RemoveAll(filepath.Join(dir, ".git"))
+	if err != nil {
+		return "", err
+	}
+
+	return commit, nil
+}
+
+func (r *Resolver) fetchURL(ctx context.Context, url, dir string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to retrieve %s: server responded %d", url, resp.StatusCode)
+	}
+
+	return extractTarball(resp.Body, dir)
+}
+
+// extractTarball unpacks a tar archive, gzipped or not, into dir. A single
+// directory at the top of the archive, as in the archives made by most
+// forges, is stripped.
+func extractTarball(r io.Reader, dir string) error {
+	br := bufio.NewReader(r)
+
+	var in io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+
+		defer gz.Close()
+		in = gz
+	}
+
+	type file struct {
+		name string
+		mode fs.FileMode
+		data []byte
+	}
+
+	var (
+		files  []file
+		prefix string
+		common = true
+	)
+
+	tr := tar.NewReader(in)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("tarball contains an unsafe path: %s", hdr.Name)
+		}
+
+		top, _, nested := strings.Cut(name, "/")
+		switch {
+		case !nested:
+			common = false
+		case prefix == "":
+			prefix = top
+		case prefix != top:
+			common = false
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, file{name: name, mode: hdr.FileInfo().Mode().Perm(), data: data})
+	}
+
+	for _, f := range files {
+		name := f.name
+		if common && prefix != "" {
+			name = strings.TrimPrefix(name, prefix+"/")
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(target, f.data, f.mode|0600)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HashTree returns the sha256 of the files in dir, covering their paths
+// and contents but not their modes or times, so the same tree hashes the
+// same wherever it's fetched to. Git metadata is skipped.
+func HashTree(dir string) (string, error) {
+	var paths []string
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sort.Strings(paths)
+
+	h := sha256.New()
+
+	for _, p := range paths {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(p)))
+		if err != nil {
+			return "", err
+		}
+
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(h, "%s\x00%d\x00%x\n", p, len(data), sum)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,218 @@
+package build
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lab47/lablog/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	}
+}
+
+func gitRepo(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	writeFiles(t, dir, files)
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"},
+		{"tag", "v1"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return dir
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     "lib-1.0/" + name,
+			Mode:     0644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}))
+
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+func TestDeps(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't available")
+	}
+
+	ctx := context.Background()
+	log := logger.New(logger.Info)
+
+	setup := func(t *testing.T) (string, *Config, *httptest.Server) {
+		repo := gitRepo(t, map[string]string{
+			"src/greet/core.clj": "(ns greet.core)\n",
+			"lib/util/core.clj":  "(ns util.core)\n",
+			"lace.yml":           "name: greet\ndependencies:\n  - name: util\n    path: lib\n",
+		})
+
+		archive := tarball(t, map[string]string{"fmt/core.clj": "(ns fmt.core)\n"})
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(archive)
+		}))
+		t.Cleanup(srv.Close)
+
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{
+			"app/core.clj": "(ns app.core)\n",
+			"lace.yml": "name: app\nmain: app.core\ndependencies:\n" +
+				"  - name: greet\n    git: " + repo + "\n    ref: v1\n    root: src\n" +
+				"  - name: fmt\n    url: " + srv.URL + "/fmt.tar.gz\n",
+		})
+
+		cfg, err := LoadConfig(dir)
+		require.NoError(t, err)
+
+		t.Setenv("LACE_DEPS_CACHE", filepath.Join(t.TempDir(), "cache"))
+
+		return dir, cfg, srv
+	}
+
+	t.Run("fetches git, url and path dependencies into a lock", func(t *testing.T) {
+		r := require.New(t)
+		dir, cfg, _ := setup(t)
+
+		res := NewResolver(log, dir, cfg)
+
+		lock, err := res.Fetch(ctx)
+		r.NoError(err)
+
+		r.Len(lock.Dependencies, 3)
+
+		greet := lock.Find("greet")
+		r.NotNil(greet)
+		r.Len(greet.Rev, 40)
+		r.Equal([]string{"util"}, greet.Requires)
+		r.FileExists(filepath.Join(res.Dir(lock, greet), "src", "greet", "core.clj"))
+
+		fmtDep := lock.Find("fmt")
+		r.NotNil(fmtDep)
+		r.FileExists(filepath.Join(res.Dir(lock, fmtDep), "fmt", "core.clj"))
+
+		util := lock.Find("util")
+		r.NotNil(util)
+		r.Equal("greet", util.In)
+		r.FileExists(filepath.Join(res.Dir(lock, util), "util", "core.clj"))
+
+		saved, err := ReadLock(dir)
+		r.NoError(err)
+		r.Equal(lock, saved)
+
+		_, err = res.Verify()
+		r.NoError(err)
+
+		var buf bytes.Buffer
+		r.NoError(res.Tree(lock, &buf))
+		r.Contains(buf.String(), "├── greet git ")
+		r.Contains(buf.String(), "│   └── util path ")
+		r.Contains(buf.String(), "└── fmt url ")
+	})
+
+	t.Run("uses only the cache when offline", func(t *testing.T) {
+		r := require.New(t)
+		dir, cfg, srv := setup(t)
+
+		res := NewResolver(log, dir, cfg)
+		res.Offline = true
+
+		_, err := res.Fetch(ctx)
+		r.ErrorContains(err, "can't be fetched offline")
+
+		res.Offline = false
+
+		lock, err := res.Fetch(ctx)
+		r.NoError(err)
+
+		srv.Close()
+		res.Offline = true
+
+		again, err := res.Fetch(ctx)
+		r.NoError(err)
+		r.Equal(lock, again)
+	})
+
+	t.Run("detects tampering with the cache and changes to the source", func(t *testing.T) {
+		r := require.New(t)
+		dir, cfg, _ := setup(t)
+
+		res := NewResolver(log, dir, cfg)
+
+		lock, err := res.Fetch(ctx)
+		r.NoError(err)
+
+		fmtDep := lock.Find("fmt")
+		path := filepath.Join(res.Dir(lock, fmtDep), "fmt", "core.clj")
+		r.NoError(os.WriteFile(path, []byte("(ns fmt.core)\n(def evil 1)\n"), 0644))
+
+		_, err = res.Verify()
+		r.ErrorContains(err, "doesn't match lace.lock")
+
+		r.NoError(os.RemoveAll(res.Dir(lock, fmtDep)))
+
+		data, err := os.ReadFile(filepath.Join(dir, LockFile))
+		r.NoError(err)
+
+		tampered := strings.Replace(string(data), fmtDep.Sha256, strings.Repeat("0", 64), 1)
+		r.NoError(os.WriteFile(filepath.Join(dir, LockFile), []byte(tampered), 0644))
+
+		_, err = res.Fetch(ctx)
+		r.ErrorContains(err, "doesn't match lace.lock")
+	})
+
+	t.Run("rejects git urls and refs that look like options", func(t *testing.T) {
+		r := require.New(t)
+
+		repo := gitRepo(t, map[string]string{"a.clj": "(ns a)\n"})
+		marker := filepath.Join(t.TempDir(), "ran")
+
+		_, err := fetchGit(ctx, "--upload-pack=touch "+marker, "", t.TempDir())
+		r.ErrorContains(err, "invalid git url")
+
+		_, err = fetchGit(ctx, repo, "--output="+marker, t.TempDir())
+		r.ErrorContains(err, "invalid git ref")
+
+		r.NoFileExists(marker)
+
+		commit, err := fetchGit(ctx, repo, "v1", t.TempDir())
+		r.NoError(err)
+		r.Len(commit, 40)
+	})
+}
@@ -0,0 +1,53 @@
+package build
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/lab47/lace/core"
+)
+
+// Graph returns the namespace dependency graph of the lace sources in the
+// project's paths, read from the ns form of each. The namespaces of its
+// dependencies only appear as those its namespaces depend on.
+func Graph(env *core.Env, dir string, cfg *Config) (*core.NSGraph, error) {
+	paths := cfg.Paths
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	seen := map[string]bool{}
+	g := core.NewNSGraph()
+
+	for _, p := range paths {
+		fsys, err := openPath(filepath.Join(dir, filepath.FromSlash(p)))
+		if err != nil {
+			return nil, err
+		}
+
+		srcs, err := findSources(fsys, seen)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, src := range srcs {
+			data, err := src.read()
+			if err != nil {
+				return nil, err
+			}
+
+			name, deps, err := core.ReadNSDecl(env, bytes.NewReader(data), src.name)
+			if err != nil {
+				return nil, err
+			}
+
+			if name == "" {
+				continue
+			}
+
+			g.Add(core.NSNode{Name: name, File: src.name, Deps: deps})
+		}
+	}
+
+	return g, nil
+}
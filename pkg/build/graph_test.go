@@ -0,0 +1,37 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lab47/lace/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "app", "core.clj"), []byte("(ns app.core\n  (:require [app.db :as db]\n            (lace [string :as s])))\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "app", "db.clj"), []byte("(ns app.db)\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "script.clj"), []byte("(println 1)\n"), 0644))
+
+	env, err := core.NewEnv()
+	require.NoError(t, err)
+
+	g, err := Graph(env, dir, &Config{Name: "app", Paths: []string{"src"}})
+	require.NoError(t, err)
+
+	var out strings.Builder
+	require.NoError(t, g.WriteDot(&out))
+
+	require.Equal(t, `digraph namespaces {
+  "app.core" -> "app.db";
+  "app.core" -> "lace.string";
+  "app.db";
+  "lace.string" [style=dashed];
+}
+`, out.String())
+}
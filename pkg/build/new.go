@@ -0,0 +1,186 @@
+package build
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+//go:embed all:templates
+var templates embed.FS
+
+// Project describes a project being created from a template. Its fields are
+// available to the template's files and to their names as {{.Name}} and
+// so on, and "__ns__" in a file's path is replaced by NamespacePath.
+type Project struct {
+	Name      string
+	Namespace string
+	Module    string
+	GoVersion string
+}
+
+// NamespacePath is the path of the directory holding the project's
+// namespaces below a source root.
+func (p *Project) NamespacePath() string {
+	return strings.ReplaceAll(p.Namespace, ".", "/")
+}
+
+var nsCleanup = regexp.MustCompile(`[^a-z0-9.\-]+`)
+
+// NewProject describes a project called name, deriving its namespace and
+// Go module from it.
+func NewProject(name string) *Project {
+	ns := nsCleanup.ReplaceAllString(strings.ToLower(name), "-")
+	ns = strings.Trim(ns, "-.")
+
+	gover := strings.TrimPrefix(runtime.Version(), "go")
+	if parts := strings.SplitN(gover, ".", 3); len(parts) >= 2 {
+		gover = parts[0] + "." + parts[1]
+	}
+
+	return &Project{
+		Name:      name,
+		Namespace: ns,
+		Module:    name,
+		GoVersion: gover,
+	}
+}
+
+// TemplateNames returns the names of the built in templates.
+func TemplateNames() []string {
+	ents, _ := templates.ReadDir("templates")
+
+	var names []string
+	for _, ent := range ents {
+		if ent.IsDir() && ent.Name() != "base" {
+			names = append(names, ent.Name())
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// UserTemplateDir returns the directory user defined templates are looked
+// up in, which is LACE_TEMPLATES if set.
+func UserTemplateDir() string {
+	if dir := os.Getenv("LACE_TEMPLATES"); dir != "" {
+		return dir
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".laced", "templates")
+}
+
+// FindTemplate returns the layers of files making up the named template,
+// to be written in order. The name is either a directory, a template in
+// the user's template directory or a built in template. Built in templates
+// are layered over a base of the files every project has.
+func FindTemplate(name string) ([]fs.FS, error) {
+	if strings.ContainsRune(name, filepath.Separator) || strings.HasPrefix(name, ".") {
+		if fi, err := os.Stat(name); err == nil && fi.IsDir() {
+			return []fs.FS{os.DirFS(name)}, nil
+		}
+
+		return nil, fmt.Errorf("template directory not found: %s", name)
+	}
+
+	user := filepath.Join(UserTemplateDir(), name)
+	if fi, err := os.Stat(user); err == nil && fi.IsDir() {
+		return []fs.FS{os.DirFS(user)}, nil
+	}
+
+	if _, err := fs.Stat(templates, path.Join("templates", name)); err != nil || name == "base" {
+		return nil, fmt.Errorf("unknown template: %s (built in templates are %s)", name, strings.Join(TemplateNames(), ", "))
+	}
+
+	base, _ := fs.Sub(templates, "templates/base")
+	tmpl, _ := fs.Sub(templates, path.Join("templates", name))
+
+	return []fs.FS{base, tmpl}, nil
+}
+
+// Generate writes the files of the template layers into dir, which must
+// not exist or be empty. Each file is rendered as a text/template with p,
+// and a .tmpl suffix is removed from its name.
+func Generate(dir string, layers []fs.FS, p *Project) error {
+	if ents, err := os.ReadDir(dir); err == nil && len(ents) > 0 {
+		return fmt.Errorf("directory %s already exists and isn't empty", dir)
+	}
+
+	files := map[string][]byte{}
+
+	for _, layer := range layers {
+		err := fs.WalkDir(layer, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			data, err := fs.ReadFile(layer, name)
+			if err != nil {
+				return err
+			}
+
+			out, err := render(name, string(data), p)
+			if err != nil {
+				return err
+			}
+
+			target, err := render(name, strings.ReplaceAll(name, "__ns__", p.NamespacePath()), p)
+			if err != nil {
+				return err
+			}
+
+			files[strings.TrimSuffix(string(target), ".tmpl")] = out
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, data := range files {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(target, data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func render(name, text string, p *Project) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, p)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering template %s: %w", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("renders a built in template over the base", func(t *testing.T) {
+		dir := t.TempDir()
+
+		layers, err := FindTemplate("app")
+		require.NoError(t, err)
+
+		p := NewProject("My App")
+		require.Equal(t, "my-app", p.Namespace)
+
+		p.Module = "example.com/my-app"
+
+		require.NoError(t, Generate(dir, layers, p))
+
+		cfg, err := readConfig(dir)
+		require.NoError(t, err)
+		require.Equal(t, "My App", cfg.Name)
+
+		src, err := os.ReadFile(filepath.Join(dir, "src", "my-app", "core.clj"))
+		require.NoError(t, err)
+		require.Contains(t, string(src), "(ns my-app.core")
+
+		mod, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		require.NoError(t, err)
+		require.Contains(t, string(mod), "module example.com/my-app")
+
+		require.Error(t, Generate(dir, layers, p))
+	})
+
+	t.Run("uses a template directory", func(t *testing.T) {
+		tmpl := t.TempDir()
+		dir := filepath.Join(t.TempDir(), "out")
+
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpl, "__ns__"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpl, "__ns__", "{{.Name}}.txt.tmpl"), []byte("{{.Namespace}}"), 0644))
+
+		layers, err := FindTemplate(tmpl)
+		require.NoError(t, err)
+
+		require.NoError(t, Generate(dir, layers, NewProject("tool")))
+
+		data, err := os.ReadFile(filepath.Join(dir, "tool", "tool.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "tool", string(data))
+	})
+
+	t.Run("rejects unknown templates", func(t *testing.T) {
+		_, err := FindTemplate("nope")
+		require.Error(t, err)
+
+		_, err = FindTemplate("base")
+		require.Error(t, err)
+	})
+}
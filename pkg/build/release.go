@@ -0,0 +1,339 @@
+package build
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Target is a platform to build the program for. Name defaults to
+// "<os>-<arch>", and OS and Arch to those of the host. CGO enables or
+// disables cgo, leaving Go's default when unset.
+type Target struct {
+	Name    string   `yaml:"name"`
+	OS      string   `yaml:"os"`
+	Arch    string   `yaml:"arch"`
+	CGO     *bool    `yaml:"cgo"`
+	LDFlags string   `yaml:"ldflags"`
+	Tags    []string `yaml:"tags"`
+}
+
+func (t *Target) goos() string {
+	if t.OS == "" {
+		return runtime.GOOS
+	}
+
+	return t.OS
+}
+
+func (t *Target) goarch() string {
+	if t.Arch == "" {
+		return runtime.GOARCH
+	}
+
+	return t.Arch
+}
+
+func (t *Target) name() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	return t.goos() + "-" + t.goarch()
+}
+
+// BuildOptions controls a release build. Version is stamped into the
+// binaries, defaulting to the version in lace.yml and then to what git
+// describes the project as. Targets restricts the build to the targets
+// with those names. With Archive set, each binary is also packed into a
+// tar.gz, and a checksums file lists the sha256 of each.
+type BuildOptions struct {
+	Version string
+	Targets []string
+	Archive bool
+	Jobs    int
+}
+
+// Artifact is a file produced by a build.
+type Artifact struct {
+	Target string
+	Path   string
+	Sha256 string
+}
+
+// Build builds the program for each of the targets in lace.yml, or for the
+// host if there are none, in parallel. The binaries are written to
+// artifacts/<target>/<name> with the version and build id stamped into
+// their Version and BuildId vars.
+func (b *Builder) Build(ctx context.Context, opts BuildOptions) ([]Artifact, error) {
+	targets, err := b.targets(opts.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	version := opts.Version
+	if version == "" {
+		version = b.version(ctx)
+	}
+
+	sources, err := b.sources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := b.buildId(sources)
+	if err != nil {
+		return nil, err
+	}
+
+	b.log.Info("beginning release build", "name", b.cfg.Name, "version", version, "id", id, "targets", len(targets))
+
+	dir, err := b.prepare(ctx, id, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := opts.Jobs
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, jobs)
+		arts = make([]Artifact, len(targets))
+		errs = make([]error, len(targets))
+	)
+
+	for i := range targets {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			arts[i], errs[i] = b.compile(ctx, dir, &targets[i], version, id, opts.Archive)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("error building target %s: %w", targets[i].name(), err)
+		}
+	}
+
+	if opts.Archive {
+		path, err := b.writeChecksums(version, arts)
+		if err != nil {
+			return nil, err
+		}
+
+		arts = append(arts, Artifact{Path: path})
+	}
+
+	return arts, nil
+}
+
+func (b *Builder) targets(names []string) ([]Target, error) {
+	targets := b.cfg.Targets
+	if len(targets) == 0 {
+		targets = []Target{{}}
+	}
+
+	if len(names) == 0 {
+		return targets, nil
+	}
+
+	var sel []Target
+
+	for _, name := range names {
+		found := false
+
+		for _, t := range targets {
+			if t.name() == name {
+				sel = append(sel, t)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown target: %s", name)
+		}
+	}
+
+	return sel, nil
+}
+
+func (b *Builder) version(ctx context.Context) string {
+	if b.cfg.Version != "" {
+		return b.cfg.Version
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "describe", "--tags", "--always", "--dirty")
+	cmd.Dir = b.dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "dev"
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+func (b *Builder) compile(ctx context.Context, dir string, t *Target, version, id string, archive bool) (Artifact, error) {
+	art := Artifact{Target: t.name()}
+
+	exe := b.cfg.Name
+	if t.goos() == "windows" {
+		exe += ".exe"
+	}
+
+	art.Path = filepath.Join(b.dir, "artifacts", t.name(), exe)
+
+	ldflags := fmt.Sprintf("-X main.Version=%s -X main.BuildId=%s", version, id)
+	if t.LDFlags != "" {
+		ldflags += " " + t.LDFlags
+	}
+
+	args := []string{"build", "-trimpath", "-o", art.Path, "-ldflags", ldflags}
+	if len(t.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(t.Tags, ","))
+	}
+
+	args = append(args, ".")
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS="+t.goos(), "GOARCH="+t.goarch())
+
+	if t.CGO != nil {
+		if *t.CGO {
+			cmd.Env = append(cmd.Env, "CGO_ENABLED=1")
+		} else {
+			cmd.Env = append(cmd.Env, "CGO_ENABLED=0")
+		}
+	}
+
+	b.log.Info("compiling", "target", t.name(), "os", t.goos(), "arch", t.goarch())
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return art, fmt.Errorf("%w\n%s", err, out)
+	}
+
+	if archive {
+		path := filepath.Join(b.dir, "artifacts", fmt.Sprintf("%s-%s-%s.tar.gz", b.cfg.Name, version, t.name()))
+
+		err = writeArchive(path, art.Path, exe)
+		if err != nil {
+			return art, err
+		}
+
+		art.Path = path
+	}
+
+	art.Sha256, err = hashFile(art.Path)
+	if err != nil {
+		return art, err
+	}
+
+	b.log.Info("built target", "target", t.name(), "path", art.Path)
+
+	return art, nil
+}
+
+// writeArchive packs the binary at exe into a tar.gz at path as name. The
+// archive's headers carry no times or owners, so the same binary always
+// packs to the same archive.
+func writeArchive(path, exe, name string) error {
+	data, err := os.ReadFile(exe)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	err = tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0755,
+		Size:     int64(len(data)),
+		ModTime:  time.Unix(0, 0),
+		Typeflag: tar.TypeReg,
+		Format:   tar.FormatPAX,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tw.Write(data)
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+
+	err = gz.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// writeChecksums writes the sha256 of each artifact in the format of
+// sha256sum, returning the path of the file.
+func (b *Builder) writeChecksums(version string, arts []Artifact) (string, error) {
+	var lines []string
+
+	for _, art := range arts {
+		lines = append(lines, fmt.Sprintf("%s  %s\n", art.Sha256, filepath.Base(art.Path)))
+	}
+
+	sort.Strings(lines)
+
+	path := filepath.Join(b.dir, "artifacts", fmt.Sprintf("%s-%s-checksums.txt", b.cfg.Name, version))
+
+	return path, os.WriteFile(path, []byte(strings.Join(lines, "")), 0644)
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
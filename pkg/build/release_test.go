@@ -0,0 +1,81 @@
+package build
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelease(t *testing.T) {
+	t.Run("selects targets by name", func(t *testing.T) {
+		r := require.New(t)
+
+		b := &Builder{cfg: &Config{
+			Name: "app",
+			Targets: []Target{
+				{OS: "linux", Arch: "amd64"},
+				{Name: "mac", OS: "darwin", Arch: "arm64"},
+			},
+		}}
+
+		all, err := b.targets(nil)
+		r.NoError(err)
+		r.Len(all, 2)
+
+		sel, err := b.targets([]string{"mac"})
+		r.NoError(err)
+		r.Len(sel, 1)
+		r.Equal("darwin", sel[0].goos())
+
+		_, err = b.targets([]string{"linux-arm64"})
+		r.ErrorContains(err, "unknown target")
+
+		b.cfg.Targets = nil
+
+		host, err := b.targets(nil)
+		r.NoError(err)
+		r.Equal(runtime.GOOS+"-"+runtime.GOARCH, host[0].name())
+	})
+
+	t.Run("packs binaries into reproducible archives", func(t *testing.T) {
+		r := require.New(t)
+
+		dir := t.TempDir()
+		exe := filepath.Join(dir, "app")
+		r.NoError(os.WriteFile(exe, []byte("binary"), 0755))
+
+		a := filepath.Join(dir, "a.tar.gz")
+		r.NoError(writeArchive(a, exe, "app"))
+
+		r.NoError(os.Chtimes(exe, time.Now(), time.Now().Add(time.Hour)))
+
+		b := filepath.Join(dir, "b.tar.gz")
+		r.NoError(writeArchive(b, exe, "app"))
+
+		sa, err := hashFile(a)
+		r.NoError(err)
+
+		sb, err := hashFile(b)
+		r.NoError(err)
+
+		r.Equal(sa, sb)
+
+		f, err := os.Open(a)
+		r.NoError(err)
+		defer f.Close()
+
+		gz, err := gzip.NewReader(f)
+		r.NoError(err)
+
+		hdr, err := tar.NewReader(gz).Next()
+		r.NoError(err)
+		r.Equal("app", hdr.Name)
+		r.Equal(int64(0755), hdr.Mode)
+	})
+}
@@ -0,0 +1,289 @@
+package build
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lab47/lablog/logger"
+	"github.com/lab47/lace/core"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+// scriptName is the name of the programs built to run scripts.
+const scriptName = "lace-script"
+
+// ScriptConfig reads the ns form at the start of the script at path and
+// returns the config of a program to run it with, built from the
+// :lace/go-imports and :lace/deps in the form's metadata:
+//
+//	#!/usr/bin/env lace
+//	(ns ^{:lace/go-imports ["github.com/google/uuid"]
+//	      :lace/deps {"greet" {:git "https://github.com/example/greet" :ref "v1.2.0"}}}
+//	  tool)
+//
+// A go-import is a package path or a map of :path and :as. Deps are named
+// by strings or keywords, as the metadata is evaluated, and each is a map of
+// the fields of a lace.yml dependency. A path dep is relative to the script.
+// If the script declares neither, the config is nil.
+func ScriptConfig(env *core.Env, path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	// A script that can't be read is left to report that when it's run.
+	form, err := core.TryRead(env, core.NewReader(bufio.NewReader(f), path))
+	if err != nil {
+		return nil, nil
+	}
+
+	list, ok := form.(*core.List)
+	if !ok {
+		return nil, nil
+	}
+
+	elems, err := core.ToSlice(env, list.Seq())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elems) < 2 {
+		return nil, nil
+	}
+
+	if sym, ok := elems[0].(core.Symbol); !ok || sym.Namespace() != "" || sym.Name() != "ns" {
+		return nil, nil
+	}
+
+	// The metadata may be on the form or, as is usual, on the name.
+	metas := []any{list}
+	if name, ok := elems[1].(core.Symbol); ok {
+		metas = append(metas, name)
+	}
+
+	var goImports, deps any
+
+	for _, obj := range metas {
+		m, ok := obj.(core.Meta)
+		if !ok || m.GetMeta() == nil {
+			continue
+		}
+
+		meta := m.GetMeta()
+
+		if ok, v, _ := meta.Get(env, core.MakeKeyword("lace/go-imports")); ok {
+			goImports = v
+		}
+
+		if ok, v, _ := meta.Get(env, core.MakeKeyword("lace/deps")); ok {
+			deps = v
+		}
+	}
+
+	if goImports == nil && deps == nil {
+		return nil, nil
+	}
+
+	cfg := &Config{Name: scriptName}
+
+	if goImports != nil {
+		cfg.GoImports, err = scriptGoImports(env, goImports)
+		if err != nil {
+			return nil, fmt.Errorf("%s: :lace/go-imports %w", path, err)
+		}
+	}
+
+	if deps != nil {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Dependencies, err = scriptDeps(env, deps, filepath.Dir(abs))
+		if err != nil {
+			return nil, fmt.Errorf("%s: :lace/deps %w", path, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func scriptGoImports(env *core.Env, obj any) ([]GoImport, error) {
+	seq, ok := obj.(core.Seqable)
+	if !ok {
+		return nil, fmt.Errorf("must be a vector")
+	}
+
+	elems, err := core.ToSlice(env, seq.Seq())
+	if err != nil {
+		return nil, err
+	}
+
+	var imps []GoImport
+
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case core.String:
+			imps = append(imps, GoImport{Path: elem.S()})
+		case core.Map:
+			var imp GoImport
+
+			if ok, v, _ := elem.Get(env, core.MakeKeyword("path")); ok {
+				imp.Path = scriptString(v)
+			}
+
+			if ok, v, _ := elem.Get(env, core.MakeKeyword("as")); ok {
+				imp.As = scriptString(v)
+			}
+
+			if imp.Path == "" {
+				return nil, fmt.Errorf("entry is missing a :path")
+			}
+
+			imps = append(imps, imp)
+		default:
+			return nil, fmt.Errorf("entries must be package paths or maps")
+		}
+	}
+
+	return imps, nil
+}
+
+func scriptDeps(env *core.Env, obj any, dir string) ([]Dependency, error) {
+	m, ok := obj.(core.Map)
+	if !ok {
+		return nil, fmt.Errorf("must be a map of names to dependencies")
+	}
+
+	entries, err := core.ToSlice(env, m.Seq())
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []Dependency
+
+	for _, entry := range entries {
+		kv, err := core.ToSlice(env, entry.(core.Seqable).Seq())
+		if err != nil {
+			return nil, err
+		}
+
+		spec, ok := kv[1].(core.Map)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a map", scriptString(kv[0]))
+		}
+
+		dep := Dependency{Name: scriptString(kv[0])}
+
+		for key, field := range map[string]*string{
+			"git":  &dep.Git,
+			"ref":  &dep.Ref,
+			"url":  &dep.URL,
+			"path": &dep.Path,
+			"root": &dep.Root,
+		} {
+			if ok, v, _ := spec.Get(env, core.MakeKeyword(key)); ok {
+				*field = scriptString(v)
+			}
+		}
+
+		if dep.Path != "" && !filepath.IsAbs(dep.Path) {
+			dep.Path = filepath.Join(dir, filepath.FromSlash(dep.Path))
+		}
+
+		err = dep.validate()
+		if err != nil {
+			return nil, err
+		}
+
+		deps = append(deps, dep)
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].Name < deps[j].Name
+	})
+
+	return deps, nil
+}
+
+// scriptString returns the text of a string, or the name of a keyword or
+// symbol.
+func scriptString(obj any) string {
+	switch obj := obj.(type) {
+	case core.String:
+		return obj.S()
+	case core.Symbol:
+		return obj.Name()
+	case core.Keyword:
+		return obj.Name()
+	default:
+		return core.SimpleToString(obj)
+	}
+}
+
+// ScriptCache returns the directory the programs built to run scripts are
+// kept in, which is LACE_SCRIPT_CACHE if set.
+func ScriptCache() string {
+	if dir := os.Getenv("LACE_SCRIPT_CACHE"); dir != "" {
+		return dir
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".laced", "scripts")
+}
+
+// BuildScript builds the program that runs scripts with the config cfg,
+// returned by ScriptConfig, unless it's already in the script cache. It
+// returns the program along with the directories of the script's deps,
+// to load their namespaces from.
+func BuildScript(ctx context.Context, log logger.Logger, cfg *Config, offline bool) (string, []string, error) {
+	data, err := cbor.Marshal(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Scripts declaring the same imports and deps share a program, which is
+	// only rebuilt by the builder when those or its deps change.
+	sum := blake2b.Sum256(data)
+	dir := filepath.Join(ScriptCache(), base58.Encode(sum[:]))
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", nil, err
+	}
+
+	b := &Builder{
+		log:     log,
+		dir:     dir,
+		cfg:     cfg,
+		offline: offline,
+	}
+
+	b.goMod, err = os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		err = b.writeGoMod()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	exe, err := b.Run(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	lock, err := ReadLock(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return exe, NewResolver(log, dir, cfg).ClassPath(lock), nil
+}
@@ -0,0 +1,60 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lab47/lace/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	env, err := core.NewEnv()
+	require.NoError(t, err)
+
+	t.Run("reads go-imports and deps from ns metadata", func(t *testing.T) {
+		path := filepath.Join(dir, "tool.clj")
+		require.NoError(t, os.WriteFile(path, []byte(`#!/usr/bin/env lace
+(ns ^{:lace/go-imports ["github.com/google/uuid" {:path "github.com/foo/bar/v2" :as "bar"}]
+      :lace/deps {"greet" {:git "https://github.com/example/greet" :ref "v1.2.0" :root "src"}
+                  :shared {:path "../shared"}}}
+  tool)
+
+(println (uuid/New))
+`), 0644))
+
+		cfg, err := ScriptConfig(env, path)
+		require.NoError(t, err)
+		require.NotNil(t, cfg)
+
+		require.Equal(t, []GoImport{
+			{Path: "github.com/google/uuid"},
+			{Path: "github.com/foo/bar/v2", As: "bar"},
+		}, cfg.GoImports)
+
+		require.Equal(t, []Dependency{
+			{Name: "greet", Git: "https://github.com/example/greet", Ref: "v1.2.0", Root: "src"},
+			{Name: "shared", Path: filepath.Join(filepath.Dir(dir), "shared")},
+		}, cfg.Dependencies)
+	})
+
+	t.Run("ignores scripts without lace metadata", func(t *testing.T) {
+		path := filepath.Join(dir, "plain.clj")
+		require.NoError(t, os.WriteFile(path, []byte("(ns ^{:doc \"plain\"} plain)\n"), 0644))
+
+		cfg, err := ScriptConfig(env, path)
+		require.NoError(t, err)
+		require.Nil(t, cfg)
+	})
+
+	t.Run("rejects bad deps", func(t *testing.T) {
+		path := filepath.Join(dir, "bad.clj")
+		require.NoError(t, os.WriteFile(path, []byte("(ns ^{:lace/deps {\"x\" {:ref \"v1\"}}} bad)\n"), 0644))
+
+		_, err := ScriptConfig(env, path)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package build
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Task is a named piece of work declared in the tasks section of lace.yml.
+// It either evaluates the lace forms in Run or calls the namespace qualified
+// function named by Fn, after the tasks in Depends have completed. A task
+// with neither just runs its dependencies.
+//
+// Options is a lace vector of lace.tools.cli option specs. The arguments
+// given to a Fn task are parsed against them and the result of parse-opts
+// is passed to the function.
+type Task struct {
+	Doc     string   `yaml:"doc"`
+	Depends []string `yaml:"depends"`
+	Run     string   `yaml:"run"`
+	Fn      string   `yaml:"fn"`
+	Options string   `yaml:"options"`
+}
+
+// Namespace returns the namespace of the task's function.
+func (t *Task) Namespace() string {
+	ns, _, _ := strings.Cut(t.Fn, "/")
+	return ns
+}
+
+func (t *Task) validate(name string, tasks map[string]Task) error {
+	if t.Run != "" && t.Fn != "" {
+		return fmt.Errorf("task %s must have only one of run or fn", name)
+	}
+
+	if t.Fn != "" {
+		ns, fn, ok := strings.Cut(t.Fn, "/")
+		if !ok || ns == "" || fn == "" {
+			return fmt.Errorf("task %s has fn %s, which isn't a namespace qualified name", name, t.Fn)
+		}
+	}
+
+	if t.Options != "" && t.Fn == "" {
+		return fmt.Errorf("task %s has options but no fn to pass them to", name)
+	}
+
+	for _, dep := range t.Depends {
+		if _, ok := tasks[dep]; !ok {
+			return fmt.Errorf("task %s depends on unknown task %s", name, dep)
+		}
+	}
+
+	return nil
+}
+
+// TaskNames returns the names of the project's tasks, sorted.
+func (c *Config) TaskNames() []string {
+	var names []string
+
+	for name := range c.Tasks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// TaskOrder returns the named task and those it depends on, directly or
+// not, ordered so that each task comes after its dependencies.
+func (c *Config) TaskOrder(name string) ([]string, error) {
+	if _, ok := c.Tasks[name]; !ok {
+		return nil, fmt.Errorf("unknown task: %s", name)
+	}
+
+	var (
+		order []string
+		state = map[string]int{}
+		visit func(name string, path []string) error
+	)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	visit = func(name string, path []string) error {
+		path = append(path, name)
+
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("tasks depend on each other: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dep := range c.Tasks[name].Depends {
+			err := visit(dep, path)
+			if err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	err := visit(name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (c *Config) validateTasks() error {
+	for _, name := range c.TaskNames() {
+		t := c.Tasks[name]
+
+		err := t.validate(name, c.Tasks)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.TaskOrder(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
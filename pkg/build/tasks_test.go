@@ -0,0 +1,65 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTasks(t *testing.T) {
+	write := func(t *testing.T, yml string) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "lace.yml"), []byte(yml), 0644))
+		return dir
+	}
+
+	t.Run("orders tasks after their dependencies", func(t *testing.T) {
+		cfg, err := LoadConfig(write(t, `
+name: app
+tasks:
+  gen:
+    run: (println "gen")
+  lint:
+    depends: [gen]
+    run: (println "lint")
+  test:
+    depends: [gen]
+    fn: app.tasks/test
+  all:
+    doc: Everything
+    depends: [lint, test]
+`))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"all", "gen", "lint", "test"}, cfg.TaskNames())
+
+		order, err := cfg.TaskOrder("all")
+		require.NoError(t, err)
+		require.Equal(t, []string{"gen", "lint", "test", "all"}, order)
+
+		order, err = cfg.TaskOrder("test")
+		require.NoError(t, err)
+		require.Equal(t, []string{"gen", "test"}, order)
+
+		task := cfg.Tasks["test"]
+		require.Equal(t, "app.tasks", task.Namespace())
+
+		_, err = cfg.TaskOrder("nope")
+		require.Error(t, err)
+	})
+
+	t.Run("rejects invalid tasks", func(t *testing.T) {
+		for _, yml := range []string{
+			"tasks:\n  a:\n    depends: [b]\n",
+			"tasks:\n  a:\n    run: (x)\n    fn: app/x\n",
+			"tasks:\n  a:\n    fn: x\n",
+			"tasks:\n  a:\n    run: (x)\n    options: '[]'\n",
+			"tasks:\n  a:\n    depends: [b]\n  b:\n    depends: [a]\n",
+		} {
+			_, err := LoadConfig(write(t, yml))
+			require.Error(t, err, yml)
+		}
+	})
+}
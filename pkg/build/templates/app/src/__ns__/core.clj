@@ -0,0 +1,9 @@
+(ns {{.Namespace}}.core)
+
+(defn greet
+  "Returns a greeting for who."
+  [who]
+  (str "hello, " who))
+
+(defn main []
+  (println (greet "world")))
@@ -0,0 +1,19 @@
+(ns {{.Namespace}}.core
+  (:require [lace.tools.cli :refer [parse-opts]]))
+
+(def cli-options
+  [["-n" "--name NAME" "Who to greet" :default "world"]
+   ["-h" "--help" "Show this help"]])
+
+(defn greet
+  "Returns a greeting for who."
+  [who]
+  (str "hello, " who))
+
+(defn main []
+  (let [{:keys [options errors summary]} (parse-opts *command-line-args* cli-options)]
+    (cond
+      errors (do (doseq [e errors] (println-err e))
+                 (exit 1))
+      (:help options) (println (str "usage: {{.Name}} [options]\n\n" summary))
+      :else (println (greet (:name options))))))
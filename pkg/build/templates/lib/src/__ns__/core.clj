@@ -0,0 +1,6 @@
+(ns {{.Namespace}}.core)
+
+(defn greet
+  "Returns a greeting for who."
+  [who]
+  (str "hello, " who))
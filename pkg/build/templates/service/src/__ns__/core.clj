@@ -0,0 +1,14 @@
+(ns {{.Namespace}}.core
+  (:require [lace.rpc :as rpc]))
+
+(defn greet
+  "Returns a greeting for who."
+  [who]
+  (str "hello, " who))
+
+(defn main []
+  (rpc/defendpoint {{.Namespace}} hello [who]
+    (greet who))
+  (println "serving {{.Namespace}}")
+  ;; Serve until the process is stopped.
+  (<! (chan)))
@@ -0,0 +1,6 @@
+(ns {{.Namespace}}.core-test
+  (:require [lace.test :refer [deftest is]]
+            [{{.Namespace}}.core]))
+
+(deftest greet
+  (is (= "hello, lace" ({{.Namespace}}.core/greet "lace"))))
@@ -0,0 +1,104 @@
+package marshal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lab47/lace/core"
+)
+
+// Code is a function marshaled as its compiled code and the values of the
+// upvals it captured, so that it runs where it's unmarshaled instead of
+// being called back through a ForeignRef. Bytecode is only understood by
+// the version of lace that compiled it, which is recorded as Version.
+type Code struct {
+	Version string            `json:"version" cbor:"1,keyasint"`
+	Code    *core.CodeAsData  `json:"code" cbor:"2,keyasint"`
+	Upvals  []cbor.RawMessage `json:"upvals,omitempty" cbor:"3,keyasint,omitempty"`
+}
+
+// ErrVersionMismatch is returned when unmarshaling code compiled by
+// another version of lace.
+var ErrVersionMismatch = errors.New("code was compiled by another version of lace")
+
+// ErrCodeNotAllowed is returned when unmarshaling code with an importer
+// that wasn't wrapped by AllowCode.
+var ErrCodeNotAllowed = errors.New("shipped code is not allowed here")
+
+// codeImporter is an Importer that also accepts Code. The wrapped
+// Importer may be nil.
+type codeImporter struct {
+	Importer
+}
+
+// AllowCode returns imp wrapped so that values unmarshaled with it may
+// contain Code, which are rebuilt into functions that run in this process.
+// Only values from callers trusted to run code should be unmarshaled with
+// it; everywhere else Code is refused with ErrCodeNotAllowed.
+func AllowCode(imp Importer) Importer {
+	return codeImporter{Importer: imp}
+}
+
+// Shipped wraps a function so that it's marshaled as Code rather than as a
+// ForeignRef.
+type Shipped struct {
+	Fn *core.Fn
+
+	env *core.Env
+}
+
+// Ship returns fn wrapped to be marshaled as its code.
+func Ship(env *core.Env, fn *core.Fn) *Shipped {
+	return &Shipped{Fn: fn, env: env}
+}
+
+func (m *marshalState) encodeShipped(sv *Shipped) ([]byte, error) {
+	cad, upvals, err := core.FnAsData(sv.env, sv.Fn)
+	if err != nil {
+		return nil, err
+	}
+
+	code := Code{
+		Version: core.VERSION,
+		Code:    cad,
+	}
+
+	for _, uv := range upvals {
+		d, err := m.Marshal(uv)
+		if err != nil {
+			return nil, err
+		}
+
+		code.Upvals = append(code.Upvals, cbor.RawMessage(d))
+	}
+
+	return m.encode(code)
+}
+
+func (s *unmarshalState) unmarshalCode(code Code) (any, error) {
+	if !s.code {
+		return nil, ErrCodeNotAllowed
+	}
+
+	if code.Version != core.VERSION {
+		return nil, fmt.Errorf("%w: compiled by %s, running %s", ErrVersionMismatch, code.Version, core.VERSION)
+	}
+
+	if code.Code == nil {
+		return nil, fmt.Errorf("marshaled function has no code")
+	}
+
+	var upvals []any
+
+	for _, d := range code.Upvals {
+		uv, err := s.unmarshal(d)
+		if err != nil {
+			return nil, err
+		}
+
+		upvals = append(upvals, uv)
+	}
+
+	return core.FnFromData(s.env, code.Code, upvals)
+}
@@ -0,0 +1,1086 @@
+package marshal
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lab47/lace/core"
+)
+
+// Values EDN has no literal for are written with tags in the lace
+// namespace, while the rest are written as the lace reader reads them:
+//
+//	big numbers     1N, 1.5M, 1/2
+//	doubles         1.0, ##NaN, ##Inf, ##-Inf
+//	regexes         #"a+"
+//	insts, UUIDs    #inst "2006-01-02T15:04:05Z", #uuid "..."
+//	byte slices     #lace/bytes "<base64>"
+//	functions       #lace/ref {:ref "..." :owner "..."}
+//	shipped code    #lace/code "<base64 of the CBOR>"
+//	Go structs      #lace/struct ["type" fields]
+//	other tags      #lace/tag [n value]
+//
+// Values read with a tag lace has no reader for are kept as a TaggedEDN.
+type ednState struct {
+	m   *marshalState
+	buf bytes.Buffer
+}
+
+// MarshalEDN marshals obj as EDN.
+func MarshalEDN(obj any) ([]byte, error) {
+	return MarshalEDNWith(obj, nil)
+}
+
+// MarshalEDNWith is MarshalEDN, registering the functions and vars obj
+// references with exp.
+func MarshalEDNWith(obj any, exp Exporter) ([]byte, error) {
+	es := ednState{
+		m: &marshalState{
+			refs:  make(map[any]int),
+			frefs: make(map[string]any),
+			exp:   exp,
+		},
+	}
+
+	if err := es.write(obj); err != nil {
+		return nil, err
+	}
+
+	return es.buf.Bytes(), nil
+}
+
+func writeEDNString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+
+	buf.WriteByte('"')
+}
+
+var charNames = map[rune]string{
+	'\n': "newline",
+	' ':  "space",
+	'\t': "tab",
+	'\r': "return",
+	'\b': "backspace",
+	'\f': "formfeed",
+}
+
+func writeEDNChar(buf *bytes.Buffer, r rune) {
+	buf.WriteByte('\\')
+
+	switch {
+	case charNames[r] != "":
+		buf.WriteString(charNames[r])
+	case r < 0x20 || r == 0x7f:
+		fmt.Fprintf(buf, "u%04x", r)
+	default:
+		buf.WriteRune(r)
+	}
+}
+
+func (es *ednState) write(obj any) error {
+	buf := &es.buf
+
+	switch sv := obj.(type) {
+	case Foreign:
+		es.writeRef(sv.ForeignRef())
+	case *Shipped:
+		code, err := es.m.encodeShipped(sv)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString("#lace/code ")
+		writeEDNString(buf, base64.StdEncoding.EncodeToString(code))
+	case *TaggedValue:
+		return es.writeTagged(sv.Tag, sv.Value)
+	case *TaggedEDN:
+		buf.WriteString("#" + sv.Tag + " ")
+		return es.write(sv.Value)
+	case core.StructMap:
+		return es.writeGo(sv.Value())
+	case []byte:
+		buf.WriteString("#lace/bytes ")
+		writeEDNString(buf, base64.StdEncoding.EncodeToString(sv))
+	case core.Symbol:
+		buf.WriteString(sv.String())
+	case core.Keyword:
+		buf.WriteString(":" + sv.RawString())
+	case core.Int:
+		buf.WriteString(strconv.Itoa(sv.I()))
+	case core.Double:
+		switch {
+		case math.IsNaN(sv.D):
+			buf.WriteString("##NaN")
+		case math.IsInf(sv.D, 1):
+			buf.WriteString("##Inf")
+		case math.IsInf(sv.D, -1):
+			buf.WriteString("##-Inf")
+		default:
+			buf.WriteString(formatDouble(sv.D))
+		}
+	case *core.BigInt:
+		buf.WriteString(sv.BigInt().String() + "N")
+	case *core.BigFloat:
+		buf.WriteString(sv.BigFloat().Text('g', -1) + "M")
+	case *core.Ratio:
+		buf.WriteString(sv.Ratio().String())
+	case core.Char:
+		writeEDNChar(buf, sv.Ch())
+	case core.Boolean:
+		buf.WriteString(strconv.FormatBool(bool(sv)))
+	case core.Nil:
+		buf.WriteString("nil")
+	case core.String:
+		writeEDNString(buf, sv.S())
+	case *core.Regex:
+		es.writeRegex(sv.R.String())
+	case core.Time:
+		buf.WriteString("#inst ")
+		writeEDNString(buf, sv.T.Format(time.RFC3339Nano))
+	case *core.Var, *core.Fn, *core.Channel:
+		es.writeRef(es.m.newFref(sv))
+	case *core.List:
+		return es.writeSeq("(", ")", sv)
+	case *core.Vector:
+		return es.writeSeq("[", "]", sv)
+	case core.Set:
+		return es.writeSet(sv)
+	case core.Map:
+		return es.writeMap(sv)
+	case core.Seqable:
+		return es.writeSeq("(", ")", sv)
+	default:
+		return es.writeGo(obj)
+	}
+
+	return nil
+}
+
+// writeRegex writes the pattern of a regex, escaping the quotes that would
+// end it.
+func (es *ednState) writeRegex(pat string) {
+	buf := &es.buf
+
+	buf.WriteString(`#"`)
+
+	escaped := false
+
+	for _, r := range pat {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			buf.WriteByte('\\')
+		}
+
+		buf.WriteRune(r)
+	}
+
+	buf.WriteByte('"')
+}
+
+func (es *ednState) writeRef(ref ForeignRef) {
+	buf := &es.buf
+
+	buf.WriteString("#lace/ref {:ref ")
+	writeEDNString(buf, ref.Ref)
+
+	if ref.Owner != "" {
+		buf.WriteString(" :owner ")
+		writeEDNString(buf, ref.Owner)
+	}
+
+	buf.WriteByte('}')
+}
+
+func (es *ednState) writeTagged(tag uint64, val any) error {
+	if tag == uuidTag {
+		if b, ok := val.([]byte); ok && len(b) == 16 {
+			es.buf.WriteString("#uuid ")
+			writeEDNString(&es.buf, UUID(b).String())
+			return nil
+		}
+	}
+
+	fmt.Fprintf(&es.buf, "#lace/tag [%d ", tag)
+
+	if err := es.write(val); err != nil {
+		return err
+	}
+
+	es.buf.WriteByte(']')
+
+	return nil
+}
+
+func (es *ednState) writeGo(obj any) error {
+	gv, err := goValueOf(es.m.env, obj)
+	if err != nil {
+		return err
+	}
+
+	switch gv.kind {
+	case goBytes, goData:
+		return es.write(gv.data)
+	case goCodec:
+		return es.writeTagged(gv.tag, gv.data)
+	case goStruct:
+		es.buf.WriteString("#lace/struct [")
+		writeEDNString(&es.buf, gv.name)
+		es.buf.WriteByte(' ')
+
+		if err := es.write(gv.data); err != nil {
+			return err
+		}
+
+		es.buf.WriteByte(']')
+	default:
+		es.buf.WriteString("nil")
+	}
+
+	return nil
+}
+
+func (es *ednState) writeSeq(open, close string, sq core.Seqable) error {
+	elems, err := core.ToSlice(es.m.env, sq.Seq())
+	if err != nil {
+		return err
+	}
+
+	es.buf.WriteString(open)
+
+	for i, el := range elems {
+		if i > 0 {
+			es.buf.WriteByte(' ')
+		}
+
+		if err := es.write(el); err != nil {
+			return err
+		}
+	}
+
+	es.buf.WriteString(close)
+
+	return nil
+}
+
+func (es *ednState) writeSet(set core.Set) error {
+	es.buf.WriteString("#{")
+
+	iter := set.SetIter()
+	for i := 0; iter.HasNext(es.m.env); i++ {
+		el, err := iter.Next(es.m.env)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			es.buf.WriteByte(' ')
+		}
+
+		if err := es.write(el); err != nil {
+			return err
+		}
+	}
+
+	es.buf.WriteByte('}')
+
+	return nil
+}
+
+func (es *ednState) writeMap(ma core.Map) error {
+	es.buf.WriteByte('{')
+
+	iter := ma.Iter()
+	for i := 0; iter.HasNext(); i++ {
+		p := iter.Next()
+
+		if i > 0 {
+			es.buf.WriteString(", ")
+		}
+
+		if err := es.write(p.Key); err != nil {
+			return err
+		}
+
+		es.buf.WriteByte(' ')
+
+		if err := es.write(p.Value); err != nil {
+			return err
+		}
+	}
+
+	es.buf.WriteByte('}')
+
+	return nil
+}
+
+// UnmarshalEDN unmarshals data marshaled by MarshalEDN, or any EDN that
+// uses only the tags it writes.
+func UnmarshalEDN(env *core.Env, data []byte) (any, error) {
+	return UnmarshalEDNWith(env, data, nil)
+}
+
+// UnmarshalEDNWith is UnmarshalEDN, using imp to resolve any foreign refs.
+func UnmarshalEDNWith(env *core.Env, data []byte, imp Importer) (any, error) {
+	r := &ednReader{
+		data: data,
+		s:    newUnmarshalState(env, imp),
+	}
+
+	val, err := r.read(true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.end(); err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// ednReader reads EDN values. When reading without decoding, the values are
+// only skipped over, which is how Raw values are found in an envelope.
+//
+// The core reader isn't used because it reads code rather than data, from
+// a peer that isn't trusted:
+//
+//   - It reads tags by calling the functions in *data-readers* and fails on
+//     tags without one, while tags here are resolved against the unmarshal
+//     state, for refs and code, and others are kept as a TaggedEDN.
+//   - It accepts syntax EDN doesn't have, such as syntax quote, #(), #'
+//     and reader conditionals, and resolves ::keywords against the current
+//     namespace of the env.
+//   - It has no ##NaN, ##Inf or ##-Inf, which MarshalEDN writes.
+//   - It reads runes through a window it can unread from, keeping only the
+//     line and column, so it can't give the offsets of a Raw value.
+type ednReader struct {
+	data []byte
+	pos  int
+
+	// s is nil when reading an envelope, which only has scalars.
+	s *unmarshalState
+}
+
+func (r *ednReader) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid EDN at offset %d: %s", r.pos, fmt.Sprintf(format, args...))
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f', ',', '(', ')', '[', ']', '{', '}', '"', ';':
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *ednReader) skipSpace() error {
+	for r.pos < len(r.data) {
+		switch c := r.data[r.pos]; {
+		case c == ';':
+			for r.pos < len(r.data) && r.data[r.pos] != '\n' {
+				r.pos++
+			}
+		case c == '#' && r.pos+1 < len(r.data) && r.data[r.pos+1] == '_':
+			r.pos += 2
+
+			if _, err := r.read(false); err != nil {
+				return err
+			}
+		case isDelimiter(c) && c != '(' && c != ')' && c != '[' && c != ']' &&
+			c != '{' && c != '}' && c != '"':
+			r.pos++
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// end checks that only whitespace is left.
+func (r *ednReader) end() error {
+	if err := r.skipSpace(); err != nil {
+		return err
+	}
+
+	if r.pos < len(r.data) {
+		return r.errorf("unexpected data after value")
+	}
+
+	return nil
+}
+
+func (r *ednReader) token() string {
+	start := r.pos
+
+	for r.pos < len(r.data) && !isDelimiter(r.data[r.pos]) {
+		r.pos++
+	}
+
+	return string(r.data[start:r.pos])
+}
+
+func (r *ednReader) env() *core.Env {
+	if r.s == nil {
+		return nil
+	}
+
+	return r.s.env
+}
+
+func (r *ednReader) read(decode bool) (any, error) {
+	if err := r.skipSpace(); err != nil {
+		return nil, err
+	}
+
+	if r.pos >= len(r.data) {
+		return nil, r.errorf("unexpected end of input")
+	}
+
+	switch c := r.data[r.pos]; c {
+	case '(', '[', '{':
+		if decode && r.s == nil {
+			return nil, r.errorf("expected a scalar")
+		}
+
+		r.pos++
+
+		return r.readColl(c, decode)
+	case ')', ']', '}':
+		return nil, r.errorf("unexpected %c", c)
+	case '"':
+		r.pos++
+
+		s, err := r.readString()
+		if err != nil {
+			return nil, err
+		}
+
+		return core.MakeString(s), nil
+	case '\\':
+		r.pos++
+		return r.readChar()
+	case ':':
+		r.pos++
+
+		name := r.token()
+		if name == "" {
+			return nil, r.errorf("empty keyword")
+		}
+
+		return core.MakeKeyword(name), nil
+	case '#':
+		r.pos++
+		return r.readDispatch(decode)
+	default:
+		return r.readAtom(r.token())
+	}
+}
+
+// readElems reads the forms of a collection up to the byte that closes it.
+func (r *ednReader) readElems(close byte, decode bool) ([]any, error) {
+	var elems []any
+
+	for {
+		if err := r.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if r.pos >= len(r.data) {
+			return nil, r.errorf("unexpected end of input")
+		}
+
+		if r.data[r.pos] == close {
+			r.pos++
+			return elems, nil
+		}
+
+		el, err := r.read(decode)
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, el)
+	}
+}
+
+func (r *ednReader) readColl(open byte, decode bool) (any, error) {
+	close := map[byte]byte{'(': ')', '[': ']', '{': '}'}[open]
+
+	elems, err := r.readElems(close, decode)
+	if err != nil || !decode {
+		return nil, err
+	}
+
+	switch open {
+	case '(':
+		return core.NewListFrom(elems...), nil
+	case '[':
+		return core.NewVectorFrom(elems...), nil
+	default:
+		if len(elems)%2 != 0 {
+			return nil, r.errorf("map with an odd number of forms")
+		}
+
+		return core.NewHashMap(r.env(), elems...)
+	}
+}
+
+func (r *ednReader) readString() (string, error) {
+	var sb strings.Builder
+
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		r.pos++
+
+		switch c {
+		case '"':
+			return sb.String(), nil
+		case '\\':
+			if r.pos >= len(r.data) {
+				return "", r.errorf("unterminated string")
+			}
+
+			e := r.data[r.pos]
+			r.pos++
+
+			switch e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case '"', '\\':
+				sb.WriteByte(e)
+			case 'u':
+				if r.pos+4 > len(r.data) {
+					return "", r.errorf("invalid unicode escape")
+				}
+
+				n, err := strconv.ParseUint(string(r.data[r.pos:r.pos+4]), 16, 32)
+				if err != nil {
+					return "", r.errorf("invalid unicode escape")
+				}
+
+				r.pos += 4
+				sb.WriteRune(rune(n))
+			default:
+				return "", r.errorf("invalid escape \\%c", e)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", r.errorf("unterminated string")
+}
+
+func (r *ednReader) readChar() (any, error) {
+	if r.pos >= len(r.data) {
+		return nil, r.errorf("unexpected end of input")
+	}
+
+	// The first rune is taken even if it's a delimiter, as in \(.
+	ch, size := utf8.DecodeRune(r.data[r.pos:])
+	r.pos += size
+
+	rest := r.token()
+	if rest == "" {
+		return core.NewChar(ch), nil
+	}
+
+	name := string(ch) + rest
+
+	for c, n := range charNames {
+		if n == name {
+			return core.NewChar(c), nil
+		}
+	}
+
+	if ch == 'u' && len(rest) == 4 {
+		n, err := strconv.ParseUint(rest, 16, 32)
+		if err == nil {
+			return core.NewChar(rune(n)), nil
+		}
+	}
+
+	return nil, r.errorf("invalid char \\%s", name)
+}
+
+func (r *ednReader) readDispatch(decode bool) (any, error) {
+	if r.pos >= len(r.data) {
+		return nil, r.errorf("unexpected end of input")
+	}
+
+	switch r.data[r.pos] {
+	case '{':
+		if decode && r.s == nil {
+			return nil, r.errorf("expected a scalar")
+		}
+
+		r.pos++
+
+		elems, err := r.readElems('}', decode)
+		if err != nil || !decode {
+			return nil, err
+		}
+
+		return core.NewSetFromSeq(r.env(), core.NewListFrom(elems...))
+	case '"':
+		r.pos++
+		return r.readRegex()
+	case '#':
+		r.pos++
+
+		switch sym := r.token(); sym {
+		case "NaN", "Inf", "-Inf":
+			return parseDouble(sym)
+		default:
+			return nil, r.errorf("unknown symbolic value ##%s", sym)
+		}
+	}
+
+	tag := r.token()
+	if tag == "" {
+		return nil, r.errorf("invalid dispatch")
+	}
+
+	if decode && r.s == nil {
+		return nil, r.errorf("expected a scalar")
+	}
+
+	val, err := r.read(decode)
+	if err != nil || !decode {
+		return nil, err
+	}
+
+	return r.s.ednTagged(tag, val)
+}
+
+// readRegex reads the pattern of a regex, where \" is a quote that doesn't
+// end it.
+func (r *ednReader) readRegex() (any, error) {
+	var sb strings.Builder
+
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		r.pos++
+
+		switch {
+		case c == '"':
+			return parseRegex(sb.String())
+		case c == '\\' && r.pos < len(r.data):
+			if r.data[r.pos] != '"' {
+				sb.WriteByte(c)
+			}
+
+			sb.WriteByte(r.data[r.pos])
+			r.pos++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return nil, r.errorf("unterminated regex")
+}
+
+func (r *ednReader) readAtom(tok string) (any, error) {
+	if tok == "" {
+		return nil, r.errorf("unexpected %q", r.data[r.pos])
+	}
+
+	switch tok {
+	case "nil":
+		return core.NIL, nil
+	case "true":
+		return core.MakeBoolean(true), nil
+	case "false":
+		return core.MakeBoolean(false), nil
+	}
+
+	c := tok[0]
+	if c == '+' || c == '-' {
+		if len(tok) == 1 || tok[1] < '0' || tok[1] > '9' {
+			return core.MakeSymbol(tok), nil
+		}
+	} else if c < '0' || c > '9' {
+		return core.MakeSymbol(tok), nil
+	}
+
+	switch {
+	case strings.HasSuffix(tok, "N"):
+		return parseBigInt(strings.TrimPrefix(tok[:len(tok)-1], "+"))
+	case strings.HasSuffix(tok, "M"):
+		return parseBigFloat(tok[:len(tok)-1])
+	case strings.Contains(tok, "/"):
+		return parseRatio(tok)
+	case strings.ContainsAny(tok, ".eE"):
+		return parseDouble(tok)
+	default:
+		return parseInt(strings.TrimPrefix(tok, "+"))
+	}
+}
+
+func (s *unmarshalState) ednTagged(tag string, val any) (any, error) {
+	switch tag {
+	case "lace/ref":
+		m, ok := val.(core.Map)
+		if !ok {
+			return nil, fmt.Errorf("#lace/ref must be a map")
+		}
+
+		var ref ForeignRef
+
+		if ok, v := m.GetEqu(core.MakeKeyword("ref")); ok {
+			if str, ok := v.(core.String); ok {
+				ref.Ref = str.S()
+			}
+		}
+
+		if ok, v := m.GetEqu(core.MakeKeyword("owner")); ok {
+			if str, ok := v.(core.String); ok {
+				ref.Owner = str.S()
+			}
+		}
+
+		return s.importRef(ref)
+	case "lace/tag", "lace/struct":
+		v, ok := val.(*core.Vector)
+		if !ok {
+			return nil, fmt.Errorf("#%s must be a vector", tag)
+		}
+
+		elems, err := core.ToSlice(s.env, v.Seq())
+		if err != nil {
+			return nil, err
+		}
+
+		if tag == "lace/tag" {
+			return s.taggedFrom(elems)
+		}
+
+		return s.structFrom(elems)
+	}
+
+	switch tag {
+	case "inst", "uuid", "lace/bytes", "lace/code":
+	default:
+		return &TaggedEDN{Tag: tag, Value: val}, nil
+	}
+
+	str, ok := val.(core.String)
+	if !ok {
+		return nil, fmt.Errorf("#%s must be a string", tag)
+	}
+
+	switch tag {
+	case "inst":
+		return parseInst(str.S())
+	case "uuid":
+		return ParseUUID(str.S())
+	case "lace/bytes":
+		return base64.StdEncoding.DecodeString(str.S())
+	case "lace/code":
+		code, err := base64.StdEncoding.DecodeString(str.S())
+		if err != nil {
+			return nil, err
+		}
+
+		return s.unmarshal(code)
+	default:
+		return nil, fmt.Errorf("unknown EDN tag #%s", tag)
+	}
+}
+
+// TaggedEDN is a value read from EDN with a tag lace has no reader for,
+// such as #myapp/point [1 2]. It keeps the tag, so that it's written again
+// as it was read.
+type TaggedEDN struct {
+	Tag   string
+	Value any
+}
+
+func (te *TaggedEDN) ToString(env *core.Env, escape bool) (string, error) {
+	val, err := core.ToString(env, te.Value)
+	if err != nil {
+		return "", err
+	}
+
+	return "#" + te.Tag + " " + val, nil
+}
+
+var rawType = reflect.TypeFor[Raw]()
+
+// encodeEDN writes an envelope as EDN, with structs as maps keyed by the
+// keywords named in their json tags.
+func encodeEDN(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := writeEnvelope(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// jsonName returns the name of a field in its json tag, and whether it's
+// left out when empty.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+
+	return name, strings.Contains(opts, "omitempty")
+}
+
+func writeEnvelope(buf *bytes.Buffer, rv reflect.Value) error {
+	if rv.Type() == rawType {
+		if rv.Len() == 0 {
+			buf.WriteString("nil")
+		} else {
+			buf.Write(rv.Bytes())
+		}
+
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			buf.WriteString("nil")
+			return nil
+		}
+
+		return writeEnvelope(buf, rv.Elem())
+	case reflect.Struct:
+		buf.WriteByte('{')
+
+		first := true
+
+		for i := 0; i < rv.NumField(); i++ {
+			f := rv.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			name, omitEmpty := jsonName(f)
+			if name == "" || omitEmpty && rv.Field(i).IsZero() {
+				continue
+			}
+
+			if !first {
+				buf.WriteString(", ")
+			}
+
+			first = false
+
+			buf.WriteString(":" + name + " ")
+
+			if err := writeEnvelope(buf, rv.Field(i)); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte('}')
+	case reflect.String:
+		writeEDNString(buf, rv.String())
+	case reflect.Bool:
+		buf.WriteString(strconv.FormatBool(rv.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		buf.WriteString(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		buf.WriteString(strconv.FormatUint(rv.Uint(), 10))
+	default:
+		return fmt.Errorf("can't encode %s as EDN", rv.Type())
+	}
+
+	return nil
+}
+
+// decodeEDN reads an envelope written by encodeEDN into v.
+func decodeEDN(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("EDN must be decoded into a pointer")
+	}
+
+	r := &ednReader{data: data}
+
+	if err := r.readEnvelope(rv.Elem()); err != nil {
+		return err
+	}
+
+	return r.end()
+}
+
+func (r *ednReader) readEnvelope(rv reflect.Value) error {
+	if err := r.skipSpace(); err != nil {
+		return err
+	}
+
+	if rv.Type() == rawType {
+		start := r.pos
+
+		if _, err := r.read(false); err != nil {
+			return err
+		}
+
+		rv.SetBytes(bytes.Clone(r.data[start:r.pos]))
+
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if bytes.HasPrefix(r.data[r.pos:], []byte("nil")) {
+			r.token()
+			rv.SetZero()
+
+			return nil
+		}
+
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+
+		return r.readEnvelope(rv.Elem())
+	case reflect.Struct:
+		return r.readEnvelopeStruct(rv)
+	}
+
+	val, err := r.read(true)
+	if err != nil {
+		return err
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		if s, ok := val.(core.String); ok {
+			rv.SetString(s.S())
+			return nil
+		}
+	case reflect.Bool:
+		if b, ok := val.(core.Boolean); ok {
+			rv.SetBool(bool(b))
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := val.(core.Int); ok {
+			rv.SetInt(int64(n.I()))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := val.(core.Int); ok && n.I() >= 0 {
+			rv.SetUint(uint64(n.I()))
+			return nil
+		}
+	default:
+		return fmt.Errorf("can't decode EDN into %s", rv.Type())
+	}
+
+	return fmt.Errorf("can't decode %s into %s", core.TypeName(val), rv.Type())
+}
+
+func (r *ednReader) readEnvelopeStruct(rv reflect.Value) error {
+	if r.pos >= len(r.data) || r.data[r.pos] != '{' {
+		return r.errorf("expected a map")
+	}
+
+	r.pos++
+
+	fields := map[string]int{}
+
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		if name, _ := jsonName(f); name != "" {
+			fields[name] = i
+		}
+	}
+
+	for {
+		if err := r.skipSpace(); err != nil {
+			return err
+		}
+
+		if r.pos >= len(r.data) {
+			return r.errorf("unexpected end of input")
+		}
+
+		if r.data[r.pos] == '}' {
+			r.pos++
+			return nil
+		}
+
+		if r.data[r.pos] != ':' {
+			return r.errorf("expected a keyword")
+		}
+
+		r.pos++
+
+		i, ok := fields[r.token()]
+		if !ok {
+			// Keys added by newer versions are skipped.
+			if _, err := r.read(false); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := r.readEnvelope(rv.Field(i)); err != nil {
+			return err
+		}
+	}
+}
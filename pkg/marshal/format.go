@@ -0,0 +1,142 @@
+package marshal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lab47/lace/core"
+)
+
+// Format is an encoding of lace values. CBOR is the compact one used
+// between lace processes, while JSON and EDN are for talking to other
+// services and for logging payloads readably. Every format round trips
+// every value that can be marshaled.
+type Format string
+
+const (
+	CBOR Format = "cbor"
+	JSON Format = "json"
+	EDN  Format = "edn"
+)
+
+// ParseFormat returns the format with the given name, where the empty name
+// is CBOR.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case "", CBOR:
+		return CBOR, nil
+	case JSON:
+		return JSON, nil
+	case EDN:
+		return EDN, nil
+	default:
+		return "", fmt.Errorf("unknown marshal format: %s", name)
+	}
+}
+
+func (f Format) String() string {
+	if f == "" {
+		return string(CBOR)
+	}
+
+	return string(f)
+}
+
+// Marshal encodes obj in the format, registering the functions and vars it
+// references with exp.
+func (f Format) Marshal(obj any, exp Exporter) ([]byte, error) {
+	switch f {
+	case "", CBOR:
+		return MarshalWith(obj, exp)
+	case JSON:
+		return MarshalJSONWith(obj, exp)
+	case EDN:
+		return MarshalEDNWith(obj, exp)
+	default:
+		return nil, fmt.Errorf("unknown marshal format: %s", string(f))
+	}
+}
+
+// Unmarshal decodes data from the format, using imp to resolve any foreign
+// refs.
+func (f Format) Unmarshal(env *core.Env, data []byte, imp Importer) (any, error) {
+	switch f {
+	case "", CBOR:
+		return UnmarshalWith(env, data, imp)
+	case JSON:
+		return UnmarshalJSONWith(env, data, imp)
+	case EDN:
+		return UnmarshalEDNWith(env, data, imp)
+	default:
+		return nil, fmt.Errorf("unknown marshal format: %s", string(f))
+	}
+}
+
+// Encode encodes v, a Go value such as the envelope of a message, in the
+// format. Structs are encoded as maps keyed by the names in their json
+// tags, and their Raw fields are included as they are.
+func (f Format) Encode(v any) ([]byte, error) {
+	switch f {
+	case "", CBOR:
+		return cbor.Marshal(v)
+	case JSON:
+		return json.Marshal(v)
+	case EDN:
+		return encodeEDN(v)
+	default:
+		return nil, fmt.Errorf("unknown marshal format: %s", string(f))
+	}
+}
+
+// Decode decodes data encoded by Encode into v.
+func (f Format) Decode(data []byte, v any) error {
+	switch f {
+	case "", CBOR:
+		return cbor.Unmarshal(data, v)
+	case JSON:
+		return json.Unmarshal(data, v)
+	case EDN:
+		return decodeEDN(data, v)
+	default:
+		return fmt.Errorf("unknown marshal format: %s", string(f))
+	}
+}
+
+// Raw is a value already marshaled in the format of the value it's a part
+// of. It's copied as it is when encoding or decoding that value, so that
+// it can be unmarshaled later, such as once the importer for its foreign
+// refs is known.
+type Raw []byte
+
+var (
+	cborNil = []byte{0xf6}
+	jsonNil = []byte("null")
+)
+
+func (r Raw) MarshalCBOR() ([]byte, error) {
+	if len(r) == 0 {
+		return cborNil, nil
+	}
+
+	return r, nil
+}
+
+func (r *Raw) UnmarshalCBOR(data []byte) error {
+	*r = bytes.Clone(data)
+	return nil
+}
+
+func (r Raw) MarshalJSON() ([]byte, error) {
+	if len(r) == 0 {
+		return jsonNil, nil
+	}
+
+	return r, nil
+}
+
+func (r *Raw) UnmarshalJSON(data []byte) error {
+	*r = bytes.Clone(data)
+	return nil
+}
@@ -0,0 +1,574 @@
+package marshal
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lab47/lace/core"
+)
+
+// Values JSON has no type for are written as objects with a single key
+// naming their type, starting with $, such as {"$kw": "ns/name"}. Maps
+// with string keys are written as plain objects unless a key starts with
+// $, in which case they're escaped like maps with other keys:
+//
+//	nil, booleans, strings   null, true, false, "..."
+//	ints and doubles         1 and 1.0, or {"$double": "NaN"}
+//	big numbers              {"$bigint": "1"}, {"$bigfloat": "1.5"}, {"$ratio": "1/2"}
+//	chars                    {"$char": "c"}
+//	keywords and symbols     {"$kw": "ns/name"}, {"$sym": "ns/name"}
+//	regexes and insts        {"$regex": "a+"}, {"$inst": "2006-01-02T15:04:05Z"}
+//	byte slices              {"$bytes": "<base64>"}
+//	vectors                  [...]
+//	lists and sets           {"$list": [...]}, {"$set": [...]}
+//	maps                     {"k": v}, or {"$map": [k1, v1, k2, v2]}
+//	functions and vars       {"$foreign": {"ref": "...", "owner": "..."}}
+//	shipped code             {"$code": "<base64 of the CBOR>"}
+//	Go structs               {"$struct": ["type", fields]}
+//	other tags               {"$tag": [n, value]}
+type jsonState struct {
+	m *marshalState
+}
+
+// MarshalJSON marshals obj as JSON, keeping the lace type of each value.
+func MarshalJSON(obj any) ([]byte, error) {
+	return MarshalJSONWith(obj, nil)
+}
+
+// MarshalJSONWith is MarshalJSON, registering the functions and vars obj
+// references with exp.
+func MarshalJSONWith(obj any, exp Exporter) ([]byte, error) {
+	js := jsonState{
+		m: &marshalState{
+			refs:  make(map[any]int),
+			frefs: make(map[string]any),
+			exp:   exp,
+		},
+	}
+
+	v, err := js.value(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func escape(kind string, val any) map[string]any {
+	return map[string]any{kind: val}
+}
+
+// formatDouble formats d so that it's read back as a double rather than
+// an int.
+func formatDouble(d float64) string {
+	s := strconv.FormatFloat(d, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+
+	return s
+}
+
+func (js *jsonState) value(obj any) (any, error) {
+	switch sv := obj.(type) {
+	case Foreign:
+		return js.foreign(sv.ForeignRef()), nil
+	case *Shipped:
+		code, err := js.m.encodeShipped(sv)
+		if err != nil {
+			return nil, err
+		}
+
+		return escape("$code", base64.StdEncoding.EncodeToString(code)), nil
+	case *TaggedValue:
+		return js.tagged(sv.Tag, sv.Value)
+	case core.StructMap:
+		return js.goValue(sv.Value())
+	case []byte:
+		return escape("$bytes", base64.StdEncoding.EncodeToString(sv)), nil
+	case core.Symbol:
+		return escape("$sym", sv.String()), nil
+	case core.Keyword:
+		return escape("$kw", sv.RawString()), nil
+	case core.Int:
+		return json.Number(strconv.Itoa(sv.I())), nil
+	case core.Double:
+		switch {
+		case math.IsNaN(sv.D):
+			return escape("$double", "NaN"), nil
+		case math.IsInf(sv.D, 1):
+			return escape("$double", "Inf"), nil
+		case math.IsInf(sv.D, -1):
+			return escape("$double", "-Inf"), nil
+		}
+
+		return json.Number(formatDouble(sv.D)), nil
+	case *core.BigInt:
+		return escape("$bigint", sv.BigInt().String()), nil
+	case *core.BigFloat:
+		return escape("$bigfloat", sv.BigFloat().Text('g', -1)), nil
+	case *core.Ratio:
+		return escape("$ratio", sv.Ratio().String()), nil
+	case core.Char:
+		return escape("$char", string(sv.Ch())), nil
+	case core.Boolean:
+		return bool(sv), nil
+	case core.Nil:
+		return nil, nil
+	case core.String:
+		return sv.S(), nil
+	case *core.Regex:
+		return escape("$regex", sv.R.String()), nil
+	case core.Time:
+		return escape("$inst", sv.T.Format(time.RFC3339Nano)), nil
+	case *core.Var, *core.Fn, *core.Channel:
+		return js.foreign(js.m.newFref(sv)), nil
+	case *core.List:
+		return js.seq("$list", sv)
+	case *core.Vector:
+		return js.values(sv)
+	case core.Set:
+		return js.set(sv)
+	case core.Map:
+		return js.mapValue(sv)
+	case core.Seqable:
+		return js.seq("$list", sv)
+	default:
+		return js.goValue(obj)
+	}
+}
+
+func (js *jsonState) foreign(ref ForeignRef) any {
+	fr := map[string]any{"ref": ref.Ref}
+	if ref.Owner != "" {
+		fr["owner"] = ref.Owner
+	}
+
+	return escape("$foreign", fr)
+}
+
+func (js *jsonState) tagged(tag uint64, val any) (any, error) {
+	v, err := js.value(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return escape("$tag", []any{tag, v}), nil
+}
+
+func (js *jsonState) goValue(obj any) (any, error) {
+	gv, err := goValueOf(js.m.env, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	switch gv.kind {
+	case goBytes:
+		return js.value(gv.data)
+	case goCodec:
+		return js.tagged(gv.tag, gv.data)
+	case goStruct:
+		fields, err := js.value(gv.data)
+		if err != nil {
+			return nil, err
+		}
+
+		return escape("$struct", []any{gv.name, fields}), nil
+	case goData:
+		return js.value(gv.data)
+	default:
+		return nil, nil
+	}
+}
+
+func (js *jsonState) values(sq core.Seqable) ([]any, error) {
+	elems, err := core.ToSlice(js.m.env, sq.Seq())
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]any, 0, len(elems))
+
+	for _, el := range elems {
+		v, err := js.value(el)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, v)
+	}
+
+	return vals, nil
+}
+
+func (js *jsonState) seq(kind string, sq core.Seqable) (any, error) {
+	vals, err := js.values(sq)
+	if err != nil {
+		return nil, err
+	}
+
+	return escape(kind, vals), nil
+}
+
+func (js *jsonState) set(set core.Set) (any, error) {
+	vals := []any{}
+
+	iter := set.SetIter()
+	for iter.HasNext(js.m.env) {
+		el, err := iter.Next(js.m.env)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := js.value(el)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, v)
+	}
+
+	return escape("$set", vals), nil
+}
+
+func (js *jsonState) mapValue(ma core.Map) (any, error) {
+	var (
+		obj   = map[string]any{}
+		pairs []any
+		plain = true
+	)
+
+	iter := ma.Iter()
+	for iter.HasNext() {
+		p := iter.Next()
+
+		k, err := js.value(p.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := js.value(p.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, k, v)
+
+		if s, ok := k.(string); ok && !strings.HasPrefix(s, "$") {
+			obj[s] = v
+		} else {
+			plain = false
+		}
+	}
+
+	if plain {
+		return obj, nil
+	}
+
+	return escape("$map", pairs), nil
+}
+
+// UnmarshalJSON unmarshals data marshaled by MarshalJSON. Plain JSON is
+// unmarshaled too, with objects as maps with string keys and arrays as
+// vectors.
+func UnmarshalJSON(env *core.Env, data []byte) (any, error) {
+	return UnmarshalJSONWith(env, data, nil)
+}
+
+// UnmarshalJSONWith is UnmarshalJSON, using imp to resolve any foreign
+// refs.
+func UnmarshalJSONWith(env *core.Env, data []byte, imp Importer) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return newUnmarshalState(env, imp).jsonValue(v)
+}
+
+func (s *unmarshalState) jsonValue(v any) (any, error) {
+	switch sv := v.(type) {
+	case nil:
+		return core.NIL, nil
+	case bool:
+		return core.MakeBoolean(sv), nil
+	case string:
+		return core.MakeString(sv), nil
+	case json.Number:
+		if strings.ContainsAny(string(sv), ".eE") {
+			return parseDouble(string(sv))
+		}
+
+		return parseInt(string(sv))
+	case []any:
+		elems, err := s.jsonValues(sv)
+		if err != nil {
+			return nil, err
+		}
+
+		return core.NewVectorFrom(elems...), nil
+	case map[string]any:
+		if len(sv) == 1 {
+			for k, x := range sv {
+				if strings.HasPrefix(k, "$") {
+					return s.jsonEscape(k, x)
+				}
+			}
+		}
+
+		kvs := make([]any, 0, 2*len(sv))
+
+		for k, x := range sv {
+			val, err := s.jsonValue(x)
+			if err != nil {
+				return nil, err
+			}
+
+			kvs = append(kvs, core.MakeString(k), val)
+		}
+
+		return core.NewHashMap(s.env, kvs...)
+	default:
+		return nil, fmt.Errorf("unsupported JSON value: %T", sv)
+	}
+}
+
+func (s *unmarshalState) jsonValues(vs []any) ([]any, error) {
+	elems := make([]any, 0, len(vs))
+
+	for _, x := range vs {
+		el, err := s.jsonValue(x)
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, el)
+	}
+
+	return elems, nil
+}
+
+func (s *unmarshalState) jsonEscape(kind string, x any) (any, error) {
+	switch kind {
+	case "$list", "$set", "$map", "$tag", "$struct":
+		vs, ok := x.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an array", kind)
+		}
+
+		elems, err := s.jsonValues(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.jsonCollection(kind, elems)
+	case "$foreign":
+		obj, ok := x.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("$foreign must be an object")
+		}
+
+		var ref ForeignRef
+
+		ref.Ref, _ = obj["ref"].(string)
+		ref.Owner, _ = obj["owner"].(string)
+
+		return s.importRef(ref)
+	}
+
+	str, ok := x.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a string", kind)
+	}
+
+	switch kind {
+	case "$kw":
+		return core.MakeKeyword(str), nil
+	case "$sym":
+		return core.MakeSymbol(str), nil
+	case "$char":
+		return decodeChar(str)
+	case "$double":
+		return parseDouble(str)
+	case "$bigint":
+		return parseBigInt(str)
+	case "$bigfloat":
+		return parseBigFloat(str)
+	case "$ratio":
+		return parseRatio(str)
+	case "$regex":
+		return parseRegex(str)
+	case "$inst":
+		return parseInst(str)
+	case "$bytes":
+		return base64.StdEncoding.DecodeString(str)
+	case "$code":
+		code, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.unmarshal(code)
+	default:
+		return nil, fmt.Errorf("unknown JSON escape: %s", kind)
+	}
+}
+
+func (s *unmarshalState) jsonCollection(kind string, elems []any) (any, error) {
+	switch kind {
+	case "$list":
+		return core.NewListFrom(elems...), nil
+	case "$set":
+		return core.NewSetFromSeq(s.env, core.NewListFrom(elems...))
+	case "$map":
+		if len(elems)%2 != 0 {
+			return nil, fmt.Errorf("$map must have an even number of elements")
+		}
+
+		return core.NewHashMap(s.env, elems...)
+	case "$tag":
+		return s.taggedFrom(elems)
+	default:
+		return s.structFrom(elems)
+	}
+}
+
+// taggedFrom decodes a tag written as its number and value.
+func (s *unmarshalState) taggedFrom(elems []any) (any, error) {
+	if len(elems) != 2 {
+		return nil, fmt.Errorf("tagged value must be a tag and a value")
+	}
+
+	tag, ok := elems[0].(core.Int)
+	if !ok || tag.I() < 0 {
+		return nil, fmt.Errorf("tagged value must start with a tag number")
+	}
+
+	return decodeTag(s.env, uint64(tag.I()), elems[1])
+}
+
+// structFrom decodes a Go struct written as its type name and fields.
+func (s *unmarshalState) structFrom(elems []any) (any, error) {
+	if len(elems) != 2 {
+		return nil, fmt.Errorf("struct must be a type name and fields")
+	}
+
+	name, ok := elems[0].(core.String)
+	if !ok {
+		return nil, fmt.Errorf("struct must start with a type name")
+	}
+
+	return decodeStruct(s.env, name.S(), elems[1])
+}
+
+func (s *unmarshalState) importRef(ref ForeignRef) (any, error) {
+	if s.imp == nil {
+		return nil, fmt.Errorf("unable to resolve foreign ref: %s", ref.Ref)
+	}
+
+	return s.imp.Import(ref)
+}
+
+// The parsers of the values the text formats write as strings.
+
+func parseInt(s string) (any, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return core.MakeInt(int(n)), nil
+	}
+
+	if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+		return parseBigInt(s)
+	}
+
+	return nil, fmt.Errorf("invalid number: %s", s)
+}
+
+func parseDouble(s string) (any, error) {
+	switch s {
+	case "NaN":
+		return core.MakeDouble(math.NaN()), nil
+	case "Inf":
+		return core.MakeDouble(math.Inf(1)), nil
+	case "-Inf":
+		return core.MakeDouble(math.Inf(-1)), nil
+	}
+
+	d, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number: %s", s)
+	}
+
+	return core.MakeDouble(d), nil
+}
+
+func parseBigInt(s string) (any, error) {
+	var bi big.Int
+	if _, ok := bi.SetString(s, 10); !ok {
+		return nil, fmt.Errorf("invalid big int: %s", s)
+	}
+
+	return core.MakeBigIntFrom(&bi), nil
+}
+
+func parseBigFloat(s string) (any, error) {
+	var bf big.Float
+	if _, ok := bf.SetPrec(256).SetString(s); !ok {
+		return nil, fmt.Errorf("invalid big float: %s", s)
+	}
+
+	return core.MakeBigFloatFrom(&bf), nil
+}
+
+func parseRatio(s string) (any, error) {
+	var r big.Rat
+	if _, ok := r.SetString(s); !ok {
+		return nil, fmt.Errorf("invalid ratio: %s", s)
+	}
+
+	return core.MakeRatio(r.Num(), r.Denom()), nil
+}
+
+func parseRegex(s string) (any, error) {
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.MakeRegex(re), nil
+}
+
+func parseInst(s string) (any, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.MakeTime(t), nil
+}
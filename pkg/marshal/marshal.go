@@ -39,8 +39,29 @@ type Regex struct {
 	Value string `json:"value" cbor:"1,keyasint"`
 }
 
+// ForeignRef identifies an object, such as a function, that can't be copied
+// and so stays with the process that owns it. Owner identifies that process
+// for whoever needs to reach the object.
 type ForeignRef struct {
-	Ref string `json:"ref" cbor:"1,keyasint"`
+	Ref   string `json:"ref" cbor:"1,keyasint"`
+	Owner string `json:"owner,omitempty" cbor:"2,keyasint,omitempty"`
+}
+
+// Exporter registers the local objects referenced by a value as it's
+// marshaled, returning the ForeignRef to encode in their place.
+type Exporter interface {
+	Export(val any) ForeignRef
+}
+
+// Importer resolves the ForeignRefs found while unmarshaling a value.
+type Importer interface {
+	Import(ref ForeignRef) (any, error)
+}
+
+// Foreign is implemented by values that stand in for an object owned by
+// another process. They're marshaled as the ref of the original object.
+type Foreign interface {
+	ForeignRef() ForeignRef
 }
 
 type Collection struct {
@@ -74,9 +95,16 @@ func init() {
 	ts.Add(to, reflect.TypeFor[Regex](), 475)
 	ts.Add(to, reflect.TypeFor[ForeignRef](), 476)
 	ts.Add(to, reflect.TypeFor[Collection](), 477)
+	ts.Add(to, reflect.TypeFor[Code](), 478)
 	ts.Add(to, reflect.TypeFor[Ref](), 480)
-
-	m, err := cbor.EncOptions{}.EncModeWithTags(ts)
+	ts.Add(to, reflect.TypeFor[GoStruct](), 481)
+
+	// Times are tagged so that they're decoded as times rather than ints,
+	// keeping their nanoseconds and zone.
+	m, err := cbor.EncOptions{
+		Time:    cbor.TimeRFC3339Nano,
+		TimeTag: cbor.EncTagRequired,
+	}.EncModeWithTags(ts)
 	if err != nil {
 		panic(err)
 	}
@@ -97,9 +125,14 @@ type marshalState struct {
 	env   *core.Env
 	refs  map[any]int
 	frefs map[string]any
+	exp   Exporter
 }
 
 func (m *marshalState) newFref(val any) ForeignRef {
+	if m.exp != nil {
+		return m.exp.Export(val)
+	}
+
 	u, err := ulid.New(ulid.Now(), ulid.DefaultEntropy())
 	if err != nil {
 		panic(err)
@@ -159,7 +192,6 @@ func (m *marshalState) encodeSet(ma core.Set) ([]byte, error) {
 		Ref:  ref.Index,
 	}
 
-	ret := map[any]any{}
 	iter := ma.SetIter()
 	for iter.HasNext(m.env) {
 		p, err := iter.Next(m.env)
@@ -175,7 +207,7 @@ func (m *marshalState) encodeSet(ma core.Set) ([]byte, error) {
 		col.Values = append(col.Values, cbor.RawMessage(k))
 	}
 
-	return m.encode(ret)
+	return m.encode(col)
 }
 
 func (m *marshalState) encodeMap(ma core.Map) ([]byte, error) {
@@ -206,11 +238,25 @@ func (m *marshalState) encodeMap(ma core.Map) ([]byte, error) {
 }
 
 func (m *marshalState) Marshal(obj any) ([]byte, error) {
-	if idx, ok := m.refs[obj]; ok {
-		return m.encode(Ref{Index: idx})
+	// Go values such as byte slices can't be map keys, and are never
+	// referenced more than once anyway.
+	if t := reflect.TypeOf(obj); t != nil && t.Comparable() {
+		if idx, ok := m.refs[obj]; ok {
+			return m.encode(Ref{Index: idx})
+		}
 	}
 
 	switch sv := obj.(type) {
+	case Foreign:
+		return m.encode(sv.ForeignRef())
+	case *Shipped:
+		return m.encodeShipped(sv)
+	case *TaggedValue:
+		return m.encodeTagged(sv.Tag, sv.Value)
+	case core.StructMap:
+		return m.marshalGo(sv.Value())
+	case []byte:
+		return m.encode(sv)
 	case core.Symbol:
 		return m.encode(Symbol{Value: sv.String()})
 	case core.Keyword:
@@ -246,6 +292,9 @@ func (m *marshalState) Marshal(obj any) ([]byte, error) {
 		return m.encode(m.newFref(sv))
 	case *core.Fn:
 		return m.encode(m.newFref(sv))
+	case *core.Channel:
+		// Like functions, channels can't be copied.
+		return m.encode(m.newFref(sv))
 	case *core.List:
 		return m.encodeSeq("list", sv)
 	case *core.Vector:
@@ -257,16 +306,23 @@ func (m *marshalState) Marshal(obj any) ([]byte, error) {
 	case core.Seqable:
 		return m.encodeSeq("list", sv)
 	default:
-		return nil, ErrUnsupported
+		return m.marshalGo(obj)
 	}
 }
 
 var ErrUnsupported = errors.New("value can not be marshaled")
 
 func Marshal(obj any) ([]byte, error) {
+	return MarshalWith(obj, nil)
+}
+
+// MarshalWith marshals obj, registering the functions and vars it references
+// with exp so they can be called by the receiver.
+func MarshalWith(obj any, exp Exporter) ([]byte, error) {
 	var ms marshalState
 	ms.refs = make(map[any]int)
 	ms.frefs = make(map[string]any)
+	ms.exp = exp
 
 	return ms.Marshal(obj)
 }
@@ -1,18 +1,32 @@
 package marshal
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
 
 	"github.com/lab47/lace/core"
 	"github.com/stretchr/testify/require"
 )
 
+func newTestEnv(t *testing.T) *core.Env {
+	e, err := core.NewEnv()
+	require.NoError(t, err)
+
+	return e
+}
+
 func TestMarshal(t *testing.T) {
 	t.Run("handles types", func(t *testing.T) {
-		var e core.Env
+		e := newTestEnv(t)
 
-		must := func(obj core.Object, err error) core.Object {
+		must := func(obj any, err error) any {
 			if err != nil {
 				panic(err)
 			}
@@ -20,7 +34,7 @@ func TestMarshal(t *testing.T) {
 			return obj
 		}
 
-		input := []core.Object{
+		input := []any{
 			core.MakeSymbol("foo"),
 			core.MakeSymbol("user/foo"),
 			core.MakeKeyword("bar"),
@@ -40,7 +54,7 @@ func TestMarshal(t *testing.T) {
 					core.MakeInt(8),
 				),
 			),
-			must(core.NewHashMap(&e,
+			must(core.NewHashMap(e,
 				core.MakeSymbol("name"),
 				core.MakeString("lace"),
 			)),
@@ -53,16 +67,16 @@ func TestMarshal(t *testing.T) {
 				b, err := Marshal(d)
 				r.NoError(err)
 
-				obj, err := Unmarshal(&e, b)
+				obj, err := Unmarshal(e, b)
 				r.NoError(err)
 
-				ostr, err := core.ToString(&e, d)
+				ostr, err := core.ToString(e, d)
 				r.NoError(err)
 
-				str, err := core.ToString(&e, obj)
+				str, err := core.ToString(e, obj)
 				r.NoError(err)
 
-				r.True(core.Equals(&e, obj, d), "didn't round trip: %s != %s", ostr, str)
+				r.True(core.Equals(e, obj, d), "didn't round trip: %s != %s", ostr, str)
 			})
 		}
 
@@ -73,22 +87,22 @@ func TestMarshal(t *testing.T) {
 
 		d := l.Cons(l)
 
-		var e core.Env
+		e := newTestEnv(t)
 
 		r := require.New(t)
 		b, err := Marshal(d)
 		r.NoError(err)
 
-		obj, err := Unmarshal(&e, b)
+		obj, err := Unmarshal(e, b)
 		r.NoError(err)
 
-		ostr, err := core.ToString(&e, d)
+		ostr, err := core.ToString(e, d)
 		r.NoError(err)
 
-		str, err := core.ToString(&e, obj)
+		str, err := core.ToString(e, obj)
 		r.NoError(err)
 
-		r.True(core.Equals(&e, obj, d), "didn't round trip: %s != %s", ostr, str)
+		r.True(core.Equals(e, obj, d), "didn't round trip: %s != %s", ostr, str)
 	})
 
 	t.Run("maintains identity", func(t *testing.T) {
@@ -96,21 +110,21 @@ func TestMarshal(t *testing.T) {
 
 		d := core.NewListFrom(l, l)
 
-		var e core.Env
+		e := newTestEnv(t)
 
 		r := require.New(t)
 		b, err := Marshal(d)
 		r.NoError(err)
 
-		obj, err := Unmarshal(&e, b)
+		obj, err := Unmarshal(e, b)
 		r.NoError(err)
 
 		seq := obj.(core.Seq)
 
-		l1, err := seq.First(&e)
+		l1, err := seq.First(e)
 		r.NoError(err)
 
-		l2, err := core.Second(&e, seq)
+		l2, err := core.Second(e, seq)
 		r.NoError(err)
 
 		var col Collection
@@ -131,12 +145,466 @@ func TestMarshal(t *testing.T) {
 		// Check identity across the unmarshalling
 		r.True(l1 == l2)
 
-		ostr, err := core.ToString(&e, d)
+		ostr, err := core.ToString(e, d)
 		r.NoError(err)
 
-		str, err := core.ToString(&e, obj)
+		str, err := core.ToString(e, obj)
+		r.NoError(err)
+
+		r.True(core.Equals(e, obj, d), "didn't round trip: %s != %s", ostr, str)
+	})
+
+	t.Run("ships functions as code with their upvals", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		obj, err := e.Eval(`(let [x 40] (fn [y] (+ x y)))`)
+		r.NoError(err)
+
+		fn, ok := obj.(*core.Fn)
+		r.True(ok)
+
+		b, err := Marshal(Ship(e, fn))
 		r.NoError(err)
 
-		r.True(core.Equals(&e, obj, d), "didn't round trip: %s != %s", ostr, str)
+		out, err := UnmarshalWith(e, b, AllowCode(nil))
+		r.NoError(err)
+
+		shipped, ok := out.(*core.Fn)
+		r.True(ok)
+		r.NotSame(fn, shipped)
+
+		val, err := shipped.Call(e, []any{core.MakeInt(2)})
+		r.NoError(err)
+		r.Equal(core.MakeInt(42), val)
+	})
+
+	t.Run("refuses code compiled by another version", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		b, err := encoder.Marshal(Code{Version: "v0.0.1", Code: &core.CodeAsData{}})
+		r.NoError(err)
+
+		_, err = UnmarshalWith(e, b, AllowCode(nil))
+		r.ErrorIs(err, ErrVersionMismatch)
+	})
+
+	t.Run("refuses code unless it's allowed", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		obj, err := e.Eval(`(fn [y] (+ 1 y))`)
+		r.NoError(err)
+
+		for _, f := range []Format{CBOR, JSON, EDN} {
+			b, err := f.Marshal(Ship(e, obj.(*core.Fn)), nil)
+			r.NoError(err)
+
+			_, err = f.Unmarshal(e, b, nil)
+			r.ErrorIs(err, ErrCodeNotAllowed, "format %s", f)
+		}
+	})
+
+	t.Run("refuses code naming unknown namespaces or defining vars", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		b, err := encoder.Marshal(Code{
+			Version: core.VERSION,
+			Code: &core.CodeAsData{
+				VarNames: []core.CodeSymbol{{Namespace: "a/b", Name: "c"}},
+			},
+		})
+		r.NoError(err)
+
+		_, err = UnmarshalWith(e, b, AllowCode(nil))
+		r.Error(err)
+
+		b, err = encoder.Marshal(Code{
+			Version: core.VERSION,
+			Code:    &core.CodeAsData{DefVarNames: []string{"pwned"}},
+		})
+		r.NoError(err)
+
+		_, err = UnmarshalWith(e, b, AllowCode(nil))
+		r.Error(err)
+		r.Nil(e.CurrentNamespace().Resolve("pwned"))
+	})
+
+	t.Run("handles Go values", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		u, err := NewUUID()
+		r.NoError(err)
+
+		input := []any{
+			[]byte("raw"),
+			3 * time.Second,
+			u,
+		}
+
+		for _, d := range input {
+			b, err := Marshal(d)
+			r.NoError(err)
+
+			obj, err := Unmarshal(e, b)
+			r.NoError(err)
+
+			r.Equal(d, obj)
+		}
+
+		b, err := Marshal(core.MakeBuffer(bytes.NewBufferString("buffered")))
+		r.NoError(err)
+
+		obj, err := Unmarshal(e, b)
+		r.NoError(err)
+		r.Equal("buffered", obj.(*core.Buffer).String())
+
+		parsed, err := ParseUUID(u.String())
+		r.NoError(err)
+		r.Equal(u, parsed)
+	})
+
+	t.Run("handles Go structs across versions of their type", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		type jobV1 struct {
+			Name    string
+			Retries int
+			Timeout time.Duration
+		}
+
+		type jobV2 struct {
+			Name     string
+			Timeout  time.Duration
+			Priority int
+		}
+
+		b, err := Marshal(&jobV1{Name: "build", Retries: 3, Timeout: time.Minute})
+		r.NoError(err)
+
+		// Without a registered type, the fields come back as a map.
+		obj, err := Unmarshal(e, b)
+		r.NoError(err)
+
+		m, ok := obj.(core.Map)
+		r.True(ok)
+
+		ok, name := m.GetEqu(core.MakeKeyword("name"))
+		r.True(ok)
+		r.Equal(core.MakeString("build"), name)
+
+		r.NoError(RegisterType(defaultTypeName(reflect.TypeFor[jobV1]()), reflect.TypeFor[jobV2]()))
+
+		obj, err = Unmarshal(e, b)
+		r.NoError(err)
+
+		r.Equal(&jobV2{Name: "build", Timeout: time.Minute}, obj)
+	})
+
+	t.Run("keeps the tags it has no codec for", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		content, err := Marshal(core.MakeString("payload"))
+		r.NoError(err)
+
+		b, err := encoder.Marshal(cbor.RawTag{Number: 9999, Content: content})
+		r.NoError(err)
+
+		obj, err := Unmarshal(e, b)
+		r.NoError(err)
+
+		tv, ok := obj.(*TaggedValue)
+		r.True(ok)
+		r.Equal(uint64(9999), tv.Tag)
+		r.Equal(core.MakeString("payload"), tv.Value)
+
+		again, err := Marshal(tv)
+		r.NoError(err)
+		r.Equal(b, again)
+	})
+
+	t.Run("uses registered codecs", func(t *testing.T) {
+		r := require.New(t)
+		e := newTestEnv(t)
+
+		type point struct{ X, Y int }
+
+		err := RegisterCodec(Codec{
+			Tag:  9000,
+			Type: reflect.TypeFor[point](),
+			Encode: func(val any) (any, error) {
+				p := val.(point)
+				return core.NewVectorFrom(core.MakeInt(p.X), core.MakeInt(p.Y)), nil
+			},
+			Decode: func(env *core.Env, data any) (any, error) {
+				xs, err := core.ToSlice(env, data.(*core.Vector).Seq())
+				if err != nil {
+					return nil, err
+				}
+
+				return point{X: xs[0].(core.Int).I(), Y: xs[1].(core.Int).I()}, nil
+			},
+		})
+		r.NoError(err)
+
+		r.Error(RegisterCodec(Codec{Tag: 475, Type: reflect.TypeFor[int8](), Encode: nil}))
+
+		b, err := Marshal(core.NewVectorFrom(point{1, 2}))
+		r.NoError(err)
+
+		obj, err := Unmarshal(e, b)
+		r.NoError(err)
+
+		first, err := obj.(*core.Vector).Nth(e, 0)
+		r.NoError(err)
+		r.Equal(point{1, 2}, first)
+	})
+}
+
+func TestFormats(t *testing.T) {
+	e := newTestEnv(t)
+
+	must := func(obj any, err error) any {
+		if err != nil {
+			panic(err)
+		}
+
+		return obj
+	}
+
+	formats := []Format{CBOR, JSON, EDN}
+
+	t.Run("round trips lace data", func(t *testing.T) {
+		input := []any{
+			core.NIL,
+			core.MakeBoolean(false),
+			core.MakeInt(-47),
+			core.MakeDouble(1),
+			core.MakeDouble(-2.5e-10),
+			core.MakeDouble(math.Inf(1)),
+			must(parseBigInt("123456789012345678901234567890")),
+			must(parseBigFloat("1.25")),
+			must(parseRatio("-1/3")),
+			core.NewChar('a'),
+			core.NewChar('\n'),
+			core.NewChar('('),
+			core.NewChar('"'),
+			core.MakeString("say \"hi\"\n\tto λ {and} $kw"),
+			core.MakeSymbol("user/foo"),
+			core.MakeKeyword("bar"),
+			core.MakeKeyword("ns/bar"),
+			core.MakeTime(time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC)),
+			core.NewListFrom(core.MakeSymbol("+"), core.MakeInt(3), core.MakeInt(4)),
+			core.NewVectorFrom(core.MakeString("a"), core.NewVectorFrom(), core.NewListFrom()),
+			must(core.NewSetFromSeq(e, core.NewListFrom(core.MakeInt(1), core.MakeKeyword("two")))),
+			must(core.NewHashMap(e,
+				core.MakeString("name"), core.MakeString("lace"),
+				core.MakeString("tags"), core.NewVectorFrom(core.MakeInt(1), core.MakeDouble(2)),
+			)),
+			must(core.NewHashMap(e,
+				core.MakeKeyword("id"), core.MakeInt(1),
+				core.MakeString("$kw"), core.MakeString("not an escape"),
+				core.NewVectorFrom(core.MakeInt(1)), core.NIL,
+			)),
+		}
+
+		for _, f := range formats {
+			for _, d := range input {
+				ostr, err := core.ToString(e, d)
+				require.NoError(t, err)
+
+				t.Run(fmt.Sprintf("%s %s", f, ostr), func(t *testing.T) {
+					r := require.New(t)
+
+					b, err := f.Marshal(d, nil)
+					r.NoError(err)
+
+					obj, err := f.Unmarshal(e, b, nil)
+					r.NoError(err, "unmarshaling %s", b)
+
+					str, err := core.ToString(e, obj)
+					r.NoError(err)
+
+					r.True(core.Equals(e, obj, d), "didn't round trip: %s != %s via %s", ostr, str, b)
+				})
+			}
+		}
+	})
+
+	t.Run("round trips Go values", func(t *testing.T) {
+		u, err := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+		require.NoError(t, err)
+
+		input := []any{
+			[]byte("bytes\x00"),
+			u,
+			90 * time.Second,
+			&TaggedValue{Tag: 9999, Value: core.MakeString("payload")},
+			&TaggedEDN{Tag: "myapp/point", Value: core.MakeString("payload")},
+		}
+
+		for _, f := range formats {
+			for _, d := range input {
+				t.Run(fmt.Sprintf("%s %T", f, d), func(t *testing.T) {
+					r := require.New(t)
+
+					b, err := f.Marshal(d, nil)
+					r.NoError(err)
+
+					obj, err := f.Unmarshal(e, b, nil)
+					r.NoError(err, "unmarshaling %s", b)
+					r.Equal(d, obj)
+				})
+			}
+
+			t.Run(fmt.Sprintf("%s regex", f), func(t *testing.T) {
+				r := require.New(t)
+
+				b, err := f.Marshal(core.MakeRegex(regexp.MustCompile(`a"b\d+`)), nil)
+				r.NoError(err)
+
+				obj, err := f.Unmarshal(e, b, nil)
+				r.NoError(err)
+				r.Equal(`a"b\d+`, obj.(*core.Regex).R.String())
+			})
+		}
+	})
+
+	t.Run("writes readable payloads", func(t *testing.T) {
+		r := require.New(t)
+
+		m, err := core.NewHashMap(e,
+			core.MakeKeyword("name"), core.MakeString("lace"),
+		)
+		r.NoError(err)
+
+		b, err := MarshalJSON(m)
+		r.NoError(err)
+		r.Equal(`{"$map":[{"$kw":"name"},"lace"]}`, string(b))
+
+		b, err = MarshalEDN(core.NewVectorFrom(m, core.MakeDouble(1), core.NewChar(' ')))
+		r.NoError(err)
+		r.Equal(`[{:name "lace"} 1.0 \space]`, string(b))
+	})
+
+	t.Run("reads plain JSON and EDN", func(t *testing.T) {
+		r := require.New(t)
+
+		obj, err := UnmarshalJSON(e, []byte(`{"ids": [1, 2.5, 18446744073709551616], "ok": true}`))
+		r.NoError(err)
+
+		ids, err := core.NewHashMap(e,
+			core.MakeString("ids"), core.NewVectorFrom(
+				core.MakeInt(1),
+				core.MakeDouble(2.5),
+				must(parseBigInt("18446744073709551616")),
+			),
+			core.MakeString("ok"), core.MakeBoolean(true),
+		)
+		r.NoError(err)
+		r.True(core.Equals(e, ids, obj))
+
+		obj, err = UnmarshalEDN(e, []byte("; a comment\n{:a [1 2 #_ 3], :b #{\\c}}"))
+		r.NoError(err)
+
+		expected, err := core.NewHashMap(e,
+			core.MakeKeyword("a"), core.NewVectorFrom(core.MakeInt(1), core.MakeInt(2)),
+			core.MakeKeyword("b"), must(core.NewSetFromSeq(e, core.NewListFrom(core.NewChar('c')))),
+		)
+		r.NoError(err)
+		r.True(core.Equals(e, expected, obj))
+
+		obj, err = UnmarshalEDN(e, []byte("#myapp/point [1 2]"))
+		r.NoError(err)
+
+		te, ok := obj.(*TaggedEDN)
+		r.True(ok)
+		r.Equal("myapp/point", te.Tag)
+		r.True(core.Equals(e, core.NewVectorFrom(core.MakeInt(1), core.MakeInt(2)), te.Value))
+
+		b, err := MarshalEDN(obj)
+		r.NoError(err)
+		r.Equal("#myapp/point [1 2]", string(b))
+
+		_, err = UnmarshalEDN(e, []byte("#inst 1"))
+		r.Error(err)
+	})
+
+	t.Run("rejects maps with an odd number of elements", func(t *testing.T) {
+		r := require.New(t)
+
+		_, err := UnmarshalJSON(e, []byte(`{"$map": ["a"]}`))
+		r.Error(err)
+
+		_, err = UnmarshalEDN(e, []byte(`{:a}`))
+		r.Error(err)
+
+		key, err := encoder.Marshal("a")
+		r.NoError(err)
+
+		b, err := encoder.Marshal(Collection{Type: "map", Values: []cbor.RawMessage{key}})
+		r.NoError(err)
+
+		_, err = Unmarshal(e, b)
+		r.ErrorContains(err, "even number")
+	})
+
+	t.Run("encodes envelopes", func(t *testing.T) {
+		type inner struct {
+			Message string `json:"message" cbor:"1,keyasint"`
+			Data    Raw    `json:"data,omitempty" cbor:"2,keyasint,omitempty"`
+		}
+
+		type envelope struct {
+			Name    string `json:"name" cbor:"1,keyasint"`
+			Value   Raw    `json:"value" cbor:"2,keyasint"`
+			Count   int64  `json:"count,omitempty" cbor:"3,keyasint,omitempty"`
+			Flag    bool   `json:"flag" cbor:"4,keyasint"`
+			Error   *inner `json:"error,omitempty" cbor:"5,keyasint,omitempty"`
+			Missing *inner `json:"missing,omitempty" cbor:"6,keyasint,omitempty"`
+		}
+
+		for _, f := range formats {
+			t.Run(f.String(), func(t *testing.T) {
+				r := require.New(t)
+
+				val, err := f.Marshal(core.NewVectorFrom(core.MakeKeyword("a"), core.MakeString("}")), nil)
+				r.NoError(err)
+
+				data, err := f.Marshal(core.MakeInt(3), nil)
+				r.NoError(err)
+
+				b, err := f.Encode(&envelope{
+					Name:  "call",
+					Value: val,
+					Count: 1 << 40,
+					Flag:  true,
+					Error: &inner{Message: "boom", Data: data},
+				})
+				r.NoError(err)
+
+				var env envelope
+
+				r.NoError(f.Decode(b, &env))
+				r.Equal("call", env.Name)
+				r.Equal(int64(1<<40), env.Count)
+				r.True(env.Flag)
+				r.Nil(env.Missing)
+				r.Equal("boom", env.Error.Message)
+
+				obj, err := f.Unmarshal(e, env.Value, nil)
+				r.NoError(err)
+				r.True(core.Equals(e, core.NewVectorFrom(core.MakeKeyword("a"), core.MakeString("}")), obj))
+
+				obj, err = f.Unmarshal(e, env.Error.Data, nil)
+				r.NoError(err)
+				r.Equal(core.MakeInt(3), obj)
+			})
+		}
 	})
 }
@@ -0,0 +1,466 @@
+package marshal
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lab47/lace/core"
+)
+
+// GoStruct is a Go struct marshaled as the name of its type and its fields,
+// converted to lace data as go/->data does. It's unmarshaled as a pointer
+// to a new value of the type registered under that name, or as a map of
+// the fields when there is none.
+type GoStruct struct {
+	Type   string          `json:"type" cbor:"1,keyasint"`
+	Fields cbor.RawMessage `json:"fields" cbor:"2,keyasint"`
+}
+
+// Codec marshals the values of a Go type as a CBOR tag. Encode returns the
+// lace data the tag contains, which is marshaled like any other value, and
+// Decode turns that data back into a value of the type.
+type Codec struct {
+	Tag    uint64
+	Type   reflect.Type
+	Encode func(val any) (any, error)
+	Decode func(env *core.Env, data any) (any, error)
+}
+
+// The tags the values marshaled as structs and collections are encoded
+// with, which codecs can't use.
+const (
+	minReservedTag = 470
+	maxReservedTag = 481
+)
+
+var registry = struct {
+	sync.RWMutex
+
+	codecs map[reflect.Type]*Codec
+	tags   map[uint64]*Codec
+
+	structs     map[string]reflect.Type
+	structNames map[reflect.Type]string
+}{
+	codecs:      map[reflect.Type]*Codec{},
+	tags:        map[uint64]*Codec{},
+	structs:     map[string]reflect.Type{},
+	structNames: map[reflect.Type]string{},
+}
+
+// RegisterCodec adds a codec for the values of c.Type. Each type and each
+// tag can only have one codec.
+func RegisterCodec(c Codec) error {
+	if c.Type == nil || c.Encode == nil || c.Decode == nil {
+		return fmt.Errorf("codec for tag %d is missing its type, Encode or Decode", c.Tag)
+	}
+
+	if c.Tag >= minReservedTag && c.Tag <= maxReservedTag {
+		return fmt.Errorf("tag %d is reserved by lace", c.Tag)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.tags[c.Tag]; ok {
+		return fmt.Errorf("tag %d already has a codec", c.Tag)
+	}
+
+	if _, ok := registry.codecs[c.Type]; ok {
+		return fmt.Errorf("type %s already has a codec", c.Type)
+	}
+
+	registry.tags[c.Tag] = &c
+	registry.codecs[c.Type] = &c
+
+	return nil
+}
+
+// RegisterType lets the structs marshaled with the given type name be
+// unmarshaled as values of t. When name is empty, the name t is marshaled
+// with by default is used, its package path and name.
+func RegisterType(name string, t reflect.Type) error {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("%s is not a struct type", t)
+	}
+
+	if name == "" {
+		name = defaultTypeName(t)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if other, ok := registry.structs[name]; ok && other != t {
+		return fmt.Errorf("type name %s is already registered for %s", name, other)
+	}
+
+	registry.structs[name] = t
+	registry.structNames[t] = name
+
+	return nil
+}
+
+func defaultTypeName(t reflect.Type) string {
+	return t.PkgPath() + "." + t.Name()
+}
+
+func typeName(t reflect.Type) string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	if name, ok := registry.structNames[t]; ok {
+		return name
+	}
+
+	return defaultTypeName(t)
+}
+
+func codecFor(t reflect.Type) *Codec {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return registry.codecs[t]
+}
+
+func codecForTag(tag uint64) *Codec {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return registry.tags[tag]
+}
+
+func structType(name string) (reflect.Type, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	t, ok := registry.structs[name]
+	return t, ok
+}
+
+// TaggedValue is a value marshaled with a tag that has no codec here. It
+// keeps the tag, so that it's marshaled again as it was received.
+type TaggedValue struct {
+	Tag   uint64
+	Value any
+}
+
+func (tv *TaggedValue) ToString(env *core.Env, escape bool) (string, error) {
+	val, err := core.ToString(env, tv.Value)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#tagged[%d %s]", tv.Tag, val), nil
+}
+
+// UUID is an RFC 9562 UUID, marshaled with the standard CBOR tag for them.
+type UUID [16]byte
+
+// NewUUID returns a random, version 4 UUID.
+func NewUUID() (UUID, error) {
+	var u UUID
+
+	if _, err := rand.Read(u[:]); err != nil {
+		return u, err
+	}
+
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+
+	return u, nil
+}
+
+// ParseUUID parses the canonical, hyphenated form of a UUID.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID: %q", s)
+	}
+
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return u, fmt.Errorf("invalid UUID: %q", s)
+	}
+
+	return u, nil
+}
+
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+func (u UUID) ToString(env *core.Env, escape bool) (string, error) {
+	if escape {
+		return fmt.Sprintf("#uuid %q", u.String()), nil
+	}
+
+	return u.String(), nil
+}
+
+// The tags of the built-in codecs. 37 is the standard tag for UUIDs, the
+// others are in the range lace uses.
+const (
+	uuidTag     = 37
+	durationTag = 482
+	bufferTag   = 483
+	ednTag      = 484
+)
+
+func init() {
+	codecs := []Codec{
+		{
+			Tag:  uuidTag,
+			Type: reflect.TypeFor[UUID](),
+			Encode: func(val any) (any, error) {
+				u := val.(UUID)
+				return u[:], nil
+			},
+			Decode: func(env *core.Env, data any) (any, error) {
+				b, ok := data.([]byte)
+				if !ok || len(b) != 16 {
+					return nil, fmt.Errorf("UUID must be 16 bytes")
+				}
+
+				return UUID(b), nil
+			},
+		},
+		{
+			Tag:  durationTag,
+			Type: reflect.TypeFor[time.Duration](),
+			Encode: func(val any) (any, error) {
+				return core.MakeInt(int(val.(time.Duration))), nil
+			},
+			Decode: func(env *core.Env, data any) (any, error) {
+				n, ok := data.(core.Int)
+				if !ok {
+					return nil, fmt.Errorf("duration must be an integer, got %s", core.TypeName(data))
+				}
+
+				return time.Duration(n.I()), nil
+			},
+		},
+		{
+			Tag:  bufferTag,
+			Type: reflect.TypeFor[*core.Buffer](),
+			Encode: func(val any) (any, error) {
+				return bytes.Clone(val.(*core.Buffer).Bytes()), nil
+			},
+			Decode: func(env *core.Env, data any) (any, error) {
+				b, ok := data.([]byte)
+				if !ok {
+					return nil, fmt.Errorf("buffer must be bytes, got %s", core.TypeName(data))
+				}
+
+				return core.MakeBuffer(bytes.NewBuffer(b)), nil
+			},
+		},
+		{
+			Tag:  ednTag,
+			Type: reflect.TypeFor[*TaggedEDN](),
+			Encode: func(val any) (any, error) {
+				te := val.(*TaggedEDN)
+				return core.NewVectorFrom(core.MakeString(te.Tag), te.Value), nil
+			},
+			Decode: func(env *core.Env, data any) (any, error) {
+				v, ok := data.(*core.Vector)
+				if !ok || v.Count() != 2 {
+					return nil, fmt.Errorf("EDN tagged value must be a vector of its tag and value")
+				}
+
+				elems, err := core.ToSlice(env, v.Seq())
+				if err != nil {
+					return nil, err
+				}
+
+				tag, ok := elems[0].(core.String)
+				if !ok {
+					return nil, fmt.Errorf("EDN tag must be a string, got %s", core.TypeName(elems[0]))
+				}
+
+				return &TaggedEDN{Tag: tag.S(), Value: elems[1]}, nil
+			},
+		},
+	}
+
+	for _, c := range codecs {
+		if err := RegisterCodec(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// goKind is how a Go value that isn't lace data is marshaled.
+type goKind int
+
+const (
+	goNil goKind = iota
+	goBytes
+	goCodec
+	goStruct
+	goData
+)
+
+// goValue is a Go value that isn't lace data, converted to the data it's
+// marshaled as. The formats differ only in how they write each kind.
+type goValue struct {
+	kind goKind
+	data any
+
+	// tag is the tag of the codec of a goCodec value.
+	tag uint64
+
+	// name is the type name of a goStruct value.
+	name string
+}
+
+// goValueOf converts a Go value using the codec of its type, or as a struct
+// or plain data.
+func goValueOf(env *core.Env, obj any) (goValue, error) {
+	rv, ok := obj.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(obj)
+	}
+
+	if !rv.IsValid() {
+		return goValue{kind: goNil}, nil
+	}
+
+	if c := codecFor(rv.Type()); c != nil {
+		data, err := c.Encode(rv.Interface())
+		if err != nil {
+			return goValue{}, err
+		}
+
+		return goValue{kind: goCodec, tag: c.Tag, data: data}, nil
+	}
+
+	switch {
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return goValue{kind: goBytes, data: rv.Bytes()}, nil
+	case rv.Kind() == reflect.Pointer && rv.Type().Elem().Kind() == reflect.Struct && rv.IsNil():
+		return goValue{kind: goNil}, nil
+	case rv.Kind() == reflect.Struct, rv.Kind() == reflect.Pointer && rv.Type().Elem().Kind() == reflect.Struct:
+		data, err := core.DataFromValue(env, rv)
+		if err != nil {
+			return goValue{}, err
+		}
+
+		return goValue{kind: goStruct, name: typeName(reflect.Indirect(rv).Type()), data: data}, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String,
+		reflect.Slice, reflect.Array, reflect.Map, reflect.Pointer, reflect.Interface:
+		data, err := core.DataFromValue(env, rv)
+		if err != nil {
+			return goValue{}, err
+		}
+
+		return goValue{kind: goData, data: data}, nil
+	default:
+		return goValue{}, ErrUnsupported
+	}
+}
+
+func (m *marshalState) encodeTagged(tag uint64, val any) ([]byte, error) {
+	content, err := m.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.encode(cbor.RawTag{Number: tag, Content: content})
+}
+
+func (m *marshalState) encodeStruct(name string, data any) ([]byte, error) {
+	fields, err := m.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.encode(GoStruct{
+		Type:   name,
+		Fields: fields,
+	})
+}
+
+// marshalGo marshals a Go value that isn't lace data, using the codec of
+// its type, or as a GoStruct.
+func (m *marshalState) marshalGo(obj any) ([]byte, error) {
+	gv, err := goValueOf(m.env, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	switch gv.kind {
+	case goBytes:
+		return m.encode(gv.data)
+	case goCodec:
+		return m.encodeTagged(gv.tag, gv.data)
+	case goStruct:
+		return m.encodeStruct(gv.name, gv.data)
+	case goData:
+		return m.Marshal(gv.data)
+	default:
+		return m.encode(nil)
+	}
+}
+
+// decodeStruct returns the struct of the type registered under name with
+// the given fields, or the fields themselves when there is none.
+func decodeStruct(env *core.Env, name string, fields any) (any, error) {
+	t, ok := structType(name)
+	if !ok {
+		return fields, nil
+	}
+
+	m, ok := fields.(core.Map)
+	if !ok {
+		return nil, fmt.Errorf("fields of %s must be a map, got %s", name, core.TypeName(fields))
+	}
+
+	return core.DataToStruct(env, t, m)
+}
+
+// decodeTag decodes the content of a tag that isn't one of the built-in
+// ones, with its codec or as a TaggedValue.
+func decodeTag(env *core.Env, tag uint64, content any) (any, error) {
+	if c := codecForTag(tag); c != nil {
+		return c.Decode(env, content)
+	}
+
+	return &TaggedValue{Tag: tag, Value: content}, nil
+}
+
+func (s *unmarshalState) unmarshalStruct(gs GoStruct) (any, error) {
+	fields, err := s.unmarshal(gs.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeStruct(s.env, gs.Type, fields)
+}
+
+func (s *unmarshalState) unmarshalTag(rt cbor.RawTag) (any, error) {
+	content, err := s.unmarshal(rt.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTag(s.env, rt.Number, content)
+}
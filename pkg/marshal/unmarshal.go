@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/lab47/lace/core"
 )
 
@@ -13,15 +14,34 @@ type unmarshalState struct {
 	env   *core.Env
 	refs  map[int]any
 	frefs map[string]any
+	imp   Importer
+	code  bool
 }
 
-func Unmarshal(env *core.Env, data []byte) (any, error) {
-	var ms unmarshalState
+// newUnmarshalState returns the state for unmarshaling a value with imp,
+// which accepts Code only if it was wrapped by AllowCode.
+func newUnmarshalState(env *core.Env, imp Importer) *unmarshalState {
+	s := &unmarshalState{
+		env:  env,
+		refs: make(map[int]any),
+		imp:  imp,
+	}
+
+	if ci, ok := imp.(codeImporter); ok {
+		s.imp = ci.Importer
+		s.code = true
+	}
 
-	ms.env = env
-	ms.refs = make(map[int]any)
+	return s
+}
+
+func Unmarshal(env *core.Env, data []byte) (any, error) {
+	return UnmarshalWith(env, data, nil)
+}
 
-	return ms.unmarshal(data)
+// UnmarshalWith unmarshals data, using imp to resolve any foreign refs.
+func UnmarshalWith(env *core.Env, data []byte, imp Importer) (any, error) {
+	return newUnmarshalState(env, imp).unmarshal(data)
 }
 
 func (s *unmarshalState) unmarshalCol(col Collection) (any, error) {
@@ -55,6 +75,10 @@ func (s *unmarshalState) unmarshalCol(col Collection) (any, error) {
 
 		ret = s
 	case "map":
+		if len(elems)%2 != 0 {
+			return nil, fmt.Errorf("map must have an even number of elements")
+		}
+
 		ret, err = core.NewHashMap(s.env, elems...)
 		if err != nil {
 			return nil, err
@@ -68,7 +92,40 @@ func (s *unmarshalState) unmarshalCol(col Collection) (any, error) {
 	return ret, nil
 }
 
+func decodeChar(s string) (any, error) {
+	r := []rune(s)
+	if len(r) != 1 {
+		return nil, fmt.Errorf("char must be a single rune, got %q", s)
+	}
+
+	return core.NewChar(r[0]), nil
+}
+
+// isStandardTag reports whether tag is one of the tags for times and big
+// ints, which the decoder handles itself.
+func isStandardTag(tag uint64) bool {
+	return tag <= 3
+}
+
+// isTag reports whether data is a CBOR tag, whose major type is 6.
+func isTag(data []byte) bool {
+	return len(data) > 0 && data[0]>>5 == 6
+}
+
 func (s *unmarshalState) unmarshal(data []byte) (any, error) {
+	if isTag(data) {
+		var rt cbor.RawTag
+
+		err := decoder.Unmarshal(data, &rt)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isStandardTag(rt.Number) && (rt.Number < minReservedTag || rt.Number > maxReservedTag) {
+			return s.unmarshalTag(rt)
+		}
+	}
+
 	var out any
 
 	err := decoder.Unmarshal(data, &out)
@@ -91,6 +148,8 @@ func (s *unmarshalState) unmarshal(data []byte) (any, error) {
 		return core.MakeBoolean(sv), nil
 	case *big.Int:
 		return core.MakeBigIntFrom(sv), nil
+	case big.Int:
+		return core.MakeBigIntFrom(&sv), nil
 	case BigFloat:
 		var bf big.Float
 		if _, ok := bf.SetPrec(256).SetString(sv.Value); !ok {
@@ -99,6 +158,8 @@ func (s *unmarshalState) unmarshal(data []byte) (any, error) {
 		return core.MakeBigFloatFrom(&bf), nil
 	case Ratio:
 		return core.MakeRatio(sv.X, sv.Y), nil
+	case Char:
+		return decodeChar(sv.Value)
 	case nil:
 		return core.NIL, nil
 	case string:
@@ -114,6 +175,18 @@ func (s *unmarshalState) unmarshal(data []byte) (any, error) {
 		return core.MakeRegex(re), nil
 	case Collection:
 		return s.unmarshalCol(sv)
+	case Code:
+		return s.unmarshalCode(sv)
+	case GoStruct:
+		return s.unmarshalStruct(sv)
+	case []byte:
+		return sv, nil
+	case ForeignRef:
+		if s.imp == nil {
+			return nil, fmt.Errorf("unable to resolve foreign ref: %s", sv.Ref)
+		}
+
+		return s.imp.Import(sv)
 	default:
 		return nil, fmt.Errorf("unsupported type: %T", sv)
 	}
@@ -0,0 +1,127 @@
+package pkgreflect
+
+import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// moduleGoVersion returns the minor version of the go directive in the
+// go.mod that governs dir, or 0 if there isn't one.
+func moduleGoVersion(dir string) int {
+	for {
+		data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if v, ok := strings.CutPrefix(strings.TrimSpace(line), "go "); ok {
+					return goMinor(v)
+				}
+			}
+
+			return 0
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return 0
+		}
+
+		dir = parent
+	}
+}
+
+func goMinor(v string) int {
+	v = strings.TrimPrefix(v, "go")
+	parts := strings.Split(v, ".")
+	if len(parts) < 2 {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(parts[1])
+	return n
+}
+
+// newerAPI returns the names of the entities in the standard library package
+// pkg that were added after Go 1.minor, according to the api files shipped
+// with the toolchain. Methods are named Type.Method.
+func newerAPI(pkg string, minor int) map[string]bool {
+	ret := map[string]bool{}
+
+	if minor == 0 {
+		return ret
+	}
+
+	prefix := "pkg " + pkg + ","
+
+	for v := minor + 1; ; v++ {
+		f, err := os.Open(filepath.Join(build.Default.GOROOT, "api", fmt.Sprintf("go1.%d.txt", v)))
+		if err != nil {
+			break
+		}
+
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			line := sc.Text()
+
+			rest, ok := strings.CutPrefix(line, prefix)
+			if !ok {
+				// Entries specific to an os/arch are prefixed with it.
+				rest, ok = strings.CutPrefix(line, "pkg "+pkg+" (")
+				if !ok {
+					continue
+				}
+
+				_, rest, _ = strings.Cut(rest, "),")
+			}
+
+			if name := apiEntityName(strings.TrimSpace(rest)); name != "" {
+				ret[name] = true
+			}
+		}
+
+		f.Close()
+	}
+
+	return ret
+}
+
+// apiEntityName extracts the name of the entity introduced by an api file
+// entry, ignoring entries that add fields or methods to interfaces.
+func apiEntityName(entry string) string {
+	kind, rest, _ := strings.Cut(entry, " ")
+
+	switch kind {
+	case "func", "const", "var":
+		return strings.FieldsFunc(rest, func(r rune) bool {
+			return r == '(' || r == ' ' || r == '['
+		})[0]
+	case "type":
+		name, def, _ := strings.Cut(rest, " ")
+		if strings.HasPrefix(def, "struct,") || strings.HasPrefix(def, "interface,") {
+			return ""
+		}
+
+		name, _, _ = strings.Cut(name, "[")
+		return name
+	case "method":
+		recv, rest, ok := strings.Cut(rest, ") ")
+		if !ok {
+			return ""
+		}
+
+		recv = strings.TrimLeft(recv, "(*")
+		if _, t, ok := strings.Cut(recv, " "); ok {
+			recv = strings.TrimLeft(t, "*")
+		}
+		recv, _, _ = strings.Cut(recv, "[")
+
+		name, _, _ := strings.Cut(rest, "(")
+		return recv + "." + name
+	default:
+		return ""
+	}
+}
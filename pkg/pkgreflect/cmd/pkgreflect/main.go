@@ -12,8 +12,10 @@ import (
 var laceName = flag.String("lace-name", "", "default to same as name")
 var pkgName = flag.String("pkg-name", "", "name of the package to generate")
 var directive = flag.Bool("honor-directive", false, "Export all entities with //lace:export directive in doc")
-var match = flag.String("match", "", "if set, only match elements that have these names (comma delim list)")
+var matchNames = flag.String("match", "", "if set, only match elements that have these names (comma delim list)")
 var inCore = flag.Bool("in-core", false, "output code to be linked directly into core (ie lace.lang)")
+var implPrefix = flag.String("impl-prefix", "", "prefix for the Go names of structs generated to implement interfaces")
+var wrapperNS = flag.String("wrapper", "", "also generate an idiomatic lace namespace with this name next to the output")
 var specialized = flag.Bool("specialized", false, "output code that attepmts to specialize functions")
 
 func main() {
@@ -48,13 +50,18 @@ func parseDir(name, output string) {
 	}
 
 	match := &pkgreflect.Match{
-		Patterns:  strings.Split(*match, ","),
 		Directive: *directive,
 	}
 
+	if *matchNames != "" {
+		match.Patterns = strings.Split(*matchNames, ",")
+	}
+
 	err = pkgreflect.Generate(name, ln, wd, output, *pkgName, match, pkgreflect.GenOptions{
 		Specialized: *specialized,
 		InCore:      *inCore,
+		ImplPrefix:  *implPrefix,
+		WrapperNS:   *wrapperNS,
 	})
 	if err != nil {
 		fmt.Println(err)
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"go/ast"
 	"go/build"
+	"go/constant"
 	"go/format"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"io"
@@ -21,19 +23,13 @@ import (
 )
 
 func codeName(t types.Type, curpkg string) string {
-	switch sv := t.(type) {
-	case *types.Pointer:
-		return "*" + codeName(sv.Elem(), curpkg)
-	case *types.Named:
-		n := sv.Obj()
-		if n.Pkg() == nil || n.Pkg().Name() == curpkg {
-			return n.Name()
+	return types.TypeString(t, func(p *types.Package) string {
+		if p.Name() == curpkg {
+			return ""
 		}
 
-		return n.Pkg().Name() + "." + n.Name()
-	default:
-		return t.String()
-	}
+		return p.Name()
+	})
 }
 
 func doesMatch(ident string, doc *ast.CommentGroup, match *Match) bool {
@@ -65,11 +61,27 @@ func doesMatch(ident string, doc *ast.CommentGroup, match *Match) bool {
 type Match struct {
 	Patterns  []string
 	Directive bool
+
+	// Exclude lists names to skip, with methods named Type.Method.
+	Exclude map[string]bool
 }
 
 type GenOptions struct {
 	Specialized bool
 	InCore      bool
+
+	// GoVersion is the Go release the generated code must build with, ie.
+	// go1.22. Standard library entities added after it are skipped. Defaults
+	// to the go directive of the enclosing go.mod.
+	GoVersion string
+
+	// WrapperNS, when set, is the name of an idiomatic lace namespace to
+	// generate next to the binding, wrapping its functions.
+	WrapperNS string
+
+	// ImplPrefix is prepended to the Go name of the structs synthesized for
+	// interfaces, so that several packages can be generated into one.
+	ImplPrefix string
 }
 
 func typeSpecs(f *ast.File) []*ast.TypeSpec {
@@ -80,6 +92,11 @@ func typeSpecs(f *ast.File) []*ast.TypeSpec {
 			if gd.Tok == token.TYPE {
 				for _, spec := range gd.Specs {
 					if ts, ok := spec.(*ast.TypeSpec); ok {
+						// Generic types can't be referenced without instantiating them.
+						if ts.TypeParams != nil {
+							continue
+						}
+
 						if len(gd.Specs) == 1 {
 							ts.Doc = gd.Doc
 						}
@@ -101,6 +118,11 @@ func typeSpecs(f *ast.File) []*ast.TypeSpec {
 func Generate(name, laceName string, base, output, outputPkg string, match *Match, opts GenOptions) error {
 	var pcfg packages.Config
 	pcfg.Mode = packages.NeedSyntax | packages.NeedFiles | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedTypesSizes
+	// The declarations are found via the file scopes, so make sure that
+	// object resolution happens when parsing.
+	pcfg.ParseFile = func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+		return parser.ParseFile(fset, filename, src, parser.ParseComments|parser.AllErrors)
+	}
 	pkgs, err := packages.Load(&pcfg, name)
 	if err != nil {
 		panic(err)
@@ -111,6 +133,21 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 		return err
 	}
 
+	if pkg.Goroot {
+		minor := goMinor(opts.GoVersion)
+		if opts.GoVersion == "" {
+			minor = moduleGoVersion(base)
+		}
+
+		if match.Exclude == nil {
+			match.Exclude = map[string]bool{}
+		}
+
+		for n := range newerAPI(name, minor) {
+			match.Exclude[n] = true
+		}
+	}
+
 	/*
 		var files []*ast.File
 
@@ -165,6 +202,9 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 	fmt.Fprintln(&buf, "")
 	fmt.Fprintln(&buf, `import "reflect"`)
 	fmt.Fprintln(&buf, `import "github.com/lab47/lace/pkg/pkgreflect"`)
+	if opts.WrapperNS != "" && output != "-" {
+		fmt.Fprintln(&buf, `import _ "embed"`)
+	}
 	if !same {
 		fmt.Fprintf(&buf, "import %s \"%s\"\n", tpkg.Name(), pkg.ImportPath)
 	}
@@ -183,7 +223,7 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 				continue
 			}
 
-			if !doesMatch(ts.Name.Name, ts.Doc, match) {
+			if !doesMatch(ts.Name.Name, ts.Doc, match) || match.Exclude[ts.Name.Name] {
 				continue
 			}
 
@@ -192,8 +232,8 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 			if it, ok := ts.Type.(*ast.InterfaceType); ok {
 				itt := info.Types[it].Type.(*types.Interface)
 
-				fmt.Fprintf(&buf, "type %sImpl struct {\n", ts.Name.Name)
-				synthStruct = append(synthStruct, ts.Name.Name+"Impl")
+				fmt.Fprintf(&buf, "type %s%sImpl struct {\n", opts.ImplPrefix, ts.Name.Name)
+				synthStruct = append(synthStruct, ts.Name.Name)
 
 				for i := 0; i < itt.NumMethods(); i++ {
 					fn := itt.Method(i)
@@ -247,7 +287,7 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 						resStr = "(" + strings.Join(ary, ", ") + ")"
 					}
 
-					fmt.Fprintf(&buf, "func (s *%sImpl) %s(%s) %s {\n", ts.Name.Name, fn.Name(), strings.Join(args, ", "), resStr)
+					fmt.Fprintf(&buf, "func (s *%s%sImpl) %s(%s) %s {\n", opts.ImplPrefix, ts.Name.Name, fn.Name(), strings.Join(args, ", "), resStr)
 					if sig.Results().Len() == 0 {
 						fmt.Fprintf(&buf, "s.%sFn(%s)\n", fn.Name(), strings.Join(cs, ", "))
 					} else {
@@ -259,6 +299,24 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 		}
 	}
 
+	wrapperVar := ""
+
+	if opts.WrapperNS != "" && output != "-" {
+		var wbuf bytes.Buffer
+		generateWrapper(&wbuf, tpkg, info, files, pkg.Doc, laceName, opts.WrapperNS, match)
+
+		err = writeIfChanged(wrapperFileName(output), wbuf.Bytes())
+		if err != nil {
+			return err
+		}
+
+		wrapperVar = wrapperVarName(output)
+
+		fmt.Fprintln(&buf, "")
+		fmt.Fprintf(&buf, "//go:embed %s\n", filepath.Base(wrapperFileName(output)))
+		fmt.Fprintf(&buf, "var %s string\n", wrapperVar)
+	}
+
 	fmt.Fprintln(&buf, "")
 	fmt.Fprintln(&buf, "func init() {")
 
@@ -280,7 +338,11 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 
 				if fn.Recv != nil && len(fn.Recv.List) == 1 {
 					rt := typeName(fn.Recv.List[0].Type)
-					if !ast.IsExported(rt) {
+					if !ast.IsExported(rt) || !slices.Contains(typesForMethods, rt) {
+						continue
+					}
+
+					if match.Exclude[rt+"."+fn.Name.Name] {
 						continue
 					}
 
@@ -306,12 +368,16 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 
 	fmt.Fprintf(&buf, "pkgreflect.AddPackage(%q, &pkgreflect.Package{\n", laceName)
 	fmt.Fprintf(&buf, "Doc: %q,\n", pkg.Doc)
+	if wrapperVar != "" {
+		fmt.Fprintf(&buf, "WrapperNS: %q,\n", opts.WrapperNS)
+		fmt.Fprintf(&buf, "Wrapper: %s,\n", wrapperVar)
+	}
 
 	// Types
 	fmt.Fprintln(&buf, "Types: map[string]pkgreflect.Type{")
 	tprint(&buf, pkgName, files, "\t%q: {Doc: %q, Value: reflect.TypeOf((*%s%s)(nil)).Elem(), Methods: %[1]s_methods},\n", match)
 	for _, ss := range synthStruct {
-		fmt.Fprintf(&buf, "\t%q: {Doc: `Struct version of interface %s for implementation`, Value: reflect.TypeFor[%[1]s]()},\n", ss, ss[:len(ss)-4])
+		fmt.Fprintf(&buf, "\t\"%sImpl\": {Doc: `Struct version of interface %[1]s for implementation`, Value: reflect.TypeFor[%s%[1]sImpl]()},\n", ss, opts.ImplPrefix)
 	}
 	fmt.Fprintln(&buf, "},")
 	fmt.Fprintln(&buf, "")
@@ -324,20 +390,20 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 
 	// Addresses of variables
 	fmt.Fprintln(&buf, "Variables: map[string]pkgreflect.Value{")
-	print(&buf, pkgName, files, ast.Var, "\t%q: {Doc: %q, Value: reflect.ValueOf(&%s%s)},\n", match)
+	print(&buf, pkgName, files, ast.Var, "\t%q: {Doc: %q, Value: reflect.ValueOf(&%s)},\n", match, tpkg)
 	fmt.Fprintln(&buf, "},")
 	fmt.Fprintln(&buf, "")
 
 	// Addresses of consts
 	fmt.Fprintln(&buf, "Consts: map[string]pkgreflect.Value{")
-	print(&buf, pkgName, files, ast.Con, "\t%q: {Doc: %q, Value: reflect.ValueOf(%s%s)},\n", match)
+	print(&buf, pkgName, files, ast.Con, "\t%q: {Doc: %q, Value: reflect.ValueOf(%s)},\n", match, tpkg)
 	fmt.Fprintln(&buf, "},")
 	fmt.Fprintln(&buf, "")
 
 	fmt.Fprintln(&buf, "})")
 	fmt.Fprintln(&buf, "}")
 
-	data, err := imports.Process("out.go", buf.Bytes(), &imports.Options{})
+	data, err := imports.Process("out.go", buf.Bytes(), &imports.Options{Comments: true})
 	if err != nil {
 		os.Stderr.Write(buf.Bytes())
 		return err
@@ -352,19 +418,24 @@ func Generate(name, laceName string, base, output, outputPkg string, match *Matc
 	if output == "-" {
 		os.Stdout.Write(formatted)
 	} else {
-		filename := filepath.Join(output)
-		oldFileData, _ := os.ReadFile(filename)
-		if !bytes.Equal(formatted, oldFileData) {
-			err = os.WriteFile(filename, formatted, 0660)
-			if err != nil {
-				panic(err)
-			}
+		err = writeIfChanged(output, formatted)
+		if err != nil {
+			panic(err)
 		}
 	}
 
 	return nil
 }
 
+func writeIfChanged(filename string, data []byte) error {
+	oldFileData, _ := os.ReadFile(filename)
+	if bytes.Equal(data, oldFileData) {
+		return nil
+	}
+
+	return os.WriteFile(filename, data, 0660)
+}
+
 func typeName(x ast.Expr) string {
 	for {
 		switch sv := x.(type) {
@@ -418,7 +489,7 @@ func tprint(w io.Writer, pkgName string, files []*ast.File, format string, match
 				continue
 			}
 
-			if !doesMatch(name, doc, match) {
+			if !doesMatch(name, doc, match) || match.Exclude[name] {
 				continue
 			}
 
@@ -436,7 +507,7 @@ func tprint(w io.Writer, pkgName string, files []*ast.File, format string, match
 	}
 }
 
-func print(w io.Writer, pkgName string, files []*ast.File, kind ast.ObjKind, format string, match *Match) {
+func print(w io.Writer, pkgName string, files []*ast.File, kind ast.ObjKind, format string, match *Match, tpkg *types.Package) {
 	type ent struct {
 		name, doc string
 	}
@@ -450,7 +521,7 @@ func print(w io.Writer, pkgName string, files []*ast.File, kind ast.ObjKind, for
 
 			doc := getDoc(object)
 
-			if !doesMatch(name, doc, match) {
+			if !doesMatch(name, doc, match) || match.Exclude[name] {
 				continue
 			}
 
@@ -468,7 +539,19 @@ func print(w io.Writer, pkgName string, files []*ast.File, kind ast.ObjKind, for
 	})
 
 	for _, name := range names {
-		fmt.Fprintf(w, format, name.name, name.doc, pkgName, name.name)
+		ref := pkgName + name.name
+
+		// Untyped integer constants that don't fit in an int, such as
+		// math.MaxUint64, need an explicit type to be referenced.
+		if c, ok := tpkg.Scope().Lookup(name.name).(*types.Const); ok {
+			if b, ok := c.Type().(*types.Basic); ok && b.Kind() == types.UntypedInt {
+				if _, exact := constant.Int64Val(c.Val()); !exact {
+					ref = "uint64(" + ref + ")"
+				}
+			}
+		}
+
+		fmt.Fprintf(w, format, name.name, name.doc, ref)
 	}
 }
 
@@ -496,7 +579,12 @@ func fnprint(w io.Writer, pkgName string, files []*ast.File, kind ast.ObjKind, m
 			if object.Kind == kind && ast.IsExported(name) {
 				fn := object.Decl.(*ast.FuncDecl)
 
-				if !doesMatch(name, fn.Doc, match) {
+				// Generic functions can't be referenced without instantiating them.
+				if fn.Type.TypeParams != nil {
+					continue
+				}
+
+				if !doesMatch(name, fn.Doc, match) || match.Exclude[name] {
 					continue
 				}
 				var args []string
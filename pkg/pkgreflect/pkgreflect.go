@@ -35,6 +35,8 @@ type Type struct {
 type Package struct {
 	Name      string
 	Doc       string
+	WrapperNS string
+	Wrapper   string
 	Types     map[string]Type
 	Functions map[string]FuncValue
 	Variables map[string]Value
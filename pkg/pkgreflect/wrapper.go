@@ -0,0 +1,259 @@
+package pkgreflect
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// KebabName converts a Go identifier into the kebab-case form used for
+// names on the lace side, ie. HTTPServer becomes http-server.
+func KebabName(s string) string {
+	var sb strings.Builder
+
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+					(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+					sb.WriteByte('-')
+				}
+			}
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+// wrapperVarName returns the name of the Go variable holding the embedded
+// wrapper source for the binding written to output.
+func wrapperVarName(output string) string {
+	base := strings.TrimSuffix(filepath.Base(output), filepath.Ext(output))
+
+	return "wrapper_" + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, base)
+}
+
+// wrapperFileName returns the path of the wrapper source written next to
+// the binding at output.
+func wrapperFileName(output string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + ".clj"
+}
+
+type wrapperFn struct {
+	name   string
+	goName string
+	doc    string
+	sig    *types.Signature
+}
+
+// lispString quotes s as a lace string literal.
+func lispString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// wrapperName derives the idiomatic name of a function, turning boolean
+// predicates such as IsAbs and HasPrefix into abs? and has-prefix?.
+func wrapperName(goName string, sig *types.Signature) string {
+	name := KebabName(goName)
+
+	res := sig.Results()
+	if res.Len() == 1 && types.Identical(res.At(0).Type(), types.Typ[types.Bool]) {
+		switch {
+		case strings.HasPrefix(name, "is-"):
+			name = name[len("is-"):] + "?"
+		case strings.HasPrefix(name, "has-"):
+			name += "?"
+		}
+	}
+
+	return name
+}
+
+// optionStruct returns the name of the struct type declared in pkg that t
+// is or points to, and whether t is a pointer. Such arguments can be given
+// to the wrapper as keyword maps.
+func optionStruct(t types.Type, pkg *types.Package) (string, bool, bool) {
+	ptr := false
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+		ptr = true
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != pkg || !named.Obj().Exported() {
+		return "", false, false
+	}
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", false, false
+	}
+
+	// Structs without exported fields can't be populated from a map.
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Exported() {
+			return named.Obj().Name(), ptr, true
+		}
+	}
+
+	return "", false, false
+}
+
+func isEnvParam(t types.Type) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == "Env" && strings.HasSuffix(named.Obj().Pkg().Path(), "lace/core")
+}
+
+// generateWrapper writes the source of an idiomatic lace namespace named
+// wrapperNS that wraps the functions of the binding laceName. Names are
+// kebab-cased, (T, bool) lookups return nil on a miss, and struct options
+// declared in the package can be given as keyword maps. Errors returned by
+// the Go functions are already thrown by the binding.
+func generateWrapper(w io.Writer, tpkg *types.Package, info *types.Info, files []*ast.File, doc, laceName, wrapperNS string, match *Match) {
+	var fns []wrapperFn
+
+	for _, f := range files {
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Type.TypeParams != nil || !ast.IsExported(fn.Name.Name) {
+				continue
+			}
+
+			if !doesMatch(fn.Name.Name, fn.Doc, match) || match.Exclude[fn.Name.Name] {
+				continue
+			}
+
+			obj, ok := info.Defs[fn.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			goName := exportName(fn)
+			sig := obj.Type().(*types.Signature)
+
+			fns = append(fns, wrapperFn{
+				name:   wrapperName(goName, sig),
+				goName: goName,
+				doc:    strings.TrimSpace(fn.Doc.Text()),
+				sig:    sig,
+			})
+		}
+	}
+
+	sort.Slice(fns, func(i, j int) bool {
+		return fns[i].name < fns[j].name
+	})
+
+	var names []string
+	for _, fn := range fns {
+		names = append(names, fn.name)
+	}
+
+	fmt.Fprintf(w, ";; Code generated by github.com/lab47/lace/pkg/pkgreflect DO NOT EDIT.\n\n")
+	fmt.Fprintf(w, "(ns %s\n  %s\n  (:refer-clojure :exclude [%s]))\n",
+		wrapperNS, lispString(doc), strings.Join(names, " "))
+
+	for _, fn := range fns {
+		fmt.Fprintln(w)
+		writeWrapperFn(w, tpkg, laceName, fn)
+	}
+}
+
+func writeWrapperFn(w io.Writer, tpkg *types.Package, laceName string, fn wrapperFn) {
+	var (
+		params   []string
+		callArgs []string
+		bindings []string
+	)
+
+	sig := fn.sig
+
+	for i := 0; i < sig.Params().Len(); i++ {
+		p := sig.Params().At(i)
+
+		if isEnvParam(p.Type()) {
+			continue
+		}
+
+		name := KebabName(p.Name())
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("a%d", i)
+		}
+
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			params = append(params, "&", name)
+			callArgs = append(callArgs, "(lace.core/vec "+name+")")
+			continue
+		}
+
+		params = append(params, name)
+		callArgs = append(callArgs, name)
+
+		if st, ptr, ok := optionStruct(p.Type(), tpkg); ok {
+			conv := fmt.Sprintf("(go/->struct %s/%s %s)", laceName, st, name)
+			if !ptr {
+				conv = "(lace.reflect/deref " + conv + ")"
+			}
+
+			bindings = append(bindings,
+				fmt.Sprintf("%s (if (lace.core/map? %s) %s %s)", name, name, conv, name))
+		}
+	}
+
+	var results []types.Type
+	for i := 0; i < sig.Results().Len(); i++ {
+		t := sig.Results().At(i).Type()
+		if types.Identical(t, types.Universe.Lookup("error").Type()) {
+			continue
+		}
+		results = append(results, t)
+	}
+
+	call := fmt.Sprintf("(%s/%s", laceName, fn.goName)
+	if len(callArgs) > 0 {
+		call += " " + strings.Join(callArgs, " ")
+	}
+	call += ")"
+
+	if len(results) == 2 && types.Identical(results[1], types.Typ[types.Bool]) {
+		call = fmt.Sprintf("(lace.core/let [[v ok] %s] (if ok v nil))", call)
+	}
+
+	if len(bindings) > 0 {
+		call = fmt.Sprintf("(lace.core/let [%s]\n    %s)", strings.Join(bindings, "\n                  "), call)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "(defn %s\n", fn.name)
+	if fn.doc != "" {
+		fmt.Fprintf(&buf, "  %s\n", lispString(fn.doc))
+	}
+	fmt.Fprintf(&buf, "  {:arglists '([%s]) :go %s}\n", strings.Join(params, " "),
+		lispString(tpkg.Path()+"."+fn.goName))
+	fmt.Fprintf(&buf, "  [%s]\n  %s)\n", strings.Join(params, " "), call)
+
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,26 @@
+package pkgreflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKebabName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("http-server", KebabName("HTTPServer"))
+	r.Equal("read-file", KebabName("ReadFile"))
+	r.Equal("user-id", KebabName("UserID"))
+	r.Equal("base64-encode", KebabName("Base64Encode"))
+}
+
+func TestAPIEntityName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Lines", apiEntityName("func Lines(string) iter.Seq[string] #61901"))
+	r.Equal("ReadLinkFS", apiEntityName("type ReadLinkFS interface { Lstat, ReadLink }"))
+	r.Equal("", apiEntityName("type Server struct, HTTP2 *HTTP2Config #67813"))
+	r.Equal("OID.UnmarshalText", apiEntityName("method (*OID) UnmarshalText([]uint8) error #66249"))
+	r.Equal("MaxInt", apiEntityName("const MaxInt = 9223372036854775807"))
+}
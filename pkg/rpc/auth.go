@@ -0,0 +1,355 @@
+package rpc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lab47/lace/core"
+	"github.com/mr-tron/base58"
+)
+
+// The headers a signed message carries: the public key of the sender, the
+// time it was signed at along with a random nonce, and the signature.
+const (
+	keyHeader   = "Lace-Key"
+	sigHeader   = "Lace-Sig"
+	timeHeader  = "Lace-Time"
+	nonceHeader = "Lace-Nonce"
+)
+
+// signedHeaders are the headers covered by the signature of a message,
+// along with its subject, reply subject and data.
+var signedHeaders = []string{keyHeader, timeHeader, nonceHeader, formatHeader, connHeader, callHeader}
+
+// signatureWindow is how far from now the time a message was signed at may
+// be. Older messages are rejected as stale, and the signatures of newer
+// ones are remembered for that long to reject them when they're replayed.
+var signatureWindow = time.Minute
+
+// The errors a signed message is rejected with.
+var (
+	ErrBadSignature      = errors.New("message signature doesn't match")
+	ErrStaleSignature    = errors.New("message signature is too old")
+	ErrReplayedSignature = errors.New("message has already been received")
+)
+
+// AllowTag is the capabilities tag listing the callers allowed to call an
+// endpoint, by the keys they sign their requests with. AnyCaller allows
+// every caller that signs its requests.
+const (
+	AllowTag  = "allow"
+	AnyCaller = "*"
+)
+
+// Identity is the ed25519 key a connection signs its adverts and requests
+// with. Other connections know it by its public key.
+type Identity struct {
+	priv ed25519.PrivateKey
+}
+
+// GenerateIdentity returns a new random identity.
+func GenerateIdentity() (*Identity, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{priv: priv}, nil
+}
+
+// IdentityFromSeed returns the identity for a base58 encoded ed25519 seed.
+func IdentityFromSeed(seed string) (*Identity, error) {
+	data, err := base58.Decode(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != ed25519.SeedSize {
+		return nil, fmt.Errorf("identity seed must be %d bytes, got %d", ed25519.SeedSize, len(data))
+	}
+
+	return &Identity{priv: ed25519.NewKeyFromSeed(data)}, nil
+}
+
+// Key returns the base58 encoded public key of the identity.
+func (id *Identity) Key() string {
+	return base58.Encode(id.priv.Public().(ed25519.PublicKey))
+}
+
+// Seed returns the base58 encoded seed the identity can be restored from.
+func (id *Identity) Seed() string {
+	return base58.Encode(id.priv.Seed())
+}
+
+var (
+	processIdentityOnce sync.Once
+	processIdentity     *Identity
+	processIdentityErr  error
+)
+
+// ProcessIdentity returns the identity the connections of this process use
+// by default. It's read from the seed in LACE_RPC_KEY, or generated when
+// that's not set.
+func ProcessIdentity() (*Identity, error) {
+	processIdentityOnce.Do(func() {
+		if seed := os.Getenv("LACE_RPC_KEY"); seed != "" {
+			processIdentity, processIdentityErr = IdentityFromSeed(seed)
+		} else {
+			processIdentity, processIdentityErr = GenerateIdentity()
+		}
+	})
+
+	return processIdentity, processIdentityErr
+}
+
+// signedPayload returns the parts of msg its signature covers, each
+// prefixed with its length so that they can't be shifted between fields.
+func signedPayload(msg *Msg) []byte {
+	var payload []byte
+
+	field := func(s string) {
+		payload = binary.AppendUvarint(payload, uint64(len(s)))
+		payload = append(payload, s...)
+	}
+
+	field(msg.Subject)
+	field(msg.Reply)
+
+	for _, h := range signedHeaders {
+		field(msg.Header[h])
+	}
+
+	field(string(msg.Data))
+
+	return payload
+}
+
+// sign adds the key and signature headers of id to msg. It's called once
+// msg has its reply subject and the rest of its headers.
+func (id *Identity) sign(msg *Msg) {
+	id.signAt(msg, time.Now())
+}
+
+func (id *Identity) signAt(msg *Msg, t time.Time) {
+	if msg.Header == nil {
+		msg.Header = map[string]string{}
+	}
+
+	var nonce [8]byte
+	rand.Read(nonce[:])
+
+	msg.Header[keyHeader] = id.Key()
+	msg.Header[timeHeader] = strconv.FormatInt(t.UnixNano(), 10)
+	msg.Header[nonceHeader] = base58.Encode(nonce[:])
+	msg.Header[sigHeader] = base58.Encode(ed25519.Sign(id.priv, signedPayload(msg)))
+}
+
+// verifyMsg returns the key msg is signed with, or "" if it isn't signed.
+// It returns an error if the signature doesn't match or msg was signed
+// outside of the signature window.
+func verifyMsg(msg *Msg) (string, error) {
+	key := msg.Header[keyHeader]
+	if key == "" {
+		return "", nil
+	}
+
+	pub, err := base58.Decode(key)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return "", ErrBadSignature
+	}
+
+	sig, err := base58.Decode(msg.Header[sigHeader])
+	if err != nil {
+		return "", ErrBadSignature
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(pub), signedPayload(msg), sig) {
+		return "", ErrBadSignature
+	}
+
+	ts, err := strconv.ParseInt(msg.Header[timeHeader], 10, 64)
+	if err != nil {
+		return "", ErrBadSignature
+	}
+
+	if age := time.Since(time.Unix(0, ts)); age > signatureWindow || age < -signatureWindow {
+		return "", ErrStaleSignature
+	}
+
+	return key, nil
+}
+
+// verify is verifyMsg that also rejects the messages the connection has
+// already received within the signature window.
+func (b *BusConnection) verify(msg *Msg) (string, error) {
+	key, err := verifyMsg(msg)
+	if err != nil || key == "" {
+		return key, err
+	}
+
+	now := time.Now()
+
+	b.replayMu.Lock()
+	defer b.replayMu.Unlock()
+
+	if now.Sub(b.replayPruned) > signatureWindow {
+		for sig, expires := range b.replays {
+			if now.After(expires) {
+				delete(b.replays, sig)
+			}
+		}
+
+		b.replayPruned = now
+	}
+
+	sig := msg.Header[sigHeader]
+
+	if _, ok := b.replays[sig]; ok {
+		return "", ErrReplayedSignature
+	}
+
+	b.replays[sig] = now.Add(2 * signatureWindow)
+
+	return key, nil
+}
+
+// Identity returns the identity the connection signs with.
+func (b *BusConnection) Identity() *Identity {
+	return b.ident
+}
+
+// SetIdentity changes the identity the connection signs with.
+func (b *BusConnection) SetIdentity(id *Identity) {
+	b.ident = id
+}
+
+// Trust limits the adverts the connection accepts to the ones signed by
+// the given keys. Until it's called, adverts signed by any key are
+// accepted.
+func (b *BusConnection) Trust(keys ...string) {
+	b.advertMu.Lock()
+	defer b.advertMu.Unlock()
+
+	if b.trusted == nil {
+		b.trusted = map[string]struct{}{}
+	}
+
+	for _, k := range keys {
+		b.trusted[k] = struct{}{}
+	}
+
+	for k, bc := range b.seen {
+		if _, ok := b.trusted[bc.key]; !ok && bc.key != b.ident.Key() {
+			delete(b.seen, k)
+		}
+	}
+}
+
+// trusts reports whether adverts signed by key are accepted. It's called
+// holding advertMu.
+func (b *BusConnection) trusts(key string) bool {
+	if b.trusted == nil {
+		return true
+	}
+
+	_, ok := b.trusted[key]
+	return ok
+}
+
+// Authorize restricts the callers of endpoint to the ones listed in the
+// AllowTag of tags. When tags has no AllowTag, anybody can call endpoint.
+func (b *BusConnection) Authorize(endpoint string, tags map[string][]string) {
+	b.aclMu.Lock()
+	defer b.aclMu.Unlock()
+
+	allow, ok := tags[AllowTag]
+	if !ok {
+		delete(b.acls, endpoint)
+		return
+	}
+
+	b.acls[endpoint] = slices.Clone(allow)
+}
+
+// authorized reports whether caller may call endpoint.
+func (b *BusConnection) authorized(endpoint, caller string) bool {
+	b.aclMu.Lock()
+	defer b.aclMu.Unlock()
+
+	allow, ok := b.acls[endpoint]
+	if !ok {
+		return true
+	}
+
+	if caller == "" {
+		return false
+	}
+
+	return slices.Contains(allow, caller) || slices.Contains(allow, AnyCaller)
+}
+
+const unauthorizedCategory = "Unauthorized"
+
+// UnauthorizedError is returned when calling an endpoint the caller isn't
+// allowed to call.
+type UnauthorizedError struct {
+	Caller   string
+	Endpoint string
+}
+
+var (
+	_ core.HasCategory = (*UnauthorizedError)(nil)
+	_ core.ErrorData   = (*UnauthorizedError)(nil)
+)
+
+func (e *UnauthorizedError) Error() string {
+	if e.Caller == "" {
+		return fmt.Sprintf("unsigned callers are not allowed to call %s", e.Endpoint)
+	}
+
+	return fmt.Sprintf("caller %s is not allowed to call %s", e.Caller, e.Endpoint)
+}
+
+func (e *UnauthorizedError) Category() string {
+	return unauthorizedCategory
+}
+
+func (e *UnauthorizedError) ErrorData() core.Map {
+	m, err := core.NewArrayMap(
+		core.MakeKeyword("caller"), core.MakeString(e.Caller),
+		core.MakeKeyword("endpoint"), core.MakeString(e.Endpoint),
+	)
+	if err != nil {
+		return nil
+	}
+
+	return m.(core.Map)
+}
+
+// unauthorizedFrom rebuilds the UnauthorizedError sent by a handler from
+// its data.
+func unauthorizedFrom(data core.Map) *UnauthorizedError {
+	e := &UnauthorizedError{}
+
+	if data == nil {
+		return e
+	}
+
+	if ok, v := data.GetEqu(core.MakeKeyword("caller")); ok {
+		e.Caller = core.SimpleToString(v)
+	}
+
+	if ok, v := data.GetEqu(core.MakeKeyword("endpoint")); ok {
+		e.Endpoint = core.SimpleToString(v)
+	}
+
+	return e
+}
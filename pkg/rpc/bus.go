@@ -2,21 +2,36 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/lab47/lablog/logger"
 	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
 	"github.com/nats-io/nats-server/v2/server"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
 )
 
+// InitBusConnection returns the connection of env to the bus, connecting
+// to the one at lace.rpc/url or LACE_RPC_URL, or starting one in process,
+// the first time it's called.
 func InitBusConnection(env *core.Env) (*BusConnection, error) {
+	return ConnectBus(env, "")
+}
+
+// ConnectBus is InitBusConnection, connecting to the bus at url unless
+// it's empty.
+func ConnectBus(env *core.Env, url string) (*BusConnection, error) {
 	logNs, err := env.InitNamespace(core.MakeSymbol("lace.log"))
 	if err != nil {
 		return nil, err
@@ -44,10 +59,8 @@ func InitBusConnection(env *core.Env) (*BusConnection, error) {
 		}
 	}
 
-	var url string
-
 	urlVr := ns.Resolve("url")
-	if urlVr != nil {
+	if url == "" && urlVr != nil {
 		core.Cast(env, urlVr.GetStatic(), &url)
 	}
 
@@ -55,63 +68,159 @@ func InitBusConnection(env *core.Env) (*BusConnection, error) {
 		url = os.Getenv("LACE_RPC_URL")
 	}
 
-	if url == "" {
-		bus, err := StartBus(log, env)
+	ctx := env.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var store string
+
+	storeVr := ns.Resolve("store")
+	if storeVr != nil {
+		core.Cast(env, storeVr.GetStatic(), &store)
+	}
+
+	if store == "" {
+		store = os.Getenv("LACE_RPC_STORE")
+	}
+
+	if store == "" {
+		store = defaultStoreDir()
+	}
+
+	var format string
+
+	if formatVr := ns.Resolve("format"); formatVr != nil {
+		switch sv := formatVr.GetStatic().(type) {
+		case core.Keyword:
+			format = sv.Name()
+		case core.String:
+			format = sv.S()
+		}
+	}
+
+	if format == "" {
+		format = os.Getenv("LACE_RPC_FORMAT")
+	}
+
+	f, err := marshal.ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	var t Transport
+
+	switch {
+	case url == "":
+		// Without a bus to connect to, the connections in the process share
+		// an embedded NATS server, with its queues kept in store.
+		bus, err := StartBusWith(log, env, BusOptions{StoreDir: store})
 		if err != nil {
 			return nil, err
 		}
 
 		ns.InternVar(env, "*bus*", bus, nil)
 
-		conn, err := bus.Connect(env.Context)
+		t, err = bus.Transport()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		t, err = Dial(ctx, url)
 		if err != nil {
 			return nil, err
 		}
-
-		ns.InternVar(env, "*connection*", conn, nil)
-		return conn, nil
 	}
 
-	nc, err := nats.Connect(url)
+	bc, err := NewBusConnection(ctx, env, log, t)
 	if err != nil {
 		return nil, err
 	}
 
-	bc := &BusConnection{
-		env: env,
-		log: log,
-		c:   nc,
-
-		caps: make(map[string]*busCap),
-		seen: make(map[string]*busCap),
-	}
+	bc.SetFormat(f)
 
 	ns.InternVar(env, "*connection*", bc, nil)
 	return bc, nil
 }
 
+// defaultStoreDir is where the bus started in process keeps its queues
+// when no store is configured, so that they survive restarts.
+func defaultStoreDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".laced", "bus")
+}
+
 type Bus struct {
 	env *core.Env
 	log logger.Logger
 	s   *server.Server
+
+	// tmpDir is the JetStream store created for the bus, removed when it's
+	// closed.
+	tmpDir string
+}
+
+// BusOptions configures the bus started by StartBusWith.
+type BusOptions struct {
+	// StoreDir is where JetStream keeps the queues of the bus, so that they
+	// survive restarts. When empty, a temporary directory is used.
+	StoreDir string
 }
 
 func StartBus(log logger.Logger, env *core.Env) (*Bus, error) {
+	return StartBusWith(log, env, BusOptions{})
+}
+
+// StartBusWith starts an embedded NATS server with JetStream enabled.
+func StartBusWith(log logger.Logger, env *core.Env, bo BusOptions) (*Bus, error) {
+	var tmpDir string
+
+	storeDir := bo.StoreDir
+	if storeDir == "" {
+		dir, err := os.MkdirTemp("", "lace-bus")
+		if err != nil {
+			return nil, err
+		}
+
+		storeDir = dir
+		tmpDir = dir
+	}
+
 	var opts server.Options
 	opts.DontListen = true
+	opts.JetStream = true
+	opts.StoreDir = storeDir
 
-	s := server.New(&opts)
+	s, err := server.NewServer(&opts)
+	if err != nil {
+		if tmpDir != "" {
+			os.RemoveAll(tmpDir)
+		}
+
+		return nil, err
+	}
 
 	go s.Start()
 
 	if !s.ReadyForConnections(10 * time.Second) {
+		s.Shutdown()
+
+		if tmpDir != "" {
+			os.RemoveAll(tmpDir)
+		}
+
 		return nil, fmt.Errorf("bus unable to start")
 	}
 
 	b := &Bus{
-		env: env,
-		log: log,
-		s:   s,
+		env:    env,
+		log:    log,
+		s:      s,
+		tmpDir: tmpDir,
 	}
 
 	return b, nil
@@ -120,6 +229,10 @@ func StartBus(log logger.Logger, env *core.Env) (*Bus, error) {
 func (b *Bus) Close() {
 	b.s.Shutdown()
 	b.s.WaitForShutdown()
+
+	if b.tmpDir != "" {
+		os.RemoveAll(b.tmpDir)
+	}
 }
 
 func (b *Bus) ServeUnix(path string) error {
@@ -158,35 +271,117 @@ func (b *Bus) serve(c net.Conn) {
 type busCap struct {
 	Capabilities
 	lastTime time.Time
+
+	// key is the key the advert was signed with.
+	key string
+}
+
+// seenKey identifies an advert by the capabilities and who advertised them,
+// since identical capabilities can be advertised by several connections.
+func seenKey(id, key string) string {
+	return id + "/" + key
 }
 
 type BusConnection struct {
 	env *core.Env
 	log logger.Logger
-	c   *nats.Conn
+	c   Transport
+
+	// id identifies the connection as the owner of the objects it exports.
+	id string
+
+	// ident signs the adverts and requests of the connection.
+	ident *Identity
+
+	// format is what the requests of the connection are marshaled with.
+	format marshal.Format
 
 	advertMu sync.Mutex
 	caps     map[string]*busCap
 	seen     map[string]*busCap
+	trusted  map[string]struct{}
+
+	aclMu sync.Mutex
+	acls  map[string][]string
+
+	// replays holds the signatures received recently, until they expire.
+	replayMu     sync.Mutex
+	replays      map[string]time.Time
+	replayPruned time.Time
+
+	// js and the queue streams known to exist are set up on first use.
+	jsMu    sync.Mutex
+	js      jetstream.JetStream
+	streams map[string]struct{}
+
+	refMu    sync.Mutex
+	exports  map[string]*export
+	exported map[any]string
+	imports  map[string]*imported
 }
 
-func (b *Bus) Connect(ctx context.Context) (*BusConnection, error) {
+func newBusConnection(env *core.Env, log logger.Logger, c Transport) *BusConnection {
+	return &BusConnection{
+		env: env,
+		log: log,
+		c:   c,
+		id:  ulid.Make().String(),
+
+		caps: make(map[string]*busCap),
+		seen: make(map[string]*busCap),
+		acls: make(map[string][]string),
+
+		replays: make(map[string]time.Time),
+
+		streams: make(map[string]struct{}),
+
+		exports:  make(map[string]*export),
+		exported: make(map[any]string),
+		imports:  make(map[string]*imported),
+	}
+}
+
+// Transport returns a transport connected to the bus in process.
+func (b *Bus) Transport() (Transport, error) {
 	c, err := nats.Connect("", nats.InProcessServer(b.s))
 	if err != nil {
 		return nil, err
 	}
 
-	bc := &BusConnection{
-		c:    c,
-		log:  b.log,
-		env:  b.env,
-		caps: make(map[string]*busCap),
-		seen: make(map[string]*busCap),
+	return NewNATSTransport(c), nil
+}
+
+func (b *Bus) Connect(ctx context.Context) (*BusConnection, error) {
+	t, err := b.Transport()
+	if err != nil {
+		return nil, err
 	}
 
+	return NewBusConnection(ctx, b.env, b.log, t)
+}
+
+// NewBusConnection starts a connection to the bus that t is connected to.
+// It runs until ctx is done, or until it's closed.
+func NewBusConnection(ctx context.Context, env *core.Env, log logger.Logger, t Transport) (*BusConnection, error) {
+	bc := newBusConnection(env, log, t)
+
+	ident, err := ProcessIdentity()
+	if err != nil {
+		t.Close()
+		return nil, err
+	}
+
+	bc.ident = ident
+
 	err = bc.watchCaps(ctx)
 	if err != nil {
-		c.Close()
+		t.Close()
+		return nil, err
+	}
+
+	err = bc.serveRefs(ctx)
+	if err != nil {
+		t.Close()
 		return nil, err
 	}
 
@@ -195,6 +390,48 @@ func (b *Bus) Connect(ctx context.Context) (*BusConnection, error) {
 	return bc, nil
 }
 
+// Close disconnects from the bus.
+func (b *BusConnection) Close() error {
+	return b.c.Close()
+}
+
+// FormatTag is the capabilities tag listing the formats an endpoint accepts
+// requests in, such as a service that isn't written in lace and only reads
+// JSON. Endpoints without it accept every format, as lace handlers respond
+// in the format of each request.
+const FormatTag = "format"
+
+// formatFor returns the format to send requests to endpoint in. That's the
+// format of the connection, unless the adverts for endpoint list the
+// formats it accepts without it.
+func (b *BusConnection) formatFor(endpoint string) marshal.Format {
+	b.advertMu.Lock()
+	defer b.advertMu.Unlock()
+
+	var accepted []string
+
+	for _, bc := range b.seen {
+		if bc.Endpoint != endpoint && bc.Endpoint+"."+bc.Method != endpoint {
+			continue
+		}
+
+		formats, ok := bc.Tags[FormatTag]
+		if !ok || slices.Contains(formats, b.format.String()) {
+			return b.format
+		}
+
+		accepted = append(accepted, formats...)
+	}
+
+	for _, name := range accepted {
+		if f, err := marshal.ParseFormat(name); err == nil {
+			return f
+		}
+	}
+
+	return b.format
+}
+
 func (b *BusConnection) BrowseCapabilities() []*Capabilities {
 	b.advertMu.Lock()
 	defer b.advertMu.Unlock()
@@ -209,21 +446,39 @@ func (b *BusConnection) BrowseCapabilities() []*Capabilities {
 }
 
 func (b *BusConnection) watchCaps(ctx context.Context) error {
-	ch := make(chan *nats.Msg)
-	sub, err := b.c.ChanSubscribe("lace.capabilities", ch)
+	ch := make(chan *Msg)
+	sub, err := b.c.Subscribe("lace.capabilities", "", ch)
+	if err != nil {
+		return err
+	}
+
+	// Withdrawals are received on the same channel so that they're
+	// processed in order with the adverts.
+	wsub, err := b.c.Subscribe(withdrawSubject, "", ch)
 	if err != nil {
+		sub.Unsubscribe()
 		return err
 	}
 
 	go func() {
 		defer sub.Unsubscribe()
+		defer wsub.Unsubscribe()
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case msg := <-ch:
-				b.processAdvert(msg.Data)
+				// The connection already knows about its own adverts.
+				if msg.Header[connHeader] == b.id {
+					continue
+				}
+
+				if msg.Subject == withdrawSubject {
+					b.processWithdraw(msg)
+				} else {
+					b.processAdvert(msg)
+				}
 			}
 		}
 	}()
@@ -231,10 +486,18 @@ func (b *BusConnection) watchCaps(ctx context.Context) error {
 	return nil
 }
 
-func (b *BusConnection) processAdvert(data []byte) {
+// processAdvert records the capabilities advertised in msg, if it's signed
+// by a trusted key.
+func (b *BusConnection) processAdvert(msg *Msg) {
+	key, err := b.verify(msg)
+	if err != nil || key == "" {
+		b.log.Warn("dropping unsigned advert", "error", err)
+		return
+	}
+
 	var cp Capabilities
 
-	err := cbor.Unmarshal(data, &cp)
+	err = cbor.Unmarshal(msg.Data, &cp)
 	if err != nil {
 		b.log.Error("error decoding advert", "error", err)
 		return
@@ -249,19 +512,103 @@ func (b *BusConnection) processAdvert(data []byte) {
 	b.advertMu.Lock()
 	defer b.advertMu.Unlock()
 
-	b.seen[id] = &busCap{
+	if !b.trusts(key) {
+		b.log.Warn("dropping advert from untrusted key", "key", key)
+		return
+	}
+
+	b.seen[seenKey(id, key)] = &busCap{
 		lastTime:     time.Now(),
 		Capabilities: cp,
+		key:          key,
 	}
 }
 
+// processWithdraw forgets capabilities that their advertiser has cleared.
+// Only the advertiser can withdraw them.
+func (b *BusConnection) processWithdraw(msg *Msg) {
+	key, err := b.verify(msg)
+	if err != nil || key == "" {
+		b.log.Warn("dropping unsigned withdrawal", "error", err)
+		return
+	}
+
+	var id string
+
+	err = cbor.Unmarshal(msg.Data, &id)
+	if err != nil {
+		b.log.Error("error decoding withdrawn capabilities", "error", err)
+		return
+	}
+
+	b.advertMu.Lock()
+	defer b.advertMu.Unlock()
+
+	delete(b.seen, seenKey(id, key))
+}
+
 var (
 	broadcastThresh = time.Minute
 	expireThresh    = 5 * time.Minute
 )
 
+// withdrawSubject is where the ids of capabilities that are no longer
+// advertised are published.
+const withdrawSubject = "lace.capabilities.withdraw"
+
+// connHeader carries the id of the connection that published an advert or
+// sent a request.
+const connHeader = "Lace-Connection"
+
+// formatHeader names the format a request is marshaled with, which its
+// handler responds with too. It's left out for CBOR, so that messages
+// without it are understood as before formats were negotiated.
+const formatHeader = "Lace-Format"
+
+// msgFormat returns the format msg is marshaled with.
+func msgFormat(msg *Msg) (marshal.Format, error) {
+	return marshal.ParseFormat(msg.Header[formatHeader])
+}
+
+// withFormat returns msg marked as marshaled with f.
+func withFormat(msg *Msg, f marshal.Format) *Msg {
+	if f == "" || f == marshal.CBOR {
+		return msg
+	}
+
+	if msg.Header == nil {
+		msg.Header = map[string]string{}
+	}
+
+	msg.Header[formatHeader] = string(f)
+
+	return msg
+}
+
+// respondAs publishes data as the reply to msg, marked as marshaled with f.
+func respondAs(t Transport, msg *Msg, f marshal.Format, data []byte) error {
+	if msg.Reply == "" {
+		return fmt.Errorf("message on %s has no reply subject", msg.Subject)
+	}
+
+	return t.Publish(withFormat(&Msg{Subject: msg.Reply, Data: data}, f))
+}
+
+// Format returns what the requests of the connection are marshaled with.
+func (b *BusConnection) Format() marshal.Format {
+	return b.format
+}
+
+// SetFormat changes what the requests of the connection are marshaled
+// with. Handlers respond in the format of each request, so a connection
+// using JSON can call services that aren't written in lace.
+func (b *BusConnection) SetFormat(f marshal.Format) {
+	b.format = f
+}
+
 func (b *BusConnection) broadcastCaps(ctx context.Context) {
-	t := time.NewTimer(5 * time.Second)
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
 
 	for {
 		select {
@@ -279,7 +626,7 @@ func (b *BusConnection) broadcastCaps(ctx context.Context) {
 				}
 			}
 
-			go b.broadcastAdverts(toBroadcast)
+			b.broadcastAdverts(toBroadcast)
 
 			var toDelete []string
 			for id, bc := range b.seen {
@@ -297,6 +644,22 @@ func (b *BusConnection) broadcastCaps(ctx context.Context) {
 	}
 }
 
+// publishCaps publishes an advert or withdrawal, marked as coming from this
+// connection.
+func (b *BusConnection) publishCaps(subject string, data []byte) error {
+	msg := &Msg{
+		Subject: subject,
+		Header:  map[string]string{connHeader: b.id},
+		Data:    data,
+	}
+
+	b.ident.sign(msg)
+
+	return b.c.Publish(msg)
+}
+
+// broadcastAdverts publishes capabilities to the bus. It's called holding
+// advertMu, so that adverts are ordered with the withdrawals of Clear.
 func (b *BusConnection) broadcastAdverts(toBroadcast []*busCap) {
 	for _, c := range toBroadcast {
 		data, err := cbor.Marshal(c.Capabilities)
@@ -304,35 +667,108 @@ func (b *BusConnection) broadcastAdverts(toBroadcast []*busCap) {
 			b.log.Error("error marshaling capabilities", "error", err)
 			continue
 		}
-		err = b.c.Publish("lace.capabilities", data)
+		err = b.publishCaps("lace.capabilities", data)
 		if err != nil {
 			b.log.Error("error publish capabilitie", "error", err)
 		}
 	}
 }
 
-func (b *BusConnection) Exchange(req *Request) (*Response, error) {
+// defaultTimeout is how long Exchange waits for a response when ctx has no
+// deadline.
+var defaultTimeout = 60 * time.Second
+
+// cancelSubject returns the subject a caller publishes to when it cancels
+// the request with the given call id.
+func cancelSubject(callId string) string {
+	return "lace.cancel." + callId
+}
+
+// callHeader carries the call id of a request, so that the handler can
+// watch for it being canceled before the request is decoded.
+const callHeader = "Lace-Call"
+
+// callMsg returns the message sending req, marshaled as data.
+func callMsg(req *Request, data []byte) *Msg {
+	msg := withFormat(&Msg{Subject: req.Endpoint, Data: data}, req.Format)
+
+	if req.CallId != "" {
+		if msg.Header == nil {
+			msg.Header = map[string]string{}
+		}
+
+		msg.Header[callHeader] = req.CallId
+	}
+
+	return msg
+}
+
+// Exchange sends req and waits for the response. The deadline of ctx is
+// sent along with the request, and cancelling ctx cancels the context of
+// the handler. Errors reported by the handler are returned as a
+// *RemoteError.
+func (b *BusConnection) Exchange(ctx context.Context, req *Request) (*Response, error) {
 	if req.Arguments == nil {
 		req.Arguments = core.NIL
 	}
 
-	data, err := req.Marshal()
+	if req.Format == "" {
+		req.Format = b.formatFor(req.Endpoint)
+	}
+
+	if req.NoResponse {
+		data, err := req.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, b.c.Publish(b.signed(withFormat(&Msg{Subject: req.Endpoint, Data: data}, req.Format)))
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	req.Deadline, _ = ctx.Deadline()
+	req.CallId = ulid.Make().String()
+
+	data, err := req.Marshal(b)
 	if err != nil {
 		return nil, err
 	}
 
-	if req.NoResponse {
-		return nil, b.c.Publish(req.Endpoint, data)
+	msg, err := b.signedRequest(ctx, callMsg(req, data))
+	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			if perr := b.c.Publish(&Msg{Subject: cancelSubject(req.CallId)}); perr != nil {
+				b.log.Error("error publishing cancel", "error", perr)
+			}
+		}
+
+		return nil, err
+	}
+
+	r, err := b.unmarshalResponse(msg)
+	if err != nil {
+		return nil, err
 	}
 
-	msg, err := b.c.Request(req.Endpoint, data, 60*time.Second)
+	return r, nil
+}
+
+// unmarshalResponse decodes the response in msg, in the format it's marked
+// with.
+func (b *BusConnection) unmarshalResponse(msg *Msg) (*Response, error) {
+	f, err := msgFormat(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	var r Response
+	r := Response{Format: f}
 
-	err = r.Unmarshal(b.env, msg.Data)
+	err = r.Unmarshal(b.env, msg.Data, b)
 	if err != nil {
 		return nil, err
 	}
@@ -340,69 +776,318 @@ func (b *BusConnection) Exchange(req *Request) (*Response, error) {
 	return &r, nil
 }
 
+// signed returns msg signed with the identity of the connection, so that
+// the handler knows who the caller is.
+func (b *BusConnection) signed(msg *Msg) *Msg {
+	if msg.Header == nil {
+		msg.Header = map[string]string{}
+	}
+
+	msg.Header[connHeader] = b.id
+
+	b.ident.sign(msg)
+	return msg
+}
+
+// signedRequest sends msg signed as a request and waits for the reply. The
+// inbox for the reply is picked before signing, so that the signature
+// covers where the reply goes.
+func (b *BusConnection) signedRequest(ctx context.Context, msg *Msg) (*Msg, error) {
+	msg.Reply = b.c.NewInbox()
+	return b.c.Request(ctx, b.signed(msg))
+}
+
+// watchCancel returns a context that's done once the caller cancels the
+// call with the given id. Handlers call it as soon as they receive a
+// request, before decoding it, so that cancels sent meanwhile aren't
+// missed.
+func (b *BusConnection) watchCancel(callId string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if callId == "" {
+		return ctx, cancel
+	}
+
+	sub, err := subscribeFunc(b.c, cancelSubject(callId), func(*Msg) {
+		cancel()
+	})
+	if err != nil {
+		b.log.Error("error watching for request cancelation", "error", err)
+		return ctx, cancel
+	}
+
+	return ctx, func() {
+		sub.Unsubscribe()
+		cancel()
+	}
+}
+
+// requestContext returns the context to handle req in, received in msg,
+// from the context returned by watchCancel. It's done once the caller's
+// deadline passes or the caller cancels the request, or when the returned
+// cancel function is called.
+func (b *BusConnection) requestContext(ctx context.Context, cancel context.CancelFunc, msg *Msg, req *Request) (context.Context, context.CancelFunc) {
+	// Callers that don't send the call id in a header are only watched
+	// once the request is decoded.
+	if msg.Header[callHeader] == "" && req.CallId != "" {
+		cancel()
+		ctx, cancel = b.watchCancel(req.CallId)
+	}
+
+	if req.Deadline.IsZero() {
+		return ctx, cancel
+	}
+
+	dctx, dcancel := context.WithDeadline(ctx, req.Deadline)
+
+	return dctx, func() {
+		dcancel()
+		cancel()
+	}
+}
+
 type BusListener struct {
-	b   *BusConnection
-	sub *nats.Subscription
-	ch  chan *nats.Msg
+	b        *BusConnection
+	endpoint string
+	sub      Subscription
+	ch       chan *Msg
+
+	// allowCode lets the arguments of requests contain shipped code, which
+	// is only accepted by nodes.
+	allowCode bool
 }
 
 func (b *BusConnection) Listen(endpoint string) (Listener, error) {
-	ch := make(chan *nats.Msg, 1)
-	sub, err := b.c.ChanQueueSubscribe(endpoint, endpoint, ch)
+	return b.listen(endpoint)
+}
+
+func (b *BusConnection) listen(endpoint string) (*BusListener, error) {
+	ch := make(chan *Msg, 1)
+	sub, err := b.c.Subscribe(endpoint, endpoint, ch)
 	if err != nil {
 		return nil, err
 	}
 
 	l := &BusListener{
-		b:   b,
-		sub: sub,
-		ch:  ch,
+		b:        b,
+		endpoint: endpoint,
+		sub:      sub,
+		ch:       ch,
 	}
 
 	return l, nil
 }
 
+func (b *BusListener) Close() error {
+	return b.sub.Unsubscribe()
+}
+
 type BusRPC struct {
-	msg *nats.Msg
-	req *Request
+	b      *BusConnection
+	msg    *Msg
+	req    *Request
+	ctx    context.Context
+	cancel context.CancelFunc
+	stream *serverStream
 }
 
+// Accept waits for the next request to the endpoint. Requests from callers
+// the endpoint isn't authorized for are rejected with an UnauthorizedError
+// rather than returned.
 func (b *BusListener) Accept(ctx context.Context) (RPC, error) {
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case msg := <-b.ch:
-		var req Request
+	for {
+		var msg *Msg
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case msg = <-b.ch:
+		}
 
-		err := req.Unmarshal(b.b.env, msg.Data)
+		wctx, wcancel := b.b.watchCancel(msg.Header[callHeader])
+
+		f, err := msgFormat(msg)
 		if err != nil {
-			return nil, err
+			wcancel()
+			b.fail(msg, marshal.CBOR, err)
+			continue
+		}
+
+		// The request is only decoded once the caller is known to be
+		// allowed, so that others can't get foreign refs imported or code
+		// rebuilt.
+		caller, err := b.b.verify(msg)
+		if err != nil {
+			wcancel()
+			b.fail(msg, f, err)
+			continue
+		}
+
+		if !b.b.authorized(b.endpoint, caller) {
+			wcancel()
+			b.reject(msg, f, caller)
+			continue
+		}
+
+		req := Request{Format: f}
+
+		var imp marshal.Importer = b.b
+		if b.allowCode {
+			imp = marshal.AllowCode(imp)
+		}
+
+		err = req.Unmarshal(b.b.env, msg.Data, imp)
+		if err != nil {
+			wcancel()
+
+			// The caller is told why, such as the request carrying code
+			// compiled by another version of lace, rather than left to
+			// time out.
+			b.fail(msg, f, err)
+			continue
 		}
 
 		req.RequestId = msg.Reply
+		req.Caller = caller
+
+		rctx, cancel := b.b.requestContext(wctx, wcancel, msg, &req)
+
+		rpc := &BusRPC{
+			b:      b.b,
+			msg:    msg,
+			req:    &req,
+			ctx:    rctx,
+			cancel: cancel,
+		}
+
+		if req.StreamTo != "" {
+			err := rpc.acceptStream()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+		}
+
+		return rpc, nil
+	}
+}
+
+func (b *BusListener) reject(msg *Msg, f marshal.Format, caller string) {
+	b.fail(msg, f, &UnauthorizedError{
+		Caller:   caller,
+		Endpoint: b.endpoint,
+	})
+}
+
+func (b *BusListener) fail(msg *Msg, f marshal.Format, rerr error) {
+	b.b.fail(msg, f, rerr)
+}
 
-		return &BusRPC{
-			msg: msg,
-			req: &req,
-		}, nil
+// fail responds to msg with err marshaled with f, unless its caller
+// doesn't wait for a response.
+func (b *BusConnection) fail(msg *Msg, f marshal.Format, rerr error) {
+	if msg.Reply == "" {
+		return
+	}
+
+	req := Request{RequestId: msg.Reply, Format: f}
+
+	data, err := req.MarshalError(b.env, rerr, b)
+	if err != nil {
+		b.log.Error("error marshaling failure", "error", err)
+		return
+	}
+
+	if err := respondAs(b.c, msg, f, data); err != nil {
+		b.log.Error("error responding with failure", "error", err)
+	}
+}
+
+// acceptStream sets up the handler's side of a streaming call and tells
+// the caller it's ready.
+func (r *BusRPC) acceptStream() error {
+	stream, err := r.b.acceptStream(r.ctx, r.req)
+	if err != nil {
+		return err
+	}
+
+	r.stream = stream
+
+	data, err := r.req.MarshalResponse(core.NIL, nil)
+	if err != nil {
+		stream.close()
+		return err
+	}
+
+	err = respondAs(r.b.c, r.msg, r.req.Format, data)
+	if err != nil {
+		stream.close()
+		return err
 	}
+
+	return nil
 }
 
 func (r *BusRPC) Request() *Request {
 	return r.req
 }
 
+func (r *BusRPC) Context() context.Context {
+	return r.ctx
+}
+
 func (r *BusRPC) Respond(val any) error {
+	defer r.cancel()
+
+	if r.stream != nil {
+		return r.stream.finish(nil)
+	}
+
+	if r.req.NoResponse {
+		return nil
+	}
+
+	data, err := r.req.MarshalResponse(val, r.b)
+	if err != nil {
+		return err
+	}
+
+	return respondAs(r.b.c, r.msg, r.req.Format, data)
+}
+
+func (r *BusRPC) RespondError(rerr error) error {
+	defer r.cancel()
+
+	if r.stream != nil {
+		return r.stream.finish(rerr)
+	}
+
 	if r.req.NoResponse {
 		return nil
 	}
 
-	data, err := r.req.MarshalResponse(val)
+	data, err := r.req.MarshalError(r.b.env, rerr, r.b)
 	if err != nil {
 		return err
 	}
 
-	return r.msg.Respond(data)
+	return respondAs(r.b.c, r.msg, r.req.Format, data)
+}
+
+func (r *BusRPC) Send(val any) error {
+	if r.stream == nil {
+		return ErrNotStreaming
+	}
+
+	return r.stream.send(val)
+}
+
+func (r *BusRPC) Recv() (any, error) {
+	if r.stream == nil {
+		return nil, ErrNotStreaming
+	}
+
+	return r.stream.recv()
 }
 
 func (c *BusConnection) Advertise(cp *Capabilities) (Advertisement, error) {
@@ -420,22 +1105,46 @@ func (c *BusConnection) Advertise(cp *Capabilities) (Advertisement, error) {
 
 	c.caps[id] = bc
 
-	go c.broadcastAdverts([]*busCap{bc})
+	// The connection skips its own broadcasts, so it sees its adverts here.
+	key := c.ident.Key()
+
+	c.seen[seenKey(id, key)] = &busCap{
+		lastTime:     time.Now(),
+		Capabilities: *cp,
+		key:          key,
+	}
+
+	c.broadcastAdverts([]*busCap{bc})
 
 	return &BusAdvert{
-		c:  c,
-		id: id,
+		c:   c,
+		id:  id,
+		key: key,
 	}, nil
 }
 
 type BusAdvert struct {
-	c  *BusConnection
-	id string
+	c   *BusConnection
+	id  string
+	key string
 }
 
+// Clear stops advertising the capabilities, and tells the other connections
+// on the bus to forget them.
 func (a *BusAdvert) Clear() {
 	a.c.advertMu.Lock()
 	defer a.c.advertMu.Unlock()
 
 	delete(a.c.caps, a.id)
+	delete(a.c.seen, seenKey(a.id, a.key))
+
+	data, err := cbor.Marshal(a.id)
+	if err != nil {
+		return
+	}
+
+	err = a.c.publishCaps(withdrawSubject, data)
+	if err != nil {
+		a.c.log.Error("error publishing withdrawn capabilities", "error", err)
+	}
 }
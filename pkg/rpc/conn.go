@@ -0,0 +1,306 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// The operations of the frames exchanged between a hub and the transports
+// connected to it over a stream.
+const (
+	opPub   = 1
+	opSub   = 2
+	opUnsub = 3
+	opMsg   = 4
+)
+
+// maxFrameSize bounds the frames read from a stream, so that a bad length
+// can't exhaust memory.
+var maxFrameSize = 64 << 20
+
+// frame is sent over a stream as a big endian uint32 length followed by
+// the CBOR encoded frame.
+type frame struct {
+	Op      int               `cbor:"1,keyasint"`
+	Subject string            `cbor:"2,keyasint,omitempty"`
+	Reply   string            `cbor:"3,keyasint,omitempty"`
+	Queue   string            `cbor:"4,keyasint,omitempty"`
+	Sid     uint64            `cbor:"5,keyasint,omitempty"`
+	Header  map[string]string `cbor:"6,keyasint,omitempty"`
+	Data    []byte            `cbor:"7,keyasint,omitempty"`
+}
+
+func writeFrame(w io.Writer, f *frame) error {
+	data, err := cbor.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+
+	_, err = w.Write(buf)
+	return err
+}
+
+func readFrame(r io.Reader) (*frame, error) {
+	var hdr [4]byte
+
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+
+	sz := binary.BigEndian.Uint32(hdr[:])
+	if int64(sz) > int64(maxFrameSize) {
+		return nil, fmt.Errorf("frame of %d bytes exceeds the maximum size", sz)
+	}
+
+	data := make([]byte, sz)
+
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	var f frame
+
+	if err := cbor.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+func (f *frame) msg() *Msg {
+	return &Msg{
+		Subject: f.Subject,
+		Reply:   f.Reply,
+		Header:  f.Header,
+		Data:    f.Data,
+	}
+}
+
+// ServeConn routes the frames sent over c until it's closed, delivering
+// the messages for the subscriptions made over it back to it.
+func (h *Hub) ServeConn(c io.ReadWriteCloser) error {
+	var wmu sync.Mutex
+
+	subs := map[uint64]*hubSub{}
+
+	defer func() {
+		c.Close()
+
+		for _, s := range subs {
+			s.Unsubscribe()
+		}
+	}()
+
+	br := bufio.NewReader(c)
+
+	for {
+		f, err := readFrame(br)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		switch f.Op {
+		case opPub:
+			h.publish(f.msg())
+		case opSub:
+			sid := f.Sid
+
+			q := newMsgQueue(func(msg *Msg, done <-chan struct{}) {
+				wmu.Lock()
+				defer wmu.Unlock()
+
+				// A failed write also fails the read loop, which cleans up.
+				writeFrame(c, &frame{
+					Op:      opMsg,
+					Sid:     sid,
+					Subject: msg.Subject,
+					Reply:   msg.Reply,
+					Header:  msg.Header,
+					Data:    msg.Data,
+				})
+			})
+
+			if old, ok := subs[sid]; ok {
+				old.Unsubscribe()
+			}
+
+			subs[sid] = h.subscribe(f.Subject, f.Queue, q)
+		case opUnsub:
+			if s, ok := subs[f.Sid]; ok {
+				s.Unsubscribe()
+				delete(subs, f.Sid)
+			}
+		default:
+			return fmt.Errorf("unknown frame op: %d", f.Op)
+		}
+	}
+}
+
+// connTransport is a transport connected to a hub over a stream.
+type connTransport struct {
+	c io.ReadWriteCloser
+
+	wmu sync.Mutex
+
+	mu     sync.Mutex
+	sid    uint64
+	subs   map[uint64]*msgQueue
+	closed bool
+}
+
+// NewConnTransport returns a transport that exchanges frames with a hub
+// over c.
+func NewConnTransport(c io.ReadWriteCloser) Transport {
+	t := &connTransport{
+		c:    c,
+		subs: make(map[uint64]*msgQueue),
+	}
+
+	go t.readLoop()
+
+	return t
+}
+
+// DialConn connects to a hub serving frames on the given network address.
+func DialConn(ctx context.Context, network, addr string) (Transport, error) {
+	var d net.Dialer
+
+	c, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConnTransport(c), nil
+}
+
+func (t *connTransport) readLoop() {
+	defer t.Close()
+
+	br := bufio.NewReader(t.c)
+
+	for {
+		f, err := readFrame(br)
+		if err != nil {
+			return
+		}
+
+		if f.Op != opMsg {
+			continue
+		}
+
+		t.mu.Lock()
+		q := t.subs[f.Sid]
+		t.mu.Unlock()
+
+		if q != nil {
+			q.push(f.msg())
+		}
+	}
+}
+
+func (t *connTransport) write(f *frame) error {
+	t.wmu.Lock()
+	defer t.wmu.Unlock()
+
+	return writeFrame(t.c, f)
+}
+
+func (t *connTransport) Publish(msg *Msg) error {
+	return t.write(&frame{
+		Op:      opPub,
+		Subject: msg.Subject,
+		Reply:   msg.Reply,
+		Header:  msg.Header,
+		Data:    msg.Data,
+	})
+}
+
+type connSub struct {
+	t   *connTransport
+	sid uint64
+}
+
+func (s *connSub) Unsubscribe() error {
+	s.t.mu.Lock()
+	q, ok := s.t.subs[s.sid]
+	delete(s.t.subs, s.sid)
+	s.t.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	q.stop()
+
+	return s.t.write(&frame{Op: opUnsub, Sid: s.sid})
+}
+
+func (t *connTransport) Subscribe(subject, queue string, ch chan<- *Msg) (Subscription, error) {
+	q := newChanQueue(ch)
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		q.stop()
+		return nil, net.ErrClosed
+	}
+
+	t.sid++
+	sid := t.sid
+	t.subs[sid] = q
+	t.mu.Unlock()
+
+	err := t.write(&frame{Op: opSub, Sid: sid, Subject: subject, Queue: queue})
+	if err != nil {
+		t.mu.Lock()
+		delete(t.subs, sid)
+		t.mu.Unlock()
+
+		q.stop()
+		return nil, err
+	}
+
+	return &connSub{t: t, sid: sid}, nil
+}
+
+func (t *connTransport) Request(ctx context.Context, msg *Msg) (*Msg, error) {
+	return request(ctx, t, msg)
+}
+
+func (t *connTransport) NewInbox() string {
+	return newInbox()
+}
+
+func (t *connTransport) Close() error {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil
+	}
+
+	t.closed = true
+	subs := t.subs
+	t.subs = make(map[uint64]*msgQueue)
+	t.mu.Unlock()
+
+	for _, q := range subs {
+		q.stop()
+	}
+
+	return t.c.Close()
+}
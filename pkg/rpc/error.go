@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"errors"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
+)
+
+type marshaledError struct {
+	Category string      `json:"category" cbor:"1,keyasint"`
+	Message  string      `json:"message" cbor:"2,keyasint"`
+	Data     marshal.Raw `json:"data,omitempty" cbor:"3,keyasint,omitempty"`
+}
+
+// marshalError captures err so it can be sent to the caller. The data is
+// the map ex-data looks in, so that it behaves the same on both sides.
+func marshalError(env *core.Env, err error, f marshal.Format, exp marshal.Exporter) *marshaledError {
+	me := &marshaledError{
+		Category: "Error",
+		Message:  err.Error(),
+	}
+
+	var hc core.HasCategory
+	if errors.As(err, &hc) {
+		me.Category = hc.Category()
+	}
+
+	var data core.Map
+
+	var (
+		ei *core.ExInfo
+		ed core.ErrorData
+	)
+
+	switch {
+	case errors.As(err, &ei):
+		me.Message = core.SimpleToString(ei.Message())
+
+		if ok, d := ei.GetEqu(core.MakeKeyword("data")); ok {
+			m, err := core.NewArrayMap(core.MakeKeyword("data"), d)
+			if err == nil {
+				data = m.(core.Map)
+			}
+		}
+	case errors.As(err, &ed):
+		data = ed.ErrorData()
+	}
+
+	if data != nil {
+		// Data that can't be marshaled is dropped rather than losing the
+		// error itself.
+		if d, err := f.Marshal(data, exp); err == nil {
+			me.Data = d
+		}
+	}
+
+	return me
+}
+
+func (me *marshaledError) unmarshal(env *core.Env, f marshal.Format, imp marshal.Importer) error {
+	re := &RemoteError{
+		cat: me.Category,
+		msg: me.Message,
+	}
+
+	if len(me.Data) > 0 {
+		d, err := f.Unmarshal(env, me.Data, imp)
+		if err != nil {
+			return err
+		}
+
+		if m, ok := d.(core.Map); ok {
+			re.data = m
+		}
+	}
+
+	if me.Category == unauthorizedCategory {
+		return unauthorizedFrom(re.data)
+	}
+
+	return re
+}
+
+// RemoteError is returned when the handler of a request failed. It carries
+// the category, message and data of the original error, so it's thrown
+// as the same lace error on the caller's side.
+type RemoteError struct {
+	cat  string
+	msg  string
+	data core.Map
+}
+
+var (
+	_ core.HasCategory = (*RemoteError)(nil)
+	_ core.ErrorData   = (*RemoteError)(nil)
+)
+
+func (e *RemoteError) Error() string {
+	return e.msg
+}
+
+func (e *RemoteError) Category() string {
+	return e.cat
+}
+
+func (e *RemoteError) ErrorData() core.Map {
+	return e.data
+}
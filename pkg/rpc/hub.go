@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+)
+
+// Hub routes messages between the connections on a bus without a NATS
+// server. Connections in the same process use its Transport directly,
+// others connect to it over TCP, unix sockets or WebSocket.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[string][]*hubSub
+
+	// next spreads the messages to a queue across its subscribers.
+	next map[string]int
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[string][]*hubSub),
+		next: make(map[string]int),
+	}
+}
+
+var (
+	memHubsMu sync.Mutex
+	memHubs   = map[string]*Hub{}
+)
+
+// MemHub returns the hub with the given name in this process, creating it
+// if need be.
+func MemHub(name string) *Hub {
+	memHubsMu.Lock()
+	defer memHubsMu.Unlock()
+
+	h, ok := memHubs[name]
+	if !ok {
+		h = NewHub()
+		memHubs[name] = h
+	}
+
+	return h
+}
+
+type hubSub struct {
+	h       *Hub
+	subject string
+	queue   string
+	q       *msgQueue
+}
+
+func (s *hubSub) Unsubscribe() error {
+	s.q.stop()
+
+	s.h.mu.Lock()
+	defer s.h.mu.Unlock()
+
+	subs := s.h.subs[s.subject]
+	for i, o := range subs {
+		if o == s {
+			subs = append(subs[:i:i], subs[i+1:]...)
+			break
+		}
+	}
+
+	if len(subs) == 0 {
+		delete(s.h.subs, s.subject)
+	} else {
+		s.h.subs[s.subject] = subs
+	}
+
+	return nil
+}
+
+func (h *Hub) subscribe(subject, queue string, q *msgQueue) *hubSub {
+	s := &hubSub{
+		h:       h,
+		subject: subject,
+		queue:   queue,
+		q:       q,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.subs[subject] = append(h.subs[subject], s)
+
+	return s
+}
+
+func (h *Hub) publish(msg *Msg) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var (
+		delivered bool
+		queues    map[string][]*hubSub
+	)
+
+	for _, s := range h.subs[msg.Subject] {
+		if s.queue == "" {
+			s.q.push(msg)
+			delivered = true
+			continue
+		}
+
+		if queues == nil {
+			queues = map[string][]*hubSub{}
+		}
+
+		queues[s.queue] = append(queues[s.queue], s)
+	}
+
+	for queue, subs := range queues {
+		key := msg.Subject + " " + queue
+
+		n := h.next[key]
+		h.next[key] = n + 1
+
+		subs[n%len(subs)].q.push(msg)
+		delivered = true
+	}
+
+	if !delivered && msg.Reply != "" {
+		reply := &Msg{
+			Subject: msg.Reply,
+			Header:  map[string]string{statusHeader: noResponders},
+		}
+
+		for _, s := range h.subs[msg.Reply] {
+			s.q.push(reply)
+		}
+	}
+}
+
+// Transport returns a transport that connects to the hub directly.
+func (h *Hub) Transport() Transport {
+	return &hubTransport{
+		h:    h,
+		subs: make(map[*hubSub]struct{}),
+	}
+}
+
+type hubTransport struct {
+	h *Hub
+
+	mu   sync.Mutex
+	subs map[*hubSub]struct{}
+}
+
+type hubTransportSub struct {
+	*hubSub
+	t *hubTransport
+}
+
+func (s hubTransportSub) Unsubscribe() error {
+	s.t.mu.Lock()
+	delete(s.t.subs, s.hubSub)
+	s.t.mu.Unlock()
+
+	return s.hubSub.Unsubscribe()
+}
+
+func (t *hubTransport) Publish(msg *Msg) error {
+	t.h.publish(msg)
+	return nil
+}
+
+func (t *hubTransport) Subscribe(subject, queue string, ch chan<- *Msg) (Subscription, error) {
+	s := t.h.subscribe(subject, queue, newChanQueue(ch))
+
+	t.mu.Lock()
+	t.subs[s] = struct{}{}
+	t.mu.Unlock()
+
+	return hubTransportSub{hubSub: s, t: t}, nil
+}
+
+func (t *hubTransport) Request(ctx context.Context, msg *Msg) (*Msg, error) {
+	return request(ctx, t, msg)
+}
+
+func (t *hubTransport) NewInbox() string {
+	return newInbox()
+}
+
+func (t *hubTransport) Close() error {
+	t.mu.Lock()
+	subs := t.subs
+	t.subs = make(map[*hubSub]struct{})
+	t.mu.Unlock()
+
+	for s := range subs {
+		s.Unsubscribe()
+	}
+
+	return nil
+}
+
+// Serve accepts connections from l and routes the frames sent over them,
+// until l is closed.
+func (h *Hub) Serve(l net.Listener) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		go h.ServeConn(c)
+	}
+}
@@ -0,0 +1,138 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
+)
+
+// NodeService is the service nodes advertise, with a node tag naming each.
+// The functions sent to a node run on it rather than being called back on
+// the sender, since they're marshaled as code.
+const (
+	NodeService = "lace.node"
+	nodeMethod  = "run"
+	nodeTag     = "node"
+)
+
+// NodeEndpoint returns the endpoint the named node receives code on.
+func NodeEndpoint(name string) string {
+	return NodeService + "." + name
+}
+
+// ServeNode runs the functions sent to the named node until ctx is done.
+// Each runs in a new child of the env of the connection, where the vars
+// its code references are resolved by name.
+//
+// Only callers signing with one of the keys in allow may send code, which
+// runs with the full access of the node. A nil allow lets anybody on the
+// bus send code, and is meant for trusted buses only.
+func (b *BusConnection) ServeNode(ctx context.Context, name string, allow []string) error {
+	tags := map[string][]string{nodeTag: {name}}
+	if allow != nil {
+		tags[AllowTag] = allow
+	}
+
+	endpoint := NodeEndpoint(name)
+
+	b.Authorize(endpoint, tags)
+	defer b.Authorize(endpoint, nil)
+
+	l, err := b.listen(endpoint)
+	if err != nil {
+		return err
+	}
+
+	defer l.Close()
+
+	l.allowCode = true
+
+	ad, err := b.Advertise(&Capabilities{
+		Endpoint: NodeService,
+		Method:   nodeMethod,
+		Tags:     tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer ad.Clear()
+
+	var inflight sync.WaitGroup
+
+	defer inflight.Wait()
+
+	for {
+		r, err := l.Accept(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		inflight.Add(1)
+
+		go func() {
+			defer inflight.Done()
+			b.runOnNode(r)
+		}()
+	}
+}
+
+func (b *BusConnection) runOnNode(r RPC) {
+	env := b.env.Child()
+
+	err := env.SetContext(r.Context())
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	args, err := core.ToSlice(env, r.Request().Arguments)
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	if len(args) == 0 {
+		r.RespondError(fmt.Errorf("no function sent to run"))
+		return
+	}
+
+	fn, ok := args[0].(*core.Fn)
+	if !ok {
+		r.RespondError(fmt.Errorf("expected a function to run, got %s", core.TypeName(args[0])))
+		return
+	}
+
+	val, err := fn.Call(env, args[1:])
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	r.Respond(val)
+}
+
+// RunOn calls fn with args on the named node, returning the result. The
+// code of fn is sent along with the values it captured, marshaled with the
+// rest of the arguments.
+func (b *BusConnection) RunOn(ctx context.Context, node string, fn *core.Fn, args []any) (any, error) {
+	req := &Request{
+		Endpoint:  NodeEndpoint(node),
+		Method:    nodeMethod,
+		Arguments: core.NewListFrom(append([]any{marshal.Ship(b.env, fn)}, args...)...),
+	}
+
+	resp, err := b.Exchange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
@@ -0,0 +1,263 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lab47/lace/pkg/marshal"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ErrNoJetStream is returned when using queues on a bus that isn't a NATS
+// server with JetStream enabled, such as a hub.
+var ErrNoJetStream = errors.New("durable queues need a bus with JetStream")
+
+// AckWait is how long a consumer has to ack a job before it's redelivered.
+var AckWait = 30 * time.Second
+
+// Each queue is kept in its own JetStream stream, which removes the jobs
+// once they're acked. The consumers of a queue share one durable consumer,
+// so that each job goes to only one of them and the position survives
+// restarts.
+const (
+	queueSubjectPrefix = "lace.queue."
+	queueStreamPrefix  = "LACE_Q_"
+	queueConsumer      = "lace"
+	topicSubjectPrefix = "lace.topic."
+)
+
+// checkQueueName rejects the names that can't be used in a stream name, so
+// that distinct queues never share a stream.
+func checkQueueName(name string) error {
+	if name == "" {
+		return fmt.Errorf("queue name can't be empty")
+	}
+
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return fmt.Errorf("invalid queue name %q: only letters, digits, - and _ are allowed", name)
+		}
+	}
+
+	return nil
+}
+
+func (b *BusConnection) jetStream() (jetstream.JetStream, error) {
+	b.jsMu.Lock()
+	defer b.jsMu.Unlock()
+
+	if b.js != nil {
+		return b.js, nil
+	}
+
+	nt, ok := b.c.(*natsTransport)
+	if !ok {
+		return nil, ErrNoJetStream
+	}
+
+	js, err := jetstream.New(nt.c)
+	if err != nil {
+		return nil, err
+	}
+
+	b.js = js
+
+	return js, nil
+}
+
+// queue creates the stream of the named queue if it doesn't exist yet.
+func (b *BusConnection) queue(ctx context.Context, name string) (jetstream.JetStream, error) {
+	if err := checkQueueName(name); err != nil {
+		return nil, err
+	}
+
+	js, err := b.jetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	b.jsMu.Lock()
+	defer b.jsMu.Unlock()
+
+	if _, ok := b.streams[name]; ok {
+		return js, nil
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      queueStreamPrefix + name,
+		Subjects:  []string{queueSubjectPrefix + name},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+	if err != nil {
+		if errors.Is(err, jetstream.ErrJetStreamNotEnabled) {
+			return nil, ErrNoJetStream
+		}
+
+		return nil, err
+	}
+
+	b.streams[name] = struct{}{}
+
+	return js, nil
+}
+
+// Enqueue adds val to the named queue, where it's kept until a consumer
+// acks it.
+func (b *BusConnection) Enqueue(ctx context.Context, name string, val any) error {
+	js, err := b.queue(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	data, err := marshal.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	_, err = js.Publish(ctx, queueSubjectPrefix+name, data)
+	return err
+}
+
+// Job is a value taken from a queue. It's redelivered unless it's acked
+// within AckWait.
+type Job struct {
+	Value any
+
+	// Attempt counts the deliveries of the job, starting at 1.
+	Attempt int
+
+	msg jetstream.Msg
+}
+
+// Ack removes the job from its queue.
+func (j *Job) Ack() error {
+	return j.msg.Ack()
+}
+
+// Nack returns the job to its queue, to be redelivered after delay.
+func (j *Job) Nack(delay time.Duration) error {
+	if delay <= 0 {
+		return j.msg.Nak()
+	}
+
+	return j.msg.NakWithDelay(delay)
+}
+
+// QueueConsumer takes jobs from a queue.
+type QueueConsumer struct {
+	b    *BusConnection
+	iter jetstream.MessagesContext
+
+	ctx  context.Context
+	stop context.CancelFunc
+}
+
+// Consume starts taking jobs from the named queue, until ctx is done or
+// the consumer is stopped.
+func (b *BusConnection) Consume(ctx context.Context, name string) (*QueueConsumer, error) {
+	js, err := b.queue(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cons, err := js.CreateOrUpdateConsumer(ctx, queueStreamPrefix+name, jetstream.ConsumerConfig{
+		Durable:   queueConsumer,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   AckWait,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := cons.Messages()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	qc := &QueueConsumer{
+		b:    b,
+		iter: iter,
+		ctx:  ctx,
+		stop: cancel,
+	}
+
+	// Stopping the iterator is what wakes up a blocked Next.
+	go func() {
+		<-ctx.Done()
+		iter.Stop()
+	}()
+
+	return qc, nil
+}
+
+// Next waits for the next job of the queue. Jobs that can't be unmarshaled
+// are terminated, so that they're not redelivered forever.
+func (qc *QueueConsumer) Next() (*Job, error) {
+	for {
+		msg, err := qc.iter.Next()
+		if err != nil {
+			if qc.ctx.Err() != nil {
+				return nil, qc.ctx.Err()
+			}
+
+			return nil, err
+		}
+
+		val, err := marshal.Unmarshal(qc.b.env, msg.Data())
+		if err != nil {
+			qc.b.log.Error("dropping job that can't be unmarshaled", "subject", msg.Subject(), "error", err)
+			msg.Term()
+			continue
+		}
+
+		job := &Job{
+			Value:   val,
+			Attempt: 1,
+			msg:     msg,
+		}
+
+		if md, err := msg.Metadata(); err == nil {
+			job.Attempt = int(md.NumDelivered)
+		}
+
+		return job, nil
+	}
+}
+
+// Stop stops taking jobs from the queue. The jobs taken but not acked yet
+// are redelivered once AckWait passes.
+func (qc *QueueConsumer) Stop() {
+	qc.stop()
+}
+
+// Publish sends val to the current subscribers of topic. Unlike a queue,
+// a topic doesn't keep the values for subscribers that come later.
+func (b *BusConnection) Publish(topic string, val any) error {
+	data, err := marshal.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	return b.c.Publish(b.signed(&Msg{Subject: topicSubjectPrefix + topic, Data: data}))
+}
+
+// Subscribe calls fn with each value published to topic, one at a time,
+// until the subscription is unsubscribed.
+func (b *BusConnection) Subscribe(topic string, fn func(val any)) (Subscription, error) {
+	return subscribeFunc(b.c, topicSubjectPrefix+topic, func(msg *Msg) {
+		val, err := marshal.Unmarshal(b.env, msg.Data)
+		if err != nil {
+			b.log.Error("dropping topic value that can't be unmarshaled", "topic", topic, "error", err)
+			return
+		}
+
+		fn(val)
+	})
+}
@@ -0,0 +1,446 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	// exportLease is how long an exported object is kept without the
+	// connections holding a proxy for it renewing the lease.
+	exportLease = 5 * time.Minute
+
+	// renewInterval is how often leases on imported objects are renewed
+	// and expired exports are swept.
+	renewInterval = time.Minute
+)
+
+// export is an object exported by the connection. It's kept while it's
+// being handed out, until expires, and while any importer holds a lease
+// on it.
+type export struct {
+	val     any
+	expires time.Time
+
+	// leases holds when the lease of each importer expires, keyed by the
+	// key and connection id the importer signs with.
+	leases map[string]time.Time
+}
+
+// live reports whether the export is still handed out or leased at now.
+func (ex *export) live(now time.Time) bool {
+	return len(ex.leases) > 0 || now.Before(ex.expires)
+}
+
+type imported struct {
+	owner   string
+	proxies int
+}
+
+// refSubject returns the subject that the connection with the given id
+// serves its exported objects on.
+func refSubject(id string) string {
+	return "lace.ref." + id
+}
+
+// Export registers val in the export table of the connection so that it
+// can be called by other connections. Exporting the same value again
+// returns the same ref and extends its lease.
+func (b *BusConnection) Export(val any) marshal.ForeignRef {
+	b.refMu.Lock()
+	defer b.refMu.Unlock()
+
+	id, ok := b.exported[val]
+	if !ok {
+		id = ulid.Make().String()
+		b.exported[val] = id
+		b.exports[id] = &export{val: val, leases: map[string]time.Time{}}
+	}
+
+	b.exports[id].expires = time.Now().Add(exportLease)
+
+	return marshal.ForeignRef{Ref: id, Owner: b.id}
+}
+
+// Import resolves ref into a value. Refs to objects exported by this
+// connection resolve to the objects themselves, others to a RemoteRef
+// that calls the object over the bus.
+func (b *BusConnection) Import(ref marshal.ForeignRef) (any, error) {
+	b.refMu.Lock()
+
+	if ref.Owner == b.id {
+		defer b.refMu.Unlock()
+
+		ex, ok := b.exports[ref.Ref]
+		if !ok {
+			return nil, fmt.Errorf("unknown exported ref: %s", ref.Ref)
+		}
+
+		return ex.val, nil
+	}
+
+	if ref.Owner == "" {
+		b.refMu.Unlock()
+		return nil, fmt.Errorf("foreign ref %s has no owner", ref.Ref)
+	}
+
+	imp, ok := b.imports[ref.Ref]
+	if !ok {
+		imp = &imported{owner: ref.Owner}
+		b.imports[ref.Ref] = imp
+	}
+
+	imp.proxies++
+
+	b.refMu.Unlock()
+
+	// The lease is taken straight away, before any release the proxy
+	// might send, since the owner only honours releases from the
+	// connections holding a lease.
+	if !ok {
+		b.sendRefs(ref.Owner, "renew", []string{ref.Ref})
+	}
+
+	rr := &RemoteRef{
+		b:   b,
+		ref: ref,
+	}
+
+	runtime.SetFinalizer(rr, (*RemoteRef).Release)
+
+	return rr, nil
+}
+
+func (b *BusConnection) releaseImport(ref marshal.ForeignRef) {
+	b.refMu.Lock()
+	defer b.refMu.Unlock()
+
+	imp, ok := b.imports[ref.Ref]
+	if !ok {
+		return
+	}
+
+	imp.proxies--
+	if imp.proxies > 0 {
+		return
+	}
+
+	delete(b.imports, ref.Ref)
+
+	go b.sendRefs(ref.Owner, "release", []string{ref.Ref})
+}
+
+func (b *BusConnection) sendRefs(owner, method string, refs []string) {
+	var args []any
+	for _, r := range refs {
+		args = append(args, core.MakeString(r))
+	}
+
+	_, err := b.Exchange(context.Background(), &Request{
+		Endpoint:   refSubject(owner),
+		Method:     method,
+		Arguments:  core.NewListFrom(args...),
+		NoResponse: true,
+	})
+	if err != nil {
+		b.log.Error("error sending ref message", "error", err, "method", method, "owner", owner)
+	}
+}
+
+// serveRefs handles calls to, and the lease messages for, the objects
+// exported by the connection.
+func (b *BusConnection) serveRefs(ctx context.Context) error {
+	ch := make(chan *Msg, 16)
+	sub, err := b.c.Subscribe(refSubject(b.id), "", ch)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer sub.Unsubscribe()
+
+		t := time.NewTicker(renewInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-ch:
+				b.processRefMsg(msg)
+			case <-t.C:
+				b.renewImports()
+				b.sweepExports()
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *BusConnection) processRefMsg(msg *Msg) {
+	wctx, wcancel := b.watchCancel(msg.Header[callHeader])
+
+	f, err := msgFormat(msg)
+	if err != nil {
+		wcancel()
+		b.log.Error("error decoding ref message", "error", err)
+		return
+	}
+
+	// As with requests to endpoints, the message is only decoded once the
+	// caller is known to be allowed.
+	caller, err := b.verify(msg)
+	if err != nil {
+		wcancel()
+		b.log.Error("error verifying ref message", "error", err)
+		b.fail(msg, f, err)
+		return
+	}
+
+	if !b.authorized(msg.Subject, caller) {
+		wcancel()
+		b.fail(msg, f, &UnauthorizedError{Caller: caller, Endpoint: msg.Subject})
+		return
+	}
+
+	req := Request{Format: f}
+
+	err = req.Unmarshal(b.env, msg.Data, b)
+	if err != nil {
+		wcancel()
+		b.log.Error("error decoding ref message", "error", err)
+		return
+	}
+
+	req.RequestId = msg.Reply
+	req.Caller = caller
+
+	refs, err := core.ToSlice(b.env, req.Arguments)
+	if err != nil {
+		wcancel()
+		b.log.Error("error decoding ref message", "error", err)
+		return
+	}
+
+	if req.Method != "call" {
+		wcancel()
+	}
+
+	switch req.Method {
+	case "call":
+		// Calls run on their own goroutine since they may well call back
+		// into objects exported by the caller.
+		ctx, cancel := b.requestContext(wctx, wcancel, msg, &req)
+		go func() {
+			defer cancel()
+			b.callExport(ctx, msg, &req, refs)
+		}()
+	case "renew", "release":
+		// Leases are held per importer, so that a connection can only
+		// give up its own.
+		importer := caller + "/" + msg.Header[connHeader]
+
+		b.refMu.Lock()
+		defer b.refMu.Unlock()
+
+		now := time.Now()
+
+		for _, r := range refs {
+			id, ok := r.(core.String)
+			if !ok {
+				continue
+			}
+
+			ex, ok := b.exports[id.S()]
+			if !ok {
+				continue
+			}
+
+			if req.Method == "renew" {
+				ex.leases[importer] = now.Add(exportLease)
+				continue
+			}
+
+			if _, ok := ex.leases[importer]; !ok {
+				continue
+			}
+
+			delete(ex.leases, importer)
+
+			if len(ex.leases) == 0 {
+				delete(b.exports, id.S())
+				delete(b.exported, ex.val)
+			}
+		}
+	default:
+		b.log.Error("unknown ref message", "method", req.Method)
+	}
+}
+
+func (b *BusConnection) callExport(ctx context.Context, msg *Msg, req *Request, args []any) {
+	val, err := b.invokeExport(ctx, args)
+
+	var data []byte
+	if err != nil {
+		data, err = req.MarshalError(b.env, err, b)
+	} else {
+		data, err = req.MarshalResponse(val, b)
+	}
+
+	if err != nil {
+		b.log.Error("error marshaling ref response", "error", err)
+		return
+	}
+
+	err = respondAs(b.c, msg, req.Format, data)
+	if err != nil {
+		b.log.Error("error responding to ref call", "error", err)
+	}
+}
+
+func (b *BusConnection) invokeExport(ctx context.Context, args []any) (any, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("ref call missing ref")
+	}
+
+	id, ok := args[0].(core.String)
+	if !ok {
+		return nil, fmt.Errorf("ref call with bad ref: %v", args[0])
+	}
+
+	b.refMu.Lock()
+	ex, ok := b.exports[id.S()]
+	b.refMu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown exported ref: %s", id.S())
+	}
+
+	fn, ok := ex.val.(core.Callable)
+	if !ok {
+		return nil, fmt.Errorf("exported ref %s is not callable", id.S())
+	}
+
+	env := b.env.Child()
+
+	err := env.SetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.Call(env, args[1:])
+}
+
+// renewImports renews the leases of every object this connection holds
+// a proxy for.
+func (b *BusConnection) renewImports() {
+	b.refMu.Lock()
+
+	byOwner := map[string][]string{}
+	for id, imp := range b.imports {
+		byOwner[imp.owner] = append(byOwner[imp.owner], id)
+	}
+
+	b.refMu.Unlock()
+
+	for owner, refs := range byOwner {
+		go b.sendRefs(owner, "renew", refs)
+	}
+}
+
+// sweepExports drops the expired leases, and the exported objects no
+// longer handed out or leased.
+func (b *BusConnection) sweepExports() {
+	b.refMu.Lock()
+	defer b.refMu.Unlock()
+
+	now := time.Now()
+
+	for id, ex := range b.exports {
+		for importer, expires := range ex.leases {
+			if now.After(expires) {
+				delete(ex.leases, importer)
+			}
+		}
+
+		if !ex.live(now) {
+			delete(b.exports, id)
+			delete(b.exported, ex.val)
+		}
+	}
+}
+
+// RemoteRef is a proxy for a function or var exported by another connection
+// on the bus. Calling it calls the original object over the bus. The lease
+// on the object is held until the proxy is released or garbage collected.
+type RemoteRef struct {
+	b        *BusConnection
+	ref      marshal.ForeignRef
+	released atomic.Bool
+}
+
+var (
+	_ core.Callable   = (*RemoteRef)(nil)
+	_ marshal.Foreign = (*RemoteRef)(nil)
+)
+
+func (r *RemoteRef) ForeignRef() marshal.ForeignRef {
+	return r.ref
+}
+
+func (r *RemoteRef) Call(env *core.Env, args []any) (any, error) {
+	if r.released.Load() {
+		return nil, fmt.Errorf("remote ref %s has been released", r.ref.Ref)
+	}
+
+	callArgs := append([]any{core.MakeString(r.ref.Ref)}, args...)
+
+	ctx := env.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	resp, err := r.b.Exchange(ctx, &Request{
+		Endpoint:  refSubject(r.ref.Owner),
+		Method:    "call",
+		Arguments: core.NewListFrom(callArgs...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// Release gives up the lease on the remote object. Once every proxy for
+// the object held by a connection is released, the owner is told it can
+// drop the object.
+func (r *RemoteRef) Release() {
+	if r.released.Swap(true) {
+		return
+	}
+
+	runtime.SetFinalizer(r, nil)
+	r.b.releaseImport(r.ref)
+}
+
+func (r *RemoteRef) ToString(env *core.Env, escape bool) (string, error) {
+	return fmt.Sprintf("#RemoteRef[%s %s]", r.ref.Owner, r.ref.Ref), nil
+}
+
+func (r *RemoteRef) Equals(env *core.Env, other any) bool {
+	o, ok := other.(*RemoteRef)
+	return ok && o.ref == r.ref
+}
+
+func (r *RemoteRef) Hash(env *core.Env) (uint32, error) {
+	return core.HashValue(env, core.MakeString(r.ref.Ref))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/lab47/lace/core"
@@ -17,49 +18,101 @@ type Request struct {
 	Arguments  core.Seq
 	RequestId  string
 	NoResponse bool
+
+	// Deadline is when the caller stops waiting for a response. It's
+	// zero when there is none.
+	Deadline time.Time
+
+	// CallId identifies the request so that the caller can cancel it.
+	CallId string
+
+	// StreamTo is the subject the values of a streaming call are sent to.
+	// It's empty for calls with a single response.
+	StreamTo string
+
+	// Window is how many values the handler of a streaming call can send
+	// before waiting for the caller to consume them.
+	Window int
+
+	// Caller is the key of the connection that sent the request, checked
+	// against its signature. It's empty when the request isn't signed.
+	Caller string
+
+	// Format is what the request is marshaled with, which the response
+	// and the values of a streaming call use too. It's CBOR when empty.
+	Format marshal.Format
 }
 
 type marshaledRequest struct {
-	Endpoint     string          `json:"endpoint" cbor:"1,keyasint"`
-	Arguments    cbor.RawMessage `json:"arguments" cbor:"2,keyasint"`
-	RequestId    string          `json:"request-id" cbor:"3,keyasint"`
-	NeedResponse bool            `json:"need-response" cbor:"4,keyasint"`
-	Method       string          `json:"method" cbor:"5,keyasint"`
+	Endpoint     string      `json:"endpoint" cbor:"1,keyasint"`
+	Arguments    marshal.Raw `json:"arguments" cbor:"2,keyasint"`
+	RequestId    string      `json:"request-id" cbor:"3,keyasint"`
+	NeedResponse bool        `json:"need-response" cbor:"4,keyasint"`
+	Method       string      `json:"method" cbor:"5,keyasint"`
+	Timeout      int64       `json:"timeout,omitempty" cbor:"6,keyasint,omitempty"`
+	CallId       string      `json:"call-id,omitempty" cbor:"7,keyasint,omitempty"`
+	StreamTo     string      `json:"stream-to,omitempty" cbor:"8,keyasint,omitempty"`
+	Window       int         `json:"window,omitempty" cbor:"9,keyasint,omitempty"`
 }
 
-func (r *Request) Marshal() ([]byte, error) {
+// Marshal encodes the request, exporting any functions in the arguments
+// with exp.
+func (r *Request) Marshal(exp marshal.Exporter) ([]byte, error) {
 	mr := marshaledRequest{
 		Endpoint:     r.Endpoint,
 		Method:       r.Method,
 		RequestId:    r.RequestId,
 		NeedResponse: r.NoResponse,
+		CallId:       r.CallId,
+		StreamTo:     r.StreamTo,
+		Window:       r.Window,
+	}
+
+	// The time left is sent rather than the deadline, so that it doesn't
+	// depend on the clocks of the caller and handler agreeing.
+	if !r.Deadline.IsZero() {
+		mr.Timeout = max(time.Until(r.Deadline).Milliseconds(), 1)
 	}
 
-	data, err := marshal.Marshal(r.Arguments)
+	data, err := r.Format.Marshal(r.Arguments, exp)
 	if err != nil {
 		return nil, err
 	}
 
-	mr.Arguments = cbor.RawMessage(data)
+	mr.Arguments = data
 
-	return cbor.Marshal(mr)
+	return r.Format.Encode(mr)
 }
 
-func (r *Request) Unmarshal(env *core.Env, data []byte) error {
+// Unmarshal decodes a request marshaled with r.Format, resolving foreign
+// refs in the arguments with imp.
+func (r *Request) Unmarshal(env *core.Env, data []byte, imp marshal.Importer) error {
 	var mr marshaledRequest
 
-	err := cbor.Unmarshal(data, &mr)
+	err := r.Format.Decode(data, &mr)
 	if err != nil {
 		return err
 	}
 
-	args, err := marshal.Unmarshal(env, mr.Arguments)
-	if err != nil {
-		return err
+	var args any = core.NIL
+
+	// Callers that aren't written in lace may leave out the arguments.
+	if len(mr.Arguments) > 0 {
+		args, err = r.Format.Unmarshal(env, mr.Arguments, imp)
+		if err != nil {
+			return err
+		}
 	}
 
-	seq, ok := args.(core.Seq)
-	if !ok {
+	var seq core.Seq
+
+	switch sv := args.(type) {
+	case core.Seq:
+		seq = sv
+	case core.Seqable:
+		// Such as the arguments of a JSON caller, sent as an array.
+		seq = sv.Seq()
+	default:
 		return fmt.Errorf("bad arguments, not seq")
 	}
 
@@ -68,42 +121,77 @@ func (r *Request) Unmarshal(env *core.Env, data []byte) error {
 	r.RequestId = mr.RequestId
 	r.NoResponse = mr.NeedResponse
 	r.Arguments = seq
+	r.CallId = mr.CallId
+	r.StreamTo = mr.StreamTo
+	r.Window = mr.Window
+
+	if mr.Timeout != 0 {
+		r.Deadline = time.Now().Add(time.Duration(mr.Timeout) * time.Millisecond)
+	}
 
 	return nil
 }
 
 type Response struct {
 	Value any
+
+	// Format is what the response is marshaled with, the format of the
+	// request.
+	Format marshal.Format
 }
 
 type marshalResponse struct {
-	Value     cbor.RawMessage `json:"value" cbor:"1,keyasint"`
+	Value     marshal.Raw     `json:"value" cbor:"1,keyasint"`
 	RequestId string          `json:"request-id" cbor:"2,keyasint"`
+	Error     *marshaledError `json:"error,omitempty" cbor:"3,keyasint,omitempty"`
 }
 
-func (r *Request) MarshalResponse(val any) ([]byte, error) {
-	d, err := marshal.Marshal(val)
+func (r *Request) MarshalResponse(val any, exp marshal.Exporter) ([]byte, error) {
+	d, err := r.Format.Marshal(val, exp)
 	if err != nil {
 		return nil, err
 	}
 
 	mr := marshalResponse{
-		Value:     cbor.RawMessage(d),
+		Value:     d,
 		RequestId: r.RequestId,
 	}
 
-	return cbor.Marshal(mr)
+	return r.Format.Encode(mr)
 }
 
-func (r *Response) Unmarshal(env *core.Env, data []byte) error {
+// MarshalError encodes a response reporting that handling the request
+// failed with err. The category, message and data of lace errors are
+// kept so they can be rethrown by the caller.
+func (r *Request) MarshalError(env *core.Env, err error, exp marshal.Exporter) ([]byte, error) {
+	d, merr := r.Format.Marshal(core.NIL, nil)
+	if merr != nil {
+		return nil, merr
+	}
+
+	mr := marshalResponse{
+		Value:     d,
+		RequestId: r.RequestId,
+		Error:     marshalError(env, err, r.Format, exp),
+	}
+
+	return r.Format.Encode(mr)
+}
+
+// Unmarshal decodes a response marshaled with r.Format.
+func (r *Response) Unmarshal(env *core.Env, data []byte, imp marshal.Importer) error {
 	var mr marshalResponse
 
-	err := cbor.Unmarshal(data, &mr)
+	err := r.Format.Decode(data, &mr)
 	if err != nil {
 		return err
 	}
 
-	val, err := marshal.Unmarshal(env, mr.Value)
+	if mr.Error != nil {
+		return mr.Error.unmarshal(env, r.Format, imp)
+	}
+
+	val, err := r.Format.Unmarshal(env, mr.Value, imp)
 	if err != nil {
 		return err
 	}
@@ -114,16 +202,35 @@ func (r *Response) Unmarshal(env *core.Env, data []byte) error {
 }
 
 type Sender interface {
-	Exchange(req *Request) (*Response, error)
+	Exchange(ctx context.Context, req *Request) (*Response, error)
 }
 
 type RPC interface {
 	Request() *Request
+
+	// Context is done once the caller's deadline passes or the caller
+	// cancels the request.
+	Context() context.Context
+
 	Respond(val any) error
+	RespondError(err error) error
+
+	// Send emits a value to the caller of a streaming request, waiting
+	// until the caller is ready for more. Respond or RespondError ends the
+	// stream, and the value given to Respond is not sent.
+	Send(val any) error
+
+	// Recv returns the next value sent by the caller of a streaming
+	// request, or io.EOF once the caller is done sending.
+	Recv() (any, error)
 }
 
 type Listener interface {
 	Accept(context.Context) (RPC, error)
+
+	// Close stops receiving requests. Requests already accepted can still
+	// be responded to.
+	Close() error
 }
 
 type Capabilities struct {
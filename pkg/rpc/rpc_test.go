@@ -2,11 +2,21 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/lab47/lablog/logger"
 	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,7 +62,7 @@ func TestRPC(t *testing.T) {
 			Arguments: args,
 		}
 
-		resp, err := c2.Exchange(req)
+		resp, err := c2.Exchange(context.TODO(), req)
 		r.NoError(err)
 
 		r.Equal(core.MakeSymbol("ok"), resp.Value)
@@ -84,7 +94,7 @@ func TestRPC(t *testing.T) {
 			Arguments: args,
 		}
 
-		_, err = c2.Exchange(req)
+		_, err = c2.Exchange(context.TODO(), req)
 		r.Error(err)
 	})
 
@@ -123,4 +133,1217 @@ func TestRPC(t *testing.T) {
 
 		r.Equal(c2cap, known[0])
 	})
+	t.Run("can pass callbacks between connections", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		b, err := StartBus(log, env)
+		r.NoError(err)
+
+		defer b.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		c1, err := b.Connect(ctx)
+		r.NoError(err)
+
+		l, err := c1.Listen("test.service")
+		r.NoError(err)
+
+		var proxy *RemoteRef
+
+		go func() {
+			rpc, err := l.Accept(ctx)
+			if err != nil {
+				panic("bad accept")
+			}
+
+			cb, err := rpc.Request().Arguments.First(env)
+			if err != nil {
+				panic(err)
+			}
+
+			proxy = cb.(*RemoteRef)
+
+			val, err := proxy.Call(env, []any{core.MakeInt(2)})
+			if err != nil {
+				panic(err)
+			}
+
+			rpc.Respond(val)
+		}()
+
+		c2, err := b.Connect(ctx)
+		r.NoError(err)
+
+		fn, err := env.Eval("(fn [x] (* x 21))")
+		r.NoError(err)
+
+		resp, err := c2.Exchange(context.TODO(), &Request{
+			Endpoint:  "test.service",
+			Method:    "call",
+			Arguments: core.NewListFrom(fn),
+		})
+		r.NoError(err)
+
+		r.Equal(core.MakeInt(42), resp.Value)
+
+		c2.refMu.Lock()
+		r.Len(c2.exports, 1)
+		var ref string
+		for id := range c2.exports {
+			ref = id
+		}
+		c2.refMu.Unlock()
+
+		// A connection that wasn't handed the ref can't release it.
+		c3, err := b.Connect(ctx)
+		r.NoError(err)
+
+		c3.sendRefs(c2.id, "release", []string{ref})
+
+		// Ref messages from a connection are handled in order, so the call
+		// is made after the release is.
+		resp, err = c3.Exchange(ctx, &Request{
+			Endpoint:  refSubject(c2.id),
+			Method:    "call",
+			Arguments: core.NewListFrom(core.MakeString(ref), core.MakeInt(1)),
+		})
+		r.NoError(err)
+		r.Equal(core.MakeInt(21), resp.Value)
+
+		proxy.Release()
+
+		r.Eventually(func() bool {
+			c2.refMu.Lock()
+			defer c2.refMu.Unlock()
+
+			return len(c2.exports) == 0
+		}, time.Second, 10*time.Millisecond)
+
+		_, err = proxy.Call(env, nil)
+		r.Error(err)
+	})
+	t.Run("rethrows handler errors on the caller", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		b, err := StartBus(log, env)
+		r.NoError(err)
+
+		defer b.Close()
+
+		c1, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		l, err := c1.Listen("test.service")
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		go func() {
+			rpc, err := l.Accept(ctx)
+			if err != nil {
+				panic("bad accept")
+			}
+
+			_, err = env.Eval(`(throw (ex-info "boom" {:code 7}))`)
+			rpc.RespondError(err)
+		}()
+
+		c2, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		_, err = c2.Exchange(ctx, &Request{
+			Endpoint: "test.service",
+			Method:   "foo",
+		})
+		r.Error(err)
+
+		var re *RemoteError
+		r.ErrorAs(err, &re)
+		r.Equal("boom", re.Error())
+
+		code, err := env.Eval(`(fn [e] (:code (ex-data e)))`)
+		r.NoError(err)
+
+		val, err := code.(core.Callable).Call(env, []any{core.WrapError(env, re)})
+		r.NoError(err)
+		r.Equal(core.MakeInt(7), val)
+	})
+
+	t.Run("propagates deadlines and cancellation to the handler", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env := &core.Env{}
+
+		b, err := StartBus(log, env)
+		r.NoError(err)
+
+		defer b.Close()
+
+		c1, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		l, err := c1.Listen("test.service")
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		handled := make(chan error, 1)
+
+		go func() {
+			rpc, err := l.Accept(ctx)
+			if err != nil {
+				panic("bad accept")
+			}
+
+			if _, ok := rpc.Context().Deadline(); !ok {
+				handled <- nil
+				return
+			}
+
+			<-rpc.Context().Done()
+			handled <- rpc.Context().Err()
+		}()
+
+		c2, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		callCtx, callCancel := context.WithCancel(ctx)
+		time.AfterFunc(100*time.Millisecond, callCancel)
+
+		_, err = c2.Exchange(callCtx, &Request{
+			Endpoint: "test.service",
+			Method:   "foo",
+		})
+		r.ErrorIs(err, context.Canceled)
+
+		select {
+		case err := <-handled:
+			r.ErrorIs(err, context.Canceled)
+		case <-ctx.Done():
+			r.FailNow("handler was not canceled")
+		}
+	})
+	t.Run("ends the handler at the time left on the caller's deadline", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env := &core.Env{}
+
+		b, err := StartBus(log, env)
+		r.NoError(err)
+
+		defer b.Close()
+
+		c1, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		l, err := c1.Listen("test.service")
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		handled := make(chan error, 1)
+
+		go func() {
+			rpc, err := l.Accept(ctx)
+			if err != nil {
+				panic("bad accept")
+			}
+
+			deadline, ok := rpc.Context().Deadline()
+			if !ok || time.Until(deadline) > 200*time.Millisecond {
+				handled <- nil
+				return
+			}
+
+			<-rpc.Context().Done()
+			handled <- rpc.Context().Err()
+		}()
+
+		c2, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		callCtx, callCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer callCancel()
+
+		_, err = c2.Exchange(callCtx, &Request{
+			Endpoint: "test.service",
+			Method:   "foo",
+		})
+		r.Error(err)
+
+		select {
+		case err := <-handled:
+			r.ErrorIs(err, context.DeadlineExceeded)
+		case <-ctx.Done():
+			r.FailNow("handler was not ended at the deadline")
+		}
+	})
+	t.Run("streams values between connections", func(t *testing.T) {
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		require.NoError(t, err)
+
+		b, err := StartBus(log, env)
+		require.NoError(t, err)
+
+		defer b.Close()
+
+		c1, err := b.Connect(context.TODO())
+		require.NoError(t, err)
+
+		l, err := c1.Listen("test.stream")
+		require.NoError(t, err)
+
+		c2, err := b.Connect(context.TODO())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		serve := func(h func(rpc RPC)) {
+			go func() {
+				rpc, err := l.Accept(ctx)
+				if err != nil {
+					panic("bad accept")
+				}
+
+				h(rpc)
+			}()
+		}
+
+		t.Run("from the handler with flow control", func(t *testing.T) {
+			r := require.New(t)
+
+			serve(func(rpc RPC) {
+				for i := 0; i < 50; i++ {
+					if err := rpc.Send(core.MakeInt(i)); err != nil {
+						rpc.RespondError(err)
+						return
+					}
+				}
+
+				rpc.Respond(core.NIL)
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "count",
+				Window:   4,
+			})
+			r.NoError(err)
+
+			vals, err := core.ToSlice(env, s.Seq())
+			r.NoError(err)
+			r.Len(vals, 50)
+			r.Equal(core.MakeInt(49), vals[49])
+		})
+
+		t.Run("in both directions", func(t *testing.T) {
+			r := require.New(t)
+
+			serve(func(rpc RPC) {
+				for {
+					val, err := rpc.Recv()
+					if err != nil {
+						break
+					}
+
+					rpc.Send(core.MakeInt(val.(core.Int).I() * 2))
+				}
+
+				rpc.Respond(core.NIL)
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "double",
+			})
+			r.NoError(err)
+
+			for i := 1; i <= 3; i++ {
+				r.NoError(s.Send(core.MakeInt(i)))
+
+				val, err := s.Recv()
+				r.NoError(err)
+				r.Equal(core.MakeInt(i*2), val)
+			}
+
+			r.NoError(s.CloseSend())
+
+			_, err = s.Recv()
+			r.ErrorIs(err, io.EOF)
+		})
+
+		t.Run("ending with an error", func(t *testing.T) {
+			r := require.New(t)
+
+			serve(func(rpc RPC) {
+				rpc.Send(core.MakeInt(1))
+				rpc.RespondError(fmt.Errorf("stream failed"))
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "fail",
+			})
+			r.NoError(err)
+
+			val, err := s.Recv()
+			r.NoError(err)
+			r.Equal(core.MakeInt(1), val)
+
+			_, err = s.Recv()
+			r.EqualError(err, "stream failed")
+		})
+
+		t.Run("cancels the handler when closed", func(t *testing.T) {
+			r := require.New(t)
+
+			handled := make(chan error, 1)
+
+			serve(func(rpc RPC) {
+				for i := 0; ; i++ {
+					if err := rpc.Send(core.MakeInt(i)); err != nil {
+						handled <- err
+						return
+					}
+				}
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "forever",
+				Window:   2,
+			})
+			r.NoError(err)
+
+			_, err = s.Recv()
+			r.NoError(err)
+
+			s.Close()
+
+			select {
+			case err := <-handled:
+				r.ErrorIs(err, context.Canceled)
+			case <-ctx.Done():
+				r.FailNow("handler was not canceled")
+			}
+		})
+
+		t.Run("to a channel", func(t *testing.T) {
+			r := require.New(t)
+
+			serve(func(rpc RPC) {
+				for i := 0; i < 3; i++ {
+					if err := rpc.Send(core.MakeInt(i)); err != nil {
+						rpc.RespondError(err)
+						return
+					}
+				}
+
+				rpc.Respond(core.NIL)
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "count",
+			})
+			r.NoError(err)
+
+			recv, err := env.Eval("(fn [ch] (<! ch))")
+			r.NoError(err)
+
+			ch := s.Chan(env)
+
+			for i := 0; i < 3; i++ {
+				val, err := recv.(*core.Fn).Call(env, []any{ch})
+				r.NoError(err)
+				r.Equal(core.MakeInt(i), val)
+			}
+
+			val, err := recv.(*core.Fn).Call(env, []any{ch})
+			r.NoError(err)
+			r.Equal(core.NIL, val)
+		})
+
+		t.Run("to a channel the consumer closes", func(t *testing.T) {
+			r := require.New(t)
+
+			handled := make(chan error, 1)
+
+			serve(func(rpc RPC) {
+				for i := 0; ; i++ {
+					if err := rpc.Send(core.MakeInt(i)); err != nil {
+						handled <- err
+						return
+					}
+				}
+			})
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.stream",
+				Method:   "forever",
+				Window:   2,
+			})
+			r.NoError(err)
+
+			recv, err := env.Eval("(fn [ch] (<! ch))")
+			r.NoError(err)
+
+			closeCh, err := env.Eval("(fn [ch] (close! ch))")
+			r.NoError(err)
+
+			ch := s.Chan(env)
+
+			_, err = recv.(*core.Fn).Call(env, []any{ch})
+			r.NoError(err)
+
+			_, err = closeCh.(*core.Fn).Call(env, []any{ch})
+			r.NoError(err)
+
+			select {
+			case err := <-handled:
+				r.ErrorIs(err, context.Canceled)
+			case <-ctx.Done():
+				r.FailNow("handler was not canceled")
+			}
+		})
+	})
+}
+
+func TestTransports(t *testing.T) {
+	transports := map[string]func(t *testing.T, hub *Hub) Transport{
+		"memory": func(t *testing.T, hub *Hub) Transport {
+			return hub.Transport()
+		},
+		"tcp": func(t *testing.T, hub *Hub) Transport {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			t.Cleanup(func() { l.Close() })
+
+			go hub.Serve(l)
+
+			tr, err := Dial(context.Background(), "tcp://"+l.Addr().String())
+			require.NoError(t, err)
+
+			return tr
+		},
+		"unix": func(t *testing.T, hub *Hub) Transport {
+			l, err := net.Listen("unix", filepath.Join(t.TempDir(), "bus.sock"))
+			require.NoError(t, err)
+
+			t.Cleanup(func() { l.Close() })
+
+			go hub.Serve(l)
+
+			tr, err := Dial(context.Background(), "unix://"+l.Addr().String())
+			require.NoError(t, err)
+
+			return tr
+		},
+		"websocket": func(t *testing.T, hub *Hub) Transport {
+			srv := httptest.NewServer(hub.WebSocketHandler())
+			t.Cleanup(srv.Close)
+
+			tr, err := Dial(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http")+"/bus")
+			require.NoError(t, err)
+
+			return tr
+		},
+	}
+
+	for name, dial := range transports {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			log := logger.New(logger.Trace)
+			env, err := core.NewEnv()
+			r.NoError(err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			hub := NewHub()
+
+			// The handler connects directly, the caller over the transport.
+			c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+			r.NoError(err)
+
+			defer c1.Close()
+
+			c2, err := NewBusConnection(ctx, env, log, dial(t, hub))
+			r.NoError(err)
+
+			defer c2.Close()
+
+			l, err := c1.Listen("test.service")
+			r.NoError(err)
+
+			go func() {
+				for {
+					rpc, err := l.Accept(ctx)
+					if err != nil {
+						return
+					}
+
+					switch rpc.Request().Method {
+					case "echo":
+						rpc.Respond(rpc.Request().Arguments)
+					case "count":
+						for i := 0; i < 20; i++ {
+							rpc.Send(core.MakeInt(i))
+						}
+
+						rpc.Respond(core.NIL)
+					}
+				}
+			}()
+
+			args := core.NewListFrom(core.MakeSymbol("arg1"), core.MakeString("arg2"))
+
+			resp, err := c2.Exchange(ctx, &Request{
+				Endpoint:  "test.service",
+				Method:    "echo",
+				Arguments: args,
+			})
+			r.NoError(err)
+			r.True(core.Equals(env, args, resp.Value))
+
+			s, err := c2.Stream(ctx, &Request{
+				Endpoint: "test.service",
+				Method:   "count",
+				Window:   4,
+			})
+			r.NoError(err)
+
+			vals, err := core.ToSlice(env, s.Seq())
+			r.NoError(err)
+			r.Len(vals, 20)
+
+			_, err = c2.Exchange(ctx, &Request{Endpoint: "test.nobody"})
+			r.ErrorIs(err, ErrNoResponders)
+
+			_, err = c1.Advertise(&Capabilities{Endpoint: "test.service", Method: "echo"})
+			r.NoError(err)
+
+			r.Eventually(func() bool {
+				return len(c2.BrowseCapabilities()) == 1
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+
+	t.Run("drops messages past the pending limit of a slow receiver", func(t *testing.T) {
+		r := require.New(t)
+
+		old := maxPending
+		maxPending = 10
+		defer func() { maxPending = old }()
+
+		tr := NewHub().Transport()
+
+		ch := make(chan *Msg)
+
+		sub, err := tr.Subscribe("test.slow", "", ch)
+		r.NoError(err)
+
+		defer sub.Unsubscribe()
+
+		for i := 0; i < 100; i++ {
+			r.NoError(tr.Publish(&Msg{Subject: "test.slow", Data: []byte{byte(i)}}))
+		}
+
+		var got []byte
+
+		for {
+			select {
+			case msg := <-ch:
+				got = append(got, msg.Data[0])
+				continue
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			break
+		}
+
+		// One message may already have been taken off the queue to be
+		// delivered when the limit was reached.
+		r.GreaterOrEqual(len(got), 10)
+		r.LessOrEqual(len(got), 11)
+		r.Equal(byte(0), got[0])
+	})
+}
+
+func TestAuth(t *testing.T) {
+	t.Run("rejects callers that aren't allowed", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		hub := NewHub()
+
+		c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		id, err := GenerateIdentity()
+		r.NoError(err)
+
+		c2.SetIdentity(id)
+
+		l, err := c1.Listen("test.secure")
+		r.NoError(err)
+
+		c1.Authorize("test.secure", map[string][]string{AllowTag: {c1.Identity().Key()}})
+
+		go func() {
+			for {
+				rpc, err := l.Accept(ctx)
+				if err != nil {
+					return
+				}
+
+				rpc.Respond(core.MakeString(rpc.Request().Caller))
+			}
+		}()
+
+		fn, err := env.Eval("(fn [] 42)")
+		r.NoError(err)
+
+		_, err = c2.Exchange(ctx, &Request{
+			Endpoint:  "test.secure",
+			Method:    "get",
+			Arguments: core.NewListFrom(fn),
+		})
+
+		var ue *UnauthorizedError
+		r.ErrorAs(err, &ue)
+		r.Equal(id.Key(), ue.Caller)
+		r.Equal("test.secure", ue.Endpoint)
+
+		// The rejected request isn't decoded, so the function it passed
+		// isn't imported.
+		c1.refMu.Lock()
+		r.Empty(c1.imports)
+		c1.refMu.Unlock()
+
+		c1.Authorize("test.secure", map[string][]string{AllowTag: {id.Key()}})
+
+		resp, err := c2.Exchange(ctx, &Request{Endpoint: "test.secure", Method: "get"})
+		r.NoError(err)
+		r.Equal(core.MakeString(id.Key()), resp.Value)
+	})
+
+	t.Run("only runs code on nodes from allowed keys", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		hub := NewHub()
+
+		c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		id, err := GenerateIdentity()
+		r.NoError(err)
+
+		c2.SetIdentity(id)
+
+		go c1.ServeNode(ctx, "worker", []string{c1.Identity().Key()})
+
+		r.Eventually(func() bool {
+			return len(c2.BrowseCapabilities()) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		r.Equal([]string{c1.Identity().Key()}, c2.BrowseCapabilities()[0].Tags[AllowTag])
+
+		fn, err := env.Eval("(fn [] 42)")
+		r.NoError(err)
+
+		_, err = c2.RunOn(ctx, "worker", fn.(*core.Fn), nil)
+
+		var ue *UnauthorizedError
+		r.ErrorAs(err, &ue)
+		r.Equal(id.Key(), ue.Caller)
+		r.Equal(NodeEndpoint("worker"), ue.Endpoint)
+
+		val, err := c1.RunOn(ctx, "worker", fn.(*core.Fn), nil)
+		r.NoError(err)
+		r.Equal(core.MakeInt(42), val)
+	})
+
+	t.Run("refuses code sent to endpoints other than nodes", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		hub := NewHub()
+
+		c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		l, err := c1.Listen("test.plain")
+		r.NoError(err)
+
+		defer l.Close()
+
+		go func() {
+			rpc, err := l.Accept(ctx)
+			if err == nil {
+				rpc.Respond(core.NIL)
+			}
+		}()
+
+		fn, err := env.Eval("(fn [] 42)")
+		r.NoError(err)
+
+		_, err = c2.Exchange(ctx, &Request{
+			Endpoint:  "test.plain",
+			Method:    "run",
+			Arguments: core.NewListFrom(marshal.Ship(env, fn.(*core.Fn))),
+		})
+		r.ErrorContains(err, marshal.ErrCodeNotAllowed.Error())
+	})
+
+	t.Run("only accepts adverts from trusted keys", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		hub := NewHub()
+
+		c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c3, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		id2, err := GenerateIdentity()
+		r.NoError(err)
+
+		c2.SetIdentity(id2)
+
+		id3, err := GenerateIdentity()
+		r.NoError(err)
+
+		c3.SetIdentity(id3)
+
+		c1.Trust(id2.Key())
+
+		_, err = c3.Advertise(&Capabilities{Endpoint: "test.rogue", Method: "steal"})
+		r.NoError(err)
+
+		_, err = c2.Advertise(&Capabilities{Endpoint: "test.good", Method: "help"})
+		r.NoError(err)
+
+		r.Eventually(func() bool {
+			return len(c1.BrowseCapabilities()) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		r.Equal("test.good", c1.BrowseCapabilities()[0].Endpoint)
+
+		// Forged adverts don't verify.
+		data, err := cbor.Marshal(&Capabilities{Endpoint: "test.forged", Method: "steal"})
+		r.NoError(err)
+
+		msg := &Msg{Subject: "lace.capabilities", Data: data}
+		id3.sign(msg)
+		msg.Header[keyHeader] = id2.Key()
+
+		r.NoError(hub.Transport().Publish(msg))
+
+		time.Sleep(50 * time.Millisecond)
+
+		r.Len(c1.BrowseCapabilities(), 1)
+	})
+
+	t.Run("rejects replayed and stale requests", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Trace)
+		env, err := core.NewEnv()
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		hub := NewHub()
+
+		c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		l, err := c1.Listen("test.secure")
+		r.NoError(err)
+
+		c1.Authorize("test.secure", map[string][]string{AllowTag: {c2.Identity().Key()}})
+
+		var calls atomic.Int32
+
+		go func() {
+			for {
+				rpc, err := l.Accept(ctx)
+				if err != nil {
+					return
+				}
+
+				calls.Add(1)
+				rpc.Respond(core.MakeString("secret"))
+			}
+		}()
+
+		// Capture the requests sent to the endpoint, as anybody on the bus
+		// can.
+		captured := make(chan *Msg, 1)
+		sub, err := hub.Transport().Subscribe("test.secure", "", captured)
+		r.NoError(err)
+
+		defer sub.Unsubscribe()
+
+		_, err = c2.Exchange(ctx, &Request{Endpoint: "test.secure", Method: "get"})
+		r.NoError(err)
+		r.Equal(int32(1), calls.Load())
+
+		msg := <-captured
+
+		replay := func(msg *Msg) error {
+			reply, err := hub.Transport().Request(ctx, msg)
+			r.NoError(err)
+
+			_, err = c2.unmarshalResponse(reply)
+			return err
+		}
+
+		// Sending the reply to another inbox breaks the signature.
+		stolen := *msg
+		stolen.Reply = hub.Transport().NewInbox()
+		r.ErrorContains(replay(&stolen), ErrBadSignature.Error())
+
+		r.ErrorContains(replay(msg), ErrReplayedSignature.Error())
+
+		stale := &Msg{Subject: "test.secure", Reply: hub.Transport().NewInbox(), Data: msg.Data}
+		c2.Identity().signAt(stale, time.Now().Add(-2*signatureWindow))
+		r.ErrorContains(replay(stale), ErrStaleSignature.Error())
+
+		r.Equal(int32(1), calls.Load())
+	})
+}
+
+func TestQueues(t *testing.T) {
+	t.Run("redelivers jobs until they're acked", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Info)
+		env := &core.Env{}
+
+		b, err := StartBus(log, env)
+		r.NoError(err)
+
+		defer b.Close()
+
+		c, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		r.NoError(c.Enqueue(ctx, "jobs", core.MakeKeyword("build")))
+
+		qc, err := c.Consume(ctx, "jobs")
+		r.NoError(err)
+
+		defer qc.Stop()
+
+		job, err := qc.Next()
+		r.NoError(err)
+
+		r.True(core.Equals(env, core.MakeKeyword("build"), job.Value))
+		r.Equal(1, job.Attempt)
+
+		r.NoError(job.Nack(0))
+
+		job, err = qc.Next()
+		r.NoError(err)
+
+		r.True(core.Equals(env, core.MakeKeyword("build"), job.Value))
+		r.Equal(2, job.Attempt)
+
+		r.NoError(job.Ack())
+	})
+
+	t.Run("keeps jobs in the store across restarts", func(t *testing.T) {
+		r := require.New(t)
+
+		log := logger.New(logger.Info)
+		env := &core.Env{}
+		dir := t.TempDir()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		b, err := StartBusWith(log, env, BusOptions{StoreDir: dir})
+		r.NoError(err)
+
+		c, err := b.Connect(context.TODO())
+		r.NoError(err)
+
+		r.NoError(c.Enqueue(ctx, "jobs", core.MakeString("survives")))
+
+		c.Close()
+		b.Close()
+
+		b, err = StartBusWith(log, env, BusOptions{StoreDir: dir})
+		r.NoError(err)
+
+		defer b.Close()
+
+		c, err = b.Connect(context.TODO())
+		r.NoError(err)
+
+		qc, err := c.Consume(ctx, "jobs")
+		r.NoError(err)
+
+		defer qc.Stop()
+
+		job, err := qc.Next()
+		r.NoError(err)
+
+		r.Equal(core.MakeString("survives"), job.Value)
+		r.NoError(job.Ack())
+	})
+
+	t.Run("requires JetStream", func(t *testing.T) {
+		r := require.New(t)
+
+		c, err := NewBusConnection(context.TODO(), &core.Env{}, logger.New(logger.Info), NewHub().Transport())
+		r.NoError(err)
+
+		defer c.Close()
+
+		r.ErrorIs(c.Enqueue(context.TODO(), "jobs", core.NIL), ErrNoJetStream)
+	})
+
+	t.Run("delivers topic values to the subscribers", func(t *testing.T) {
+		r := require.New(t)
+
+		hub := NewHub()
+		env := &core.Env{}
+
+		c1, err := NewBusConnection(context.TODO(), env, logger.New(logger.Info), hub.Transport())
+		r.NoError(err)
+
+		defer c1.Close()
+
+		c2, err := NewBusConnection(context.TODO(), env, logger.New(logger.Info), hub.Transport())
+		r.NoError(err)
+
+		defer c2.Close()
+
+		vals := make(chan any, 1)
+
+		sub, err := c2.Subscribe("news", func(val any) { vals <- val })
+		r.NoError(err)
+
+		defer sub.Unsubscribe()
+
+		r.NoError(c1.Publish("news", core.MakeInt(7)))
+
+		select {
+		case val := <-vals:
+			r.Equal(core.MakeInt(7), val)
+		case <-time.After(3 * time.Second):
+			r.FailNow("no value published")
+		}
+	})
+}
+
+func TestFormats(t *testing.T) {
+	hub := NewHub()
+
+	env, err := core.NewEnv()
+	require.NoError(t, err)
+
+	log := logger.New(logger.Info)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c1, err := NewBusConnection(ctx, env, log, hub.Transport())
+	require.NoError(t, err)
+
+	defer c1.Close()
+
+	l, err := c1.Listen("test.formats")
+	require.NoError(t, err)
+
+	defer l.Close()
+
+	go func() {
+		for {
+			rpc, err := l.Accept(ctx)
+			if err != nil {
+				return
+			}
+
+			req := rpc.Request()
+
+			args, err := core.ToSlice(env, req.Arguments)
+			if err != nil {
+				rpc.RespondError(err)
+				continue
+			}
+
+			switch req.Method {
+			case "echo":
+				rpc.Respond(core.NewVectorFrom(core.MakeString(req.Format.String()), core.NewVectorFrom(args...)))
+			case "add":
+				rpc.Respond(core.MakeInt(args[0].(core.Int).I() + args[1].(core.Int).I()))
+			case "count":
+				for i := 0; i < 3; i++ {
+					rpc.Send(core.MakeKeyword(fmt.Sprintf("n%d", i)))
+				}
+
+				rpc.Respond(core.NIL)
+			default:
+				_, err = env.Eval(`(throw (ex-info "boom" {:code 7}))`)
+				rpc.RespondError(err)
+			}
+		}
+	}()
+
+	t.Run("uses a format the endpoint advertises", func(t *testing.T) {
+		r := require.New(t)
+
+		c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+		r.NoError(err)
+
+		defer c2.Close()
+
+		ad, err := c1.Advertise(&Capabilities{
+			Endpoint: "test",
+			Method:   "formats",
+			Tags:     map[string][]string{FormatTag: {"json"}},
+		})
+		r.NoError(err)
+
+		defer ad.Clear()
+
+		r.Eventually(func() bool {
+			return c2.formatFor("test.formats") == marshal.JSON
+		}, 3*time.Second, 10*time.Millisecond)
+
+		resp, err := c2.Exchange(ctx, &Request{Endpoint: "test.formats", Method: "echo"})
+		r.NoError(err)
+		r.Equal(marshal.JSON, resp.Format)
+	})
+
+	for _, f := range []marshal.Format{marshal.CBOR, marshal.JSON, marshal.EDN} {
+		t.Run("negotiates "+f.String(), func(t *testing.T) {
+			r := require.New(t)
+
+			c2, err := NewBusConnection(ctx, env, log, hub.Transport())
+			r.NoError(err)
+
+			defer c2.Close()
+
+			c2.SetFormat(f)
+
+			args := []any{core.MakeKeyword("a"), core.MakeString("b"), core.MakeDouble(1)}
+
+			resp, err := c2.Exchange(ctx, &Request{
+				Endpoint:  "test.formats",
+				Method:    "echo",
+				Arguments: core.NewListFrom(args...),
+			})
+			r.NoError(err)
+			r.Equal(f, resp.Format)
+			r.True(core.Equals(env, core.NewVectorFrom(core.MakeString(f.String()), core.NewVectorFrom(args...)), resp.Value))
+
+			_, err = c2.Exchange(ctx, &Request{Endpoint: "test.formats", Method: "fail"})
+
+			var re *RemoteError
+			r.ErrorAs(err, &re)
+			r.Equal("boom", re.Error())
+
+			ok, code := re.ErrorData().GetEqu(core.MakeKeyword("data"))
+			r.True(ok)
+			str, err := core.ToString(env, code)
+			r.NoError(err)
+			r.Equal("{:code 7}", str)
+
+			s, err := c2.Stream(ctx, &Request{Endpoint: "test.formats", Method: "count"})
+			r.NoError(err)
+
+			vals, err := core.ToSlice(env, s.Seq())
+			r.NoError(err)
+			r.Equal([]any{core.MakeKeyword("n0"), core.MakeKeyword("n1"), core.MakeKeyword("n2")}, vals)
+		})
+	}
+
+	t.Run("serves callers that speak JSON", func(t *testing.T) {
+		r := require.New(t)
+
+		reply, err := hub.Transport().Request(ctx, &Msg{
+			Subject: "test.formats",
+			Header:  map[string]string{formatHeader: "json"},
+			Data:    []byte(`{"endpoint": "test.formats", "method": "add", "arguments": [40, 2]}`),
+		})
+		r.NoError(err)
+		r.Equal("json", reply.Header[formatHeader])
+
+		var resp struct {
+			Value int `json:"value"`
+		}
+
+		r.NoError(json.Unmarshal(reply.Data, &resp))
+		r.Equal(42, resp.Value)
+	})
 }
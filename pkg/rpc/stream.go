@@ -0,0 +1,509 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
+	"github.com/oklog/ulid/v2"
+)
+
+// defaultWindow is how many values a handler can send before waiting for
+// the caller to consume them, when the caller doesn't say.
+var defaultWindow = 16
+
+// ErrNotStreaming is returned when streaming a value over a call that has
+// a single response.
+var ErrNotStreaming = errors.New("request is not streaming")
+
+// streamFrame is a value sent over a streaming call, in either direction.
+// The last frame from the handler is Done, or carries the Error it failed
+// with.
+type streamFrame struct {
+	Value marshal.Raw     `json:"value,omitempty" cbor:"1,keyasint,omitempty"`
+	Done  bool            `json:"done,omitempty" cbor:"2,keyasint,omitempty"`
+	Error *marshaledError `json:"error,omitempty" cbor:"3,keyasint,omitempty"`
+}
+
+// creditSubject returns the subject a caller grants the handler of the
+// streaming call with the given id more room to send on.
+func creditSubject(callId string) string {
+	return "lace.credit." + callId
+}
+
+// upstreamSubject returns the subject a caller sends values to the handler
+// of the streaming call with the given id on.
+func upstreamSubject(callId string) string {
+	return "lace.upstream." + callId
+}
+
+// serverStream is the handler's side of a streaming call.
+type serverStream struct {
+	b   *BusConnection
+	ctx context.Context
+	req *Request
+
+	credit int
+	grants chan int
+
+	in     chan any
+	inDone sync.Once
+
+	subs []Subscription
+}
+
+func (b *BusConnection) acceptStream(ctx context.Context, req *Request) (*serverStream, error) {
+	if req.CallId == "" {
+		return nil, fmt.Errorf("streaming request without a call id")
+	}
+
+	s := &serverStream{
+		b:      b,
+		ctx:    ctx,
+		req:    req,
+		credit: req.Window,
+		grants: make(chan int, 1),
+		in:     make(chan any, defaultWindow),
+	}
+
+	if s.credit <= 0 {
+		s.credit = defaultWindow
+	}
+
+	sub, err := subscribeFunc(b.c, creditSubject(req.CallId), func(msg *Msg) {
+		var n int
+		if err := req.Format.Decode(msg.Data, &n); err != nil {
+			b.log.Error("error decoding stream credit", "error", err)
+			return
+		}
+
+		select {
+		case s.grants <- n:
+		case <-ctx.Done():
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.subs = append(s.subs, sub)
+
+	sub, err = subscribeFunc(b.c, upstreamSubject(req.CallId), s.processUpstream)
+	if err != nil {
+		s.close()
+		return nil, err
+	}
+
+	s.subs = append(s.subs, sub)
+
+	return s, nil
+}
+
+// processUpstream queues a value sent by the caller, acknowledging it once
+// there's room so the caller doesn't get ahead of the handler.
+func (s *serverStream) processUpstream(msg *Msg) {
+	var frame streamFrame
+
+	if err := s.req.Format.Decode(msg.Data, &frame); err != nil {
+		s.b.log.Error("error decoding stream frame", "error", err)
+		return
+	}
+
+	if frame.Done {
+		s.inDone.Do(func() { close(s.in) })
+		respond(s.b.c, msg, nil)
+		return
+	}
+
+	val, err := s.req.Format.Unmarshal(s.b.env, frame.Value, s.b)
+	if err != nil {
+		s.b.log.Error("error decoding stream value", "error", err)
+		return
+	}
+
+	select {
+	case s.in <- val:
+		respond(s.b.c, msg, nil)
+	case <-s.ctx.Done():
+	}
+}
+
+func (s *serverStream) send(val any) error {
+	for s.credit == 0 {
+		select {
+		case n := <-s.grants:
+			s.credit += n
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+
+	d, err := s.req.Format.Marshal(val, s.b)
+	if err != nil {
+		return err
+	}
+
+	s.credit--
+
+	return s.publish(&streamFrame{Value: d})
+}
+
+func (s *serverStream) recv() (any, error) {
+	select {
+	case val, ok := <-s.in:
+		if !ok {
+			return nil, io.EOF
+		}
+
+		return val, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// finish ends the stream, reporting err to the caller if it's not nil.
+func (s *serverStream) finish(err error) error {
+	defer s.close()
+
+	frame := &streamFrame{Done: true}
+	if err != nil {
+		frame = &streamFrame{Error: marshalError(s.b.env, err, s.req.Format, s.b)}
+	}
+
+	return s.publish(frame)
+}
+
+func (s *serverStream) publish(frame *streamFrame) error {
+	data, err := s.req.Format.Encode(frame)
+	if err != nil {
+		return err
+	}
+
+	return s.b.c.Publish(&Msg{Subject: s.req.StreamTo, Data: data})
+}
+
+func (s *serverStream) close() {
+	for _, sub := range s.subs {
+		sub.Unsubscribe()
+	}
+}
+
+// Stream is the caller's side of a streaming call. Values sent by the
+// handler are read with Recv, or consumed as a lace channel or lazy seq.
+// Values can also be sent to the handler with Send.
+type Stream struct {
+	b      *BusConnection
+	callId string
+	window int
+	format marshal.Format
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	sub Subscription
+	ch  chan *Msg
+
+	mu       sync.Mutex
+	consumed int
+	done     bool
+
+	closeOnce sync.Once
+}
+
+// Stream starts a streaming call to the handler of req. The call ends once
+// the handler is done, or when ctx is canceled or the stream is closed,
+// which also cancels the context of the handler.
+func (b *BusConnection) Stream(ctx context.Context, req *Request) (*Stream, error) {
+	if req.Arguments == nil {
+		req.Arguments = core.NIL
+	}
+
+	if req.Window <= 0 {
+		req.Window = defaultWindow
+	}
+
+	if req.Format == "" {
+		req.Format = b.format
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	s := &Stream{
+		b:      b,
+		callId: ulid.Make().String(),
+		window: req.Window,
+		format: req.Format,
+		ctx:    ctx,
+		cancel: cancel,
+
+		// The handler never has more than the window outstanding, plus
+		// the final frame.
+		ch: make(chan *Msg, req.Window+1),
+	}
+
+	req.CallId = s.callId
+	req.StreamTo = b.c.NewInbox()
+	req.Deadline, _ = ctx.Deadline()
+
+	sub, err := b.c.Subscribe(req.StreamTo, "", s.ch)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	s.sub = sub
+
+	data, err := req.Marshal(b)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	// The handler acknowledges the call once it's ready for values to be
+	// sent to it.
+	openCtx, openCancel := context.WithTimeout(ctx, defaultTimeout)
+	defer openCancel()
+
+	msg, err := b.signedRequest(openCtx, callMsg(req, data))
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	_, err = b.unmarshalResponse(msg)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	runtime.SetFinalizer(s, (*Stream).Close)
+
+	return s, nil
+}
+
+// Recv returns the next value sent by the handler, io.EOF once the handler
+// is done, or the error the handler failed with.
+func (s *Stream) Recv() (any, error) {
+	s.mu.Lock()
+	done := s.done
+	s.mu.Unlock()
+
+	if done {
+		return nil, io.EOF
+	}
+
+	var msg *Msg
+
+	select {
+	case msg = <-s.ch:
+	case <-s.ctx.Done():
+		s.Close()
+		return nil, s.ctx.Err()
+	}
+
+	var frame streamFrame
+
+	if err := s.format.Decode(msg.Data, &frame); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	if frame.Done || frame.Error != nil {
+		s.mu.Lock()
+		s.done = true
+		s.mu.Unlock()
+
+		s.Close()
+
+		if frame.Error != nil {
+			return nil, frame.Error.unmarshal(s.b.env, s.format, s.b)
+		}
+
+		return nil, io.EOF
+	}
+
+	val, err := s.format.Unmarshal(s.b.env, frame.Value, s.b)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	s.grant()
+
+	return val, nil
+}
+
+// grant gives the handler room to send more values once half the window
+// has been consumed.
+func (s *Stream) grant() {
+	s.mu.Lock()
+	s.consumed++
+
+	n := s.consumed
+	if n < (s.window+1)/2 {
+		s.mu.Unlock()
+		return
+	}
+
+	s.consumed = 0
+	s.mu.Unlock()
+
+	data, err := s.format.Encode(n)
+	if err != nil {
+		return
+	}
+
+	if err := s.b.c.Publish(&Msg{Subject: creditSubject(s.callId), Data: data}); err != nil {
+		s.b.log.Error("error granting stream credit", "error", err)
+	}
+}
+
+// Send sends val to the handler, waiting until the handler has room for it.
+func (s *Stream) Send(val any) error {
+	d, err := s.format.Marshal(val, s.b)
+	if err != nil {
+		return err
+	}
+
+	return s.sendFrame(&streamFrame{Value: d})
+}
+
+// CloseSend tells the handler no more values will be sent to it.
+func (s *Stream) CloseSend() error {
+	return s.sendFrame(&streamFrame{Done: true})
+}
+
+func (s *Stream) sendFrame(frame *streamFrame) error {
+	data, err := s.format.Encode(frame)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.b.c.Request(s.ctx, &Msg{Subject: upstreamSubject(s.callId), Data: data})
+	return err
+}
+
+// Close stops the stream. If the handler isn't done yet, its context is
+// canceled.
+func (s *Stream) Close() {
+	s.closeOnce.Do(func() {
+		runtime.SetFinalizer(s, nil)
+
+		s.mu.Lock()
+		done := s.done
+		s.mu.Unlock()
+
+		if !done {
+			if err := s.b.c.Publish(&Msg{Subject: cancelSubject(s.callId)}); err != nil {
+				s.b.log.Error("error publishing cancel", "error", err)
+			}
+		}
+
+		s.sub.Unsubscribe()
+		s.cancel()
+	})
+}
+
+// Chan returns a lace channel that receives the values sent by the handler.
+// The channel is closed when the stream ends, after receiving the error the
+// handler failed with, if any. Closing the channel, or the stream's context
+// ending, closes the stream.
+func (s *Stream) Chan(env *core.Env) *core.Channel {
+	out := make(chan core.FutureResult, s.window)
+	ch := core.MakeChannel(out)
+
+	var (
+		// mu is held while delivering, so that the channel isn't closed
+		// under a send.
+		mu     sync.Mutex
+		closed bool
+
+		gone     = make(chan struct{})
+		goneOnce sync.Once
+	)
+
+	// close! means the consumer is done with the stream.
+	ch.OnClose(func() {
+		goneOnce.Do(func() { close(gone) })
+
+		mu.Lock()
+		closed = true
+		mu.Unlock()
+
+		s.Close()
+	})
+
+	deliver := func(res core.FutureResult) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return false
+		}
+
+		select {
+		case out <- res:
+			return true
+		case <-gone:
+			return false
+		case <-s.ctx.Done():
+			return false
+		}
+	}
+
+	// The goroutine reports errors in its own env, as env's engine belongs
+	// to the caller.
+	genv := env.Child()
+
+	go func() {
+		defer ch.Close()
+
+		for {
+			val, err := s.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			if err != nil {
+				deliver(core.MakeFutureResult(core.NIL, core.WrapError(genv, err)))
+				return
+			}
+
+			if !deliver(core.MakeFutureResult(val, nil)) {
+				s.Close()
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Seq returns a lazy seq of the values sent by the handler. Realizing the
+// seq past the error the handler failed with throws it.
+func (s *Stream) Seq() core.Seq {
+	var next core.Proc
+
+	next = core.Proc{
+		Name: "stream-seq",
+		Fn: func(env *core.Env, args []any) (any, error) {
+			val, err := s.Recv()
+			if err == io.EOF {
+				return core.EmptyList, nil
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			return core.NewConsSeq(val, core.NewLazySeq(next)), nil
+		},
+	}
+
+	return core.NewLazySeq(next)
+}
@@ -0,0 +1,421 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrNoResponders is returned by a request to a subject nobody is
+// subscribed to.
+var ErrNoResponders = errors.New("no responders for request")
+
+// Msg is a message carried by a Transport.
+type Msg struct {
+	Subject string
+	Reply   string
+	Header  map[string]string
+	Data    []byte
+}
+
+// Transport moves messages between the connections on a bus. Everything
+// above it, requests, streams, refs and adverts, is built out of publishing
+// to and subscribing to subjects.
+type Transport interface {
+	// Publish sends msg to the subscribers of its subject.
+	Publish(msg *Msg) error
+
+	// Subscribe delivers the messages sent to subject to ch, in the order
+	// they were published. Messages are buffered while ch is full, up to a
+	// limit past which they're dropped, as NATS does for slow consumers.
+	// When queue isn't empty, each message is delivered to only one of the
+	// subscribers with that queue.
+	Subscribe(subject, queue string, ch chan<- *Msg) (Subscription, error)
+
+	// Request publishes msg with a reply subject and waits for the first
+	// reply to it. The reply subject is a new inbox unless msg already has
+	// one.
+	Request(ctx context.Context, msg *Msg) (*Msg, error)
+
+	// NewInbox returns a unique subject to receive replies on.
+	NewInbox() string
+
+	Close() error
+}
+
+type Subscription interface {
+	Unsubscribe() error
+}
+
+// statusHeader is set on the reply a hub sends when a request has no
+// responders.
+const (
+	statusHeader = "Lace-Status"
+	noResponders = "no-responders"
+)
+
+// Dial connects to the bus at the given url. The scheme picks the transport:
+//
+//	nats://host:port   a NATS server
+//	tcp://host:port    a hub serving frames over TCP
+//	unix:///path       a hub serving frames over a unix socket
+//	ws://host/path     a hub serving frames over WebSocket, or wss for TLS
+//	mem://name         the hub with the given name in this process
+func Dial(ctx context.Context, addr string) (Transport, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "nats", "tls":
+		nc, err := nats.Connect(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewNATSTransport(nc), nil
+	case "tcp":
+		return DialConn(ctx, "tcp", u.Host)
+	case "unix":
+		return DialConn(ctx, "unix", u.Path)
+	case "ws", "wss":
+		c, err := dialWebSocket(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewConnTransport(c), nil
+	case "mem":
+		return MemHub(u.Host + u.Path).Transport(), nil
+	default:
+		return nil, fmt.Errorf("unknown bus transport: %s", addr)
+	}
+}
+
+// request implements Request for transports that don't have their own, by
+// subscribing to an inbox for the reply.
+func request(ctx context.Context, t Transport, msg *Msg) (*Msg, error) {
+	ch := make(chan *Msg, 1)
+
+	inbox := msg.Reply
+	if inbox == "" {
+		inbox = t.NewInbox()
+	}
+
+	sub, err := t.Subscribe(inbox, "", ch)
+	if err != nil {
+		return nil, err
+	}
+
+	defer sub.Unsubscribe()
+
+	req := *msg
+	req.Reply = inbox
+
+	err = t.Publish(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case reply := <-ch:
+		if reply.Header[statusHeader] == noResponders {
+			return nil, ErrNoResponders
+		}
+
+		return reply, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newInbox() string {
+	return "_INBOX." + ulid.Make().String()
+}
+
+// respond publishes data as the reply to msg.
+func respond(t Transport, msg *Msg, data []byte) error {
+	if msg.Reply == "" {
+		return fmt.Errorf("message on %s has no reply subject", msg.Subject)
+	}
+
+	return t.Publish(&Msg{Subject: msg.Reply, Data: data})
+}
+
+type funcSub struct {
+	sub  Subscription
+	done chan struct{}
+	once sync.Once
+}
+
+func (s *funcSub) Unsubscribe() error {
+	s.once.Do(func() { close(s.done) })
+	return s.sub.Unsubscribe()
+}
+
+// subscribeFunc calls fn with each message sent to subject, one at a time.
+func subscribeFunc(t Transport, subject string, fn func(*Msg)) (Subscription, error) {
+	ch := make(chan *Msg, 1)
+
+	sub, err := t.Subscribe(subject, "", ch)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := &funcSub{
+		sub:  sub,
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		for {
+			select {
+			case msg := <-ch:
+				fn(msg)
+			case <-fs.done:
+				return
+			}
+		}
+	}()
+
+	return fs, nil
+}
+
+// maxPending is how many messages a queue buffers for a receiver that's
+// behind. Past it, new messages are dropped rather than let a stalled
+// receiver use up memory, like the pending limit of a NATS subscription.
+var maxPending = 65536
+
+// msgQueue delivers messages in order without blocking the publisher,
+// buffering them while the receiver is behind.
+type msgQueue struct {
+	deliver func(msg *Msg, done <-chan struct{})
+
+	mu      sync.Mutex
+	pending []*Msg
+
+	wake chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func newMsgQueue(deliver func(msg *Msg, done <-chan struct{})) *msgQueue {
+	q := &msgQueue{
+		deliver: deliver,
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	go q.pump()
+
+	return q
+}
+
+// newChanQueue returns a queue delivering to ch.
+func newChanQueue(ch chan<- *Msg) *msgQueue {
+	return newMsgQueue(func(msg *Msg, done <-chan struct{}) {
+		select {
+		case ch <- msg:
+		case <-done:
+		}
+	})
+}
+
+func (q *msgQueue) push(msg *Msg) {
+	q.mu.Lock()
+
+	if len(q.pending) >= maxPending {
+		q.mu.Unlock()
+		return
+	}
+
+	q.pending = append(q.pending, msg)
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *msgQueue) pump() {
+	for {
+		q.mu.Lock()
+
+		if len(q.pending) == 0 {
+			q.mu.Unlock()
+
+			select {
+			case <-q.wake:
+				continue
+			case <-q.done:
+				return
+			}
+		}
+
+		msg := q.pending[0]
+		q.pending[0] = nil
+		q.pending = q.pending[1:]
+
+		q.mu.Unlock()
+
+		select {
+		case <-q.done:
+			return
+		default:
+			q.deliver(msg, q.done)
+		}
+	}
+}
+
+// stop drops the pending messages and stops delivering.
+func (q *msgQueue) stop() {
+	q.once.Do(func() { close(q.done) })
+}
+
+type natsTransport struct {
+	c *nats.Conn
+}
+
+// NewNATSTransport returns a Transport that uses a NATS connection.
+func NewNATSTransport(c *nats.Conn) Transport {
+	return &natsTransport{c: c}
+}
+
+func (t *natsTransport) toNATS(msg *Msg) *nats.Msg {
+	nm := &nats.Msg{
+		Subject: msg.Subject,
+		Reply:   msg.Reply,
+		Data:    msg.Data,
+	}
+
+	if len(msg.Header) > 0 {
+		nm.Header = nats.Header{}
+		for k, v := range msg.Header {
+			nm.Header.Set(k, v)
+		}
+	}
+
+	return nm
+}
+
+func fromNATS(nm *nats.Msg) *Msg {
+	msg := &Msg{
+		Subject: nm.Subject,
+		Reply:   nm.Reply,
+		Data:    nm.Data,
+	}
+
+	if len(nm.Header) > 0 {
+		msg.Header = map[string]string{}
+		for k := range nm.Header {
+			msg.Header[k] = nm.Header.Get(k)
+		}
+	}
+
+	return msg
+}
+
+func (t *natsTransport) Publish(msg *Msg) error {
+	return t.c.PublishMsg(t.toNATS(msg))
+}
+
+type natsSub struct {
+	sub *nats.Subscription
+	q   *msgQueue
+}
+
+func (s *natsSub) Unsubscribe() error {
+	s.q.stop()
+	return s.sub.Unsubscribe()
+}
+
+func (t *natsTransport) Subscribe(subject, queue string, ch chan<- *Msg) (Subscription, error) {
+	q := newChanQueue(ch)
+
+	handler := func(nm *nats.Msg) {
+		q.push(fromNATS(nm))
+	}
+
+	var (
+		sub *nats.Subscription
+		err error
+	)
+
+	if queue == "" {
+		sub, err = t.c.Subscribe(subject, handler)
+	} else {
+		sub, err = t.c.QueueSubscribe(subject, queue, handler)
+	}
+
+	if err != nil {
+		q.stop()
+		return nil, err
+	}
+
+	return &natsSub{sub: sub, q: q}, nil
+}
+
+func (t *natsTransport) Request(ctx context.Context, msg *Msg) (*Msg, error) {
+	if msg.Reply != "" {
+		return t.requestTo(ctx, msg)
+	}
+
+	nm, err := t.c.RequestMsgWithContext(ctx, t.toNATS(msg))
+	if err != nil {
+		if errors.Is(err, nats.ErrNoResponders) {
+			return nil, ErrNoResponders
+		}
+
+		return nil, err
+	}
+
+	return fromNATS(nm), nil
+}
+
+// requestTo implements Request for messages that have their reply subject,
+// which the requests of the NATS connection replace with their own.
+func (t *natsTransport) requestTo(ctx context.Context, msg *Msg) (*Msg, error) {
+	sub, err := t.c.SubscribeSync(msg.Reply)
+	if err != nil {
+		return nil, err
+	}
+
+	defer sub.Unsubscribe()
+
+	err = sub.AutoUnsubscribe(1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.c.PublishMsg(t.toNATS(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	nm, err := sub.NextMsgWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nm.Data) == 0 && nm.Header.Get("Status") == "503" {
+		return nil, ErrNoResponders
+	}
+
+	return fromNATS(nm), nil
+}
+
+func (t *natsTransport) NewInbox() string {
+	return nats.NewInbox()
+}
+
+func (t *natsTransport) Close() error {
+	t.c.Close()
+	return nil
+}
@@ -0,0 +1,353 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The frames of the hub protocol are carried as binary WebSocket messages.
+// Only what that needs of RFC 6455 is implemented: no extensions, no
+// subprotocols, and text messages are read like binary ones.
+
+const wsGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	wsContinuation = 0x0
+	wsText         = 0x1
+	wsBinary       = 0x2
+	wsClose        = 0x8
+	wsPing         = 0x9
+	wsPong         = 0xa
+)
+
+func wsAccept(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + wsGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func headerHas(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// WebSocketHandler returns a handler that upgrades requests to WebSocket
+// and serves the hub protocol over them.
+func (h *Hub) WebSocketHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Sec-WebSocket-Key")
+
+		if r.Method != http.MethodGet ||
+			!headerHas(r.Header, "Connection", "upgrade") ||
+			!headerHas(r.Header, "Upgrade", "websocket") ||
+			r.Header.Get("Sec-WebSocket-Version") != "13" ||
+			key == "" {
+			http.Error(w, "expected a websocket upgrade", http.StatusBadRequest)
+			return
+		}
+
+		hj, ok := w.(http.Hijacker)
+		if !ok {
+			http.Error(w, "websocket upgrade not supported", http.StatusInternalServerError)
+			return
+		}
+
+		c, brw, err := hj.Hijack()
+		if err != nil {
+			return
+		}
+
+		fmt.Fprintf(brw, "HTTP/1.1 101 Switching Protocols\r\n"+
+			"Upgrade: websocket\r\n"+
+			"Connection: Upgrade\r\n"+
+			"Sec-WebSocket-Accept: %s\r\n\r\n", wsAccept(key))
+
+		if err := brw.Flush(); err != nil {
+			c.Close()
+			return
+		}
+
+		h.ServeConn(newWSConn(c, brw.Reader, false))
+	})
+}
+
+func dialWebSocket(ctx context.Context, u *url.URL) (io.ReadWriteCloser, error) {
+	host := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "wss" {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+
+	var (
+		c   net.Conn
+		err error
+	)
+
+	if u.Scheme == "wss" {
+		d := tls.Dialer{Config: &tls.Config{ServerName: u.Hostname()}}
+		c, err = d.DialContext(ctx, "tcp", host)
+	} else {
+		var d net.Dialer
+		c, err = d.DialContext(ctx, "tcp", host)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	key := base64.StdEncoding.EncodeToString(nonce[:])
+
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Path: u.Path, RawQuery: u.RawQuery},
+		Host:   u.Host,
+		Header: http.Header{
+			"Upgrade":               {"websocket"},
+			"Connection":            {"Upgrade"},
+			"Sec-WebSocket-Key":     {key},
+			"Sec-WebSocket-Version": {"13"},
+		},
+	}
+
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+
+	if dl, ok := ctx.Deadline(); ok {
+		c.SetDeadline(dl)
+		defer c.SetDeadline(time.Time{})
+	}
+
+	if err := req.Write(c); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(c)
+
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		resp.Header.Get("Sec-WebSocket-Accept") != wsAccept(key) {
+		c.Close()
+		return nil, fmt.Errorf("websocket handshake with %s failed: %s", u, resp.Status)
+	}
+
+	return newWSConn(c, br, true), nil
+}
+
+// wsConn reads and writes the payload of WebSocket messages as a stream.
+type wsConn struct {
+	c  net.Conn
+	br *bufio.Reader
+
+	// client masks the frames it writes, as clients must.
+	client bool
+
+	wmu sync.Mutex
+
+	// The state of the frame being read.
+	remaining uint64
+	mask      [4]byte
+	masked    bool
+	maskPos   int
+}
+
+func newWSConn(c net.Conn, br *bufio.Reader, client bool) *wsConn {
+	return &wsConn{
+		c:      c,
+		br:     br,
+		client: client,
+	}
+}
+
+func (w *wsConn) Read(p []byte) (int, error) {
+	for w.remaining == 0 {
+		if err := w.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+
+	if uint64(len(p)) > w.remaining {
+		p = p[:w.remaining]
+	}
+
+	n, err := w.br.Read(p)
+	w.unmask(p[:n])
+	w.remaining -= uint64(n)
+
+	return n, err
+}
+
+func (w *wsConn) unmask(p []byte) {
+	if !w.masked {
+		return
+	}
+
+	for i := range p {
+		p[i] ^= w.mask[w.maskPos%4]
+		w.maskPos++
+	}
+}
+
+// nextFrame reads the header of the next data frame, handling the control
+// frames before it.
+func (w *wsConn) nextFrame() error {
+	for {
+		var hdr [2]byte
+
+		if _, err := io.ReadFull(w.br, hdr[:]); err != nil {
+			return err
+		}
+
+		op := hdr[0] & 0x0f
+		w.masked = hdr[1]&0x80 != 0
+
+		n := uint64(hdr[1] & 0x7f)
+
+		switch n {
+		case 126:
+			var ext [2]byte
+			if _, err := io.ReadFull(w.br, ext[:]); err != nil {
+				return err
+			}
+
+			n = uint64(binary.BigEndian.Uint16(ext[:]))
+		case 127:
+			var ext [8]byte
+			if _, err := io.ReadFull(w.br, ext[:]); err != nil {
+				return err
+			}
+
+			n = binary.BigEndian.Uint64(ext[:])
+		}
+
+		if w.masked {
+			if _, err := io.ReadFull(w.br, w.mask[:]); err != nil {
+				return err
+			}
+		}
+
+		w.maskPos = 0
+
+		switch op {
+		case wsContinuation, wsText, wsBinary:
+			w.remaining = n
+			return nil
+		case wsClose, wsPing, wsPong:
+			if n > 125 {
+				return errors.New("websocket control frame too large")
+			}
+
+			payload := make([]byte, n)
+			if _, err := io.ReadFull(w.br, payload); err != nil {
+				return err
+			}
+
+			w.unmask(payload)
+
+			switch op {
+			case wsClose:
+				w.writeFrame(wsClose, payload)
+				return io.EOF
+			case wsPing:
+				if err := w.writeFrame(wsPong, payload); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unknown websocket opcode: %d", op)
+		}
+	}
+}
+
+func (w *wsConn) Write(p []byte) (int, error) {
+	if err := w.writeFrame(wsBinary, p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (w *wsConn) writeFrame(op byte, p []byte) error {
+	buf := make([]byte, 0, 14+len(p))
+	buf = append(buf, 0x80|op)
+
+	var maskBit byte
+	if w.client {
+		maskBit = 0x80
+	}
+
+	switch {
+	case len(p) < 126:
+		buf = append(buf, maskBit|byte(len(p)))
+	case len(p) <= 0xffff:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(len(p)))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(len(p)))
+	}
+
+	if w.client {
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return err
+		}
+
+		buf = append(buf, mask[:]...)
+
+		for i, b := range p {
+			buf = append(buf, b^mask[i%4])
+		}
+	} else {
+		buf = append(buf, p...)
+	}
+
+	w.wmu.Lock()
+	defer w.wmu.Unlock()
+
+	_, err := w.c.Write(buf)
+	return err
+}
+
+func (w *wsConn) Close() error {
+	w.writeFrame(wsClose, nil)
+	return w.c.Close()
+}
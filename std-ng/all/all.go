@@ -3,7 +3,10 @@ package all
 import (
 	_ "github.com/lab47/lace/std-ng/base64"
 	_ "github.com/lab47/lace/std-ng/crypto"
+	_ "github.com/lab47/lace/std-ng/errors"
 	_ "github.com/lab47/lace/std-ng/hex"
 	_ "github.com/lab47/lace/std-ng/log"
+	_ "github.com/lab47/lace/std-ng/namespace"
+	_ "github.com/lab47/lace/std-ng/rpc"
 	_ "github.com/lab47/lace/std-ng/string"
 )
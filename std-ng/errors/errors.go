@@ -0,0 +1,127 @@
+package errors
+
+import (
+	"errors"
+
+	"github.com/lab47/lace/core"
+)
+
+func Setup(env *core.Env) error {
+	b := core.NewNSBuilder(env, "lace.errors")
+
+	b.Defn(&core.DefnInfo{
+		Name:  "is?",
+		Args:  []string{"err", "target"},
+		Doc:   "True if any error in err's chain matches target, which may be a Go error value such as os/ErrNotExist.",
+		Added: "1.0",
+		Tag:   "Boolean",
+		Fn:    is,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "as",
+		Args:  []string{"err", "type"},
+		Doc:   "Returns the first error in err's chain that has the given type, or nil if there is none.",
+		Added: "1.0",
+		Fn:    as,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "unwrap",
+		Args:  []string{"err"},
+		Doc:   "Returns the error that err wraps, or nil if it wraps nothing.",
+		Added: "1.0",
+		Fn:    unwrap,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "join",
+		Args:  []string{"&", "errs"},
+		Doc:   "Returns an error that wraps all the given errors, ignoring nils. Returns nil if there are none.",
+		Added: "1.0",
+		Fn:    join,
+	})
+
+	return nil
+}
+
+func init() {
+	core.AddNativeNamespace("lace.errors", Setup)
+}
+
+// goError extracts the Go error from obj, treating nil as no error.
+func goError(env *core.Env, index int, obj any) (error, error) {
+	if obj == core.NIL {
+		return nil, nil
+	}
+
+	err, ok := core.AsGoError(obj)
+	if !ok {
+		return nil, env.NewArgTypeError(index, obj, "Error")
+	}
+
+	return err, nil
+}
+
+func is(env *core.Env, obj, target any) (bool, error) {
+	err, cerr := goError(env, 0, obj)
+	if cerr != nil {
+		return false, cerr
+	}
+
+	tgt, cerr := goError(env, 1, target)
+	if cerr != nil {
+		return false, cerr
+	}
+
+	return errors.Is(err, tgt), nil
+}
+
+func as(env *core.Env, obj any, t core.Type) (any, error) {
+	err, cerr := goError(env, 0, obj)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if err == nil {
+		return core.NIL, nil
+	}
+
+	if found, ok := core.ErrorAs(err, t); ok {
+		return found, nil
+	}
+
+	return core.NIL, nil
+}
+
+func unwrap(env *core.Env, obj any) (any, error) {
+	err, cerr := goError(env, 0, obj)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	if inner := errors.Unwrap(err); inner != nil {
+		return inner, nil
+	}
+
+	return core.NIL, nil
+}
+
+func join(env *core.Env, args []any) (any, error) {
+	var errs []error
+
+	for i, obj := range args {
+		err, cerr := goError(env, i, obj)
+		if cerr != nil {
+			return nil, cerr
+		}
+
+		errs = append(errs, err)
+	}
+
+	if joined := errors.Join(errs...); joined != nil {
+		return joined, nil
+	}
+
+	return core.NIL, nil
+}
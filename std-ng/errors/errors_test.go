@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/lab47/lace/core"
+	_ "github.com/lab47/lace/gen-reflect"
+	_ "github.com/lab47/lace/gen-reflect/stdlib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors(t *testing.T) {
+	eval := func(t *testing.T, e *core.Env, code string) any {
+		obj, err := e.Eval(code)
+		require.NoError(t, err)
+		return obj
+	}
+
+	t.Run("catch matches go sentinel errors", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		for _, code := range []string{
+			`(try (os/ReadFile "/does/not/exist") (catch os/ErrNotExist e :missing))`,
+			`(try (os/ReadFile "/does/not/exist") (catch os.ErrNotExist e :missing))`,
+			`(try (os/ReadFile "/does/not/exist") (catch os/ErrExist e :wrong) (catch os/ErrNotExist e :missing))`,
+		} {
+			r.True(core.Equals(e, core.MakeKeyword("missing"), eval(t, e, code)), code)
+		}
+	})
+
+	t.Run("catch matches go error types", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		for _, code := range []string{
+			`(try (os/ReadFile "/does/not/exist") (catch os/PathError e e))`,
+			`(try (os/ReadFile "/does/not/exist") (catch *os.PathError e e))`,
+			`(try (os/ReadFile "/does/not/exist") (catch *fs.PathError e e))`,
+			`(try (os/ReadFile "/does/not/exist") (catch fs/PathError e e))`,
+			`(try (os/ReadFile "/does/not/exist") (catch *io.fs.PathError e e))`,
+		} {
+			obj := eval(t, e, code)
+			r.IsType(&fs.PathError{}, obj, code)
+		}
+	})
+
+	t.Run("provides lace.errors", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.errors :as errors])`)
+		eval(t, e, `(def missing (try (os/ReadFile "/does/not/exist") (catch Error e e)))`)
+
+		r.Equal(core.MakeBoolean(true), eval(t, e, `(errors/is? missing os/ErrNotExist)`))
+		r.Equal(core.MakeBoolean(false), eval(t, e, `(errors/is? missing os/ErrExist)`))
+		r.IsType(&fs.PathError{}, eval(t, e, `(errors/as missing os/PathError)`))
+		r.IsType(&fs.PathError{}, eval(t, e, `(errors/unwrap missing)`))
+		r.Equal(core.NIL, eval(t, e, `(errors/unwrap nil)`))
+
+		joined := eval(t, e, `(errors/join (ex-info "other" {}) nil missing)`)
+		jerr, ok := joined.(error)
+		r.True(ok)
+		r.ErrorIs(jerr, fs.ErrNotExist)
+	})
+
+	t.Run("ex-info exposes its cause to go", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		obj := eval(t, e, `(try (os/ReadFile "/does/not/exist") (catch Error e (ex-info "wrapped" {:a 1} e)))`)
+
+		exErr, ok := obj.(error)
+		r.True(ok)
+		r.ErrorIs(exErr, fs.ErrNotExist)
+
+		var ee *core.EvalError
+		r.True(errors.As(exErr, &ee))
+		r.Equal("wrapped", ee.Error())
+
+		r.True(core.Equals(e, core.MakeKeyword("missing"),
+			eval(t, e, `(try (throw (ex-info "wrapped" {} (try (os/ReadFile "/does/not/exist") (catch Error e e)))) (catch os/ErrNotExist e :missing))`)))
+	})
+}
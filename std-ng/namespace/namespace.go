@@ -0,0 +1,207 @@
+package namespace
+
+import (
+	"github.com/lab47/lace/core"
+)
+
+func Setup(env *core.Env) error {
+	b := core.NewNSBuilder(env, "lace.tools.namespace")
+
+	b.Defn(&core.DefnInfo{
+		Name:  "refresh",
+		Doc:   "Unloads the namespaces whose files have changed since they were loaded, along with the namespaces that depend on them, and loads them again in dependency order. Returns a vector of the namespaces that were loaded again. If one fails to load, it and those after it are kept as they were and tried again by the next refresh.",
+		Added: "1.0",
+		Tag:   "Vector",
+		Fn:    refresh,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "refresh-all",
+		Doc:   "Like refresh, but unloads and loads again every namespace that was loaded from a file.",
+		Added: "1.0",
+		Tag:   "Vector",
+		Fn:    refreshAll,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "graph",
+		Doc:   "Returns a map of each namespace loaded from a file to a vector of the namespaces its ns form requires or uses.",
+		Added: "1.0",
+		Tag:   "Map",
+		Fn:    graph,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "dependents",
+		Args:  []string{"&", "names"},
+		Doc:   "Returns a vector of the named namespaces and every namespace that depends on them, in the order refresh would load them.",
+		Added: "1.0",
+		Tag:   "Vector",
+		Fn:    dependents,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "watch-and-reload!",
+		Args: []string{"opts"},
+		Doc: `Watches directories for changes to .clj files and refreshes the namespaces loaded from them, along with those that depend on them, in the background. Errors reloading are printed, and the namespaces that failed are tried again on the next change. Returns the watcher, which stop-watch! stops.
+
+  opts is a map of:
+    :paths          directories to watch, defaulting to those on *classpath*
+    :before-reload  fn called with a vector of the namespaces about to be reloaded
+    :after-reload   fn called with a vector of the reloaded namespaces, once they all
+                    reloaded, such as to rebind a server's handlers`,
+		Added: "1.0",
+		Fn:    watchAndReload,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name:  "stop-watch!",
+		Args:  []string{"watcher"},
+		Doc:   "Stops a watcher started by watch-and-reload!.",
+		Added: "1.0",
+		Fn:    stopWatch,
+	})
+
+	return nil
+}
+
+func init() {
+	core.AddNativeNamespace("lace.tools.namespace", Setup)
+}
+
+func symbols(names []string) *core.Vector {
+	v := core.EmptyVector()
+	for _, name := range names {
+		v, _ = v.Conjoin(core.MakeSymbol(name))
+	}
+
+	return v
+}
+
+func refresh(env *core.Env) (any, error) {
+	loaded, err := env.Refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	return symbols(loaded), nil
+}
+
+func refreshAll(env *core.Env) (any, error) {
+	loaded, err := env.RefreshAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return symbols(loaded), nil
+}
+
+func graph(env *core.Env) (any, error) {
+	var m core.Associative = core.EmptyArrayMap()
+
+	for _, n := range env.NSGraph().Nodes() {
+		var err error
+
+		m, err = m.Assoc(env, core.MakeSymbol(n.Name), symbols(n.Deps))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func dependents(env *core.Env, args []any) (any, error) {
+	var names []string
+
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case core.Symbol:
+			names = append(names, arg.Name())
+		case *core.Namespace:
+			names = append(names, arg.Name.Name())
+		default:
+			return nil, env.NewArgTypeError(i, arg, "Symbol")
+		}
+	}
+
+	return symbols(env.NSGraph().Dependents(names)), nil
+}
+
+func watchAndReload(env *core.Env, opts any) (any, error) {
+	m, ok := opts.(core.Gettable)
+	if !ok {
+		return nil, env.NewArgTypeError(0, opts, "Map")
+	}
+
+	var wo WatchOptions
+
+	if ok, paths, _ := m.Get(env, core.MakeKeyword("paths")); ok && paths != core.NIL {
+		seq, ok := paths.(core.Seqable)
+		if !ok {
+			return nil, env.NewError(":paths must be a vector of strings")
+		}
+
+		elems, err := core.ToSlice(env, seq.Seq())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, elem := range elems {
+			s, ok := elem.(core.String)
+			if !ok {
+				return nil, env.NewError(":paths must be a vector of strings")
+			}
+
+			wo.Paths = append(wo.Paths, s.S())
+		}
+	}
+
+	// The hooks are called by the watcher, apart from the caller.
+	henv := env.Child()
+
+	hook := func(key string) (func([]string) error, error) {
+		ok, fn, _ := m.Get(env, core.MakeKeyword(key))
+		if !ok || fn == core.NIL {
+			return nil, nil
+		}
+
+		callable, ok := fn.(core.Callable)
+		if !ok {
+			return nil, env.NewError("%s must be a function", ":"+key)
+		}
+
+		return func(namespaces []string) error {
+			_, err := callable.Call(henv, []any{symbols(namespaces)})
+			return err
+		}, nil
+	}
+
+	var err error
+
+	wo.BeforeReload, err = hook("before-reload")
+	if err != nil {
+		return nil, err
+	}
+
+	wo.AfterReload, err = hook("after-reload")
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := Watch(env, wo)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func stopWatch(env *core.Env, watcher any) (any, error) {
+	w, ok := watcher.(*Watcher)
+	if !ok {
+		return nil, env.NewArgTypeError(0, watcher, "Watcher")
+	}
+
+	return core.NIL, w.Close()
+}
@@ -0,0 +1,114 @@
+package namespace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lab47/lace/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespace(t *testing.T) {
+	r := require.New(t)
+
+	e, err := core.NewEnv()
+	r.NoError(err)
+
+	dir := t.TempDir()
+	r.NoError(os.MkdirAll(filepath.Join(dir, "app"), 0755))
+
+	write := func(name, code string) {
+		path := filepath.Join(dir, name)
+		r.NoError(os.WriteFile(path, []byte(code), 0644))
+
+		// Push the mtime forward so a rewrite within the same tick is seen.
+		mt := time.Now().Add(time.Duration(len(code)) * time.Second)
+		r.NoError(os.Chtimes(path, mt, mt))
+	}
+
+	write("app/util.clj", "(ns app.util)\n(def n 1)\n")
+	write("app/core.clj", "(ns app.core (:require [app.util :as u]))\n(defn n [] u/n)\n")
+
+	e.SetClassPath(dir)
+
+	eval := func(code string) any {
+		obj, err := e.Eval(code)
+		r.NoError(err)
+		return obj
+	}
+
+	check := func(code string) {
+		r.Equal(core.MakeBoolean(true), eval(code), code)
+	}
+
+	eval(`(require 'lace.tools.namespace 'app.core)`)
+
+	check(`(= {'app.core '[app.util] 'app.util []} (lace.tools.namespace/graph))`)
+	check(`(= '[app.util app.core] (lace.tools.namespace/dependents 'app.util))`)
+
+	write("app/util.clj", "(ns app.util)\n(def n 2)\n")
+
+	check(`(= '[app.util app.core] (lace.tools.namespace/refresh))`)
+	check(`(= 2 (app.core/n))`)
+	check(`(empty? (lace.tools.namespace/refresh))`)
+}
+
+func TestWatch(t *testing.T) {
+	r := require.New(t)
+
+	e, err := core.NewEnv()
+	r.NoError(err)
+
+	dir := t.TempDir()
+	r.NoError(os.MkdirAll(filepath.Join(dir, "app"), 0755))
+	r.NoError(os.WriteFile(filepath.Join(dir, "app", "util.clj"), []byte("(ns app.util)\n(def n 1)\n"), 0644))
+	r.NoError(os.WriteFile(filepath.Join(dir, "app", "core.clj"), []byte("(ns app.core (:require [app.util :as u]))\n(defn n [] u/n)\n"), 0644))
+
+	e.SetClassPath(dir)
+
+	_, err = e.Eval(`(require 'lace.tools.namespace 'app.core)`)
+	r.NoError(err)
+
+	before := make(chan *core.Vector, 1)
+	after := make(chan *core.Vector, 1)
+
+	w, err := Watch(e, WatchOptions{
+		BeforeReload: func(namespaces []string) error {
+			before <- symbols(namespaces)
+			return nil
+		},
+		AfterReload: func(namespaces []string) error {
+			after <- symbols(namespaces)
+			return nil
+		},
+	})
+	r.NoError(err)
+	defer w.Close()
+
+	path := filepath.Join(dir, "app", "util.clj")
+	r.NoError(os.WriteFile(path, []byte("(ns app.util)\n(def n 2)\n"), 0644))
+
+	// Push the mtime forward so the rewrite is seen even on coarse clocks.
+	mt := time.Now().Add(time.Minute)
+	r.NoError(os.Chtimes(path, mt, mt))
+
+	select {
+	case v := <-before:
+		r.Equal(2, v.Count())
+	case <-time.After(5 * time.Second):
+		r.FailNow("no reload")
+	}
+
+	select {
+	case v := <-after:
+		r.Equal(2, v.Count())
+	case <-time.After(5 * time.Second):
+		r.FailNow("no reload")
+	}
+
+	obj, err := e.Eval(`(app.core/n)`)
+	r.NoError(err)
+	r.True(core.Equals(e, obj, core.MakeInt(2)))
+}
@@ -0,0 +1,191 @@
+package namespace
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/lab47/lace/core"
+)
+
+// settle is how long a watcher waits after a change for others to follow,
+// so that saving several files reloads them once.
+const settle = 100 * time.Millisecond
+
+// WatchOptions configures a Watcher.
+type WatchOptions struct {
+	// Paths are the directories to watch, along with those beneath them.
+	// Defaults to the directories on *classpath*, or the current directory
+	// if it has none.
+	Paths []string
+
+	// BeforeReload is called with the namespaces about to be reloaded.
+	BeforeReload func(namespaces []string) error
+
+	// AfterReload is called with the namespaces that were reloaded, once
+	// they all have been.
+	AfterReload func(namespaces []string) error
+}
+
+// Watcher reloads the namespaces of an Env as their files change, along
+// with those that depend on them.
+type Watcher struct {
+	env  *core.Env
+	opts WatchOptions
+	fsw  *fsnotify.Watcher
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// Watch starts watching opts.Paths for changes to .clj files, refreshing
+// env when they change. Errors reloading are printed rather than returned,
+// and the namespaces that failed are tried again on the next change.
+func Watch(env *core.Env, opts WatchOptions) (*Watcher, error) {
+	if len(opts.Paths) == 0 {
+		opts.Paths = env.ClassPathDirs()
+	}
+
+	if len(opts.Paths) == 0 {
+		opts.Paths = []string{"."}
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		env:  env.Child(),
+		opts: opts,
+		fsw:  fsw,
+		done: make(chan struct{}),
+	}
+
+	for _, path := range opts.Paths {
+		err := w.add(path)
+		if err != nil {
+			fsw.Close()
+			return nil, err
+		}
+	}
+
+	w.wg.Add(1)
+	go w.loop()
+
+	return w, nil
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	select {
+	case <-w.done:
+		return nil
+	default:
+	}
+
+	close(w.done)
+	err := w.fsw.Close()
+	w.wg.Wait()
+
+	return err
+}
+
+// add watches dir and the directories beneath it, as fsnotify only
+// watches the directories it's given. Hidden directories are skipped.
+func (w *Watcher) add(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		return w.fsw.Add(path)
+	})
+}
+
+func (w *Watcher) loop() {
+	defer w.wg.Done()
+
+	timer := time.NewTimer(settle)
+	timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+		case ev, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+
+			if ev.Has(fsnotify.Create) {
+				if fi, err := os.Stat(ev.Name); err == nil && fi.IsDir() {
+					if err := w.add(ev.Name); err != nil {
+						fmt.Fprintf(core.Stderr, "error watching %s: %s\n", ev.Name, err)
+					}
+				}
+			}
+
+			if filepath.Ext(ev.Name) == ".clj" {
+				timer.Reset(settle)
+			}
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+
+			fmt.Fprintf(core.Stderr, "error watching files: %s\n", err)
+		case <-timer.C:
+			w.reload()
+		}
+	}
+}
+
+// reload refreshes the env, calling the hooks around it. Errors are
+// printed, and AfterReload is only called if every namespace reloaded.
+func (w *Watcher) reload() {
+	g := w.env.NSGraph()
+
+	changed, removed := g.Changed()
+	if len(changed) == 0 && len(removed) == 0 {
+		return
+	}
+
+	if w.opts.BeforeReload != nil {
+		err := w.opts.BeforeReload(g.Dependents(append(changed, removed...)))
+		if err != nil {
+			core.DisplayError(w.env, fmt.Errorf("before-reload failed: %w", err))
+		}
+	}
+
+	loaded, err := w.env.Refresh()
+	if len(loaded) > 0 {
+		fmt.Fprintf(core.Stderr, "reloaded %s\n", strings.Join(loaded, " "))
+	}
+
+	if err != nil {
+		core.DisplayError(w.env, err)
+		return
+	}
+
+	if w.opts.AfterReload != nil {
+		err := w.opts.AfterReload(loaded)
+		if err != nil {
+			core.DisplayError(w.env, fmt.Errorf("after-reload failed: %w", err))
+		}
+	}
+}
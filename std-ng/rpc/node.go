@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/rpc"
+)
+
+func evalOn(env *core.Env, node any, form any) (any, error) {
+	name, err := nameOf(env, node)
+	if err != nil {
+		return nil, err
+	}
+
+	// The form is compiled here, so the node only needs the vars it
+	// references.
+	expr, err := core.Parse(form, &core.ParseContext{Env: env})
+	if err != nil {
+		return nil, err
+	}
+
+	fn, err := core.Compile(env, []core.Expr{expr})
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.RunOn(envContext(env), name, fn, nil)
+}
+
+func spawnOn(env *core.Env, node any, f *core.Fn, args any) (*core.Channel, error) {
+	name, err := nameOf(env, node)
+	if err != nil {
+		return nil, err
+	}
+
+	var fargs []any
+
+	if args != core.NIL {
+		seqable, err := core.AssertSeqable(env, args, "")
+		if err != nil {
+			return nil, err
+		}
+
+		fargs, err = core.ToSlice(env, seqable.Seq())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like go, the result is delivered on a channel.
+	out := make(chan core.FutureResult, 1)
+	ch := core.MakeChannel(out)
+
+	go func() {
+		var cerr core.Error
+
+		val, err := conn.RunOn(envContext(env), name, f, fargs)
+		if err != nil {
+			cerr = core.WrapError(env, err)
+		}
+
+		out <- core.MakeFutureResult(val, cerr)
+		ch.Close()
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,210 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/rpc"
+)
+
+func enqueue(env *core.Env, queue any, val any) error {
+	name, err := nameOf(env, queue)
+	if err != nil {
+		return err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return err
+	}
+
+	return conn.Enqueue(envContext(env), name, val)
+}
+
+// Consumer is a handler taking the jobs of a durable queue.
+type Consumer struct {
+	queue   string
+	handler core.Callable
+
+	qc   *rpc.QueueConsumer
+	done chan struct{}
+}
+
+func consume(env *core.Env, queue any, handler core.Callable) (*Consumer, error) {
+	name, err := nameOf(env, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like endpoints, consumers stop along with the scope they're started
+	// from.
+	qc, err := conn.Consume(envContext(env), name)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Consumer{
+		queue:   name,
+		handler: handler,
+		qc:      qc,
+		done:    make(chan struct{}),
+	}
+
+	go c.run(env)
+
+	return c, nil
+}
+
+// retryDelay is how long a job that failed waits before it's redelivered,
+// growing with each attempt up to a minute.
+func retryDelay(attempt int) time.Duration {
+	return min(time.Duration(attempt)*time.Second, time.Minute)
+}
+
+func (c *Consumer) run(env *core.Env) {
+	defer close(c.done)
+
+	for {
+		job, err := c.qc.Next()
+		if err != nil {
+			return
+		}
+
+		if err := c.handle(env, job); err != nil {
+			job.Nack(retryDelay(job.Attempt))
+			continue
+		}
+
+		job.Ack()
+	}
+}
+
+func (c *Consumer) handle(env *core.Env, job *rpc.Job) error {
+	env = env.Child()
+
+	_, err := c.handler.Call(env, []any{job.Value})
+	return err
+}
+
+// Stop stops taking jobs, waiting for the one being handled to finish.
+func (c *Consumer) Stop() {
+	c.qc.Stop()
+	<-c.done
+}
+
+func (c *Consumer) ToString(env *core.Env, escape bool) (string, error) {
+	return fmt.Sprintf("#Consumer[%s]", c.queue), nil
+}
+
+func publish(env *core.Env, topic any, val any) error {
+	name, err := nameOf(env, topic)
+	if err != nil {
+		return err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return err
+	}
+
+	return conn.Publish(name, val)
+}
+
+// topicSub delivers the values of a topic to the channel returned by
+// subscribe.
+type topicSub struct {
+	ch   *core.Channel
+	out  chan core.FutureResult
+	sub  rpc.Subscription
+	done chan struct{}
+
+	// mu is held while delivering, so that the channel isn't closed under
+	// a send.
+	mu     sync.Mutex
+	closed bool
+}
+
+var (
+	topicSubsMu sync.Mutex
+	topicSubs   = map[*core.Channel]*topicSub{}
+)
+
+func (ts *topicSub) deliver(val any) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return
+	}
+
+	select {
+	case ts.out <- core.MakeFutureResult(val, nil):
+	case <-ts.done:
+	}
+}
+
+func (ts *topicSub) stop() {
+	close(ts.done)
+	ts.sub.Unsubscribe()
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.closed = true
+	ts.ch.Close()
+}
+
+func subscribe(env *core.Env, topic any) (*core.Channel, error) {
+	name, err := nameOf(env, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan core.FutureResult)
+
+	ts := &topicSub{
+		ch:   core.MakeChannel(out),
+		out:  out,
+		done: make(chan struct{}),
+	}
+
+	ts.sub, err = conn.Subscribe(name, ts.deliver)
+	if err != nil {
+		return nil, err
+	}
+
+	topicSubsMu.Lock()
+	topicSubs[ts.ch] = ts
+	topicSubsMu.Unlock()
+
+	// The subscription ends along with the scope it's made from.
+	if ctx := env.Context; ctx != nil {
+		context.AfterFunc(ctx, func() { unsubscribe(ts.ch) })
+	}
+
+	return ts.ch, nil
+}
+
+func unsubscribe(ch *core.Channel) {
+	topicSubsMu.Lock()
+	ts, ok := topicSubs[ch]
+	delete(topicSubs, ch)
+	topicSubsMu.Unlock()
+
+	if ok {
+		ts.stop()
+	}
+}
@@ -0,0 +1,78 @@
+(def url
+  "The url of the bus to connect to. When nil, LACE_RPC_URL is used, and
+  failing that a bus is started in process."
+  nil)
+
+(def store
+  "The directory the bus started in process keeps its durable queues in.
+  When nil, LACE_RPC_STORE is used, and failing that ~/.laced/bus. Queues
+  on a bus given by url need a NATS server with JetStream."
+  nil)
+
+(def format
+  "The format requests are marshaled with, :cbor, :json or :edn. When nil,
+  LACE_RPC_FORMAT is used, and failing that :cbor. Handlers respond in the
+  format of each request, so JSON can be used to call services that aren't
+  written in lace."
+  nil)
+
+(defn call
+  "Calls method of service with args and returns the response. Errors
+  thrown by the handler are thrown by call."
+  [service method & args]
+  (call* (str service) method args))
+
+(defn cast
+  "Sends a request to method of service with args, without waiting for it
+  to be handled."
+  [service method & args]
+  (cast* (str service) method args))
+
+(defn spawn-on
+  "Calls f with args on the named node, started with lace node. The code of
+  f is sent along with the values it closes over, and the vars it uses are
+  resolved by name on the node. Returns a channel receiving the result."
+  [node f & args]
+  (spawn-on* node f args))
+
+(defn stop!
+  "Stops serving endpoint, which may be the var defined by defendpoint, or
+  stops a consumer returned by consume. Requests or jobs being handled are
+  let finish."
+  [endpoint]
+  (stop* (if (var? endpoint) @endpoint endpoint)))
+
+(defn- endpoint-var [service method]
+  (symbol (str (apply str (replace {\. \-} (str service))) "-" (name method))))
+
+(defmacro defendpoint
+  "Serves method of service, calling body with the arguments of each request
+  bound to args. An options map may come before args, with the :tags the
+  endpoint is advertised with:
+
+    (defendpoint svc.greeter hello {:tags {:region \"eu\"}} [who]
+      (str \"hello \" who))
+
+  An :allow tag lists the keys of the callers allowed to call the endpoint,
+  as returned by identity on their side, or \"*\" for any signed caller.
+
+  The endpoint is defined as a var named after the service and method, such
+  as svc-greeter-hello. It's served until stopped with stop!, or until the
+  scope it's served from ends."
+  [service method & decl]
+  (let [opts (if (map? (first decl)) (first decl) {})
+        decl (if (map? (first decl)) (rest decl) decl)
+        args (first decl)
+        body (rest decl)]
+    `(def ~(endpoint-var service method)
+       (serve ~(str service) ~(keyword (name method)) ~(:tags opts) (fn ~args ~@body)))))
+
+(defmacro with-endpoints
+  "Evaluates the endpoints, such as defendpoint forms, then body, stopping
+  the endpoints once body is done."
+  [endpoints & body]
+  `(let [eps# ~endpoints]
+     (try
+       ~@body
+       (finally
+         (doseq [ep# eps#] (stop! ep#))))))
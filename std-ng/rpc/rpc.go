@@ -0,0 +1,575 @@
+package rpc
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/rpc"
+)
+
+func Setup(env *core.Env) error {
+	b := core.NewNSBuilder(env, "lace.rpc")
+
+	b.Defn(&core.DefnInfo{
+		Name: "connection",
+		Doc:  "Returns the bus connection, connecting to the bus at url or LACE_RPC_URL, or starting one in process.",
+		Fn:   rpc.InitBusConnection,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "serve",
+		Doc:  "Serves method of service on the bus by calling handler with the arguments of each request, advertising it with tags.",
+		Args: []string{"service", "method", "tags", "handler"},
+		Fn:   serve,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "stop*",
+		Doc:  "Stops serving an endpoint or consuming a queue, waiting for the requests or jobs being handled to finish.",
+		Args: []string{"endpoint"},
+		Fn:   stop,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "call*",
+		Doc:  "Calls method of service with args, returning the response.",
+		Args: []string{"service", "method", "args"},
+		Fn:   call,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "cast*",
+		Doc:  "Sends a request to method of service with args, without waiting for a response.",
+		Args: []string{"service", "method", "args"},
+		Fn:   cast,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "enqueue",
+		Doc:  "Adds val to the durable queue, where it's kept until a consumer handles it.",
+		Args: []string{"queue", "val"},
+		Fn:   enqueue,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "consume",
+		Doc:  "Calls handler with each job taken from the durable queue, acking the job when handler returns and redelivering it when handler throws. Returns a consumer to stop with stop!.",
+		Args: []string{"queue", "handler"},
+		Fn:   consume,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "publish",
+		Doc:  "Sends val to the current subscribers of topic.",
+		Args: []string{"topic", "val"},
+		Fn:   publish,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "subscribe",
+		Doc:  "Returns a channel receiving the values published to topic, until it's passed to unsubscribe.",
+		Args: []string{"topic"},
+		Fn:   subscribe,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "unsubscribe",
+		Doc:  "Stops the subscription of a channel returned by subscribe, and closes the channel.",
+		Args: []string{"ch"},
+		Fn:   unsubscribe,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "eval-on",
+		Doc:  "Compiles form and evaluates it on the named node, returning the result.",
+		Args: []string{"node", "form"},
+		Fn:   evalOn,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "spawn-on*",
+		Doc:  "Calls f with args on the named node, returning a channel receiving the result.",
+		Args: []string{"node", "f", "args"},
+		Fn:   spawnOn,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "identity",
+		Doc:  "Returns the key the connection signs its adverts and requests with, as listed in the allow tag of endpoints.",
+		Fn:   identity,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "trust",
+		Doc:  "Only accepts the adverts signed by the given keys from now on.",
+		Args: []string{"keys"},
+		Fn:   trust,
+	})
+
+	b.Defn(&core.DefnInfo{
+		Name: "find",
+		Doc:  "Returns the capabilities advertised on the bus that match query.",
+		Args: []string{"query"},
+		Fn:   find,
+	})
+
+	return b.Run(code)
+}
+
+//go:embed rpc.clj
+var code []byte
+
+func init() {
+	core.AddNativeNamespace("lace.rpc", Setup)
+}
+
+// nameOf returns the name of a string, keyword or symbol.
+func nameOf(env *core.Env, obj any) (string, error) {
+	switch v := obj.(type) {
+	case core.String:
+		return v.S(), nil
+	case core.Keyword:
+		return v.Name(), nil
+	case core.Symbol:
+		return v.Name(), nil
+	default:
+		return "", env.NewError("expected a string, keyword or symbol, got %s", core.TypeName(obj))
+	}
+}
+
+// subject returns the bus subject that requests to method of service are
+// sent to. Each method has its own, so that the endpoints serving the
+// methods of a service don't take each other's requests.
+func subject(service, method string) string {
+	return service + "." + method
+}
+
+func envContext(env *core.Env) context.Context {
+	if env.Context != nil {
+		return env.Context
+	}
+
+	return context.Background()
+}
+
+// Endpoint is a handler serving a method of a service on the bus.
+type Endpoint struct {
+	service string
+	method  string
+	handler core.Callable
+
+	l  rpc.Listener
+	ad rpc.Advertisement
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// inflight tracks the requests being handled, which are let finish
+	// when the endpoint stops.
+	inflight sync.WaitGroup
+}
+
+func serve(env *core.Env, service string, method any, tags any, handler core.Callable) (*Endpoint, error) {
+	mname, err := nameOf(env, method)
+	if err != nil {
+		return nil, err
+	}
+
+	caps := &rpc.Capabilities{
+		Endpoint: service,
+		Method:   mname,
+	}
+
+	caps.Tags, err = toTags(env, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := conn.Listen(subject(service, mname))
+	if err != nil {
+		return nil, err
+	}
+
+	// The allow tag of the endpoint restricts who can call it.
+	conn.Authorize(subject(service, mname), caps.Tags)
+
+	ad, err := conn.Advertise(caps)
+	if err != nil {
+		l.Close()
+		return nil, err
+	}
+
+	// The endpoint stops along with the scope it's served from.
+	ctx, cancel := context.WithCancel(envContext(env))
+
+	ep := &Endpoint{
+		service: service,
+		method:  mname,
+		handler: handler,
+		l:       l,
+		ad:      ad,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	go ep.run(ctx, env)
+
+	return ep, nil
+}
+
+func (ep *Endpoint) run(ctx context.Context, env *core.Env) {
+	defer close(ep.done)
+
+	for {
+		r, err := ep.l.Accept(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			// Requests that can't be decoded have nobody to report to.
+			continue
+		}
+
+		ep.inflight.Add(1)
+
+		go func() {
+			defer ep.inflight.Done()
+			ep.handle(env, r)
+		}()
+	}
+
+	ep.ad.Clear()
+	ep.l.Close()
+	ep.inflight.Wait()
+}
+
+func (ep *Endpoint) handle(env *core.Env, r rpc.RPC) {
+	env = env.Child()
+
+	err := env.SetContext(r.Context())
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	args, err := core.ToSlice(env, r.Request().Arguments)
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	val, err := ep.handler.Call(env, args)
+	if err != nil {
+		r.RespondError(err)
+		return
+	}
+
+	r.Respond(val)
+}
+
+// Stop stops accepting requests, withdraws the advertisement of the
+// endpoint and waits for the requests being handled to finish.
+func (ep *Endpoint) Stop() {
+	ep.cancel()
+	<-ep.done
+}
+
+func (ep *Endpoint) ToString(env *core.Env, escape bool) (string, error) {
+	return fmt.Sprintf("#Endpoint[%s %s]", ep.service, ep.method), nil
+}
+
+func stop(env *core.Env, obj any) error {
+	switch v := obj.(type) {
+	case *Endpoint:
+		v.Stop()
+	case *Consumer:
+		v.Stop()
+	default:
+		return env.NewError("expected an endpoint or a consumer, got %s", core.TypeName(obj))
+	}
+
+	return nil
+}
+
+func call(env *core.Env, service string, method any, args any) (any, error) {
+	resp, err := exchange(env, service, method, args, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+func cast(env *core.Env, service string, method any, args any) error {
+	_, err := exchange(env, service, method, args, true)
+	return err
+}
+
+func exchange(env *core.Env, service string, method any, args any, noResponse bool) (*rpc.Response, error) {
+	mname, err := nameOf(env, method)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &rpc.Request{
+		Endpoint:   subject(service, mname),
+		Method:     mname,
+		NoResponse: noResponse,
+	}
+
+	if args != core.NIL {
+		seqable, err := core.AssertSeqable(env, args, "")
+		if err != nil {
+			return nil, err
+		}
+
+		req.Arguments = seqable.Seq()
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.Exchange(envContext(env), req)
+}
+
+func identity(env *core.Env) (string, error) {
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return "", err
+	}
+
+	return conn.Identity().Key(), nil
+}
+
+func trust(env *core.Env, keys any) error {
+	vals, err := tagValues(env, keys)
+	if err != nil {
+		return err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return err
+	}
+
+	conn.Trust(vals...)
+
+	return nil
+}
+
+// toTags converts a map of tag names to a value or a collection of values
+// into the tags of capabilities.
+func toTags(env *core.Env, obj any) (map[string][]string, error) {
+	tags := map[string][]string{}
+
+	if obj == core.NIL {
+		return tags, nil
+	}
+
+	m, ok := obj.(core.Map)
+	if !ok {
+		return nil, env.NewError("tags must be a map, got %s", core.TypeName(obj))
+	}
+
+	for iter := m.Iter(); iter.HasNext(); {
+		p := iter.Next()
+
+		k, err := nameOf(env, p.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		vals, err := tagValues(env, p.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		tags[k] = vals
+	}
+
+	return tags, nil
+}
+
+func tagValues(env *core.Env, obj any) ([]string, error) {
+	_, isStr := obj.(core.String)
+
+	seqable, ok := obj.(core.Seqable)
+	if !ok || isStr {
+		v, err := nameOf(env, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{v}, nil
+	}
+
+	items, err := core.ToSlice(env, seqable.Seq())
+	if err != nil {
+		return nil, err
+	}
+
+	var vals []string
+
+	for _, item := range items {
+		v, err := nameOf(env, item)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, v)
+	}
+
+	return vals, nil
+}
+
+// capQuery matches capabilities by service, method and tags. A query tag
+// matches when the capabilities have that value among the values of the tag.
+type capQuery struct {
+	service string
+	method  string
+	tags    map[string][]string
+}
+
+func parseQuery(env *core.Env, obj any) (*capQuery, error) {
+	q := &capQuery{tags: map[string][]string{}}
+
+	if obj == core.NIL {
+		return q, nil
+	}
+
+	m, ok := obj.(core.Map)
+	if !ok {
+		return nil, env.NewError("query must be a map, got %s", core.TypeName(obj))
+	}
+
+	for iter := m.Iter(); iter.HasNext(); {
+		p := iter.Next()
+
+		k, err := nameOf(env, p.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		switch k {
+		case "service":
+			q.service, err = nameOf(env, p.Value)
+		case "method":
+			q.method, err = nameOf(env, p.Value)
+		case "tag":
+			// A single tag given as "name=value".
+			var tag string
+
+			tag, err = nameOf(env, p.Value)
+			if err == nil {
+				name, val, _ := strings.Cut(tag, "=")
+				q.tags[name] = append(q.tags[name], val)
+			}
+		case "tags":
+			var tags map[string][]string
+
+			tags, err = toTags(env, p.Value)
+			for name, vals := range tags {
+				q.tags[name] = append(q.tags[name], vals...)
+			}
+		default:
+			return nil, env.NewError("unknown query key: %s", k)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
+func (q *capQuery) match(cp *rpc.Capabilities) bool {
+	if q.service != "" && q.service != cp.Endpoint {
+		return false
+	}
+
+	if q.method != "" && q.method != cp.Method {
+		return false
+	}
+
+	for name, vals := range q.tags {
+		for _, v := range vals {
+			if !slices.Contains(cp.Tags[name], v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func find(env *core.Env, query any) (any, error) {
+	q, err := parseQuery(env, query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := rpc.InitBusConnection(env)
+	if err != nil {
+		return nil, err
+	}
+
+	caps := conn.BrowseCapabilities()
+
+	slices.SortFunc(caps, func(a, b *rpc.Capabilities) int {
+		if c := strings.Compare(a.Endpoint, b.Endpoint); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Method, b.Method)
+	})
+
+	var found []any
+
+	for _, cp := range caps {
+		if !q.match(cp) {
+			continue
+		}
+
+		m, err := capMap(env, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		found = append(found, m)
+	}
+
+	return core.NewVectorFrom(found...), nil
+}
+
+func capMap(env *core.Env, cp *rpc.Capabilities) (any, error) {
+	var tags []any
+
+	for name, vals := range cp.Tags {
+		tags = append(tags, core.MakeString(name), core.MakeStringVector(vals))
+	}
+
+	tm, err := core.NewHashMap(env, tags...)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewHashMap(env,
+		core.MakeKeyword("service"), core.MakeString(cp.Endpoint),
+		core.MakeKeyword("method"), core.MakeKeyword(cp.Method),
+		core.MakeKeyword("tags"), tm,
+	)
+}
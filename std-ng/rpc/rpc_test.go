@@ -0,0 +1,222 @@
+package rpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lab47/lace/core"
+	"github.com/lab47/lace/pkg/marshal"
+	prpc "github.com/lab47/lace/pkg/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPC(t *testing.T) {
+	// The buses started in process keep their queues under the home
+	// directory.
+	t.Setenv("HOME", t.TempDir())
+
+	eval := func(t *testing.T, e *core.Env, code string) any {
+		obj, err := e.Eval(code)
+		require.NoError(t, err)
+		return obj
+	}
+
+	t.Run("calls endpoints defined with defendpoint", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/defendpoint svc.greeter hello [who] (str "hello " who))`)
+		defer eval(t, e, `(rpc/stop! #'svc-greeter-hello)`)
+
+		r.Equal(core.MakeString("hello bob"), eval(t, e, `(rpc/call 'svc.greeter :hello "bob")`))
+		r.Equal(core.NIL, eval(t, e, `(rpc/cast "svc.greeter" :hello "bob")`))
+	})
+
+	t.Run("throws the errors of the handler", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/defendpoint svc.greeter fail [] (throw (ex-info "nope" {:x 1})))`)
+		defer eval(t, e, `(rpc/stop! #'svc-greeter-fail)`)
+
+		obj := eval(t, e, `(try (rpc/call "svc.greeter" :fail) (catch Error e (:x (ex-data e))))`)
+		r.Equal(core.MakeInt(1), obj)
+	})
+
+	t.Run("finds endpoints by tag until they're stopped", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/defendpoint svc.store get {:tags {:region "eu"}} [k] k)`)
+		eval(t, e, `(rpc/defendpoint svc.store put {:tags {:region ["us" "ap"]}} [k v] v)`)
+
+		r.Equal(core.MakeInt(1), eval(t, e, `(count (rpc/find {:tag "region=eu"}))`))
+		r.Equal(core.MakeKeyword("put"), eval(t, e, `(:method (first (rpc/find {:tags {:region "ap"}})))`))
+		r.Equal(core.MakeInt(2), eval(t, e, `(count (rpc/find {:service "svc.store"}))`))
+
+		eval(t, e, `(rpc/stop! #'svc-store-get)`)
+
+		r.Equal(core.MakeInt(0), eval(t, e, `(count (rpc/find {:tag "region=eu"}))`))
+
+		eval(t, e, `(rpc/stop! #'svc-store-put)`)
+	})
+
+	t.Run("only lets allowed callers call an endpoint", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/defendpoint svc.vault open {:tags {:allow ["nobody"]}} [] :opened)`)
+		eval(t, e, `(rpc/defendpoint svc.vault peek {:tags {:allow [(rpc/identity)]}} [] :peeked)`)
+		defer eval(t, e, `(rpc/stop! #'svc-vault-open)`)
+		defer eval(t, e, `(rpc/stop! #'svc-vault-peek)`)
+
+		r.True(core.Equals(e, core.MakeKeyword("denied"), eval(t, e, `(try (rpc/call "svc.vault" :open) (catch Error e :denied))`)))
+		r.True(core.Equals(e, core.MakeKeyword("peeked"), eval(t, e, `(rpc/call "svc.vault" :peek)`)))
+	})
+
+	t.Run("stops endpoints at the end of with-endpoints", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+
+		obj := eval(t, e, `(rpc/with-endpoints [(rpc/serve "math" :double nil (fn [x] (* 2 x)))]
+		                    (rpc/call "math" :double 21))`)
+		r.Equal(core.MakeInt(42), obj)
+
+		r.Equal(core.MakeInt(0), eval(t, e, `(count (rpc/find {:service "math"}))`))
+	})
+
+	t.Run("marshals requests with the configured format", func(t *testing.T) {
+		r := require.New(t)
+
+		t.Setenv("LACE_RPC_FORMAT", "edn")
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/defendpoint svc.greeter greet [who] {:greeting (str "hello " (name who))})`)
+		defer eval(t, e, `(rpc/stop! #'svc-greeter-greet)`)
+
+		conn, err := prpc.InitBusConnection(e)
+		r.NoError(err)
+		r.Equal(marshal.EDN, conn.Format())
+
+		r.Equal(core.MakeString("hello bob"), eval(t, e, `(:greeting (rpc/call "svc.greeter" :greet :bob))`))
+	})
+
+	t.Run("handles the jobs of durable queues, retrying the failed ones", func(t *testing.T) {
+		r := require.New(t)
+
+		t.Setenv("LACE_RPC_STORE", t.TempDir())
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		defer closeBus(t, e)
+
+		eval(t, e, `(def done (chan 1))`)
+		eval(t, e, `(def tries (atom 0))`)
+		eval(t, e, `(rpc/enqueue :builds {:id 1})`)
+		eval(t, e, `(def c (rpc/consume :builds (fn [job]
+		                                         (when (= 1 (swap! tries inc))
+		                                           (throw (ex-info "flaky" {})))
+		                                         (>! done (:id job)))))`)
+		defer eval(t, e, `(rpc/stop! c)`)
+
+		r.Equal(core.MakeInt(1), eval(t, e, `(<! done)`))
+		r.Equal(core.MakeInt(2), eval(t, e, `@tries`))
+	})
+
+	t.Run("keeps queues under the home directory by default", func(t *testing.T) {
+		r := require.New(t)
+
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(rpc/enqueue :reports {:id 1})`)
+
+		closeBus(t, e)
+
+		_, err = os.Stat(filepath.Join(home, ".laced", "bus", "jetstream"))
+		r.NoError(err)
+	})
+
+	t.Run("delivers published values to subscribed channels", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+		eval(t, e, `(def news (rpc/subscribe :news))`)
+
+		eval(t, e, `(rpc/publish :news "extra")`)
+		r.Equal(core.MakeString("extra"), eval(t, e, `(<! news)`))
+
+		eval(t, e, `(rpc/unsubscribe news)`)
+		r.Equal(core.NIL, eval(t, e, `(<! news)`))
+	})
+
+	t.Run("runs code on nodes", func(t *testing.T) {
+		r := require.New(t)
+
+		e, err := core.NewEnv()
+		r.NoError(err)
+
+		eval(t, e, `(require '[lace.rpc :as rpc])`)
+
+		conn, err := prpc.InitBusConnection(e)
+		r.NoError(err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go conn.ServeNode(ctx, "worker", []string{conn.Identity().Key()})
+
+		require.Eventually(t, func() bool {
+			return eval(t, e, `(count (rpc/find {:tag "node=worker"}))`) == core.MakeInt(1)
+		}, 3*time.Second, 10*time.Millisecond)
+
+		r.Equal(core.MakeInt(3), eval(t, e, `(rpc/eval-on :worker '(+ 1 2))`))
+		r.Equal(core.MakeInt(15), eval(t, e, `(let [x 10] (<! (rpc/spawn-on "worker" (fn [y] (+ x y)) 5)))`))
+
+		obj := eval(t, e, `(try (rpc/eval-on :worker '(throw (ex-info "boom" {:x 1}))) (catch Error e (:x (ex-data e))))`)
+		r.Equal(core.MakeInt(1), obj)
+	})
+}
+
+// closeBus shuts down the bus started in process, so that its store can be
+// removed.
+func closeBus(t *testing.T, e *core.Env) {
+	vr := e.EnsureNamespace(core.MakeSymbol("lace.rpc")).Resolve("*bus*")
+	require.NotNil(t, vr)
+
+	bus, ok := vr.GetStatic().(*prpc.Bus)
+	require.True(t, ok)
+
+	bus.Close()
+}